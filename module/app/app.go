@@ -100,12 +100,17 @@ import (
 
 	gravityparams "github.com/althea-net/cosmos-gravity-bridge/module/app/params"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity"
+	gravityclient "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
 	gravitytypes "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
 const appName = "app"
 
+// PoolPriorityUpgradeName is the software upgrade that re-keys the outgoing pool of a chain started before
+// transfers had a priority, and sets the parameters added since to their defaults
+const PoolPriorityUpgradeName = "pool-priority"
+
 var (
 	// DefaultNodeHome sets the folder where the applcation data and configuration will be stored
 	DefaultNodeHome string
@@ -126,6 +131,13 @@ var (
 			distrclient.ProposalHandler,
 			upgradeclient.ProposalHandler,
 			upgradeclient.CancelProposalHandler,
+			gravityclient.BridgeMigrationProposalHandler,
+			gravityclient.AttestationVetoProposalHandler,
+			gravityclient.EvacuatePoolProposalHandler,
+			gravityclient.CancelOutgoingBatchProposalHandler,
+			gravityclient.BridgeInstanceResetProposalHandler,
+			gravityclient.ResolveEventNonceProposalHandler,
+			gravityclient.ReleaseQuarantinedDepositProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -351,12 +363,31 @@ func NewGravityApp(
 		scopedIBCKeeper,
 	)
 
+	app.gravityKeeper = keeper.NewKeeper(
+		appCodec,
+		keys[gravitytypes.StoreKey],
+		app.GetSubspace(gravitytypes.ModuleName),
+		stakingKeeper,
+		app.bankKeeper,
+		app.slashingKeeper,
+	)
+
+	app.upgradeKeeper.SetUpgradeHandler(PoolPriorityUpgradeName, func(ctx sdk.Context, _ upgradetypes.Plan) {
+		app.gravityKeeper.MigrateMissingParams(ctx)
+		if err := app.gravityKeeper.MigratePoolKeys(ctx); err != nil {
+			panic(err)
+		}
+	})
+
+	// the gravity keeper is created before the gov router so that gravity proposals can be routed
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramsproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
+		AddRoute(paramsproposal.RouterKey, gravity.NewParamChangeProposalHandler(app.gravityKeeper,
+			params.NewParamChangeProposalHandler(app.paramsKeeper))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
-		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.ibcKeeper.ClientKeeper))
+		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.ibcKeeper.ClientKeeper)).
+		AddRoute(gravitytypes.RouterKey, gravity.NewGravityProposalHandler(app.gravityKeeper))
 
 	app.govKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -401,15 +432,6 @@ func NewGravityApp(
 		app.transferKeeper,
 	)
 
-	app.gravityKeeper = keeper.NewKeeper(
-		appCodec,
-		keys[gravitytypes.StoreKey],
-		app.GetSubspace(gravitytypes.ModuleName),
-		stakingKeeper,
-		app.bankKeeper,
-		app.slashingKeeper,
-	)
-
 	app.stakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
 			app.distrKeeper.Hooks(),
@@ -554,11 +576,14 @@ func NewGravityApp(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetAnteHandler(
-		ante.NewAnteHandler(
-			app.accountKeeper,
-			app.bankKeeper,
-			ante.DefaultSigVerificationGasConsumer,
-			encodingConfig.TxConfig.SignModeHandler(),
+		gravity.NewAnteHandler(
+			ante.NewAnteHandler(
+				app.accountKeeper,
+				app.bankKeeper,
+				ante.DefaultSigVerificationGasConsumer,
+				encodingConfig.TxConfig.SignModeHandler(),
+			),
+			app.gravityKeeper,
 		),
 	)
 	app.SetEndBlocker(app.EndBlocker)
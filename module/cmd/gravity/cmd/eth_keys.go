@@ -2,18 +2,23 @@ package cmd
 
 import (
 	"crypto/ecdsa"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/cli"
+
+	gravitytypes "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
 const flagPassphrase = "passphrase"
@@ -35,6 +40,7 @@ The keyring supports the following backends:
 
 	cmd.AddCommand(
 		AddKeyCommand(),
+		SignValidatorAddressCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
@@ -65,6 +71,36 @@ func AddKeyCommand() *cobra.Command {
 	return cmd
 }
 
+// SignValidatorAddressCommand defines a keys command to prove control of an ethereum key for MsgSetOrchestratorAddress
+func SignValidatorAddressCommand() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "sign-validator-address [validator-address] [ethereum-private-key]",
+		Short: "Sign a validator operator address with an ethereum key",
+		Long: `Print the hex encoded signature that proves to set-orchestrator-address and gentx that the
+validator controls the ethereum key. The private key is the hex encoded one printed by "eth_keys add".
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(args[1], "0x"))
+			if err != nil {
+				return err
+			}
+			signature, err := gravitytypes.NewEthereumSignature(gravitytypes.DelegateKeysSignHash(validator), privateKey)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), hex.EncodeToString(signature))
+			return err
+		},
+	}
+	return cmd
+}
+
 type EthereumKeyOutput struct {
 	PublicKey  string `json:"public_key"`
 	PrivateKey string `json:"private_key"`
@@ -44,17 +44,19 @@ func GenTxCmd(mbm module.BasicManager, txEncCfg client.TxEncodingConfig, genBalI
 
 	//nolint: exhaustivestruct
 	cmd := &cobra.Command{
-		Use:   "gentx [key_name] [amount] [eth-address] [orchestrator-address]",
+		Use:   "gentx [key_name] [amount] [eth-address] [orchestrator-address] [eth-signature]",
 		Short: "Generate a genesis tx carrying a self delegation, oracle key delegation and orchestrator key delegation",
-		Args:  cobra.ExactArgs(4),
+		Args:  cobra.ExactArgs(5),
 		Long: fmt.Sprintf(`Generate a genesis transaction that creates a validator with a self-delegation, oracle key 
 delegation and orchestrator key delegation that is signed by the key in the Keyring referenced by a given name. A node 
 ID and Bech32 consensus pubkey may optionally be provided. If they are omitted, they will be retrieved from the 
-priv_validator.json file. The following default parameters are included:
+priv_validator.json file. The eth signature proves control of the eth address, create it with
+"eth_keys sign-validator-address" for the validator operator address of the key. The following default
+parameters are included:
     %s
 
 Example:
-$ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn --home=/path/to/home/dir --keyring-backend=os --chain-id=test-chain-1 \
+$ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn 1b3d...c41c --home=/path/to/home/dir --keyring-backend=os --chain-id=test-chain-1 \
     --moniker="myvalidator" \
     --commission-max-change-rate=0.01 \
     --commission-max-rate=1.0 \
@@ -178,6 +180,10 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 				Validator:    sdk.ValAddress(key.GetAddress()).String(),
 				Orchestrator: orchAddress.String(),
 				EthAddress:   ethAddress,
+				EthSignature: args[4],
+			}
+			if err := delegateKeySetMsg.ValidateBasic(); err != nil {
+				return errors.Wrap(err, "invalid orchestrator key delegation")
 			}
 
 			msgs := []sdk.Msg{msg, delegateKeySetMsg}
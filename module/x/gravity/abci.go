@@ -2,23 +2,102 @@ package gravity
 
 import (
 	"sort"
+	"strconv"
+	"time"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.SetLastBlockHeader(ctx)
+	// subscribers of the block events learn the version of the typed events below from the gravity event
+	ctx.EventManager().EmitEvent(types.NewEventVersionEvent())
 	params := k.GetParams(ctx)
-	slashing(ctx, k)
-	attestationTally(ctx, k)
+	// the slashing scans, attestation handling, valset creation and pruning each run within the gas
+	// budget params set for them, so that bridge housekeeping can not hold up the chain. Their work is
+	// done in units that are kept when the budget runs out, the next block resumes after the last one
+	k.RunWithGasBudget(ctx, "slashing", params.SlashingGasBudget, func(ctx sdk.Context) {
+		slashing(ctx, k)
+	})
+	k.RunWithGasBudget(ctx, "attestation_tally", params.AttestationGasBudget, func(ctx sdk.Context) {
+		attestationTally(ctx, k)
+	})
+	k.RunWithGasBudget(ctx, "attestation_execution", params.AttestationGasBudget, func(ctx sdk.Context) {
+		executeQueuedAttestations(ctx, k, params)
+	})
+	checkEventNonceStall(ctx, k)
+	updateObservedEthereumHeight(ctx, k)
+	calibrateEthereumBlockTime(ctx, k)
 	cleanupTimedOutBatches(ctx, k)
 	cleanupTimedOutLogicCalls(ctx, k)
-	createValsets(ctx, k)
-	pruneValsets(ctx, k, params)
-	pruneAttestations(ctx, k)
+	refundStalledPool(ctx, k)
+	refundExpiredPool(ctx, k)
+	advanceBridgeMigration(ctx, k)
+	k.RunWithGasBudget(ctx, "valset_creation", params.ValsetCreationGasBudget, func(ctx sdk.Context) {
+		k.RunBudgetedUnit(ctx, func(ctx sdk.Context) {
+			createValsets(ctx, k)
+		})
+	})
+	k.RunWithGasBudget(ctx, "pruning", params.PruningGasBudget, func(ctx sdk.Context) {
+		pruneValsets(ctx, k, params)
+		pruneAttestations(ctx, k, params)
+		k.PruneBridgeStats(ctx)
+		k.PruneRefundReceipts(ctx)
+		k.PruneDepositReceipts(ctx)
+		pruneBatchHistory(ctx, k)
+	})
+}
+
+// executeQueuedAttestations applies observed attestations to the state in event nonce order, at most
+// AttestationExecutionBudget of them per block, the rest wait in the queue for the following blocks
+func executeQueuedAttestations(ctx sdk.Context, k keeper.Keeper, params types.Params) {
+	k.ExecuteQueuedAttestations(ctx, params.AttestationExecutionBudget)
+}
+
+// checkEventNonceStall alerts once the event nonce after the last observed one has been waiting longer than
+// EventNonceStallThreshold blocks for attestation consensus
+func checkEventNonceStall(ctx sdk.Context, k keeper.Keeper) {
+	k.CheckEventNonceStall(ctx)
+}
+
+// updateObservedEthereumHeight moves the observed Ethereum height to the power weighted median of the
+// heights reported by the bonded validators, so that a single orchestrator can not skew it, and alerts on
+// the validators that drifted too far behind that median
+func updateObservedEthereumHeight(ctx sdk.Context, k keeper.Keeper) {
+	k.UpdateObservedEthereumHeight(ctx)
+	k.CheckEthereumHeightDrift(ctx)
+}
+
+// calibrateEthereumBlockTime periodically recalibrates the Ethereum block time heights are projected with from
+// the Ethereum heights and timestamps of observed claims
+func calibrateEthereumBlockTime(ctx sdk.Context, k keeper.Keeper) {
+	k.CalibrateEthereumBlockTime(ctx)
+}
+
+// refundStalledPool returns the transfers waiting in the pool to their senders, a bounded number per block,
+// once too few orchestrators sent a heartbeat within the StallRefundThreshold to confirm anything
+func refundStalledPool(ctx sdk.Context, k keeper.Keeper) {
+	k.RefundStalledPool(ctx)
+}
+
+// refundExpiredPool returns the transfers that waited in the pool for longer than the PoolTxTimeout to
+// their senders, a bounded number per block
+func refundExpiredPool(ctx sdk.Context, k keeper.Keeper) {
+	k.RefundExpiredPool(ctx)
+}
+
+// advanceBridgeMigration emits the migration valset once a governance approved contract
+// migration has finished draining the old contract, it runs after the timeout cleanup so
+// that batches and logic calls which timed out in this block no longer hold it back
+func advanceBridgeMigration(ctx sdk.Context, k keeper.Keeper) {
+	k.AdvanceBridgeMigration(ctx)
 }
 
 func createValsets(ctx sdk.Context, k keeper.Keeper) {
@@ -28,13 +107,17 @@ func createValsets(ctx sdk.Context, k keeper.Keeper) {
 	// 2. If there is at least one validator who started unbonding in current block. (we persist last unbonded block height in hooks.go)
 	//      This will make sure the unbonding validator has to provide an attestation to a new Valset
 	//	    that excludes him before he completely Unbonds.  Otherwise he will be slashed
-	// 3. If power change between validators of CurrentValset and latest valset request is > 5%
+	// 3. If power change between validators of CurrentValset and latest valset request is > ValsetPowerChangeThreshold
+	// 4. If the latest valset request is MaxValsetInterval blocks old, so that the valset on Ethereum can not go
+	//    stale on a chain where the power barely moves
 
 	// get the last valsets to compare against
 	latestValset := k.GetLatestValset(ctx)
 	lastUnbondingHeight := k.GetLastUnBondingBlockHeight(ctx)
+	params := k.GetParams(ctx)
 
 	significantPowerDiff := false
+	intervalElapsed := false
 	if latestValset != nil {
 		intCurrMembers, err := types.BridgeValidators(k.GetCurrentValset(ctx).Members).ToInternal()
 		if err != nil {
@@ -45,10 +128,12 @@ func createValsets(ctx sdk.Context, k keeper.Keeper) {
 			panic(sdkerrors.Wrap(err, "invalid latest valset members"))
 		}
 
-		significantPowerDiff = intCurrMembers.PowerDiff(*intLatestMembers) > 0.05
+		powerDiff := sdk.MustNewDecFromStr(strconv.FormatFloat(intCurrMembers.PowerDiff(*intLatestMembers), 'f', sdk.Precision, 64))
+		significantPowerDiff = powerDiff.GT(params.ValsetPowerChangeThreshold)
+		intervalElapsed = params.MaxValsetInterval != 0 && uint64(ctx.BlockHeight()) >= latestValset.Height+params.MaxValsetInterval
 	}
 
-	if (latestValset == nil) || (lastUnbondingHeight == uint64(ctx.BlockHeight())) || significantPowerDiff {
+	if (latestValset == nil) || (lastUnbondingHeight == uint64(ctx.BlockHeight())) || significantPowerDiff || intervalElapsed {
 		// if the conditions are true, put in a new validator set request to be signed and submitted to Ethereum
 		k.SetValsetRequest(ctx)
 	}
@@ -56,22 +141,19 @@ func createValsets(ctx sdk.Context, k keeper.Keeper) {
 
 func pruneValsets(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	// Validator set pruning
-	// prune all validator sets with a nonce less than the
-	// last observed nonce, they can't be submitted any longer
+	// prune all validator sets with a nonce more than ValsetRetention below the
+	// last observed nonce, they can't be submitted any longer and are only kept
+	// around for the HistoricalValsets query
 	//
 	// Only prune valsets after the signed valsets window has passed
 	// so that slashing can occur the block before we remove them
 	lastObserved := k.GetLastObservedValset(ctx)
 	currentBlock := uint64(ctx.BlockHeight())
 	tooEarly := currentBlock < params.SignedValsetsWindow
-	if lastObserved != nil && !tooEarly {
+	if lastObserved != nil && !tooEarly && lastObserved.Nonce > params.ValsetRetention {
 		earliestToPrune := currentBlock - params.SignedValsetsWindow
-		sets := k.GetValsets(ctx)
-		for _, set := range sets {
-			if set.Nonce < lastObserved.Nonce && set.Height < earliestToPrune {
-				k.DeleteValset(ctx, set.Nonce)
-			}
-		}
+		// this resumes from where the last block stopped, at most MaxPrunedPerBlock valsets are removed per block
+		k.PruneValsets(ctx, lastObserved.Nonce-params.ValsetRetention, earliestToPrune)
 	}
 }
 
@@ -83,6 +165,7 @@ func slashing(ctx sdk.Context, k keeper.Keeper) {
 	ValsetSlashing(ctx, k, params)
 	BatchSlashing(ctx, k, params)
 	LogicCallSlashing(ctx, k, params)
+	ClaimSlashing(ctx, k, params)
 
 }
 
@@ -124,29 +207,50 @@ func attestationTally(ctx sdk.Context, k keeper.Keeper) {
 			// If no attestation becomes observed, when we get to the next nonce, every attestation in
 			// it will be skipped. The same will happen for every nonce after that.
 			if nonce == uint64(k.GetLastObservedEventNonce(ctx))+1 {
-				k.TryAttestation(ctx, &att)
+				// the last observed event nonce is the cursor of the tally, each attestation is counted on its own
+				att := att
+				k.RunBudgetedUnit(ctx, func(ctx sdk.Context) {
+					k.TryAttestation(ctx, &att)
+				})
 			}
 		}
 	}
 }
 
-// cleanupTimedOutBatches deletes batches that have passed their expiration on Ethereum
+// cleanupTimedOutBatches deletes batches that have passed their expiration on Ethereum and returns their
+// transactions to the pool
 // keep in mind several things when modifying this function
 // A) unlike nonces timeouts are not monotonically increasing, meaning batch 5 can have a later timeout than batch 6
 //    this means that we MUST only cleanup a single batch at a time
 // B) it is possible for ethereumHeight to be zero if no events have ever occurred, make sure your code accounts for this
 // C) When we compute the timeout we do our best to estimate the Ethereum block height at that very second. But what we work with
-//    here is the Ethereum block height at the time of the last Deposit or Withdraw to be observed. It's very important we do not
-//    project, if we do a slowdown on ethereum could cause a double spend. Instead timeouts will *only* occur after the timeout period
-//    AND any deposit or withdraw has occurred to update the Ethereum block height.
+//
+//	here is the power weighted median of the Ethereum heights reported by the validators. It's very important we do not
+//	project, if we do a slowdown on ethereum could cause a double spend. Instead timeouts will *only* occur after the timeout period
+//	AND a majority of the validators have reported an Ethereum block height past it.
+//
+// D) an observed batch executed event may still be waiting in the attestation execution queue, so nothing is
+//    cleaned up until the queue has drained, otherwise an executed batch could be canceled and refunded
+// E) once every timed out batch is canceled a new batch is built for each token that had one, so the returned
+//    transactions do not wait in the pool for a batch request
 func cleanupTimedOutBatches(ctx sdk.Context, k keeper.Keeper) {
+	if k.HasQueuedAttestations(ctx) {
+		return
+	}
 	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight
 	batches := k.GetOutgoingTxBatches(ctx)
+	var timedOutTokens []types.EthAddress
+	seen := make(map[string]bool)
 	for _, batch := range batches {
 		if batch.BatchTimeout < ethereumHeight {
-			k.CancelOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce)
+			k.TimeoutOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce)
+			if !seen[batch.TokenContract.GetAddress()] {
+				seen[batch.TokenContract.GetAddress()] = true
+				timedOutTokens = append(timedOutTokens, batch.TokenContract)
+			}
 		}
 	}
+	k.RebuildTimedOutBatches(ctx, timedOutTokens)
 }
 
 // cleanupTimedOutBatches deletes logic calls that have passed their expiration on Ethereum
@@ -155,10 +259,16 @@ func cleanupTimedOutBatches(ctx sdk.Context, k keeper.Keeper) {
 //    this means that we MUST only cleanup a single call at a time
 // B) it is possible for ethereumHeight to be zero if no events have ever occurred, make sure your code accounts for this
 // C) When we compute the timeout we do our best to estimate the Ethereum block height at that very second. But what we work with
-//    here is the Ethereum block height at the time of the last Deposit or Withdraw to be observed. It's very important we do not
-//    project, if we do a slowdown on ethereum could cause a double spend. Instead timeouts will *only* occur after the timeout period
-//    AND any deposit or withdraw has occurred to update the Ethereum block height.
+//
+//	here is the power weighted median of the Ethereum heights reported by the validators. It's very important we do not
+//	project, if we do a slowdown on ethereum could cause a double spend. Instead timeouts will *only* occur after the timeout period
+//	AND a majority of the validators have reported an Ethereum block height past it.
+//
+// D) as with batches nothing is cleaned up until the attestation execution queue has drained
 func cleanupTimedOutLogicCalls(ctx sdk.Context, k keeper.Keeper) {
+	if k.HasQueuedAttestations(ctx) {
+		return
+	}
 	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight
 	calls := k.GetOutgoingLogicCalls(ctx)
 	for _, call := range calls {
@@ -179,89 +289,23 @@ func ValsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	// unslashedValsets are sorted by nonce in ASC order
 	// Question: do we need to sort each time? See if this can be epoched
 	for _, vs := range unslashedValsets {
-		confirms := k.GetValsetConfirms(ctx, vs.Nonce)
-
-		// SLASH BONDED VALIDTORS who didn't attest valset request
-		currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
-		for _, val := range currentBondedSet {
-			consAddr, _ := val.GetConsAddr()
-			valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
-
-			//  Slash validator ONLY if he joined before valset is created
-			if exist && uint64(valSigningInfo.StartHeight) < vs.Height {
-				// Check if validator has confirmed valset or not
-				found := false
-				for _, conf := range confirms {
-					// problem site for delegate key rotation, see issue #344
-					ethAddress, foundEthAddress := k.GetEthAddressByValidator(ctx, val.GetOperator())
-					if foundEthAddress && conf.EthAddress == ethAddress.GetAddress() {
-						found = true
-						break
-					}
-				}
-				// slash validators for not confirming valsets
-				if !found {
-					cons, _ := val.GetConsAddr()
-					k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionValset)
-					if !val.IsJailed() {
-						k.StakingKeeper.Jail(ctx, cons)
-						// Our unbonding hook SHOULD be triggered after the above jail
-						// but is not when triggered by the endblocker TODO investigate why
-						k.SetLastUnBondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
-					}
-
-				}
-			}
-		}
-
-		// SLASH UNBONDING VALIDATORS who didn't attest valset request
-		blockTime := ctx.BlockTime().Add(k.StakingKeeper.GetParams(ctx).UnbondingTime)
-		blockHeight := ctx.BlockHeight()
-		unbondingValIterator := k.StakingKeeper.ValidatorQueueIterator(ctx, blockTime, blockHeight)
-		defer unbondingValIterator.Close()
-
-		// All unbonding validators
-		for ; unbondingValIterator.Valid(); unbondingValIterator.Next() {
-			unbondingValidators := k.DeserializeValidatorIterator(unbondingValIterator.Value())
-
-			for _, valAddr := range unbondingValidators.Addresses {
-				addr, err := sdk.ValAddressFromBech32(valAddr)
-				if err != nil {
-					panic(err)
-				}
-				validator, _ := k.StakingKeeper.GetValidator(ctx, sdk.ValAddress(addr))
-				valConsAddr, _ := validator.GetConsAddr()
-				valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, valConsAddr)
-
-				// Only slash validators who joined after valset is created and they are unbonding and UNBOND_SLASHING_WINDOW didn't passed
-				if exist && valSigningInfo.StartHeight < int64(vs.Height) && validator.IsUnbonding() && vs.Height < uint64(validator.UnbondingHeight)+params.UnbondSlashingValsetsWindow {
-					// Check if validator has confirmed valset or not
-					found := false
-					for _, conf := range confirms {
-						// TODO this presents problems for delegate key rotation see issue #344
-						confVal, _ := sdk.AccAddressFromBech32(conf.Orchestrator)
-						valAddr, foundValidator := k.GetOrchestratorValidator(ctx, confVal)
-						if foundValidator && valAddr.GetOperator().Equals(validator.GetOperator()) {
-							found = true
-							break
-						}
-					}
-
-					// slash validators for not confirming valsets
-					if !found {
-						k.StakingKeeper.Slash(ctx, valConsAddr, ctx.BlockHeight(), validator.ConsensusPower(), params.SlashFractionValset)
-						if !validator.IsJailed() {
-							k.StakingKeeper.Jail(ctx, valConsAddr)
-							// Our unbonding hook SHOULD be triggered after the above jail
-							// but is not when triggered by the endblocker TODO investigate why
-							k.SetLastUnBondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
-						}
-					}
+		vs := vs
+		k.RunBudgetedUnit(ctx, func(ctx sdk.Context) {
+			// SLASH BONDED AND UNBONDING VALIDATORS who didn't attest valset request, an unbonding validator only
+			// until UnbondSlashingValsetsWindow blocks after it started unbonding
+			for _, val := range k.GetValsetNonSigners(ctx, vs) {
+				cons, _ := val.GetConsAddr()
+				k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionValset)
+				if !val.IsJailed() {
+					k.StakingKeeper.Jail(ctx, cons)
+					// Our unbonding hook SHOULD be triggered after the above jail
+					// but is not when triggered by the endblocker TODO investigate why
+					k.SetLastUnBondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
 				}
 			}
-		}
-		// then we set the latest slashed valset  nonce
-		k.SetLastSlashedValsetNonce(ctx, vs.Nonce)
+			// then we set the latest slashed valset  nonce
+			k.SetLastSlashedValsetNonce(ctx, vs.Nonce)
+		})
 	}
 }
 
@@ -269,41 +313,41 @@ func BatchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 
 	// We look through the full bonded set (the active set)
 	// and we slash users who haven't signed a batch confirmation that is >15hrs in blocks old
-	maxHeight := uint64(0)
+	currentHeight := uint64(ctx.BlockHeight())
+	shortestWindow := k.GetShortestSignedBatchesWindow(ctx)
 
 	// don't slash in the beginning before there aren't even SignedBatchesWindow blocks yet
-	if uint64(ctx.BlockHeight()) > params.SignedBatchesWindow {
-		maxHeight = uint64(ctx.BlockHeight()) - params.SignedBatchesWindow
-	} else {
+	if currentHeight <= shortestWindow {
 		// we can't slash anyone if this window has not yet passed
 		return
 	}
+	maxHeight := currentHeight - shortestWindow
 
+	// every token may have its own window, so a batch past the shortest window may not be due yet. The last slashed
+	// batch block can not move past such a batch, the batches after it that were slashed for are marked instead
+	lastSlashedBatchBlock := k.GetLastSlashedBatchBlock(ctx)
+	pendingFrom := uint64(0)
 	unslashedBatches := k.GetUnSlashedBatches(ctx, maxHeight)
 	for _, batch := range unslashedBatches {
-
-		// SLASH BONDED VALIDTORS who didn't attest batch requests
-		currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
-		confirms := k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract)
-		for _, val := range currentBondedSet {
-			// Don't slash validators who joined after batch is created
-			consAddr, _ := val.GetConsAddr()
-			valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
-			if exist && valSigningInfo.StartHeight > int64(batch.Block) {
-				continue
+		if currentHeight-batch.Block <= k.GetSignedBatchesWindow(ctx, batch.TokenContract) {
+			if pendingFrom == 0 {
+				pendingFrom = batch.Block
 			}
+			continue
+		}
+		if batch.Block > lastSlashedBatchBlock {
+			lastSlashedBatchBlock = batch.Block
+		}
+		if k.IsBatchSlashed(ctx, batch) {
+			continue
+		}
 
-			found := false
-			for _, conf := range confirms {
-				// TODO this presents problems for delegate key rotation see issue #344
-				confVal, _ := sdk.AccAddressFromBech32(conf.Orchestrator)
-				valAddr, foundValidator := k.GetOrchestratorValidator(ctx, confVal)
-				if foundValidator && valAddr.GetOperator().Equals(val.GetOperator()) {
-					found = true
-					break
-				}
-			}
-			if !found {
+		// the slashed mark of the batch is the cursor until the last slashed batch block moves past it
+		batch := batch
+		k.RunBudgetedUnit(ctx, func(ctx sdk.Context) {
+			// SLASH BONDED AND UNBONDING VALIDATORS who didn't attest batch requests, leaving out those who joined
+			// after the batch was created or were unbonding for longer than UnbondSlashingValsetsWindow by then
+			for _, val := range k.GetBatchNonSigners(ctx, batch) {
 				cons, _ := val.GetConsAddr()
 				k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionBatch)
 				if !val.IsJailed() {
@@ -313,9 +357,19 @@ func BatchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 					k.SetLastUnBondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
 				}
 			}
-		}
-		// then we set the latest slashed batch block
-		k.SetLastSlashedBatchBlock(ctx, batch.Block)
+			k.SetBatchSlashed(ctx, batch)
+		})
+	}
+
+	// then we set the latest slashed batch block, below the first batch that is not due yet
+	if pendingFrom != 0 && lastSlashedBatchBlock >= pendingFrom {
+		lastSlashedBatchBlock = pendingFrom - 1
+	}
+	if lastSlashedBatchBlock > k.GetLastSlashedBatchBlock(ctx) {
+		k.RunBudgetedUnit(ctx, func(ctx sdk.Context) {
+			k.SetLastSlashedBatchBlock(ctx, lastSlashedBatchBlock)
+			k.PruneSlashedBatchMarks(ctx, lastSlashedBatchBlock)
+		})
 	}
 }
 
@@ -335,83 +389,109 @@ func LogicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 
 	unslashedLogicCalls := k.GetUnSlashedLogicCalls(ctx, maxHeight)
 	for _, call := range unslashedLogicCalls {
+		call := call
+		k.RunBudgetedUnit(ctx, func(ctx sdk.Context) {
+			// SLASH BONDED VALIDTORS who didn't attest batch requests
+			currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+			confirms := k.GetLogicConfirmByInvalidationIDAndNonce(ctx, call.InvalidationId, call.InvalidationNonce)
+			for _, val := range currentBondedSet {
+				// Don't slash validators who joined after batch is created
+				consAddr, _ := val.GetConsAddr()
+				valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+				if exist && valSigningInfo.StartHeight > int64(call.Block) {
+					continue
+				}
 
-		// SLASH BONDED VALIDTORS who didn't attest batch requests
-		currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
-		confirms := k.GetLogicConfirmByInvalidationIDAndNonce(ctx, call.InvalidationId, call.InvalidationNonce)
-		for _, val := range currentBondedSet {
-			// Don't slash validators who joined after batch is created
-			consAddr, _ := val.GetConsAddr()
-			valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
-			if exist && valSigningInfo.StartHeight > int64(call.Block) {
-				continue
-			}
-
-			found := false
-			for _, conf := range confirms {
-				// TODO this presents problems for delegate key rotation see issue #344
-				confVal, _ := sdk.AccAddressFromBech32(conf.Orchestrator)
-				valAddr, foundValidator := k.GetOrchestratorValidator(ctx, confVal)
-				if foundValidator && valAddr.GetOperator().Equals(val.GetOperator()) {
-					found = true
-					break
+				found := false
+				for _, conf := range confirms {
+					// TODO this presents problems for delegate key rotation see issue #344
+					confVal, _ := sdk.AccAddressFromBech32(conf.Orchestrator)
+					valAddr, foundValidator := k.GetOrchestratorValidator(ctx, confVal)
+					if foundValidator && valAddr.GetOperator().Equals(val.GetOperator()) {
+						found = true
+						break
+					}
 				}
-			}
-			if !found {
-				cons, _ := val.GetConsAddr()
-				k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionLogicCall)
-				if !val.IsJailed() {
-					k.StakingKeeper.Jail(ctx, cons)
-					// Our unbonding hook SHOULD be triggered after the above jail
-					// but is not when triggered by the endblocker TODO investigate why
-					k.SetLastUnBondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
+				if !found {
+					cons, _ := val.GetConsAddr()
+					k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionLogicCall)
+					if !val.IsJailed() {
+						k.StakingKeeper.Jail(ctx, cons)
+						// Our unbonding hook SHOULD be triggered after the above jail
+						// but is not when triggered by the endblocker TODO investigate why
+						k.SetLastUnBondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
+					}
 				}
 			}
-		}
-		// then we set the latest slashed logic call block
-		k.SetLastSlashedLogicCallBlock(ctx, call.Block)
+			// then we set the latest slashed logic call block
+			k.SetLastSlashedLogicCallBlock(ctx, call.Block)
+		})
 	}
 }
 
-// Iterate over all attestations currently being voted on in order of nonce
-// and prune those that are older than the current nonce and no longer have any
-// use. This could be combined with create attestation and save some computation
-// but (A) pruning keeps the iteration small in the first place and (B) there is
-// already enough nuance in the other handler that it's best not to complicate it further
-func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
-	attmap := k.GetAttestationMapping(ctx)
-	// We make a slice with all the event nonces that are in the attestation mapping
-	keys := make([]uint64, 0, len(attmap))
-	for k := range attmap {
-		keys = append(keys, k)
-	}
-	// Then we sort it
-	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
-
-	// we delete all attestations earlier than the current event nonce
-	// minus some buffer value. This buffer value is purely to allow
-	// frontends and other UI components to view recent oracle history
-	const eventsToKeep = 1000
-	lastNonce := uint64(k.GetLastObservedEventNonce(ctx))
-	var cutoff uint64
-	if lastNonce <= eventsToKeep {
+// ClaimSlashing slashes and jails the bonded validators that did not submit a claim for an event within
+// SignedClaimsWindow blocks of its attestation being created. Event nonces are checked in order once they are
+// observed and their window has passed. A validator that claimed a conflicting event at the same nonce did run its
+// oracle and is not slashed here, neither is one that joined after the attestation was created
+func ClaimSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
+	// claim slashing is disabled while the window is zero
+	if params.SignedClaimsWindow == 0 || uint64(ctx.BlockHeight()) <= params.SignedClaimsWindow {
 		return
-	} else {
-		cutoff = lastNonce - eventsToKeep
 	}
+	maxHeight := uint64(ctx.BlockHeight()) - params.SignedClaimsWindow
 
-	// This iterates over all keys (event nonces) in the attestation mapping. Each value contains
-	// a slice with one or more attestations at that event nonce. There can be multiple attestations
-	// at one event nonce when validators disagree about what event happened at that nonce.
-	for _, nonce := range keys {
-		// This iterates over all attestations at a particular event nonce.
-		// They are ordered by when the first attestation at the event nonce was received.
-		// This order is not important.
-		for _, att := range attmap[nonce] {
-			// delete all before the cutoff
-			if nonce < cutoff {
-				k.DeleteAttestation(ctx, att)
-			}
+	for _, nonce := range k.GetUnSlashedClaimNonces(ctx) {
+		att, voters := k.GetClaimVoters(ctx, nonce)
+		// the following event nonces are younger still, they are checked in a later block
+		if att != nil && att.Height > maxHeight {
+			return
 		}
+		nonce := nonce
+		k.RunBudgetedUnit(ctx, func(ctx sdk.Context) {
+			// a vetoed event was not a real one, nobody is slashed for not claiming it
+			if att != nil && !att.Vetoed {
+				currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+				for _, val := range currentBondedSet {
+					// Don't slash validators who joined after the attestation was created
+					consAddr, _ := val.GetConsAddr()
+					valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+					if exist && valSigningInfo.StartHeight > int64(att.Height) {
+						continue
+					}
+					if voters[val.GetOperator().String()] {
+						continue
+					}
+					k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionClaim)
+					if !val.IsJailed() {
+						k.StakingKeeper.Jail(ctx, consAddr)
+						// Our unbonding hook SHOULD be triggered after the above jail
+						// but is not when triggered by the endblocker TODO investigate why
+						k.SetLastUnBondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
+					}
+				}
+			}
+			// then we set the latest checked event nonce
+			k.SetLastSlashedClaimEventNonce(ctx, nonce)
+		})
 	}
 }
+
+// pruneAttestations deletes the attestations of events that were observed more than AttestationRetention
+// blocks ago, the retained ones allow frontends and other UI components to view recent oracle history
+func pruneAttestations(ctx sdk.Context, k keeper.Keeper, params types.Params) {
+	cutoff, earliestToKeep, ok := k.AttestationPruneCutoff(ctx, params)
+	if !ok {
+		return
+	}
+	// this resumes from where the last block stopped, so a large backlog is spread over several
+	// blocks with at most MaxPrunedPerBlock attestations removed per block
+	k.PruneAttestations(ctx, cutoff, earliestToKeep)
+}
+
+// pruneBatchHistory keeps the timed out batch and batch lifecycle history of the last batches created, it only
+// exists so that users can find out what became of the batch their withdrawal was in
+func pruneBatchHistory(ctx sdk.Context, k keeper.Keeper) {
+	const batchesToKeep = 1000
+	k.PruneTimedOutBatches(ctx, batchesToKeep)
+	k.PruneFinishedBatches(ctx, batchesToKeep)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -173,6 +174,63 @@ func TestValsetSlashing_UnbondingValidator_UnbondWindow_NotExpired(t *testing.T)
 	// check if tokens shouldn't be slashed for val2.
 }
 
+// Tests that an unbonding validator has to confirm the batches created within UnbondSlashingValsetsWindow blocks of it
+// starting to unbond, and is exempt from those created later
+func TestSigningObligations_UnbondingValidator(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+
+	unbondingHeight := ctx.BlockHeight() + 2
+	input.Context = ctx.WithBlockHeight(unbondingHeight)
+	sh := staking.NewHandler(input.StakingKeeper)
+	_, err := sh(input.Context, keeper.NewTestMsgUnDelegateValidator(keeper.ValAddrs[0], keeper.StakingAmount))
+	require.NoError(t, err)
+	staking.EndBlocker(input.Context, input.StakingKeeper)
+
+	exemptFrom := uint64(unbondingHeight) + params.UnbondSlashingValsetsWindow
+	ctx = ctx.WithBlockHeight(int64(exemptFrom) + 2)
+
+	unbonding := pk.GetUnbondingValidators(ctx)
+	require.Len(t, unbonding, 1)
+	require.Equal(t, keeper.ValAddrs[0], unbonding[0].GetOperator())
+	require.Equal(t, exemptFrom, pk.GetSigningExemptHeight(ctx, unbonding[0]))
+
+	// the validator left the bonded set, so it is no longer part of the valsets
+	require.Len(t, pk.GetCurrentValset(ctx).Members, len(keeper.ValAddrs)-1)
+
+	storeBatch := func(nonce uint64, block uint64) *types.InternalOutgoingTxBatch {
+		batch, err := types.NewInternalOutgingTxBatchFromExternalBatch(types.OutgoingTxBatch{
+			BatchNonce:    nonce,
+			Transactions:  []*types.OutgoingTransferTx{},
+			TokenContract: keeper.TokenContractAddrs[0],
+			Block:         block,
+		})
+		require.NoError(t, err)
+		pk.StoreBatchUnsafe(ctx, batch)
+		return batch
+	}
+	// nobody signs either batch
+	obligated := storeBatch(1, exemptFrom-1)
+	exempt := storeBatch(2, exemptFrom)
+
+	isNonSigner := func(batch *types.InternalOutgoingTxBatch) bool {
+		for _, val := range pk.GetBatchNonSigners(ctx, batch) {
+			if val.GetOperator().Equals(keeper.ValAddrs[0]) {
+				return true
+			}
+		}
+		return false
+	}
+	assert.True(t, isNonSigner(obligated))
+	assert.False(t, isNonSigner(exempt))
+	assert.Len(t, pk.GetBatchNonSigners(ctx, exempt), 4)
+
+	obligations := pk.GetSigningObligations(ctx, unbonding[0])
+	assert.Equal(t, exemptFrom, obligations.ExemptFromHeight)
+	assert.Equal(t, []types.BatchObligation{{TokenContract: obligated.TokenContract.GetAddress(), BatchNonce: 1}}, obligations.Batches)
+}
+
 func TestBatchSlashing(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	pk := input.GravityKeeper
@@ -237,6 +295,138 @@ func TestBatchSlashing(t *testing.T) {
 
 }
 
+// Tests that a batch of a token with a longer signing window is not slashed for before its window passed, and that it
+// holds back the last slashed batch block without the later batches of other tokens being slashed for twice
+func TestBatchSlashingTokenWindows(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.TokenSignedBatchesWindows = []types.TokenSignedBatchesWindow{
+		{TokenContract: keeper.TokenContractAddrs[1], SignedBatchesWindow: params.SignedBatchesWindow + 100},
+	}
+	pk.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedValsetsWindow) + 2)
+
+	storeBatch := func(nonce uint64, tokenContract string, block uint64) *types.InternalOutgoingTxBatch {
+		batch, err := types.NewInternalOutgingTxBatchFromExternalBatch(types.OutgoingTxBatch{
+			BatchNonce:    nonce,
+			Transactions:  []*types.OutgoingTransferTx{},
+			TokenContract: tokenContract,
+			Block:         block,
+		})
+		require.NoError(t, err)
+		pk.StoreBatchUnsafe(ctx, batch)
+		return batch
+	}
+	// only the first validator signs neither batch, jailing everyone would leave no valset to build
+	lenient := storeBatch(1, keeper.TokenContractAddrs[1], uint64(ctx.BlockHeight()-int64(params.SignedBatchesWindow+2)))
+	strict := storeBatch(2, keeper.TokenContractAddrs[0], uint64(ctx.BlockHeight()-int64(params.SignedBatchesWindow+1)))
+	for i, orch := range keeper.AccAddrs[1:] {
+		pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[i+1], orch)
+		for _, batch := range []*types.InternalOutgoingTxBatch{lenient, strict} {
+			pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+				Nonce:         batch.BatchNonce,
+				TokenContract: batch.TokenContract.GetAddress(),
+				EthSigner:     keeper.EthAddrs[i+1].String(),
+				Orchestrator:  orch.String(),
+				Signature:     "",
+			})
+		}
+	}
+
+	assert.Equal(t, params.SignedBatchesWindow+100, pk.GetSignedBatchesWindow(ctx, lenient.TokenContract))
+	assert.Equal(t, params.SignedBatchesWindow, pk.GetSignedBatchesWindow(ctx, strict.TokenContract))
+
+	EndBlocker(ctx, pk)
+
+	// the validator is slashed for the batch of the strict token only
+	require.True(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsJailed())
+	require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[1]).IsJailed())
+	assert.True(t, pk.IsBatchSlashed(ctx, strict))
+	assert.False(t, pk.IsBatchSlashed(ctx, lenient))
+	assert.Equal(t, lenient.Block-1, pk.GetLastSlashedBatchBlock(ctx))
+
+	// the remaining validators confirm the valset built meanwhile, so that only the batches are slashed for
+	valset := pk.GetLatestValset(ctx)
+	for i, orch := range keeper.AccAddrs[1:] {
+		ethAddr, err := types.NewEthAddress(keeper.EthAddrs[i+1].String())
+		require.NoError(t, err)
+		pk.SetValsetConfirm(ctx, *types.NewMsgValsetConfirm(valset.Nonce, *ethAddr, orch, "dummysig"))
+	}
+
+	// once the window of the lenient token passed its batch is slashed for as well and the marks are pruned
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 100)
+	EndBlocker(ctx, pk)
+	assert.Equal(t, strict.Block, pk.GetLastSlashedBatchBlock(ctx))
+	assert.False(t, pk.IsBatchSlashed(ctx, strict))
+	assert.False(t, pk.IsBatchSlashed(ctx, lenient))
+}
+
+// Tests that validators that did not claim an observed event are slashed once the claim window passed, unless they
+// claimed a conflicting event at the same nonce
+func TestClaimSlashing(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.SignedClaimsWindow = 10
+	pk.SetParams(ctx, params)
+	// the validators are bonded at the height of the test env, the claims come after
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 100)
+
+	attest := func(nonce uint64, amount int64, voters ...int) {
+		msg := types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    nonce,
+			TokenContract:  keeper.TokenContractAddrs[0],
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: keeper.EthAddrs[0].String(),
+			CosmosReceiver: keeper.AccAddrs[0].String(),
+			Orchestrator:   keeper.AccAddrs[0].String(),
+		}
+		any, err := codectypes.NewAnyWithValue(&msg)
+		require.NoError(t, err)
+		hash, err := msg.ClaimHash()
+		require.NoError(t, err)
+		votes := make([]string, 0, len(voters))
+		for _, i := range voters {
+			votes = append(votes, keeper.ValAddrs[i].String())
+		}
+		pk.SetAttestation(ctx, nonce, hash, &types.Attestation{
+			Votes:            votes,
+			Height:           uint64(ctx.BlockHeight()),
+			Claim:            any,
+			ClaimHashVersion: types.ClaimHashVersion,
+		})
+		pk.TryAttestation(ctx, pk.GetAttestation(ctx, nonce, hash))
+	}
+	// the first validator claims a conflicting event at nonce 1 and nothing at nonce 2
+	attest(1, 100, 1, 2, 3, 4)
+	attest(1, 200, 0)
+	attest(2, 100, 1, 2, 3, 4)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	// the second validator does not claim nonce 3, which is still within the window
+	attest(3, 100, 0, 2, 3, 4)
+	require.Equal(t, uint64(3), pk.GetLastObservedEventNonce(ctx))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 6)
+	ClaimSlashing(ctx, pk, pk.GetParams(ctx))
+
+	require.True(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsJailed())
+	for i := 1; i < len(keeper.ValAddrs); i++ {
+		require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[i]).IsJailed())
+	}
+	assert.Equal(t, uint64(2), pk.GetLastSlashedClaimEventNonce(ctx))
+
+	// disabled by a zero window
+	params = pk.GetParams(ctx)
+	params.SignedClaimsWindow = 0
+	pk.SetParams(ctx, params)
+	ClaimSlashing(ctx.WithBlockHeight(ctx.BlockHeight()+100), pk, pk.GetParams(ctx))
+	require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[1]).IsJailed())
+	assert.Equal(t, uint64(2), pk.GetLastSlashedClaimEventNonce(ctx))
+}
+
 func TestValsetEmission(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	pk := input.GravityKeeper
@@ -258,6 +448,45 @@ func TestValsetEmission(t *testing.T) {
 	require.True(t, len(valsets) == 2)
 }
 
+// Tests that a new valset is requested once the latest one is MaxValsetInterval blocks old, and that a power change
+// is measured against the ValsetPowerChangeThreshold param
+func TestValsetCreationThresholds(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.MaxValsetInterval = 10
+	pk.SetParams(ctx, params)
+
+	EndBlocker(ctx, pk)
+	require.Len(t, pk.GetValsets(ctx), 1)
+	height := ctx.BlockHeight()
+
+	// the power did not change, so nothing is requested until the interval elapsed
+	EndBlocker(ctx.WithBlockHeight(height+9), pk)
+	require.Len(t, pk.GetValsets(ctx), 1)
+	EndBlocker(ctx.WithBlockHeight(height+10), pk)
+	require.Len(t, pk.GetValsets(ctx), 2)
+	EndBlocker(ctx.WithBlockHeight(height+11), pk)
+	require.Len(t, pk.GetValsets(ctx), 2)
+
+	// a 2% power change is below the default threshold, but above a lowered one
+	vs := pk.GetCurrentValset(ctx)
+	internalMembers, err := types.BridgeValidators(vs.Members).ToInternal()
+	require.NoError(t, err)
+	delta := float64(internalMembers.TotalPower()) * 0.02
+	vs.Members[0].Power = uint64(float64(vs.Members[0].Power) - delta/2)
+	vs.Members[1].Power = uint64(float64(vs.Members[1].Power) + delta/2)
+	pk.StoreValset(ctx.WithBlockHeight(height+11), vs)
+	require.Len(t, pk.GetValsets(ctx), 3)
+	EndBlocker(ctx.WithBlockHeight(height+12), pk)
+	require.Len(t, pk.GetValsets(ctx), 3)
+
+	params.ValsetPowerChangeThreshold = sdk.NewDecWithPrec(1, 2)
+	pk.SetParams(ctx, params)
+	EndBlocker(ctx.WithBlockHeight(height+13), pk)
+	require.Len(t, pk.GetValsets(ctx), 4)
+}
+
 func TestValsetSetting(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	pk := input.GravityKeeper
@@ -287,6 +516,7 @@ func TestBatchTimeout(t *testing.T) {
 
 	require.Greater(t, params.AverageBlockTime, uint64(0))
 	require.Greater(t, params.AverageEthereumBlockTime, uint64(0))
+	require.Greater(t, params.EthereumTimeoutMargin, uint64(0))
 
 	// mint some vouchers first
 	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
@@ -320,8 +550,8 @@ func TestBatchTimeout(t *testing.T) {
 
 	b2, err2 := pk.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
 	require.NoError(t, err2)
-	// this is exactly block 500 plus twelve hours
-	require.Equal(t, b2.BatchTimeout, uint64(504))
+	// this is exactly block 500 plus the timeout margin
+	require.Equal(t, b2.BatchTimeout, 500+params.EthereumTimeoutMargin)
 
 	// make sure the batches got stored in the first place
 	gotFirstBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, b1.TokenContract, b1.BatchNonce)
@@ -329,12 +559,13 @@ func TestBatchTimeout(t *testing.T) {
 	gotSecondBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, b2.TokenContract, b2.BatchNonce)
 	require.NotNil(t, gotSecondBatch)
 
-	// when, way into the future
-	ctx = ctx.WithBlockTime(now)
-	ctx = ctx.WithBlockHeight(9)
+	// when, with a timeout way into the future
+	params.EthereumTimeoutMargin = 10000
+	pk.SetParams(ctx, params)
 
 	b3, err2 := pk.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
 	require.NoError(t, err2)
+	require.Equal(t, b3.BatchTimeout, uint64(10500))
 
 	EndBlocker(ctx, pk)
 
@@ -358,4 +589,72 @@ func TestBatchTimeout(t *testing.T) {
 	require.Nil(t, gotSecondBatch)
 	gotThirdBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, b3.TokenContract, b3.BatchNonce)
 	require.NotNil(t, gotThirdBatch)
+
+	// the transactions of the timed out batches are back in the pool, with an event for each of them
+	unbatched := make(map[uint64]bool)
+	for _, tx := range pk.GetUnbatchedTransactions(ctx) {
+		unbatched[tx.Id] = true
+	}
+	requeued := make(map[string]bool)
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeBatchTimeoutTxRequeued {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyOutgoingTXID {
+				requeued[string(attr.Value)] = true
+			}
+		}
+	}
+	for _, tx := range append(b1.Transactions, b2.Transactions...) {
+		assert.True(t, unbatched[tx.Id])
+		assert.True(t, requeued[fmt.Sprint(tx.Id)])
+	}
+	for _, tx := range b3.Transactions {
+		assert.False(t, unbatched[tx.Id])
+		assert.False(t, requeued[fmt.Sprint(tx.Id)])
+	}
+}
+
+func TestBatchTimeoutRebuild(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.RebuildTimedOutBatches = true
+	pk.SetParams(ctx, params)
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+		allVouchers         = sdk.NewCoins(sdk.NewInt64Coin(myTokenDenom, 99999))
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	for i, v := range []int64{2, 3, 2, 1} {
+		_, err := pk.AddToOutgoingPool(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, int64(i+100)), sdk.NewInt64Coin(myTokenDenom, v))
+		require.NoError(t, err)
+	}
+	// without an observed Ethereum height the batch times out as soon as one is observed
+	b1, err := pk.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	require.NoError(t, err)
+	require.Len(t, pk.GetUnbatchedTransactions(ctx), 2)
+
+	pk.SetLastObservedEthereumBlockHeight(ctx, 500)
+	EndBlocker(ctx, pk)
+
+	// the returned transactions are batched again right away, together with the rest of the pool
+	require.Nil(t, pk.GetOutgoingTXBatch(ctx, b1.TokenContract, b1.BatchNonce))
+	batches := pk.GetOutgoingTxBatches(ctx)
+	require.Len(t, batches, 1)
+	assert.Greater(t, batches[0].BatchNonce, b1.BatchNonce)
+	assert.Equal(t, 500+params.EthereumTimeoutMargin, batches[0].BatchTimeout)
+	assert.Len(t, batches[0].Transactions, 4)
+	assert.Empty(t, pk.GetUnbatchedTransactions(ctx))
 }
@@ -0,0 +1,41 @@
+package gravity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// NewAnteHandler runs authHandler and then checks the gravity messages of a transaction that CheckTx would otherwise
+// let into the mempool without running their handler
+func NewAnteHandler(authHandler sdk.AnteHandler, k keeper.Keeper) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		newCtx, err := authHandler(ctx, tx, simulate)
+		if err != nil {
+			return newCtx, err
+		}
+		return newCtx, checkValsetConfirms(newCtx, k, tx)
+	}
+}
+
+// checkValsetConfirms rejects a transaction at CheckTx if one of its MsgValsetConfirm can not be stored, most
+// importantly if its signature does not recover to the Ethereum address of the validator. The confirms bundled in a
+// MsgSubmitConfirms are left to its handler, which reports them one by one. DeliverTx checks confirms in the handler
+func checkValsetConfirms(ctx sdk.Context, k keeper.Keeper, tx sdk.Tx) error {
+	if !ctx.IsCheckTx() {
+		return nil
+	}
+	for _, msg := range tx.GetMsgs() {
+		var request interface{} = msg
+		if serviceMsg, ok := msg.(sdk.ServiceMsg); ok {
+			request = serviceMsg.Request
+		}
+		if confirm, ok := request.(*types.MsgValsetConfirm); ok {
+			if err := k.ValidateValsetConfirm(ctx, confirm); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// debugStoreEntry is a raw store entry with its value decoded, the key is left hex encoded since it is a
+// concatenation of the prefix and binary fields
+type debugStoreEntry struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// CmdDebug groups the commands that read entries straight out of the gravity store, they serve state
+// no query exposes, such as the raw pool ordering, and are meant for debugging only
+func CmdDebug() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:                        "debug",
+		Short:                      "Decode raw gravity store entries, use --height to read a past block",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		cmdDebugDump("dump-pool", "Dump the unbatched transaction pool in store order", types.OutgoingTXPoolKey,
+			func() codec.ProtoMarshaler { return &types.OutgoingTransferTx{} }),
+		cmdDebugDump("dump-batches", "Dump the outgoing transaction batches", types.OutgoingTXBatchKey,
+			func() codec.ProtoMarshaler { return &types.OutgoingTxBatch{} }),
+		cmdDebugDump("dump-attestations", "Dump the attestations in event nonce order", types.OracleAttestationKey,
+			func() codec.ProtoMarshaler { return &types.Attestation{} }),
+	)
+	return cmd
+}
+
+func cmdDebugDump(use, short string, prefix []byte, newValue func() codec.ProtoMarshaler) *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			//nolint: exhaustivestruct
+			res, err := clientCtx.QueryABCI(abci.RequestQuery{
+				Path:   fmt.Sprintf("/store/%s/subspace", types.StoreKey),
+				Data:   prefix,
+				Height: clientCtx.Height,
+			})
+			if err != nil {
+				return err
+			}
+			var pairs kv.Pairs
+			if err := pairs.Unmarshal(res.Value); err != nil {
+				return err
+			}
+
+			entries := make([]debugStoreEntry, 0, len(pairs.Pairs))
+			for _, pair := range pairs.Pairs {
+				value := newValue()
+				if err := value.Unmarshal(pair.Value); err != nil {
+					return fmt.Errorf("undecodable value under key %X: %w", pair.Key, err)
+				}
+				bz, err := clientCtx.JSONMarshaler.MarshalJSON(value)
+				if err != nil {
+					return err
+				}
+				entries = append(entries, debugStoreEntry{Key: hex.EncodeToString(pair.Key), Value: bz})
+			}
+
+			out, err := json.MarshalIndent(entries, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(out))
+			return err
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,434 @@
+package cli
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// FlagRefund refunds the transactions of a canceled batch instead of returning them to the pool
+const FlagRefund = "refund"
+
+// CmdSubmitBridgeMigrationProposal submits a governance proposal to migrate the bridge to a new Gravity contract
+func CmdSubmitBridgeMigrationProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gravity-bridge-migration [new-bridge-contract]",
+		Short: "Submit a proposal to migrate the bridge to a new Gravity contract",
+		Long: `Submit a proposal to migrate the bridge to a new Gravity contract. Once passed new sends and
+batches are refused until the pending batches and logic calls have drained, after which a migration
+valset is emitted. The new contract must be deployed with that valset and continue the event nonce
+sequence of the current contract, the module switches over once its MigrationCompletedClaim is observed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			newContract, err := types.NewEthAddress(args[0])
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewBridgeMigrationProposal(title, description, *newContract)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	return cmd
+}
+
+// CmdSubmitAttestationVetoProposal submits a governance proposal to veto a pending attestation
+func CmdSubmitAttestationVetoProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gravity-attestation-veto [event-nonce] [claim-hash]",
+		Short: "Submit a proposal to veto an unobserved attestation",
+		Long: `Submit a proposal to veto an unobserved attestation, identified by its event nonce and hex
+encoded claim hash. Once passed the attestation's votes are cleared and it can never be observed, which
+halts the processing of Ethereum events at that nonce. Use it when orchestrators are attesting to an
+event emitted by a compromised Ethereum contract.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			eventNonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			claimHash, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewAttestationVetoProposal(title, description, eventNonce, claimHash)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	return cmd
+}
+
+// CmdSubmitResolveEventNonceProposal submits a governance proposal to move the bridge past a stuck event nonce
+func CmdSubmitResolveEventNonceProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gravity-resolve-event-nonce [event-nonce] [claim-hash]",
+		Short: "Submit a proposal to resolve an event nonce whose attestations can not reach consensus",
+		Long: `Submit a proposal to resolve the event nonce after the last observed one, when the validators
+can not agree on it and every later Ethereum event is blocked. With the hex encoded claim hash of one of
+its attestations that attestation is observed and applied as if it had passed the vote. Without a claim
+hash the event nonce is skipped and none of its events is applied, which loses any deposit it carried.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			eventNonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			var claimHash []byte
+			if len(args) == 2 {
+				claimHash, err = hex.DecodeString(args[1])
+				if err != nil {
+					return err
+				}
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewResolveEventNonceProposal(title, description, eventNonce, claimHash)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	return cmd
+}
+
+// CmdSubmitReleaseQuarantinedDepositProposal submits a governance proposal to pay out a quarantined deposit
+func CmdSubmitReleaseQuarantinedDepositProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gravity-release-quarantined-deposit [event-nonce] [recipient]",
+		Short: "Submit a proposal to pay out a deposit held in quarantine",
+		Long: `Submit a proposal to pay out the deposit from the event at the given event nonce, which is held in the
+module account because its Ethereum sender is listed in the quarantined_eth_senders param. It is paid to the
+recipient, or to the receiver the deposit named if no recipient is given.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			eventNonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			var recipient sdk.AccAddress
+			if len(args) == 2 {
+				recipient, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewReleaseQuarantinedDepositProposal(title, description, eventNonce, recipient)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	return cmd
+}
+
+// CmdSubmitEvacuatePoolProposal submits a governance proposal to refund every pending transfer to Ethereum
+func CmdSubmitEvacuatePoolProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gravity-evacuate-pool",
+		Short: "Submit a proposal to cancel every unexecuted batch and refund the whole transaction pool",
+		Long: `Submit a proposal to cancel every unexecuted batch and refund every transaction in the pool to its
+sender. This is a last resort for when the Ethereum contract is known to be compromised and withdrawals must
+not be relayed, a canceled batch that is relayed anyway pays out funds that were already refunded.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewEvacuatePoolProposal(title, description)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	return cmd
+}
+
+// CmdSubmitCancelOutgoingBatchProposal submits a governance proposal to cancel an unexecuted batch
+func CmdSubmitCancelOutgoingBatchProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gravity-cancel-batch [token-contract] [batch-nonce]",
+		Short: "Submit a proposal to cancel an unexecuted batch",
+		Long: `Submit a proposal to cancel the unexecuted batch of token-contract with batch-nonce. Once passed its
+transactions go back into the pool, or with --refund are refunded to their senders. Use it when the ERC20 is
+exploited on Ethereum and its withdrawals must not be relayed, a canceled batch that is relayed anyway pays out
+funds that were returned to the pool or refunded.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenContract, err := types.NewEthAddress(args[0])
+			if err != nil {
+				return err
+			}
+			batchNonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			refund, err := cmd.Flags().GetBool(FlagRefund)
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCancelOutgoingBatchProposal(title, description, *tokenContract, batchNonce, refund)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(FlagRefund, false, "refund the transactions of the batch instead of returning them to the pool")
+	return cmd
+}
+
+// CmdSubmitBridgeInstanceResetProposal submits a governance proposal to accept the claims and confirms of the previous bridge instance
+func CmdSubmitBridgeInstanceResetProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gravity-bridge-instance-reset",
+		Short: "Submit a proposal to accept the claims and confirms produced before the chain was relaunched",
+		Long: `Submit a proposal to accept the claims and confirms produced for the previous bridge instance. A chain
+relaunched from an export refuses claims at event nonces that were already observed and confirms for valsets,
+batches and logic calls created before the relaunch. Only pass this when the relaunch continues the same bridge
+and those signatures are known to be valid.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewBridgeInstanceResetProposal(title, description)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	return cmd
+}
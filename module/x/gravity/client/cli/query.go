@@ -1,10 +1,15 @@
 package cli
 
 import (
+	"fmt"
+	"io/ioutil"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/spf13/cobra"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
@@ -24,7 +29,46 @@ func GetQueryCmd() *cobra.Command {
 		CmdGetValsetRequest(),
 		CmdGetValsetConfirm(),
 		CmdGetPendingValsetRequest(),
+		CmdGetValsetDeploymentArgs(),
+		CmdGetHistoricalValsets(),
+		CmdGetRelaySignatures(),
+		CmdGetSigningObligations(),
 		CmdGetPendingOutgoingTXBatchRequest(),
+		CmdGetOrchestratorSubmissions(),
+		CmdGetOrchestratorLiveness(),
+		CmdGetBridgeMigration(),
+		CmdGetBridgeStats(),
+		CmdGetBridgeTokenStats(),
+		CmdGetSolvencyReport(),
+		CmdGetBridgeStatus(),
+		CmdGetDepositByEthTxHash(),
+		CmdGetBatchLifecycle(),
+		CmdGetEventNonceGap(),
+		CmdGetFeeExemptSenders(),
+		CmdReplayAttestations(),
+		CmdSimulateProposal(),
+		CmdGetTimedOutBatches(),
+		CmdGetPendingBatchPreview(),
+		CmdGetSendToEthFeeSuggestion(),
+		CmdGetRefundReceipts(),
+		CmdGetDepositReceipts(),
+		CmdGetQuarantinedDeposits(),
+		CmdGetModuleSendGrants(),
+		CmdGetBridgeInstance(),
+		CmdGetAuditLog(),
+		CmdGetEthDestinationLabels(),
+		CmdGetCosmosOriginatedTokens(),
+		CmdGetUnbatchedTxsBySender(),
+		CmdGetUnbatchedTxs(),
+		CmdGetOutgoingTxBatches(),
+		CmdGetPendingSendToEthByReceiver(),
+		CmdGetFirstSendDelay(),
+		CmdGetObservedEthereumHeight(),
+		CmdGetEthereumGasPrice(),
+		CmdGetEthereumBlockTimeCalibration(),
+		CmdGetProjectedEthereumHeight(),
+		CmdGetAttestationVotes(),
+		CmdDebug(),
 		// CmdGetAllOutgoingTXBatchRequest(),
 		// CmdGetOutgoingTXBatchByNonceRequest(),
 		// CmdGetAllAttestationsRequest(),
@@ -185,6 +229,149 @@ func CmdGetPendingValsetRequest() *cobra.Command {
 	return cmd
 }
 
+func CmdGetOrchestratorSubmissions() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "orchestrator-submissions [bech32 orchestrator address]",
+		Short: "Get the last claimed event nonce and the confirms the module already has from an orchestrator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryOrchestratorSubmissionsRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.OrchestratorSubmissions(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetValsetDeploymentArgs() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "valset-deployment-args [optional nonce]",
+		Short: "Get the Gravity contract constructor arguments for the current valset, or for the valset of a nonce",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryValsetDeploymentArgsRequest{Nonce: 0}
+			if len(args) == 1 {
+				nonce, err := strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+				req.Nonce = nonce
+			}
+
+			res, err := queryClient.ValsetDeploymentArgs(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetHistoricalValsets() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "historical-valsets",
+		Short: "Query the valsets that were not pruned yet, oldest first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HistoricalValsets(cmd.Context(), &types.QueryHistoricalValsetsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "historical-valsets")
+	return cmd
+}
+
+func CmdGetRelaySignatures() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "relay-signatures [nonce] [optional token-contract]",
+		Short: "Get the signatures to relay the valset of a nonce, or the batch of a nonce for a token contract, in the order the Gravity contract takes them",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryRelaySignaturesRequest{ValsetNonce: nonce}
+			if len(args) == 2 {
+				req = &types.QueryRelaySignaturesRequest{BatchNonce: nonce, TokenContract: args[1]}
+			}
+
+			res, err := queryClient.RelaySignatures(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetSigningObligations() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "signing-obligations [optional validator-address]",
+		Short: "Get the valsets and batches a validator, or every bonded and unbonding validator, still has to confirm",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySigningObligationsRequest{}
+			if len(args) == 1 {
+				req.ValidatorAddress = args[0]
+			}
+
+			res, err := queryClient.SigningObligations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdGetPendingOutgoingTXBatchRequest() *cobra.Command {
 	//nolint: exhaustivestruct
 	cmd := &cobra.Command{
@@ -210,3 +397,933 @@ func CmdGetPendingOutgoingTXBatchRequest() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdGetOrchestratorLiveness() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "orchestrator-liveness [max-heartbeat-age]",
+		Short: "Summarize the latest heartbeats of the orchestrators of all bonded validators, max-heartbeat-age is in blocks",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryOrchestratorLivenessRequest{}
+			if len(args) == 1 {
+				age, err := strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+				req.MaxHeartbeatAge = age
+			}
+
+			res, err := queryClient.OrchestratorLiveness(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBridgeMigration() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "bridge-migration",
+		Short: "Query the in progress bridge contract migration, if any",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BridgeMigration(cmd.Context(), &types.QueryBridgeMigrationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBridgeStats() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "bridge-stats",
+		Short: "Query the deposits, withdrawals and unique senders of the last day and week",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BridgeStats(cmd.Context(), &types.QueryBridgeStatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBridgeTokenStats() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "bridge-token-stats [window-hours] [token-contract]",
+		Short: "Query the per token volume and unique senders of the last window-hours hours, at most a week",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			hours, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBridgeTokenStatsRequest{
+				WindowHours: hours,
+			}
+			if len(args) == 2 {
+				req.TokenContract = args[1]
+			}
+
+			res, err := queryClient.BridgeTokenStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetSolvencyReport() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "solvency-report",
+		Short: "Compare the escrow and supply of every bridged token with the transfers still owed on it",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SolvencyReport(cmd.Context(), &types.QuerySolvencyReportRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBridgeStatus() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "bridge-status",
+		Short: "Get an overview of the bridge, the observed Ethereum state, the valsets to relay, the pending batches and pool of every token and the module balances",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BridgeStatus(cmd.Context(), &types.QueryBridgeStatusRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetDepositByEthTxHash() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "deposit-by-eth-tx-hash [eth-tx-hash]",
+		Short: "Query the status of the deposit from Ethereum made by a transaction: pending, observed, minted or quarantined",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDepositByEthTxHashRequest{EthTxHash: args[0]}
+
+			res, err := queryClient.DepositByEthTxHash(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBatchLifecycle() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "batch-lifecycle [token-contract] [nonce]",
+		Short: "Query where a batch is in its life: the confirms and power it collected and whether it was executed, canceled or timed out",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryBatchLifecycleRequest{TokenContract: args[0], BatchNonce: nonce}
+
+			res, err := queryClient.BatchLifecycle(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetEventNonceGap() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "event-nonce-gap",
+		Short: "Query the lowest event nonce without attestation consensus and how many blocks it has been pending",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EventNonceGap(cmd.Context(), &types.QueryEventNonceGapRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetFeeExemptSenders() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "fee-exempt-senders",
+		Short: "Query the Cosmos addresses exempt from the min fee and the chain fees of transfers to Ethereum",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeExemptSenders(cmd.Context(), &types.QueryFeeExemptSendersRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdReplayAttestations() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "replay-attestations",
+		Short: "Replay the stored observed claims against an empty bank and compare the vouchers they issued with the live supply",
+		Long: `Replay the stored observed claims against an empty bank and compare the vouchers they issued with the live supply.
+Nothing the replay does is persisted. It re-executes every stored claim, so query a node you operate.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReplayAttestations(cmd.Context(), &types.QueryReplayAttestationsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSimulateProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "simulate-proposal [proposal-file]",
+		Short: "Show the state entries and funds a gravity proposal would change if it passed now",
+		Long: `Execute a gravity proposal against the current state without persisting anything and list the gravity
+store entries it would create, update or delete and the funds it would move. The proposal file holds the JSON of
+the proposal with its type, for example:
+
+{
+  "@type": "/gravity.v1.EvacuatePoolProposal",
+  "title": "Evacuate the pool",
+  "description": "The bridge contract is compromised"
+}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var content govtypes.Content
+			if err := clientCtx.JSONMarshaler.UnmarshalInterfaceJSON(bz, &content); err != nil {
+				return err
+			}
+			msg, ok := content.(proto.Message)
+			if !ok {
+				return fmt.Errorf("%T does not implement proto.Message", content)
+			}
+			proposal, err := codectypes.NewAnyWithValue(msg)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SimulateProposal(cmd.Context(), &types.QuerySimulateProposalRequest{Proposal: proposal})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetRefundReceipts() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "refund-receipts [sender]",
+		Short: "Query the receipts of the transfers to Ethereum that were refunded to a sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRefundReceiptsRequest{
+				Sender:     args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RefundReceipts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "refund-receipts")
+	return cmd
+}
+
+func CmdGetDepositReceipts() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "deposit-receipts [receiver]",
+		Short: "Query the receipts of the deposits from Ethereum paid out to a receiver, with who sent them",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDepositReceiptsRequest{
+				Receiver:   args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DepositReceipts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "deposit-receipts")
+	return cmd
+}
+
+func CmdGetQuarantinedDeposits() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "quarantined-deposits",
+		Short: "Query the deposits from quarantined Ethereum senders held in the module account until governance releases them",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QuarantinedDeposits(cmd.Context(), &types.QueryQuarantinedDepositsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetModuleSendGrants() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "module-send-grants",
+		Short: "Query the budgets other modules were granted by governance for sending to Ethereum",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ModuleSendGrants(cmd.Context(), &types.QueryModuleSendGrantsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBridgeInstance() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "bridge-instance",
+		Short: "Query the bridge instance the chain is running, a new one is started every time the chain is launched from genesis",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BridgeInstance(cmd.Context(), &types.QueryBridgeInstanceRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetAuditLog() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "audit-log",
+		Short: "Query the log of the changes governance made to the bridge, oldest first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AuditLog(cmd.Context(), &types.QueryAuditLogRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "audit-log")
+	return cmd
+}
+
+func CmdGetEthDestinationLabels() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "eth-destination-labels [owner] [label]",
+		Short: "Query the labeled Ethereum destinations in the address book of an account, or a single label",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 2 {
+				req := &types.QueryEthDestinationLabelRequest{
+					Owner: args[0],
+					Label: args[1],
+				}
+
+				res, err := queryClient.EthDestinationLabel(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryEthDestinationLabelsRequest{
+				Owner:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.EthDestinationLabels(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "eth-destination-labels")
+	return cmd
+}
+
+func CmdGetCosmosOriginatedTokens() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "cosmos-originated-tokens",
+		Short: "Query the Cosmos originated denoms with a deployed ERC20, with the symbol and decimals of the ERC20",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CosmosOriginatedTokens(cmd.Context(), &types.QueryCosmosOriginatedTokensRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "cosmos-originated-tokens")
+	return cmd
+}
+
+func CmdGetUnbatchedTxsBySender() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "unbatched-txs-by-sender [sender]",
+		Short: "Query the transfers to Ethereum of a sender that are still waiting in the pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryUnbatchedTxsBySenderRequest{
+				Sender:     args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.UnbatchedTxsBySender(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbatched-txs-by-sender")
+	return cmd
+}
+
+func CmdGetUnbatchedTxs() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "unbatched-txs [optional token-contract]",
+		Short: "Query the transfers to Ethereum waiting in the pool, in the order batches pick them",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryUnbatchedTxsRequest{
+				TokenContract: "",
+				Pagination:    pageReq,
+			}
+			if len(args) == 1 {
+				req.TokenContract = args[0]
+			}
+
+			res, err := queryClient.UnbatchedTxs(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbatched-txs")
+	return cmd
+}
+
+// FlagUnsignedBy restricts outgoing-batches to the batches an orchestrator has not confirmed yet
+const FlagUnsignedBy = "unsigned-by"
+
+func CmdGetOutgoingTxBatches() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "outgoing-batches [optional token-contract]",
+		Short: "Query the outgoing batches, grouped by token contract in batch nonce order",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			unsignedBy, err := cmd.Flags().GetString(FlagUnsignedBy)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryOutgoingTxBatchesRequest{
+				TokenContract: "",
+				UnsignedBy:    unsignedBy,
+				Pagination:    pageReq,
+			}
+			if len(args) == 1 {
+				req.TokenContract = args[0]
+			}
+
+			res, err := queryClient.OutgoingTxBatches(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(FlagUnsignedBy, "", "only list the batches this orchestrator address has not confirmed yet")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "outgoing-batches")
+	return cmd
+}
+
+func CmdGetPendingSendToEthByReceiver() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "pending-send-to-eth-by-receiver [eth-address]",
+		Short: "Query the transfers to an Ethereum address that are waiting in batches or in the pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingSendToEthByReceiverRequest{
+				Receiver:   args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PendingSendToEthByReceiver(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-send-to-eth-by-receiver")
+	return cmd
+}
+
+func CmdGetFirstSendDelay() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "first-send-delay [account]",
+		Short: "Query the blocks sends of an account to new Ethereum destinations are held and the destinations it has sent to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFirstSendDelayRequest{
+				Account:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.FirstSendDelay(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "first-send-delay")
+	return cmd
+}
+
+func CmdGetTimedOutBatches() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "timed-out-batches [token-contract]",
+		Short: "Query the batches canceled after timing out on Ethereum and the transactions they returned to the pool",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTimedOutBatchesRequest{}
+			if len(args) == 1 {
+				req.TokenContract = args[0]
+			}
+
+			res, err := queryClient.TimedOutBatches(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetPendingBatchPreview() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "batch-preview [denom]",
+		Short: "Query the batch, its total fee and checkpoint a batch request for the denom would build right now",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingBatchPreviewRequest{Denom: args[0]}
+
+			res, err := queryClient.PendingBatchPreview(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetSendToEthFeeSuggestion() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "send-to-eth-fee-suggestion [denom]",
+		Short: "Query the fee a transfer of the denom to Ethereum should pay to make it into the next batch worth relaying",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySendToEthFeeSuggestionRequest{Denom: args[0]}
+
+			res, err := queryClient.SendToEthFeeSuggestion(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetObservedEthereumHeight() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "ethereum-height",
+		Short: "Query the observed Ethereum height and the validator votes it is the median of",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ObservedEthereumHeight(cmd.Context(), &types.QueryObservedEthereumHeightRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetEthereumGasPrice() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "ethereum-gas-price",
+		Short: "Query the median Ethereum gas price reported by the orchestrators, which batches must pay for",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EthereumGasPrice(cmd.Context(), &types.QueryEthereumGasPriceRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetEthereumBlockTimeCalibration() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "ethereum-block-time",
+		Short: "Query the average Ethereum block time in use and the state of its calibration",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EthereumBlockTimeCalibration(cmd.Context(), &types.QueryEthereumBlockTimeCalibrationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetProjectedEthereumHeight() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "projected-ethereum-height",
+		Short: "Query the projected current Ethereum height that outgoing batch and logic call timeouts are based on",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProjectedEthereumHeight(cmd.Context(), &types.QueryProjectedEthereumHeightRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetAttestationVotes() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "attestation-votes [event-nonce] [claim-hash]",
+		Short: "Show who voted on the attestations for an event nonce, with their power and the power still needed for observation",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryAttestationVotesRequest{EventNonce: nonce}
+			if len(args) == 2 {
+				req.ClaimHash = args[1]
+			}
+
+			res, err := queryClient.AttestationVotes(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,226 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// FlagMinFees is the total fee below which request-batch refuses to request a batch
+const FlagMinFees = "min-fees"
+
+// CmdRelayer groups the commands a relayer needs to request batches, follow their signing and report bad signatures
+func CmdRelayer() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:                        "relayer",
+		Short:                      "Relayer subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		CmdRelayerRequestBatch(),
+		CmdRelayerListUnsignedBatches(),
+		CmdRelayerListConfirms(),
+		CmdRelayerSubmitEvidence(),
+	)
+	return cmd
+}
+
+func CmdRelayerRequestBatch() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "request-batch [denom]",
+		Short: "Preview the batch a request for the denom would build and request it if its total fee reaches --min-fees",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(cliCtx)
+
+			minFees := sdk.ZeroInt()
+			if s, _ := cmd.Flags().GetString(FlagMinFees); s != "" {
+				var ok bool
+				if minFees, ok = sdk.NewIntFromString(s); !ok || minFees.IsNegative() {
+					return fmt.Errorf("invalid %s %s", FlagMinFees, s)
+				}
+			}
+
+			preview, err := queryClient.PendingBatchPreview(cmd.Context(), &types.QueryPendingBatchPreviewRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+			if preview.Batch == nil {
+				return fmt.Errorf("nothing to batch for %s", args[0])
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "batch of %d transactions to %s, total fees %s\n",
+				len(preview.Batch.Transactions), preview.Batch.TokenContract, preview.TotalFees)
+			if preview.TotalFees.LT(minFees) {
+				return fmt.Errorf("total fees %s below %s %s", preview.TotalFees, FlagMinFees, minFees)
+			}
+
+			msg := types.MsgRequestBatch{
+				Sender: cliCtx.GetFromAddress().String(),
+				Denom:  args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().String(FlagMinFees, "", "the total fee, in the smallest unit of the token, the batch has to pay at least")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRelayerListUnsignedBatches() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "list-unsigned-batches [orchestrator-address]",
+		Short: "List the outgoing batches the orchestrator has not confirmed yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			unsigned := &types.QueryOutgoingTxBatchesResponse{Batches: []*types.OutgoingTxBatch{}}
+			pageReq := &query.PageRequest{}
+			for {
+				res, err := queryClient.OutgoingTxBatches(cmd.Context(), &types.QueryOutgoingTxBatchesRequest{
+					UnsignedBy: args[0],
+					Pagination: pageReq,
+				})
+				if err != nil {
+					return err
+				}
+				unsigned.Batches = append(unsigned.Batches, res.Batches...)
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+			}
+
+			return clientCtx.PrintProto(unsigned)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRelayerListConfirms() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "list-confirms [nonce] [optional token-contract]",
+		Short: "List the confirms of the valset of a nonce, or of the batch of a nonce for a token contract",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 2 {
+				res, err := queryClient.BatchConfirms(cmd.Context(), &types.QueryBatchConfirmsRequest{
+					Nonce:           nonce,
+					ContractAddress: args[1],
+				})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+			res, err := queryClient.ValsetConfirmsByNonce(cmd.Context(), &types.QueryValsetConfirmsByNonceRequest{Nonce: nonce})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRelayerSubmitEvidence() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "submit-evidence [subject-file] [signature]",
+		Short: "Submit evidence that a validator signed a valset, batch or logic call that never existed on this chain",
+		Long: `Submit evidence that a validator signed a valset, batch or logic call that never existed on this chain. The
+subject file holds the JSON of what was signed with its type, for example:
+
+{
+  "@type": "/gravity.v1.OutgoingTxBatch",
+  "batch_nonce": "12",
+  "batch_timeout": "13000000",
+  "transactions": [],
+  "token_contract": "0x...",
+  "block": "0"
+}
+
+The signature is the hex encoded Ethereum signature found on the Gravity contract.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var signed types.EthereumSigned
+			if err := cliCtx.JSONMarshaler.UnmarshalInterfaceJSON(bz, &signed); err != nil {
+				return err
+			}
+			signedMsg, ok := signed.(proto.Message)
+			if !ok {
+				return fmt.Errorf("%T is not a proto message", signed)
+			}
+			subject, err := codectypes.NewAnyWithValue(signedMsg)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSubmitBadSignatureEvidence{
+				Subject:   subject,
+				Signature: args[1],
+				Sender:    cliCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/hex"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -17,6 +19,21 @@ import (
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
+const (
+	// FlagCallbackTarget is the contract a transfer to Ethereum delivers to and calls the receiver hook of
+	FlagCallbackTarget = "callback-target"
+	// FlagCallbackData is the hex encoded data passed to the receiver hook
+	FlagCallbackData = "callback-data"
+	// FlagPriority is the priority class a transfer to Ethereum pays for
+	FlagPriority = "priority"
+	// FlagPayload is the hex encoded payload a transfer to Ethereum carries through its batch
+	FlagPayload = "payload"
+	// FlagEthBaseFee is the Ethereum base fee in wei a heartbeat reports
+	FlagEthBaseFee = "eth-base-fee"
+	// FlagEthPriorityFee is the Ethereum priority fee in wei a heartbeat reports along with the base fee
+	FlagEthPriorityFee = "eth-priority-fee"
+)
+
 func GetTxCmd(storeKey string) *cobra.Command {
 	//nolint: exhaustivestruct
 	gravityTxCmd := &cobra.Command{
@@ -29,8 +46,16 @@ func GetTxCmd(storeKey string) *cobra.Command {
 
 	gravityTxCmd.AddCommand([]*cobra.Command{
 		CmdSendToEth(),
+		CmdCancelAllSendToEth(),
+		CmdReleaseSendToEth(),
+		CmdBumpSendToEthFee(),
 		CmdRequestBatch(),
 		CmdSetOrchestratorAddress(),
+		CmdOrchestratorHeartbeat(),
+		CmdSetEthDestinationLabel(),
+		CmdSetFirstSendDelay(),
+		CmdFundValsetReward(),
+		CmdRelayer(),
 		GetUnsafeTestingCmd(),
 	}...)
 
@@ -99,9 +124,20 @@ func CmdUnsafeETHAddr() *cobra.Command {
 func CmdSendToEth() *cobra.Command {
 	//nolint: exhaustivestruct
 	cmd := &cobra.Command{
-		Use:   "send-to-eth [eth-dest] [amount] [bridge-fee]",
+		Use:   "send-to-eth [eth-dest-or-label] [amount] [optional bridge-fee]",
 		Short: "Adds a new entry to the transaction pool to withdraw an amount from the Ethereum bridge contract",
-		Args:  cobra.ExactArgs(3),
+		Long: `Adds a new entry to the transaction pool to withdraw an amount from the Ethereum bridge contract. The
+destination is either an Ethereum address or a label in the senders address book, see set-eth-destination-label.
+With --callback-target the amount is delivered to that contract instead, which is then called with
+onTokenTransfer(destination, amount, callback-data) in the same Ethereum transaction.
+With --priority the transfer pays the SendToEthPriorityFees of that class to be batched before the transfers of
+lower classes, whatever their fees.
+With --payload the transfer carries the data to its destination as part of its batch, at most
+MaxSendToEthPayloadSize bytes.
+With --dry-run the fee a transfer of the token should pay to make it into the next batch worth relaying is
+printed along with that batch and the depth of the pool, and the transaction is simulated instead of broadcast.
+The bridge fee may be left out then, the suggested fee is used in its place.`,
+		Args: cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -113,27 +149,67 @@ func CmdSendToEth() *cobra.Command {
 			if err != nil {
 				return sdkerrors.Wrap(err, "amount")
 			}
-			bridgeFee, err := sdk.ParseCoinsNormalized(args[2])
-			if err != nil {
-				return sdkerrors.Wrap(err, "bridge fee")
+			if len(amount) != 1 {
+				return fmt.Errorf("expecting just 1 coin amount for amount")
 			}
 
-			ethAddr, err := types.NewEthAddress(args[0])
+			if cliCtx.Simulate {
+				queryClient := types.NewQueryClient(cliCtx)
+				suggestion, err := queryClient.SendToEthFeeSuggestion(cmd.Context(), &types.QuerySendToEthFeeSuggestionRequest{Denom: amount[0].Denom})
+				if err != nil {
+					return err
+				}
+				if err := cliCtx.PrintProto(suggestion); err != nil {
+					return err
+				}
+				if len(args) == 2 {
+					args = append(args, sdk.NewCoin(amount[0].Denom, suggestion.SuggestedFee).String())
+				}
+			}
+			if len(args) == 2 {
+				return fmt.Errorf("the bridge fee may only be left out with --%s", flags.FlagDryRun)
+			}
+			bridgeFee, err := sdk.ParseCoinsNormalized(args[2])
 			if err != nil {
-				return sdkerrors.Wrap(err, "invalid eth address")
+				return sdkerrors.Wrap(err, "bridge fee")
 			}
 
-			if len(amount) > 1 || len(bridgeFee) > 1 {
+			if len(bridgeFee) > 1 {
 				return fmt.Errorf("coin amounts too long, expecting just 1 coin amount for both amount and bridgeFee")
 			}
 
 			// Make the message
 			msg := types.MsgSendToEth{
 				Sender:    cosmosAddr.String(),
-				EthDest:   ethAddr.GetAddress(),
 				Amount:    amount[0],
 				BridgeFee: bridgeFee[0],
 			}
+			// anything that is not an Ethereum address is looked up in the address book on chain
+			if ethAddr, err := types.NewEthAddress(args[0]); err == nil {
+				msg.EthDest = ethAddr.GetAddress()
+			} else {
+				msg.EthDestLabel = args[0]
+			}
+			if msg.CallbackTarget, err = cmd.Flags().GetString(FlagCallbackTarget); err != nil {
+				return err
+			}
+			callbackData, err := cmd.Flags().GetString(FlagCallbackData)
+			if err != nil {
+				return err
+			}
+			if msg.CallbackData, err = hex.DecodeString(strings.TrimPrefix(callbackData, "0x")); err != nil {
+				return sdkerrors.Wrap(err, "callback data")
+			}
+			if msg.Priority, err = cmd.Flags().GetUint32(FlagPriority); err != nil {
+				return err
+			}
+			payload, err := cmd.Flags().GetString(FlagPayload)
+			if err != nil {
+				return err
+			}
+			if msg.Payload, err = hex.DecodeString(strings.TrimPrefix(payload, "0x")); err != nil {
+				return sdkerrors.Wrap(err, "payload")
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -141,6 +217,94 @@ func CmdSendToEth() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
 		},
 	}
+	cmd.Flags().String(FlagCallbackTarget, "", "contract to deliver the amount to and call the receiver hook of")
+	cmd.Flags().String(FlagCallbackData, "", "hex encoded data passed to the receiver hook")
+	cmd.Flags().Uint32(FlagPriority, 0, "priority class of the transfer, see the SendToEthPriorityFees param")
+	cmd.Flags().String(FlagPayload, "", "hex encoded payload the transfer carries to its destination")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdCancelAllSendToEth() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "cancel-all-send-to-eth",
+		Short: "Cancel and refund every transfer to Ethereum of the sender that has not been batched yet",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelAllSendToEth(cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdReleaseSendToEth() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "release-send-to-eth [transaction-id]",
+		Short: "Confirm a transfer to Ethereum that is held back because its fee is far larger than its amount",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "transaction id")
+			}
+
+			msg := types.NewMsgReleaseSendToEth(cliCtx.GetFromAddress(), txId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBumpSendToEthFee() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "bump-send-to-eth-fee [transaction-id] [extra-fee]",
+		Short: "Add to the bridge fee of a transfer to Ethereum that is still waiting in the pool",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "transaction id")
+			}
+			extraFee, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "extra fee")
+			}
+
+			msg := types.NewMsgBumpSendToEthFee(cliCtx.GetFromAddress(), txId, extraFee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -177,9 +341,10 @@ func CmdRequestBatch() *cobra.Command {
 func CmdSetOrchestratorAddress() *cobra.Command {
 	//nolint: exhaustivestruct
 	cmd := &cobra.Command{
-		Use:   "set-orchestrator-address [validator-address] [orchestrator-address] [ethereum-address]",
+		Use:   "set-orchestrator-address [validator-address] [orchestrator-address] [ethereum-address] [ethereum-signature]",
 		Short: "Allows validators to delegate their voting responsibilities to a given key.",
-		Args:  cobra.ExactArgs(3),
+		Long:  "The ethereum signature proves control of the ethereum key, create it with `eth_keys sign-validator-address`.",
+		Args:  cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -189,6 +354,7 @@ func CmdSetOrchestratorAddress() *cobra.Command {
 				Validator:    args[0],
 				Orchestrator: args[1],
 				EthAddress:   args[2],
+				EthSignature: args[3],
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -200,3 +366,136 @@ func CmdSetOrchestratorAddress() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdOrchestratorHeartbeat() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "orchestrator-heartbeat [eth-block-height] [version] [optional eth-gas-price]",
+		Short: "Signal that the orchestrator sending this tx is online and report the latest Ethereum block and gas price in wei it has seen",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			ethHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "eth block height")
+			}
+			var gasPrice uint64
+			if len(args) == 3 {
+				gasPrice, err = strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return sdkerrors.Wrap(err, "eth gas price")
+				}
+			}
+
+			baseFee, err := cmd.Flags().GetUint64(FlagEthBaseFee)
+			if err != nil {
+				return err
+			}
+			priorityFee, err := cmd.Flags().GetUint64(FlagEthPriorityFee)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOrchestratorHeartbeat(cliCtx.GetFromAddress(), ethHeight, args[1], gasPrice, baseFee, priorityFee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Uint64(FlagEthBaseFee, 0, "the Ethereum base fee in wei, for type-2 transactions")
+	cmd.Flags().Uint64(FlagEthPriorityFee, 0, "the Ethereum priority fee in wei, for type-2 transactions, requires a base fee")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSetEthDestinationLabel() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "set-eth-destination-label [label] [eth-address]",
+		Short: "Label an Ethereum destination in the senders address book so send-to-eth can send to the label, omit the address to remove the label",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			var ethAddr *types.EthAddress
+			if len(args) == 2 {
+				ethAddr, err = types.NewEthAddress(args[1])
+				if err != nil {
+					return sdkerrors.Wrap(err, "invalid eth address")
+				}
+			}
+
+			msg := types.NewMsgSetEthDestinationLabel(cliCtx.GetFromAddress(), args[0], ethAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSetFirstSendDelay() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "set-first-send-delay [blocks]",
+		Short: "Hold sends to Ethereum addresses the sender never sent to before for a number of blocks, in which they can be canceled, 0 opts out",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			blocks, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid number of blocks")
+			}
+
+			msg := types.NewMsgSetFirstSendDelay(cliCtx.GetFromAddress(), blocks)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdFundValsetReward() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "fund-valset-reward [amount]",
+		Short: "Add vouchers of an Ethereum originated token to the reserve valset rewards in that token are paid from",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "amount")
+			}
+
+			msg := types.NewMsgFundValsetReward(cliCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,29 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client/cli"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client/rest"
+)
+
+// BridgeMigrationProposalHandler is the gov client handler for a BridgeMigrationProposal
+var BridgeMigrationProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitBridgeMigrationProposal, rest.BridgeMigrationProposalRESTHandler)
+
+// AttestationVetoProposalHandler is the gov client handler for an AttestationVetoProposal
+var AttestationVetoProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitAttestationVetoProposal, rest.AttestationVetoProposalRESTHandler)
+
+// EvacuatePoolProposalHandler is the gov client handler for an EvacuatePoolProposal
+var EvacuatePoolProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitEvacuatePoolProposal, rest.EvacuatePoolProposalRESTHandler)
+
+// CancelOutgoingBatchProposalHandler is the gov client handler for a CancelOutgoingBatchProposal
+var CancelOutgoingBatchProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitCancelOutgoingBatchProposal, rest.CancelOutgoingBatchProposalRESTHandler)
+
+// BridgeInstanceResetProposalHandler is the gov client handler for a BridgeInstanceResetProposal
+var BridgeInstanceResetProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitBridgeInstanceResetProposal, rest.BridgeInstanceResetProposalRESTHandler)
+
+// ResolveEventNonceProposalHandler is the gov client handler for a ResolveEventNonceProposal
+var ResolveEventNonceProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitResolveEventNonceProposal, rest.ResolveEventNonceProposalRESTHandler)
+
+// ReleaseQuarantinedDepositProposalHandler is the gov client handler for a ReleaseQuarantinedDepositProposal
+var ReleaseQuarantinedDepositProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitReleaseQuarantinedDepositProposal, rest.ReleaseQuarantinedDepositProposalRESTHandler)
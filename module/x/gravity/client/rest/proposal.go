@@ -0,0 +1,333 @@
+package rest
+
+import (
+	"encoding/hex"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+type bridgeMigrationProposalReq struct {
+	BaseReq           rest.BaseReq   `json:"base_req"`
+	Title             string         `json:"title"`
+	Description       string         `json:"description"`
+	NewBridgeContract string         `json:"new_bridge_contract"`
+	Proposer          sdk.AccAddress `json:"proposer"`
+	Deposit           sdk.Coins      `json:"deposit"`
+}
+
+type attestationVetoProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	EventNonce  uint64         `json:"event_nonce"`
+	ClaimHash   string         `json:"claim_hash"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+}
+
+type resolveEventNonceProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	EventNonce  uint64         `json:"event_nonce"`
+	ClaimHash   string         `json:"claim_hash"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+}
+
+type releaseQuarantinedDepositProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	EventNonce  uint64         `json:"event_nonce"`
+	Recipient   sdk.AccAddress `json:"recipient"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+}
+
+type evacuatePoolProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+}
+
+type cancelOutgoingBatchProposalReq struct {
+	BaseReq       rest.BaseReq   `json:"base_req"`
+	Title         string         `json:"title"`
+	Description   string         `json:"description"`
+	TokenContract string         `json:"token_contract"`
+	BatchNonce    uint64         `json:"batch_nonce"`
+	Refund        bool           `json:"refund"`
+	Proposer      sdk.AccAddress `json:"proposer"`
+	Deposit       sdk.Coins      `json:"deposit"`
+}
+
+type bridgeInstanceResetProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+}
+
+// BridgeMigrationProposalRESTHandler returns the REST handler for submitting a bridge migration proposal
+func BridgeMigrationProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "gravity_bridge_migration",
+		Handler:  postBridgeMigrationProposalHandler(cliCtx),
+	}
+}
+
+func postBridgeMigrationProposalHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req bridgeMigrationProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		newContract, err := types.NewEthAddress(req.NewBridgeContract)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewBridgeMigrationProposal(req.Title, req.Description, *newContract)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// AttestationVetoProposalRESTHandler returns the REST handler for submitting an attestation veto proposal
+func AttestationVetoProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "gravity_attestation_veto",
+		Handler:  postAttestationVetoProposalHandler(cliCtx),
+	}
+}
+
+func postAttestationVetoProposalHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req attestationVetoProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		claimHash, err := hex.DecodeString(req.ClaimHash)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewAttestationVetoProposal(req.Title, req.Description, req.EventNonce, claimHash)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// ResolveEventNonceProposalRESTHandler returns the REST handler for submitting an event nonce resolution proposal
+func ResolveEventNonceProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "gravity_resolve_event_nonce",
+		Handler:  postResolveEventNonceProposalHandler(cliCtx),
+	}
+}
+
+func postResolveEventNonceProposalHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req resolveEventNonceProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		claimHash, err := hex.DecodeString(req.ClaimHash)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewResolveEventNonceProposal(req.Title, req.Description, req.EventNonce, claimHash)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// ReleaseQuarantinedDepositProposalRESTHandler returns the REST handler for submitting a quarantined deposit
+// release proposal
+func ReleaseQuarantinedDepositProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "gravity_release_quarantined_deposit",
+		Handler:  postReleaseQuarantinedDepositProposalHandler(cliCtx),
+	}
+}
+
+func postReleaseQuarantinedDepositProposalHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req releaseQuarantinedDepositProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewReleaseQuarantinedDepositProposal(req.Title, req.Description, req.EventNonce, req.Recipient)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// EvacuatePoolProposalRESTHandler returns the REST handler for submitting a pool evacuation proposal
+func EvacuatePoolProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "gravity_evacuate_pool",
+		Handler:  postEvacuatePoolProposalHandler(cliCtx),
+	}
+}
+
+func postEvacuatePoolProposalHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req evacuatePoolProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewEvacuatePoolProposal(req.Title, req.Description)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// CancelOutgoingBatchProposalRESTHandler returns the REST handler for submitting a batch cancellation proposal
+func CancelOutgoingBatchProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "gravity_cancel_batch",
+		Handler:  postCancelOutgoingBatchProposalHandler(cliCtx),
+	}
+}
+
+func postCancelOutgoingBatchProposalHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req cancelOutgoingBatchProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		tokenContract, err := types.NewEthAddress(req.TokenContract)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewCancelOutgoingBatchProposal(req.Title, req.Description, *tokenContract, req.BatchNonce, req.Refund)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// BridgeInstanceResetProposalRESTHandler returns the REST handler for submitting a bridge instance reset proposal
+func BridgeInstanceResetProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "gravity_bridge_instance_reset",
+		Handler:  postBridgeInstanceResetProposalHandler(cliCtx),
+	}
+}
+
+func postBridgeInstanceResetProposalHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req bridgeInstanceResetProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewBridgeInstanceResetProposal(req.Title, req.Description)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -25,6 +26,24 @@ func TestCosmosOriginated(t *testing.T) {
 	acceptDepositEvent(tv)
 }
 
+func TestCosmosOriginatedTokens(t *testing.T) {
+	tv := initializeTestingVars(t)
+	tokens, _, err := tv.input.GravityKeeper.GetCosmosOriginatedTokens(tv.ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+
+	addDenomToERC20Relation(tv)
+	tokens, pageRes, err := tv.input.GravityKeeper.GetCosmosOriginatedTokens(tv.ctx, &query.PageRequest{CountTotal: true})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), pageRes.Total)
+	assert.Equal(t, []types.CosmosOriginatedToken{{
+		Denom:    tv.denom,
+		Erc20:    tv.erc20,
+		Symbol:   "atom",
+		Decimals: 6,
+	}}, tokens)
+}
+
 type testingVars struct {
 	myOrchestratorAddr sdk.AccAddress
 	myValAddr          sdk.ValAddress
@@ -82,6 +101,7 @@ func addDenomToERC20Relation(tv *testingVars) {
 		Symbol:        "atom",
 		Decimals:      6,
 		Orchestrator:  tv.myOrchestratorAddr.String(),
+		BridgeChainId: tv.input.GravityKeeper.GetBridgeChainID(tv.ctx),
 	}
 
 	_, err := tv.h(tv.ctx, &ethClaim)
@@ -172,6 +192,7 @@ func acceptDepositEvent(tv *testingVars) {
 		EthereumSender: anyETHAddr,
 		CosmosReceiver: myCosmosAddr.String(),
 		Orchestrator:   myOrchestratorAddr.String(),
+		BridgeChainId:  tv.input.GravityKeeper.GetBridgeChainID(tv.ctx),
 	}
 
 	_, err := tv.h(tv.ctx, &ethClaim)
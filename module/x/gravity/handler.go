@@ -16,6 +16,7 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		ctx.EventManager().EmitEvent(types.NewEventVersionEvent())
 		switch msg := msg.(type) {
 		case *types.MsgSetOrchestratorAddress:
 			res, err := msgServer.SetOrchestratorAddress(sdk.WrapSDKContext(ctx), msg)
@@ -50,12 +51,45 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgCancelSendToEth:
 			res, err := msgServer.CancelSendToEth(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelAllSendToEth:
+			res, err := msgServer.CancelAllSendToEth(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgReleaseSendToEth:
+			res, err := msgServer.ReleaseSendToEth(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgBumpSendToEthFee:
+			res, err := msgServer.BumpSendToEthFee(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgValsetUpdatedClaim:
 			res, err := msgServer.ValsetUpdateClaim(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgMigrationCompletedClaim:
+			res, err := msgServer.MigrationCompletedClaim(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgSubmitBadSignatureEvidence:
 			res, err := msgServer.SubmitBadSignatureEvidence(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgOrchestratorHeartbeat:
+			res, err := msgServer.OrchestratorHeartbeat(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetEthDestinationLabel:
+			res, err := msgServer.SetEthDestinationLabel(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetFirstSendDelay:
+			res, err := msgServer.SetFirstSendDelay(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSubmitConfirms:
+			res, err := msgServer.SubmitConfirms(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSubmitConflictingClaimEvidence:
+			res, err := msgServer.SubmitConflictingClaimEvidence(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSubmitEthereumEvents:
+			res, err := msgServer.SubmitEthereumEvents(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgFundValsetReward:
+			res, err := msgServer.FundValsetReward(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("Unrecognized Gravity Msg type: %v", msg.Type()))
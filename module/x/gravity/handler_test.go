@@ -2,10 +2,13 @@ package gravity
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"encoding/hex"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -84,6 +87,7 @@ func TestMsgSendToCosmosClaimSingleValidator(t *testing.T) {
 		myValAddr                         = sdk.ValAddress(myOrchestratorAddr) // revisit when proper mapping is impl in keeper
 		myNonce                           = uint64(1)
 		anyETHAddr                        = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		userETHAddr                       = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
 		tokenETHAddr                      = "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
 		myBlockTime                       = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
 		amountA, _                        = sdk.NewIntFromString("50000000000000000000")  // 50 ETH
@@ -108,6 +112,7 @@ func TestMsgSendToCosmosClaimSingleValidator(t *testing.T) {
 		EthereumSender: anyETHAddr,
 		CosmosReceiver: myCosmosAddr.String(),
 		Orchestrator:   myOrchestratorAddr.String(),
+		BridgeChainId:  input.GravityKeeper.GetBridgeChainID(ctx),
 	}
 
 	// when
@@ -132,6 +137,7 @@ func TestMsgSendToCosmosClaimSingleValidator(t *testing.T) {
 	EndBlocker(ctx, input.GravityKeeper)
 	// then
 	require.Error(t, err)
+	assert.True(t, types.ErrAlreadySubmitted.Is(err))
 	balance = input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
 	assert.Equal(t, sdk.Coins{sdk.NewCoin("gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", amountA)}, balance)
 
@@ -143,6 +149,7 @@ func TestMsgSendToCosmosClaimSingleValidator(t *testing.T) {
 		EthereumSender: anyETHAddr,
 		CosmosReceiver: myCosmosAddr.String(),
 		Orchestrator:   myOrchestratorAddr.String(),
+		BridgeChainId:  input.GravityKeeper.GetBridgeChainID(ctx),
 	}
 
 	// when
@@ -154,7 +161,7 @@ func TestMsgSendToCosmosClaimSingleValidator(t *testing.T) {
 	balance = input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
 	assert.Equal(t, sdk.Coins{sdk.NewCoin("gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", amountA)}, balance)
 
-	// Test to finally accept consecutive nonce
+	// Test to finally accept consecutive nonce, deposited by a contract on behalf of a user
 	ethClaim = types.MsgSendToCosmosClaim{
 		EventNonce:     uint64(2),
 		Amount:         amountA,
@@ -162,6 +169,10 @@ func TestMsgSendToCosmosClaimSingleValidator(t *testing.T) {
 		EthereumSender: anyETHAddr,
 		CosmosReceiver: myCosmosAddr.String(),
 		Orchestrator:   myOrchestratorAddr.String(),
+		BridgeChainId:  input.GravityKeeper.GetBridgeChainID(ctx),
+		TokenSender:    userETHAddr,
+
+		EthereumSenderIsContract: true,
 	}
 
 	// when
@@ -173,6 +184,18 @@ func TestMsgSendToCosmosClaimSingleValidator(t *testing.T) {
 	require.NoError(t, err)
 	balance = input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
 	assert.Equal(t, sdk.Coins{sdk.NewCoin("gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", amountB)}, balance)
+
+	// both deposits have a receipt with who sent them
+	receipts, _, err := input.GravityKeeper.GetDepositReceipts(ctx, myCosmosAddr, nil)
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	assert.Equal(t, anyETHAddr, receipts[0].EthereumSender)
+	assert.Equal(t, "", receipts[0].TokenSender)
+	assert.Equal(t, anyETHAddr, receipts[1].EthereumSender)
+	assert.Equal(t, userETHAddr, receipts[1].TokenSender)
+	assert.False(t, receipts[0].EthereumSenderIsContract)
+	assert.True(t, receipts[1].EthereumSenderIsContract)
+	assert.Equal(t, sdk.NewCoin("gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", amountA), receipts[1].Amount)
 }
 
 //nolint: exhaustivestruct
@@ -216,6 +239,7 @@ func TestMsgSendToCosmosClaimsMultiValidator(t *testing.T) {
 		EthereumSender: anyETHAddr,
 		CosmosReceiver: myCosmosAddr.String(),
 		Orchestrator:   orchestratorAddr1.String(),
+		BridgeChainId:  input.GravityKeeper.GetBridgeChainID(ctx),
 	}
 	ethClaim2 := types.MsgSendToCosmosClaim{
 		EventNonce:     myNonce,
@@ -224,6 +248,7 @@ func TestMsgSendToCosmosClaimsMultiValidator(t *testing.T) {
 		EthereumSender: anyETHAddr,
 		CosmosReceiver: myCosmosAddr.String(),
 		Orchestrator:   orchestratorAddr2.String(),
+		BridgeChainId:  input.GravityKeeper.GetBridgeChainID(ctx),
 	}
 	ethClaim3 := types.MsgSendToCosmosClaim{
 		EventNonce:     myNonce,
@@ -232,6 +257,7 @@ func TestMsgSendToCosmosClaimsMultiValidator(t *testing.T) {
 		EthereumSender: anyETHAddr,
 		CosmosReceiver: myCosmosAddr.String(),
 		Orchestrator:   orchestratorAddr3.String(),
+		BridgeChainId:  input.GravityKeeper.GetBridgeChainID(ctx),
 	}
 
 	// when
@@ -275,12 +301,115 @@ func TestMsgSendToCosmosClaimsMultiValidator(t *testing.T) {
 	assert.Equal(t, sdk.Coins{sdk.NewInt64Coin("gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", 12)}, balance3)
 }
 
+//nolint: exhaustivestruct
+func TestClaimBridgeChainIDMismatch(t *testing.T) {
+	var (
+		myOrchestratorAddr sdk.AccAddress = make([]byte, sdk.AddrLen)
+		myCosmosAddr, _                   = sdk.AccAddressFromBech32("cosmos16ahjkfqxpp6lvfy9fpfnfjg39xr96qett0alj5")
+		myValAddr                         = sdk.ValAddress(myOrchestratorAddr)
+	)
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	input.GravityKeeper.StakingKeeper = keeper.NewStakingKeeperMock(myValAddr)
+	input.GravityKeeper.SetOrchestratorValidator(ctx, myValAddr, myOrchestratorAddr)
+	h := NewHandler(input.GravityKeeper)
+
+	claim := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		TokenContract:  "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e",
+		Amount:         sdk.NewInt(12),
+		EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+		CosmosReceiver: myCosmosAddr.String(),
+		Orchestrator:   myOrchestratorAddr.String(),
+		BridgeChainId:  input.GravityKeeper.GetBridgeChainID(ctx) + 1,
+	}
+	_, err := h(ctx, &claim)
+	require.True(t, types.ErrMismatched.Is(err))
+	hash, err := claim.ClaimHash()
+	require.NoError(t, err)
+	assert.Nil(t, input.GravityKeeper.GetAttestation(ctx, 1, hash))
+
+	claim.BridgeChainId = input.GravityKeeper.GetBridgeChainID(ctx)
+	_, err = h(ctx, &claim)
+	require.NoError(t, err)
+	assert.NotNil(t, input.GravityKeeper.GetAttestation(ctx, 1, hash))
+
+	// an orchestrator that does not report the chain id is not checked
+	claim.EventNonce, claim.BridgeChainId = 2, 0
+	_, err = h(ctx, &claim)
+	require.NoError(t, err)
+	hash, err = claim.ClaimHash()
+	require.NoError(t, err)
+	assert.NotNil(t, input.GravityKeeper.GetAttestation(ctx, 2, hash))
+}
+
+//nolint: exhaustivestruct
+func TestMsgSendToCosmosClaimsFastPath(t *testing.T) {
+	var (
+		orchestratorAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		orchestratorAddr2, _ = sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+		orchestratorAddr3, _ = sdk.AccAddressFromBech32("cosmos193fw83ynn76328pty4yl7473vg9x86alq2cft7")
+		validatorEthAddr1, _ = types.NewEthAddress("0x0000000000000000000000000000000000000001")
+		validatorEthAddr2, _ = types.NewEthAddress("0x0000000000000000000000000000000000000002")
+		validatorEthAddr3, _ = types.NewEthAddress("0x0000000000000000000000000000000000000003")
+		myCosmosAddr, _      = sdk.AccAddressFromBech32("cosmos16ahjkfqxpp6lvfy9fpfnfjg39xr96qett0alj5")
+		valAddr1             = sdk.ValAddress(orchestratorAddr1)
+		valAddr2             = sdk.ValAddress(orchestratorAddr2)
+		valAddr3             = sdk.ValAddress(orchestratorAddr3)
+		anyETHAddr           = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		tokenETHAddr         = "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+	)
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	input.GravityKeeper.StakingKeeper = keeper.NewStakingKeeperMock(valAddr1, valAddr2, valAddr3)
+	input.GravityKeeper.SetEthAddressForValidator(ctx, valAddr1, *validatorEthAddr1)
+	input.GravityKeeper.SetEthAddressForValidator(ctx, valAddr2, *validatorEthAddr2)
+	input.GravityKeeper.SetEthAddressForValidator(ctx, valAddr3, *validatorEthAddr3)
+	input.GravityKeeper.SetOrchestratorValidator(ctx, valAddr1, orchestratorAddr1)
+	input.GravityKeeper.SetOrchestratorValidator(ctx, valAddr2, orchestratorAddr2)
+	input.GravityKeeper.SetOrchestratorValidator(ctx, valAddr3, orchestratorAddr3)
+	params := input.GravityKeeper.GetParams(ctx)
+	params.AttestationFastPathThreshold = 90
+	input.GravityKeeper.SetParams(ctx, params)
+	h := NewHandler(input.GravityKeeper)
+
+	voucher := sdk.Coins{sdk.NewInt64Coin("gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", 12)}
+	for i, orchestrator := range []sdk.AccAddress{orchestratorAddr1, orchestratorAddr2, orchestratorAddr3} {
+		_, err := h(ctx, &types.MsgSendToCosmosClaim{
+			EventNonce:     1,
+			TokenContract:  tokenETHAddr,
+			Amount:         sdk.NewInt(12),
+			EthereumSender: anyETHAddr,
+			CosmosReceiver: myCosmosAddr.String(),
+			Orchestrator:   orchestrator.String(),
+			BridgeChainId:  input.GravityKeeper.GetBridgeChainID(ctx),
+		})
+		require.NoError(t, err)
+
+		// two of three votes pass the regular threshold, but only the EndBlocker observes them
+		if i < 2 {
+			assert.Equal(t, uint64(0), input.GravityKeeper.GetLastObservedEventNonce(ctx))
+			assert.True(t, input.BankKeeper.GetAllBalances(ctx, myCosmosAddr).IsZero())
+		}
+	}
+
+	// the final vote passes the fast path threshold and is observed without an EndBlocker
+	assert.Equal(t, uint64(1), input.GravityKeeper.GetLastObservedEventNonce(ctx))
+	assert.Equal(t, voucher, input.BankKeeper.GetAllBalances(ctx, myCosmosAddr))
+
+	// the EndBlocker does not apply it again
+	EndBlocker(ctx, input.GravityKeeper)
+	assert.Equal(t, voucher, input.BankKeeper.GetAllBalances(ctx, myCosmosAddr))
+}
+
 //nolint: exhaustivestruct
 func TestMsgSetOrchestratorAddresses(t *testing.T) {
 	var (
-		ethAddress, _                 = types.NewEthAddress("0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255")
+		ethKey, _                     = crypto.GenerateKey()
+		ethAddress, _                 = types.NewEthAddress(crypto.PubkeyToAddress(ethKey.PublicKey).Hex())
 		cosmosAddress  sdk.AccAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen)
-		ethAddress2, _                = types.NewEthAddress("0x26126048c706fB45a5a6De8432F428e794d0b952")
+		ethKey2, _                    = crypto.GenerateKey()
+		ethAddress2, _                = types.NewEthAddress(crypto.PubkeyToAddress(ethKey2.PublicKey).Hex())
 		cosmosAddress2 sdk.AccAddress = bytes.Repeat([]byte{0x2}, sdk.AddrLen)
 		valAddress     sdk.ValAddress = bytes.Repeat([]byte{0x2}, sdk.AddrLen)
 		blockTime                     = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
@@ -296,10 +425,20 @@ func TestMsgSetOrchestratorAddresses(t *testing.T) {
 	h := NewHandler(input.GravityKeeper)
 	ctx = ctx.WithBlockTime(blockTime)
 
-	// test setting keys
-	msg := types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, *ethAddress)
+	// an address whose key did not sign the validator address is refused
 	ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(blockHeight)
-	_, err := h(ctx, msg)
+	otherSig, err := types.NewEthereumSignature(types.DelegateKeysSignHash(valAddress), ethKey2)
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, *ethAddress, otherSig))
+	require.Error(t, err)
+	_, found := k.GetEthAddressByValidator(ctx, valAddress)
+	require.False(t, found)
+
+	// test setting keys
+	sig, err := types.NewEthereumSignature(types.DelegateKeysSignHash(valAddress), ethKey)
+	require.NoError(t, err)
+	msg := types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, *ethAddress, sig)
+	_, err = h(ctx, msg)
 	require.NoError(t, err)
 
 	// test all lookup methods
@@ -328,8 +467,308 @@ func TestMsgSetOrchestratorAddresses(t *testing.T) {
 
 	// try to set values again. This should fail see issue #344 for why allowing this
 	// would require keeping a history of all validators delegate keys forever
-	msg = types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress2, *ethAddress2)
+	sig2, err := types.NewEthereumSignature(types.DelegateKeysSignHash(valAddress), ethKey2)
+	require.NoError(t, err)
+	msg = types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress2, *ethAddress2, sig2)
 	ctx = ctx.WithBlockTime(blockTime2).WithBlockHeight(blockHeight2)
 	_, err = h(ctx, msg)
 	require.Error(t, err)
 }
+
+//nolint: exhaustivestruct
+func TestMsgOrchestratorHeartbeat(t *testing.T) {
+	var (
+		myOrchestratorAddr sdk.AccAddress = make([]byte, sdk.AddrLen)
+		myValAddr                         = sdk.ValAddress(myOrchestratorAddr)
+		myBlockTime                       = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
+	)
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	input.GravityKeeper.StakingKeeper = keeper.NewStakingKeeperMock(myValAddr)
+	input.GravityKeeper.SetEthAddressForValidator(ctx, myValAddr, *types.ZeroAddress())
+	input.GravityKeeper.SetOrchestratorValidator(ctx, myValAddr, myOrchestratorAddr)
+	h := NewHandler(input.GravityKeeper)
+
+	// no heartbeat yet, the orchestrator is not live
+	liveness := input.GravityKeeper.GetOrchestratorLiveness(ctx, 10)
+	require.Len(t, liveness, 1)
+	assert.False(t, liveness[0].Live)
+	assert.Nil(t, liveness[0].LastHeartbeat)
+
+	ctx = ctx.WithBlockTime(myBlockTime).WithBlockHeight(100)
+	_, err := h(ctx, types.NewMsgOrchestratorHeartbeat(myOrchestratorAddr, 1234, "v0.4.0", 30000000000, 25000000000, 2000000000))
+	require.NoError(t, err)
+
+	heartbeat := input.GravityKeeper.GetOrchestratorHeartbeat(ctx, myValAddr)
+	require.NotNil(t, heartbeat)
+	assert.Equal(t, uint64(1234), heartbeat.EthBlockHeight)
+	assert.Equal(t, "v0.4.0", heartbeat.Version)
+	assert.Equal(t, uint64(30000000000), heartbeat.EthGasPrice)
+	assert.Equal(t, uint64(25000000000), heartbeat.EthBaseFee)
+	assert.Equal(t, uint64(2000000000), heartbeat.EthPriorityFee)
+	assert.Equal(t, uint64(100), heartbeat.CosmosBlockHeight)
+	assert.Equal(t, uint64(myBlockTime.Unix()), heartbeat.CosmosBlockTime)
+
+	liveness = input.GravityKeeper.GetOrchestratorLiveness(ctx.WithBlockHeight(110), 10)
+	assert.True(t, liveness[0].Live)
+	liveness = input.GravityKeeper.GetOrchestratorLiveness(ctx.WithBlockHeight(111), 10)
+	assert.False(t, liveness[0].Live)
+
+	// an unknown orchestrator can not send heartbeats
+	_, err = h(ctx, types.NewMsgOrchestratorHeartbeat(keeper.AccAddrs[0], 1234, "v0.4.0", 0, 0, 0))
+	require.Error(t, err)
+
+	// a priority fee is only valid along with a base fee
+	_, err = h(ctx, types.NewMsgOrchestratorHeartbeat(myOrchestratorAddr, 1234, "v0.4.0", 0, 0, 2000000000))
+	require.Error(t, err)
+}
+
+//nolint: exhaustivestruct
+func TestMsgSubmitConfirms(t *testing.T) {
+	var (
+		myOrchestratorAddr sdk.AccAddress = make([]byte, sdk.AddrLen)
+		myValAddr                         = sdk.ValAddress(myOrchestratorAddr)
+	)
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr, err := types.NewEthAddress(crypto.PubkeyToAddress(privKey.PublicKey).String())
+	require.NoError(t, err)
+	k.StakingKeeper = keeper.NewStakingKeeperMock(myValAddr)
+	k.SetEthAddressForValidator(ctx, myValAddr, *ethAddr)
+	k.SetOrchestratorValidator(ctx, myValAddr, myOrchestratorAddr)
+	h := NewHandler(k)
+
+	confirm := func(nonce uint64, signer *ecdsa.PrivateKey) types.MsgValsetConfirm {
+		valset := &types.Valset{Nonce: nonce, RewardAmount: sdk.ZeroInt(), RewardToken: types.ZeroAddress().GetAddress()}
+		k.StoreValset(ctx, valset)
+		sig, err := types.NewEthereumSignature(valset.GetCheckpoint(k.GetGravityID(ctx)), signer)
+		require.NoError(t, err)
+		return types.MsgValsetConfirm{
+			Nonce:        nonce,
+			Orchestrator: myOrchestratorAddr.String(),
+			EthAddress:   ethAddr.GetAddress(),
+			Signature:    hex.EncodeToString(sig),
+		}
+	}
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	good, bad := confirm(1, privKey), confirm(2, otherKey)
+
+	// a confirm from another orchestrator can not be bundled
+	stranger := good
+	stranger.Orchestrator = keeper.AccAddrs[0].String()
+	_, err = h(ctx, types.NewMsgSubmitConfirms(myOrchestratorAddr, []types.MsgValsetConfirm{stranger}, nil, nil))
+	require.Error(t, err)
+
+	// the refused confirm does not undo the accepted one
+	res, err := keeper.NewMsgServerImpl(k).SubmitConfirms(sdk.WrapSDKContext(ctx),
+		types.NewMsgSubmitConfirms(myOrchestratorAddr, []types.MsgValsetConfirm{good, bad}, nil, nil))
+	require.NoError(t, err)
+	require.Len(t, res.ValsetConfirmErrors, 2)
+	assert.Empty(t, res.ValsetConfirmErrors[0])
+	assert.NotEmpty(t, res.ValsetConfirmErrors[1])
+	assert.NotNil(t, k.GetValsetConfirm(ctx, 1, myOrchestratorAddr))
+	assert.Nil(t, k.GetValsetConfirm(ctx, 2, myOrchestratorAddr))
+
+	// a resubmitted confirm is classified as such and does not fail the message
+	res, err = keeper.NewMsgServerImpl(k).SubmitConfirms(sdk.WrapSDKContext(ctx),
+		types.NewMsgSubmitConfirms(myOrchestratorAddr, []types.MsgValsetConfirm{good, bad}, nil, nil))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), res.AlreadySubmitted)
+	_, err = h(ctx, &good)
+	assert.True(t, types.ErrAlreadySubmitted.Is(err))
+
+	// the message fails when every confirm is refused
+	_, err = h(ctx, types.NewMsgSubmitConfirms(myOrchestratorAddr, []types.MsgValsetConfirm{bad}, nil, nil))
+	require.Error(t, err)
+
+	// the module reports the confirms it already has
+	submissions, err := k.OrchestratorSubmissions(sdk.WrapSDKContext(ctx),
+		&types.QueryOrchestratorSubmissionsRequest{Address: myOrchestratorAddr.String()})
+	require.NoError(t, err)
+	require.Len(t, submissions.ValsetConfirms, 1)
+	assert.Equal(t, uint64(1), submissions.ValsetConfirms[0].Nonce)
+	assert.Empty(t, submissions.BatchConfirms)
+}
+
+// confirmTx is a transaction carrying msgs for the ante handler tests
+type confirmTx []sdk.Msg
+
+func (tx confirmTx) GetMsgs() []sdk.Msg   { return tx }
+func (tx confirmTx) ValidateBasic() error { return nil }
+
+// Tests that the ante handler rejects a valset confirm whose signature does not recover to the Ethereum address of
+// the validator at CheckTx, and leaves DeliverTx to the handler
+//nolint: exhaustivestruct
+func TestValsetConfirmAnteHandler(t *testing.T) {
+	var (
+		myOrchestratorAddr sdk.AccAddress = make([]byte, sdk.AddrLen)
+		myValAddr                         = sdk.ValAddress(myOrchestratorAddr)
+	)
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context.WithIsCheckTx(true)
+	k := input.GravityKeeper
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr, err := types.NewEthAddress(crypto.PubkeyToAddress(privKey.PublicKey).String())
+	require.NoError(t, err)
+	k.StakingKeeper = keeper.NewStakingKeeperMock(myValAddr)
+	k.SetEthAddressForValidator(ctx, myValAddr, *ethAddr)
+	k.SetOrchestratorValidator(ctx, myValAddr, myOrchestratorAddr)
+
+	valset := &types.Valset{Nonce: 1, RewardAmount: sdk.ZeroInt(), RewardToken: types.ZeroAddress().GetAddress()}
+	k.StoreValset(ctx, valset)
+	confirm := func(signer *ecdsa.PrivateKey) *types.MsgValsetConfirm {
+		sig, err := types.NewEthereumSignature(valset.GetCheckpoint(k.GetGravityID(ctx)), signer)
+		require.NoError(t, err)
+		return &types.MsgValsetConfirm{
+			Nonce:        valset.Nonce,
+			Orchestrator: myOrchestratorAddr.String(),
+			EthAddress:   ethAddr.GetAddress(),
+			Signature:    hex.EncodeToString(sig),
+		}
+	}
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	good, bad := confirm(privKey), confirm(otherKey)
+	wrongAddress := confirm(privKey)
+	wrongAddress.EthAddress = keeper.EthAddrs[0].String()
+
+	anteHandler := NewAnteHandler(func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	}, k)
+
+	_, err = anteHandler(ctx, confirmTx{good}, false)
+	require.NoError(t, err)
+	_, err = anteHandler(ctx, confirmTx{good, bad}, false)
+	require.Error(t, err)
+	_, err = anteHandler(ctx, confirmTx{wrongAddress}, false)
+	require.Error(t, err)
+	_, err = anteHandler(ctx, confirmTx{sdk.ServiceMsg{MethodName: "/gravity.v1.Msg/ValsetConfirm", Request: bad}}, false)
+	require.Error(t, err)
+
+	// bundled confirms are reported one by one by the handler
+	_, err = anteHandler(ctx, confirmTx{types.NewMsgSubmitConfirms(myOrchestratorAddr, []types.MsgValsetConfirm{*bad}, nil, nil)}, false)
+	require.NoError(t, err)
+
+	// DeliverTx leaves the confirm to the handler
+	_, err = anteHandler(ctx.WithIsCheckTx(false), confirmTx{bad}, false)
+	require.NoError(t, err)
+	_, err = NewHandler(k)(ctx.WithIsCheckTx(false), bad)
+	require.Error(t, err)
+}
+
+//nolint: exhaustivestruct
+func TestMsgSubmitEthereumEvents(t *testing.T) {
+	var (
+		myOrchestratorAddr sdk.AccAddress = make([]byte, sdk.AddrLen)
+		myCosmosAddr, _                   = sdk.AccAddressFromBech32("cosmos16ahjkfqxpp6lvfy9fpfnfjg39xr96qett0alj5")
+		myValAddr                         = sdk.ValAddress(myOrchestratorAddr)
+		anyETHAddr                        = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		tokenETHAddr                      = "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+		denom                             = "gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+	)
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	k.StakingKeeper = keeper.NewStakingKeeperMock(myValAddr)
+	k.SetEthAddressForValidator(ctx, myValAddr, *types.ZeroAddress())
+	k.SetOrchestratorValidator(ctx, myValAddr, myOrchestratorAddr)
+	h := NewHandler(k)
+
+	deposit := func(nonce uint64) *types.MsgSendToCosmosClaim {
+		return &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			TokenContract:  tokenETHAddr,
+			Amount:         sdk.NewInt(100),
+			EthereumSender: anyETHAddr,
+			CosmosReceiver: myCosmosAddr.String(),
+			Orchestrator:   myOrchestratorAddr.String(),
+			BridgeChainId:  k.GetBridgeChainID(ctx),
+		}
+	}
+	submit := func(ctx sdk.Context, claims ...types.EthereumClaim) error {
+		msg, err := types.NewMsgSubmitEthereumEvents(myOrchestratorAddr, claims...)
+		require.NoError(t, err)
+		_, err = h(ctx, msg)
+		return err
+	}
+
+	// claims from another orchestrator or sharing an event nonce can not be bundled
+	stranger := deposit(1)
+	stranger.Orchestrator = keeper.AccAddrs[0].String()
+	require.Error(t, submit(ctx, deposit(1), stranger))
+	require.Error(t, submit(ctx, deposit(1), deposit(1)))
+
+	// a skipped nonce fails the whole message, like a failed transaction its state is discarded
+	xCtx, _ := ctx.CacheContext()
+	require.Error(t, submit(xCtx, deposit(1), deposit(3)))
+	assert.Equal(t, uint64(0), k.GetLastEventNonceByValidator(ctx, myValAddr))
+
+	// the claims are processed in event nonce order whatever their order in the message
+	require.NoError(t, submit(ctx, deposit(2), deposit(1)))
+	EndBlocker(ctx, k)
+	for _, nonce := range []uint64{1, 2} {
+		hash, err := deposit(nonce).ClaimHash()
+		require.NoError(t, err)
+		att := k.GetAttestation(ctx, nonce, hash)
+		require.NotNil(t, att)
+		assert.True(t, att.Observed)
+	}
+	assert.Equal(t, sdk.Coins{sdk.NewInt64Coin(denom, 200)}, input.BankKeeper.GetAllBalances(ctx, myCosmosAddr))
+}
+
+//nolint: exhaustivestruct
+func TestMsgSetEthDestinationLabel(t *testing.T) {
+	var (
+		userCosmosAddr, _ = sdk.AccAddressFromBech32("cosmos1990z7dqsvh8gthw9pa5sn4wuy2xrsd80mg5z6y")
+		denom             = "gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+		startingCoins     = sdk.Coins{sdk.NewInt64Coin(denom, 1000)}
+		ethDestination, _ = types.NewEthAddress("0x3c9289da00b02dC623d0D8D907619890301D26d4")
+	)
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	h := NewHandler(input.GravityKeeper)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, startingCoins))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, userCosmosAddr, startingCoins))
+
+	_, err := h(ctx, types.NewMsgSetEthDestinationLabel(userCosmosAddr, "treasury", ethDestination))
+	require.NoError(t, err)
+	labels, _, err := input.GravityKeeper.GetEthDestinationLabels(ctx, userCosmosAddr, nil)
+	require.NoError(t, err)
+	require.Len(t, labels, 1)
+	assert.Equal(t, ethDestination.GetAddress(), labels[0].EthAddress)
+
+	// a send to the label goes to the labeled address
+	msg := &types.MsgSendToEth{
+		Sender:       userCosmosAddr.String(),
+		EthDestLabel: "treasury",
+		Amount:       sdk.NewInt64Coin(denom, 100),
+		BridgeFee:    sdk.NewInt64Coin(denom, 1)}
+	require.NoError(t, msg.ValidateBasic())
+	_, err = h(ctx, msg)
+	require.NoError(t, err)
+	txs := input.GravityKeeper.GetUnbatchedTransactions(ctx)
+	require.Len(t, txs, 1)
+	assert.Equal(t, ethDestination.GetAddress(), txs[0].DestAddress.GetAddress())
+
+	// a mistyped label is never sent to
+	msg.EthDestLabel = "treasurey"
+	_, err = h(ctx, msg)
+	require.ErrorIs(t, err, types.ErrUnknown)
+
+	// the label can only be used by its owner
+	_, err = input.GravityKeeper.GetEthDestinationLabel(ctx, keeper.AccAddrs[0], "treasury")
+	require.ErrorIs(t, err, types.ErrUnknown)
+
+	_, err = h(ctx, types.NewMsgSetEthDestinationLabel(userCosmosAddr, "treasury", nil))
+	require.NoError(t, err)
+	labels, _, err = input.GravityKeeper.GetEthDestinationLabels(ctx, userCosmosAddr, nil)
+	require.NoError(t, err)
+	assert.Empty(t, labels)
+	_, err = h(ctx, types.NewMsgSetEthDestinationLabel(userCosmosAddr, "treasury", nil))
+	require.Error(t, err)
+}
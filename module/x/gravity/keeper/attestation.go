@@ -1,13 +1,16 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
 	"sort"
 	"strconv"
+	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
@@ -28,8 +31,14 @@ func (k Keeper) Attest(
 	// but checking it here gives individual eth signers a chance to retry,
 	// and prevents validators from submitting two claims with the same nonce.
 	// This prevents there being two attestations with the same nonce that get 2/3s of the votes
-	// in the endBlocker.
-	lastEventNonce := k.GetLastEventNonceByValidator(ctx, valAddr)
+	// in the endBlocker. A validator that fell more than MaxClaimAge behind continues from the oldest
+	// event nonce a claim may still be for.
+	lastEventNonce := k.GetResumeEventNonce(ctx, valAddr)
+	// a claim at or below the last nonce is a resubmission, it is told apart so orchestrators can retry safely
+	if claim.GetEventNonce() <= lastEventNonce {
+		return nil, sdkerrors.Wrapf(types.ErrAlreadySubmitted, "claim at event nonce %d, last claimed %d",
+			claim.GetEventNonce(), lastEventNonce)
+	}
 	if claim.GetEventNonce() != lastEventNonce+1 {
 		return nil, types.ErrNonContiguousEventNonce
 	}
@@ -40,14 +49,38 @@ func (k Keeper) Attest(
 		return nil, sdkerrors.Wrap(err, "unable to compute claim hash")
 	}
 	att := k.GetAttestation(ctx, claim.GetEventNonce(), hash)
+	// an attestation created before the last claim hash version bump is still keyed under the previous version,
+	// votes keep going to it so it is not orphaned. New attestations are never created under the old version
+	if att == nil && types.ClaimHashVersion > 0 {
+		previousHash, err := claim.VersionedClaimHash(types.ClaimHashVersion - 1)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "unable to compute previous claim hash")
+		}
+		if previous := k.GetAttestation(ctx, claim.GetEventNonce(), previousHash); previous != nil {
+			att, hash = previous, previousHash
+		}
+	}
+	if att != nil && att.Vetoed {
+		return nil, sdkerrors.Wrapf(types.ErrAttestationVetoed, "event nonce %d", claim.GetEventNonce())
+	}
 
 	// If it does not exist, create a new one.
 	if att == nil {
 		att = &types.Attestation{
-			Observed: false,
-			Votes:    []string{},
-			Height:   uint64(ctx.BlockHeight()),
-			Claim:    anyClaim,
+			Observed:          false,
+			Votes:             []string{},
+			Height:            uint64(ctx.BlockHeight()),
+			Claim:             anyClaim,
+			EthBlockTimestamp: 0,
+			ObservedTime:      0,
+			Vetoed:            false,
+			PendingExecution:  false,
+			ClaimHashVersion:  types.ClaimHashVersion,
+			ObservedHeight:    0,
+		}
+		// the timestamp is part of the claim hash so every vote on this attestation agrees on it
+		if timestamped, ok := claim.(types.TimestampedEthereumClaim); ok {
+			att.EthBlockTimestamp = timestamped.GetEthBlockTimestamp()
 		}
 	}
 
@@ -61,14 +94,18 @@ func (k Keeper) Attest(
 }
 
 // TryAttestation checks if an attestation has enough votes to be applied to the consensus state
-// and has not already been marked Observed, then calls processAttestation to actually apply it to the state,
-// and then marks it Observed and emits an event.
+// and has not already been marked Observed, then marks it Observed, queues it to be applied to the state
+// by processAttestation and emits an event.
 func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
+	// a vetoed attestation holds no votes and must never be applied
+	if att.Vetoed {
+		return
+	}
 	claim, err := k.UnpackAttestationClaim(att)
 	if err != nil {
 		panic("could not cast to claim")
 	}
-	hash, err := claim.ClaimHash()
+	hash, err := attestationClaimHash(att, claim)
 	if err != nil {
 		panic("unable to compute claim hash")
 	}
@@ -91,21 +128,7 @@ func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
 			// If the power of all the validators that have voted on the attestation is higher or equal to the threshold,
 			// process the attestation, set Observed to true, and break
 			if attestationPower.GTE(requiredPower) {
-				lastEventNonce := k.GetLastObservedEventNonce(ctx)
-				// this check is performed at the next level up so this should never panic
-				// outside of programmer error.
-				if claim.GetEventNonce() != lastEventNonce+1 {
-					panic("attempting to apply events to state out of order")
-				}
-				k.setLastObservedEventNonce(ctx, claim.GetEventNonce())
-				k.SetLastObservedEthereumBlockHeight(ctx, claim.GetBlockHeight())
-
-				att.Observed = true
-				k.SetAttestation(ctx, claim.GetEventNonce(), hash, att)
-
-				k.processAttestation(ctx, att, claim)
-				k.emitObservedEvent(ctx, att, claim)
-
+				k.observeAttestation(ctx, att, claim, hash)
 				break
 			}
 		}
@@ -115,9 +138,69 @@ func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
 	}
 }
 
+// observeAttestation marks the attestation at the next event nonce observed and queues its event to be applied
+// to the state by ExecuteQueuedAttestations, within the per block budget
+func (k Keeper) observeAttestation(ctx sdk.Context, att *types.Attestation, claim types.EthereumClaim, hash []byte) {
+	lastEventNonce := k.GetLastObservedEventNonce(ctx)
+	// this check is performed at the next level up so this should never panic
+	// outside of programmer error.
+	if claim.GetEventNonce() != lastEventNonce+1 {
+		panic("attempting to apply events to state out of order")
+	}
+	k.setLastObservedEventNonce(ctx, claim.GetEventNonce())
+	if timestamped, ok := claim.(types.TimestampedEthereumClaim); ok {
+		k.RecordEthereumBlockTimeSample(ctx, claim.GetBlockHeight(), timestamped.GetEthBlockTimestamp())
+	}
+
+	att.Observed = true
+	att.ObservedTime = uint64(ctx.BlockTime().Unix())
+	att.ObservedHeight = uint64(ctx.BlockHeight())
+	att.PendingExecution = true
+	k.SetAttestation(ctx, claim.GetEventNonce(), hash, att)
+
+	k.enqueueAttestationExecution(ctx, claim.GetEventNonce(), hash)
+	k.emitObservedEvent(ctx, att, claim)
+}
+
+// TryFastPathAttestation observes an attestation in the block its final claim was submitted in instead
+// of waiting for the EndBlocker, once the power that has voted on it reaches the AttestationFastPathThreshold
+// param. Only the attestation at the next expected event nonce can take the fast path
+func (k Keeper) TryFastPathAttestation(ctx sdk.Context, att *types.Attestation) {
+	threshold := k.GetParams(ctx).AttestationFastPathThreshold
+	if threshold == 0 || att.Observed || att.Vetoed {
+		return
+	}
+	claim, err := k.UnpackAttestationClaim(att)
+	if err != nil {
+		panic("could not cast to claim")
+	}
+	if claim.GetEventNonce() != k.GetLastObservedEventNonce(ctx)+1 {
+		return
+	}
+
+	totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
+	requiredPower := sdk.NewIntFromUint64(threshold).Mul(totalPower).Quo(sdk.NewInt(100))
+	attestationPower := sdk.NewInt(0)
+	for _, validator := range att.Votes {
+		val, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			panic(err)
+		}
+		attestationPower = attestationPower.Add(sdk.NewInt(k.StakingKeeper.GetLastValidatorPower(ctx, val)))
+	}
+	if attestationPower.GTE(requiredPower) {
+		k.TryAttestation(ctx, att)
+		// apply the head of the execution queue right away, which is this attestation unless
+		// earlier observations are still waiting on the per block budget
+		if att.Observed {
+			k.ExecuteQueuedAttestations(ctx, 1)
+		}
+	}
+}
+
 // processAttestation actually applies the attestation to the consensus state
 func (k Keeper) processAttestation(ctx sdk.Context, att *types.Attestation, claim types.EthereumClaim) {
-	hash, err := claim.ClaimHash()
+	hash, err := attestationClaimHash(att, claim)
 	if err != nil {
 		panic("unable to compute claim hash")
 	}
@@ -141,7 +224,7 @@ func (k Keeper) processAttestation(ctx sdk.Context, att *types.Attestation, clai
 // emitObservedEvent emits an event with information about an attestation that has been applied to
 // consensus state.
 func (k Keeper) emitObservedEvent(ctx sdk.Context, att *types.Attestation, claim types.EthereumClaim) {
-	hash, err := claim.ClaimHash()
+	hash, err := attestationClaimHash(att, claim)
 	if err != nil {
 		panic(sdkerrors.Wrap(err, "unable to compute claim hash"))
 	}
@@ -157,7 +240,24 @@ func (k Keeper) emitObservedEvent(ctx sdk.Context, att *types.Attestation, claim
 		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(claim.GetEventNonce())),
 		// TODO: do we want to emit more information?
 	)
-	ctx.EventManager().EmitEvent(observationEvent)
+	if att.EthBlockTimestamp != 0 {
+		observationEvent = observationEvent.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeyEthBlockTimestamp, fmt.Sprint(att.EthBlockTimestamp)))
+	}
+	k.emitLegacyEvent(ctx, observationEvent)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAttestationObserved{
+		AttestationType:   claim.GetType(),
+		EventNonce:        claim.GetEventNonce(),
+		ClaimHash:         hex.EncodeToString(hash),
+		EthBlockTimestamp: att.EthBlockTimestamp,
+	}); err != nil {
+		panic(sdkerrors.Wrap(err, "emit attestation observed event"))
+	}
+}
+
+// attestationClaimHash returns the hash the attestation is keyed under, computed with its claim hash version
+func attestationClaimHash(att *types.Attestation, claim types.EthereumClaim) ([]byte, error) {
+	return claim.VersionedClaimHash(att.ClaimHashVersion)
 }
 
 // SetAttestation sets the attestation in the store
@@ -186,19 +286,22 @@ func (k Keeper) DeleteAttestation(ctx sdk.Context, att types.Attestation) {
 	if err != nil {
 		panic("Bad Attestation in DeleteAttestation")
 	}
-	hash, err := claim.ClaimHash()
+	hash, err := attestationClaimHash(&att, claim)
 	if err != nil {
 		panic(sdkerrors.Wrap(err, "unable to compute claim hash"))
 	}
 	store := ctx.KVStore(k.storeKey)
 
 	store.Delete(types.GetAttestationKey(claim.GetEventNonce(), hash))
+	if deposit, ok := claim.(*types.MsgSendToCosmosClaim); ok {
+		k.deleteDepositEthTxHash(ctx, deposit)
+	}
 }
 
 // GetAttestationMapping returns a mapping of eventnonce -> attestations at that nonce
 func (k Keeper) GetAttestationMapping(ctx sdk.Context) (out map[uint64][]types.Attestation) {
 	out = make(map[uint64][]types.Attestation)
-	k.IterateAttestaions(ctx, func(_ []byte, att types.Attestation) bool {
+	k.IterateAttestaions(ctx, IterationLimit{}, func(_ []byte, att types.Attestation) bool {
 		claim, err := k.UnpackAttestationClaim(&att)
 		if err != nil {
 			panic("couldn't cast to claim")
@@ -214,14 +317,17 @@ func (k Keeper) GetAttestationMapping(ctx sdk.Context) (out map[uint64][]types.A
 	return
 }
 
-// IterateAttestaions iterates through all attestations
-func (k Keeper) IterateAttestaions(ctx sdk.Context, cb func([]byte, types.Attestation) bool) {
+// IterateAttestaions iterates through the attestations in event nonce order, within limit
+func (k Keeper) IterateAttestaions(ctx sdk.Context, limit IterationLimit, cb func([]byte, types.Attestation) bool) {
 	store := ctx.KVStore(k.storeKey)
 	prefix := types.OracleAttestationKey
-	iter := store.Iterator(prefixRange(prefix))
+	start, end := prefixRange(prefix)
+	iter := store.Iterator(limit.bounds(start, end, false))
 	defer iter.Close()
 
-	for ; iter.Valid(); iter.Next() {
+	var visited uint64
+	for ; iter.Valid() && !limit.reached(visited); iter.Next() {
+		visited++
 		att := types.Attestation{
 			Observed: false,
 			Votes:    []string{},
@@ -233,6 +339,10 @@ func (k Keeper) IterateAttestaions(ctx sdk.Context, cb func([]byte, types.Attest
 				XXX_unrecognized:     []byte{},
 				XXX_sizecache:        0,
 			},
+			EthBlockTimestamp: 0,
+			ObservedTime:      0,
+			Vetoed:            false,
+			PendingExecution:  false,
 		}
 		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &att)
 		// cb returns true to stop early
@@ -242,34 +352,90 @@ func (k Keeper) IterateAttestaions(ctx sdk.Context, cb func([]byte, types.Attest
 	}
 }
 
-// GetMostRecentAttestations returns sorted (by nonce) attestations up to a provided limit number of attestations
-// Note: calls GetAttestationMapping in the hopes that there are potentially many attestations
-// which are distributed between few nonces to minimize sorting time
-func (k Keeper) GetMostRecentAttestations(ctx sdk.Context, limit uint64) []*types.Attestation {
-	attestationMapping := k.GetAttestationMapping(ctx)
-	attestations := make([]*types.Attestation, 0, limit)
+// IterateAttestationsByNonce iterates through all attestations for a single event nonce, passing the claim
+// hash each attestation is stored under. cb returns true to stop early
+func (k Keeper) IterateAttestationsByNonce(ctx sdk.Context, eventNonce uint64, cb func([]byte, types.Attestation) bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := append(types.OracleAttestationKey, types.UInt64Bytes(eventNonce)...)
+	iter := store.Iterator(prefixRange(prefix))
+	defer iter.Close()
 
-	keys := make([]uint64, 0, len(attestationMapping))
-	for k := range attestationMapping {
-		keys = append(keys, k)
+	for ; iter.Valid(); iter.Next() {
+		var att types.Attestation
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &att)
+		if cb(iter.Key()[len(prefix):], att) {
+			return
+		}
 	}
-	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+}
 
-	// Iterate the nonces and collect the attestations
-	count := 0
-	for _, nonce := range keys {
-		if count >= int(limit) {
-			break
+// GetAttestationVoteBreakdown returns the current power of every validator that voted on an attestation and
+// of every validator in the current set that did not, along with the power still required for it to be observed
+func (k Keeper) GetAttestationVoteBreakdown(
+	ctx sdk.Context, eventNonce uint64, claimHash []byte, att types.Attestation) types.AttestationVoteBreakdown {
+	totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
+	requiredPower := types.AttestationVotesPowerThreshold.Mul(totalPower).Quo(sdk.NewInt(100))
+
+	votes := make([]*types.AttestationVote, 0, len(att.Votes))
+	voted := make(map[string]bool, len(att.Votes))
+	votedPower := sdk.ZeroInt()
+	for _, validator := range att.Votes {
+		val, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			panic(err)
 		}
-		for _, att := range attestationMapping[nonce] {
-			if count >= int(limit) {
-				break
-			}
-			attestations = append(attestations, &att)
-			count++
+		power := k.StakingKeeper.GetLastValidatorPower(ctx, val)
+		votedPower = votedPower.Add(sdk.NewInt(power))
+		votes = append(votes, &types.AttestationVote{Validator: validator, Power: uint64(power)})
+		voted[validator] = true
+	}
+
+	var missing []*types.AttestationVote
+	k.StakingKeeper.IterateLastValidators(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		operator := validator.GetOperator()
+		if !voted[operator.String()] {
+			power := k.StakingKeeper.GetLastValidatorPower(ctx, operator)
+			missing = append(missing, &types.AttestationVote{Validator: operator.String(), Power: uint64(power)})
 		}
+		return false
+	})
+	// the validators holding up the attestation the most come first
+	sort.SliceStable(missing, func(i, j int) bool {
+		return missing[i].Power > missing[j].Power
+	})
+
+	remainingPower := sdk.ZeroInt()
+	if !att.Observed && votedPower.LT(requiredPower) {
+		remainingPower = requiredPower.Sub(votedPower)
 	}
+	return types.AttestationVoteBreakdown{
+		EventNonce:     eventNonce,
+		ClaimHash:      hex.EncodeToString(claimHash),
+		Observed:       att.Observed,
+		Votes:          votes,
+		VotedPower:     votedPower.Uint64(),
+		RequiredPower:  requiredPower.Uint64(),
+		RemainingPower: remainingPower.Uint64(),
+		TotalPower:     totalPower.Uint64(),
+		MissingVotes:   missing,
+	}
+}
 
+// GetMostRecentAttestations returns sorted (by nonce) attestations up to a provided limit number of attestations
+func (k Keeper) GetMostRecentAttestations(ctx sdk.Context, limit uint64) []*types.Attestation {
+	attestations := make([]*types.Attestation, 0, limit)
+	if limit == 0 {
+		return attestations
+	}
+	// attestations are keyed by event nonce, so the store order is the nonce order
+	k.IterateAttestaions(ctx, IterationLimit{StartAfter: nil, MaxResults: limit}, func(_ []byte, att types.Attestation) bool {
+		// unpacking caches the claim on the attestation, as GetAttestationMapping does
+		if _, err := k.UnpackAttestationClaim(&att); err != nil {
+			panic("couldn't cast to claim")
+		}
+		attestations = append(attestations, &att)
+		return false
+	})
 	return attestations
 }
 
@@ -284,8 +450,8 @@ func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context) uint64 {
 	return types.UInt64FromBytes(bytes)
 }
 
-// GetLastObservedEthereumBlockHeight height gets the block height to of the last observed attestation from
-// the store
+// GetLastObservedEthereumBlockHeight gets the observed Ethereum block height from the store, this is the
+// power weighted median of the heights reported by the validators, see UpdateObservedEthereumHeight
 func (k Keeper) GetLastObservedEthereumBlockHeight(ctx sdk.Context) types.LastObservedEthereumBlockHeight {
 	store := ctx.KVStore(k.storeKey)
 	bytes := store.Get(types.LastObservedEthereumBlockHeightKey)
@@ -294,11 +460,13 @@ func (k Keeper) GetLastObservedEthereumBlockHeight(ctx sdk.Context) types.LastOb
 		return types.LastObservedEthereumBlockHeight{
 			CosmosBlockHeight:   0,
 			EthereumBlockHeight: 0,
+			CosmosBlockTime:     0,
 		}
 	}
 	height := types.LastObservedEthereumBlockHeight{
 		CosmosBlockHeight:   0,
 		EthereumBlockHeight: 0,
+		CosmosBlockTime:     0,
 	}
 	k.cdc.MustUnmarshalBinaryBare(bytes, &height)
 	return height
@@ -310,6 +478,7 @@ func (k Keeper) SetLastObservedEthereumBlockHeight(ctx sdk.Context, ethereumHeig
 	height := types.LastObservedEthereumBlockHeight{
 		EthereumBlockHeight: ethereumHeight,
 		CosmosBlockHeight:   uint64(ctx.BlockHeight()),
+		CosmosBlockTime:     uint64(ctx.BlockTime().UnixNano() / int64(time.Millisecond)),
 	}
 	store.Set(types.LastObservedEthereumBlockHeightKey, k.cdc.MustMarshalBinaryBare(&height))
 }
@@ -326,11 +495,12 @@ func (k Keeper) GetLastObservedValset(ctx sdk.Context) *types.Valset {
 		return nil
 	}
 	valset := types.Valset{
-		Nonce:        0,
-		Members:      []*types.BridgeValidator{},
-		Height:       0,
-		RewardAmount: sdk.Int{},
-		RewardToken:  "",
+		Nonce:          0,
+		Members:        []*types.BridgeValidator{},
+		Height:         0,
+		RewardAmount:   sdk.Int{},
+		RewardToken:    "",
+		PowerThreshold: 0,
 	}
 	k.cdc.MustUnmarshalBinaryBare(bytes, &valset)
 	return &valset
@@ -373,3 +543,99 @@ func (k Keeper) setLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValA
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetLastEventNonceByValidatorKey(validator), types.UInt64Bytes(nonce))
 }
+
+// HandleAttestationVetoProposal marks the unobserved attestation named by a passed AttestationVetoProposal
+// as vetoed and clears its votes. The attestation is kept in the store so that no further votes can be
+// cast on it, which leaves its event nonce unobserved and halts attestation processing at that nonce
+func (k Keeper) HandleAttestationVetoProposal(ctx sdk.Context, p *types.AttestationVetoProposal) error {
+	claimHash, err := p.GetClaimHashBytes()
+	if err != nil {
+		return err
+	}
+	att := k.GetAttestation(ctx, p.EventNonce, claimHash)
+	if att == nil {
+		return sdkerrors.Wrapf(types.ErrUnknown, "attestation for event nonce %d", p.EventNonce)
+	}
+	if att.Observed {
+		return sdkerrors.Wrap(types.ErrInvalid, "attestation has already been observed")
+	}
+	if att.Vetoed {
+		return sdkerrors.Wrap(types.ErrAttestationVetoed, "attestation has already been vetoed")
+	}
+
+	votes := len(att.Votes)
+	att.Vetoed = true
+	att.Votes = []string{}
+	k.SetAttestation(ctx, p.EventNonce, claimHash, att)
+	k.appendAuditLog(ctx, p,
+		fmt.Sprintf("attestation %s at event nonce %d with %d votes", p.ClaimHash, p.EventNonce, votes),
+		fmt.Sprintf("attestation %s at event nonce %d vetoed", p.ClaimHash, p.EventNonce))
+
+	k.emitLegacyEvent(ctx,
+		sdk.NewEvent(
+			types.EventTypeAttestationVetoed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyAttestationID, string(types.GetAttestationKey(p.EventNonce, claimHash))),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(p.EventNonce)),
+		),
+	)
+	return ctx.EventManager().EmitTypedEvent(&types.EventAttestationVetoed{
+		EventNonce: p.EventNonce,
+		ClaimHash:  hex.EncodeToString(claimHash),
+	})
+}
+
+// HandleResolveEventNonceProposal moves the bridge past the event nonce named by a passed ResolveEventNonceProposal,
+// which must be the next one to be observed. The attestation named by its claim hash is observed and queued
+// for execution regardless of its votes, without a claim hash the event nonce is marked observed without
+// applying any event. Validators that have not claimed the event nonce continue from the next one, their
+// orchestrators are told that claims at or below it were already submitted
+func (k Keeper) HandleResolveEventNonceProposal(ctx sdk.Context, p *types.ResolveEventNonceProposal) error {
+	lastObserved := k.GetLastObservedEventNonce(ctx)
+	if p.EventNonce != lastObserved+1 {
+		return sdkerrors.Wrapf(types.ErrInvalid, "event nonce %d is not the next to be observed after %d",
+			p.EventNonce, lastObserved)
+	}
+
+	event := sdk.NewEvent(
+		types.EventTypeEventNonceResolved,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(p.EventNonce)),
+	)
+	resolved := types.EventNonceResolved{EventNonce: p.EventNonce}
+	outcome := fmt.Sprintf("event nonce %d skipped", p.EventNonce)
+	if p.ClaimHash != "" {
+		claimHash, err := p.GetClaimHashBytes()
+		if err != nil {
+			return err
+		}
+		att := k.GetAttestation(ctx, p.EventNonce, claimHash)
+		if att == nil {
+			return sdkerrors.Wrapf(types.ErrUnknown, "attestation for event nonce %d", p.EventNonce)
+		}
+		if att.Vetoed {
+			return sdkerrors.Wrap(types.ErrAttestationVetoed, "a vetoed attestation can not be observed")
+		}
+		claim, err := k.UnpackAttestationClaim(att)
+		if err != nil {
+			return sdkerrors.Wrap(err, "unable to unpack claim")
+		}
+		k.observeAttestation(ctx, att, claim, claimHash)
+		outcome = fmt.Sprintf("attestation %s at event nonce %d observed with %d votes", p.ClaimHash, p.EventNonce, len(att.Votes))
+		event = event.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeyAttestationID, string(types.GetAttestationKey(p.EventNonce, claimHash))))
+		resolved.ClaimHash = hex.EncodeToString(claimHash)
+	} else {
+		k.setLastObservedEventNonce(ctx, p.EventNonce)
+	}
+
+	k.StakingKeeper.IterateLastValidators(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		if k.GetLastEventNonceByValidator(ctx, validator.GetOperator()) < p.EventNonce {
+			k.setLastEventNonceByValidator(ctx, validator.GetOperator(), p.EventNonce)
+		}
+		return false
+	})
+	k.appendAuditLog(ctx, p, fmt.Sprintf("last observed event nonce %d", lastObserved), outcome)
+	k.emitLegacyEvent(ctx, event)
+	return ctx.EventManager().EmitTypedEvent(&resolved)
+}
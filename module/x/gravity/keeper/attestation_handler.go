@@ -3,182 +3,316 @@ package keeper
 import (
 	"fmt"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
-// AttestationHandler processes `observed` Attestations
+// ClaimHandler applies an observed claim of the claim type it is registered for
+type ClaimHandler interface {
+	Handle(ctx sdk.Context, att types.Attestation, claim types.EthereumClaim) error
+}
+
+// ClaimHandlerFunc lets a plain function be used as a ClaimHandler
+type ClaimHandlerFunc func(ctx sdk.Context, att types.Attestation, claim types.EthereumClaim) error
+
+// Handle calls f
+func (f ClaimHandlerFunc) Handle(ctx sdk.Context, att types.Attestation, claim types.EthereumClaim) error {
+	return f(ctx, att, claim)
+}
+
+// AttestationHandler processes `observed` Attestations by passing every claim to the handler of its claim type.
+// The built in claim types are handled here, the handlers of any other claim type are looked up in the registry
+// of the keeper
 type AttestationHandler struct {
 	keeper     Keeper
 	bankKeeper types.BankKeeper
+	handlers   map[types.ClaimType]ClaimHandler
+}
+
+// newAttestationHandler returns an AttestationHandler that applies the built in claim types with keeper and
+// bankKeeper
+func newAttestationHandler(keeper Keeper, bankKeeper types.BankKeeper) AttestationHandler {
+	a := AttestationHandler{
+		keeper:     keeper,
+		bankKeeper: bankKeeper,
+		handlers:   make(map[types.ClaimType]ClaimHandler),
+	}
+	a.handlers[types.CLAIM_TYPE_SEND_TO_COSMOS] = ClaimHandlerFunc(a.handleSendToCosmos)
+	a.handlers[types.CLAIM_TYPE_BATCH_SEND_TO_ETH] = ClaimHandlerFunc(a.handleBatchSendToEth)
+	a.handlers[types.CLAIM_TYPE_ERC20_DEPLOYED] = ClaimHandlerFunc(a.handleERC20Deployed)
+	a.handlers[types.CLAIM_TYPE_VALSET_UPDATED] = ClaimHandlerFunc(a.handleValsetUpdated)
+	a.handlers[types.CLAIM_TYPE_LOGIC_CALL_EXECUTED] = ClaimHandlerFunc(a.handleLogicCallExecuted)
+	a.handlers[types.CLAIM_TYPE_MIGRATION_COMPLETED] = ClaimHandlerFunc(a.handleMigrationCompleted)
+	return a
 }
 
 // Handle is the entry point for Attestation processing.
 func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim types.EthereumClaim) error {
-	switch claim := claim.(type) {
-	// deposit in this context means a deposit into the Ethereum side of the bridge
-	case *types.MsgSendToCosmosClaim:
-		tokenAddress, err := types.NewEthAddress(claim.TokenContract)
-		if err != nil {
-			return sdkerrors.Wrap(err, "invalid token contract on claim")
-		}
-		// Check if coin is Cosmos-originated asset and get denom
-		isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, *tokenAddress)
-
-		if isCosmosOriginated {
-			// If it is cosmos originated, unlock the coins
-			coins := sdk.Coins{sdk.NewCoin(denom, claim.Amount)}
-
-			addr, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
-			if err != nil {
-				return sdkerrors.Wrap(err, "invalid receiver address")
-			}
-
-			if err = a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
-				return sdkerrors.Wrap(err, "transfer vouchers")
-			}
-		} else {
-			// If it is not cosmos originated, mint the coins (aka vouchers)
-			coins := sdk.Coins{sdk.NewCoin(denom, claim.Amount)}
-
-			if err := a.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
-				return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
-			}
-
-			addr, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
-			if err != nil {
-				return sdkerrors.Wrap(err, "invalid receiver address")
-			}
-
-			if err = a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
-				return sdkerrors.Wrap(err, "transfer vouchers")
-			}
-		}
-	// withdraw in this context means a withdraw from the Ethereum side of the bridge
-	case *types.MsgBatchSendToEthClaim:
-		contract, err := types.NewEthAddress(claim.TokenContract)
-		if err != nil {
-			return sdkerrors.Wrap(err, "invalid token contract on batch")
+	handler, ok := a.handlers[claim.GetType()]
+	if !ok {
+		handler, ok = a.keeper.claimHandlers[claim.GetType()]
+	}
+	if !ok {
+		panic(fmt.Sprintf("Invalid event type for attestations %s", claim.GetType()))
+	}
+	return handler.Handle(ctx, att, claim)
+}
+
+// RegisterClaimHandler registers handler for the observed claims of claimType, so that a chain can add its own
+// claim types without changing the module. The claim type also has to be registered as an EthereumClaim
+// implementation with the interface registry of the app, or its attestations can not be unpacked. Registering
+// a built in claim type or a claim type twice panics, this is meant to be called while the app is wired up
+func (k Keeper) RegisterClaimHandler(claimType types.ClaimType, handler ClaimHandler) {
+	if claimType == types.CLAIM_TYPE_UNSPECIFIED {
+		panic("can not register a handler for the unspecified claim type")
+	}
+	if _, ok := newAttestationHandler(k, k.bankKeeper).handlers[claimType]; ok {
+		panic(fmt.Sprintf("claim type %s is handled by the gravity module", claimType))
+	}
+	if _, ok := k.claimHandlers[claimType]; ok {
+		panic(fmt.Sprintf("claim type %s already has a handler", claimType))
+	}
+	k.claimHandlers[claimType] = handler
+}
+
+// SubmitClaim votes on claim for the orchestrator that made it, with the checks the claim messages of the module go
+// through. A chain that registered its own claim type submits those claims through it from its own message handler
+func (k Keeper) SubmitClaim(ctx sdk.Context, claim types.EthereumClaim) error {
+	srv := msgServer{Keeper: k}
+	if err := srv.checkOrchestratorValidatorInSet(ctx, claim.GetClaimer().String()); err != nil {
+		return err
+	}
+	any, err := codectypes.NewAnyWithValue(claim)
+	if err != nil {
+		return err
+	}
+	return srv.claimHandlerCommon(ctx, any, claim)
+}
+
+// handleSendToCosmos applies a deposit into the Ethereum side of the bridge
+func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, _ types.Attestation, c types.EthereumClaim) error {
+	claim := c.(*types.MsgSendToCosmosClaim)
+	tokenAddress, err := types.NewEthAddress(claim.TokenContract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid token contract on claim")
+	}
+	// Check if coin is Cosmos-originated asset and get denom
+	isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, *tokenAddress)
+	addr, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid receiver address")
+	}
+
+	coins := sdk.Coins{sdk.NewCoin(denom, claim.Amount)}
+	if !isCosmosOriginated {
+		// If it is not cosmos originated, mint the coins (aka vouchers)
+		if err := a.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
 		}
-		a.keeper.OutgoingTxBatchExecuted(ctx, *contract, claim.BatchNonce)
+	}
+	// a contract depositing on behalf of a user counts the user as the sender, not the contract
+	a.keeper.recordBridgeDeposit(ctx, *tokenAddress, claim.GetDepositor(), claim.Amount)
+
+	// a deposit from a quarantined sender stays in the module account, where Cosmos originated coins are escrowed
+	// and the vouchers were just minted, until governance releases it
+	if a.keeper.isQuarantinedDeposit(ctx, claim) {
+		a.keeper.quarantineDeposit(ctx, claim, addr, denom)
 		return nil
-	case *types.MsgERC20DeployedClaim:
-		tokenAddress, err := types.NewEthAddress(claim.TokenContract)
-		if err != nil {
-			return sdkerrors.Wrap(err, "invalid token contract on claim")
-		}
-		// Check if it already exists
-		existingERC20, exists := a.keeper.GetCosmosOriginatedERC20(ctx, claim.CosmosDenom)
-		if exists {
-			return sdkerrors.Wrap(
-				types.ErrInvalid,
-				fmt.Sprintf("ERC20 %s already exists for denom %s", existingERC20, claim.CosmosDenom))
-		}
+	}
 
-		// Check if denom exists
-		metadata := a.keeper.bankKeeper.GetDenomMetaData(ctx, claim.CosmosDenom)
-		if metadata.Base == "" {
-			return sdkerrors.Wrap(types.ErrUnknown, fmt.Sprintf("denom not found %s", claim.CosmosDenom))
-		}
+	// unlock the Cosmos originated coins or hand out the minted vouchers
+	if err = a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+		return sdkerrors.Wrap(err, "transfer vouchers")
+	}
+	receipt := a.keeper.setDepositReceipt(ctx, claim, addr, denom)
+	a.keeper.gravityHooks.AfterSendToCosmos(ctx, receipt)
+	return nil
+}
 
-		// Check if attributes of ERC20 match Cosmos denom
-		if claim.Name != metadata.Display {
-			return sdkerrors.Wrap(
-				types.ErrInvalid,
-				fmt.Sprintf("ERC20 name %s does not match denom display %s", claim.Name, metadata.Description))
+// handleBatchSendToEth applies a withdraw from the Ethereum side of the bridge, the execution of a batch
+func (a AttestationHandler) handleBatchSendToEth(ctx sdk.Context, _ types.Attestation, c types.EthereumClaim) error {
+	claim := c.(*types.MsgBatchSendToEthClaim)
+	contract, err := types.NewEthAddress(claim.TokenContract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid token contract on batch")
+	}
+	// the batch is deleted once executed, the hooks are handed what it was
+	batch := a.keeper.GetOutgoingTXBatch(ctx, *contract, claim.BatchNonce)
+	if batch != nil {
+		a.keeper.recordFinishedBatch(ctx, batch, types.BATCH_LIFECYCLE_STATUS_EXECUTED, claim.BlockHeight)
+	}
+	a.keeper.OutgoingTxBatchExecuted(ctx, *contract, claim.BatchNonce)
+	a.keeper.gravityHooks.AfterBatchExecuted(ctx, *batch.ToExternal())
+	var relayer *types.EthAddress
+	if claim.Relayer != "" {
+		if relayer, err = types.NewEthAddress(claim.Relayer); err != nil {
+			return sdkerrors.Wrap(err, "invalid relayer on batch")
 		}
+	}
+	a.keeper.RunRelayerLottery(ctx, relayer, claim.EventNonce)
+	return nil
+}
+
+// handleERC20Deployed maps a Cosmos originated denom to its newly deployed ERC20
+func (a AttestationHandler) handleERC20Deployed(ctx sdk.Context, _ types.Attestation, c types.EthereumClaim) error {
+	claim := c.(*types.MsgERC20DeployedClaim)
+	tokenAddress, err := types.NewEthAddress(claim.TokenContract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid token contract on claim")
+	}
+	// Check if it already exists
+	existingERC20, exists := a.keeper.GetCosmosOriginatedERC20(ctx, claim.CosmosDenom)
+	if exists {
+		return sdkerrors.Wrap(
+			types.ErrInvalid,
+			fmt.Sprintf("ERC20 %s already exists for denom %s", existingERC20, claim.CosmosDenom))
+	}
 
-		if claim.Symbol != metadata.Display {
-			return sdkerrors.Wrap(
-				types.ErrInvalid,
-				fmt.Sprintf("ERC20 symbol %s does not match denom display %s", claim.Symbol, metadata.Display))
+	// Check if denom exists
+	metadata := a.keeper.bankKeeper.GetDenomMetaData(ctx, claim.CosmosDenom)
+	if metadata.Base == "" {
+		return sdkerrors.Wrap(types.ErrUnknown, fmt.Sprintf("denom not found %s", claim.CosmosDenom))
+	}
+
+	// Check if attributes of ERC20 match Cosmos denom
+	if claim.Name != metadata.Display {
+		return sdkerrors.Wrap(
+			types.ErrInvalid,
+			fmt.Sprintf("ERC20 name %s does not match denom display %s", claim.Name, metadata.Description))
+	}
+
+	if claim.Symbol != metadata.Display {
+		return sdkerrors.Wrap(
+			types.ErrInvalid,
+			fmt.Sprintf("ERC20 symbol %s does not match denom display %s", claim.Symbol, metadata.Display))
+	}
+
+	// ERC20 tokens use a very simple mechanism to tell you where to display the decimal point.
+	// The "decimals" field simply tells you how many decimal places there will be.
+	// Cosmos denoms have a system that is much more full featured, with enterprise-ready token denominations.
+	// There is a DenomUnits array that tells you what the name of each denomination of the
+	// token is.
+	// To correlate this with an ERC20 "decimals" field, we have to search through the DenomUnits array
+	// to find the DenomUnit which matches up to the main token "display" value. Then we take the
+	// "exponent" from this DenomUnit.
+	// If the correct DenomUnit is not found, it will default to 0. This will result in there being no decimal places
+	// in the token's ERC20 on Ethereum. So, for example, if this happened with Atom, 1 Atom would appear on Ethereum
+	// as 1 million Atoms, having 6 extra places before the decimal point.
+	// This will only happen with a Denom Metadata which is for all intents and purposes invalid, but I am not sure
+	// this is checked for at any other point.
+	decimals := uint32(0)
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit.Denom == metadata.Display {
+			decimals = denomUnit.Exponent
+			break
 		}
+	}
+
+	if decimals != uint32(claim.Decimals) {
+		return sdkerrors.Wrap(
+			types.ErrInvalid,
+			fmt.Sprintf("ERC20 decimals %d does not match denom decimals %d", claim.Decimals, decimals))
+	}
+
+	// Add to denom-erc20 mapping
+	a.keeper.setCosmosOriginatedDenomToERC20(ctx, claim.CosmosDenom, *tokenAddress)
+	return nil
+}
 
-		// ERC20 tokens use a very simple mechanism to tell you where to display the decimal point.
-		// The "decimals" field simply tells you how many decimal places there will be.
-		// Cosmos denoms have a system that is much more full featured, with enterprise-ready token denominations.
-		// There is a DenomUnits array that tells you what the name of each denomination of the
-		// token is.
-		// To correlate this with an ERC20 "decimals" field, we have to search through the DenomUnits array
-		// to find the DenomUnit which matches up to the main token "display" value. Then we take the
-		// "exponent" from this DenomUnit.
-		// If the correct DenomUnit is not found, it will default to 0. This will result in there being no decimal places
-		// in the token's ERC20 on Ethereum. So, for example, if this happened with Atom, 1 Atom would appear on Ethereum
-		// as 1 million Atoms, having 6 extra places before the decimal point.
-		// This will only happen with a Denom Metadata which is for all intents and purposes invalid, but I am not sure
-		// this is checked for at any other point.
-		decimals := uint32(0)
-		for _, denomUnit := range metadata.DenomUnits {
-			if denomUnit.Denom == metadata.Display {
-				decimals = denomUnit.Exponent
-				break
-			}
+// handleValsetUpdated records the last observed valset and accounts for its reward
+func (a AttestationHandler) handleValsetUpdated(ctx sdk.Context, _ types.Attestation, c types.EthereumClaim) error {
+	claim := c.(*types.MsgValsetUpdatedClaim)
+	rewardAddress, err := types.NewEthAddress(claim.RewardToken)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid reward token on claim")
+	}
+	// TODO here we should check the contents of the validator set against
+	// the store, if they differ we should take some action to indicate to the
+	// user that bridge highjacking has occurred
+	valset := types.Valset{
+		Nonce:          claim.ValsetNonce,
+		Members:        claim.Members,
+		Height:         0,
+		RewardAmount:   claim.RewardAmount,
+		RewardToken:    claim.RewardToken,
+		PowerThreshold: 0,
+	}
+	// the event does not carry the threshold, the contract checkpointed the valset with the one it was created with
+	if stored := a.keeper.GetValset(ctx, claim.ValsetNonce); stored != nil {
+		valset.PowerThreshold = stored.PowerThreshold
+	}
+	a.keeper.SetLastObservedValset(ctx, valset)
+	// if the reward is greater than zero and the reward token
+	// is valid then some reward was issued by this validator set
+	if claim.RewardAmount.GT(sdk.ZeroInt()) && claim.RewardToken != types.ZeroAddressString {
+		if err := a.accountValsetReward(ctx, *rewardAddress, claim.RewardAmount); err != nil {
+			return err
 		}
+	}
+	a.keeper.gravityHooks.AfterValsetUpdated(ctx, valset)
+	return nil
+}
 
-		if decimals != uint32(claim.Decimals) {
-			return sdkerrors.Wrap(
-				types.ErrInvalid,
-				fmt.Sprintf("ERC20 decimals %d does not match denom decimals %d", claim.Decimals, decimals))
+// accountValsetReward accounts for the reward the contract paid the relayer of a valset. A Cosmos originated reward
+// now exists on Ethereum and may come back to Cosmos, so it is minted into the module account. Note we are minting
+// based on the claim, the reward param could have changed since the valset was created. The flow is
+// user relays valset and gets reward -> event relayed to cosmos mints tokens to module
+// -> user sends tokens to cosmos and gets the minted tokens from the module
+// which can not race as the deposit always comes after the valset update in event nonce order.
+// An Ethereum originated reward was paid out of the tokens backing the vouchers, so the same amount of vouchers is
+// burned from the valset reward reserve. If the reserve can not cover it the rest stays in circulation without
+// backing, which is reported so that the reserve can be refilled and the shortfall burned by governance
+func (a AttestationHandler) accountValsetReward(ctx sdk.Context, token types.EthAddress, amount sdk.Int) error {
+	isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, token)
+	if isCosmosOriginated {
+		if err := a.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.Coins{sdk.NewCoin(denom, amount)}); err != nil {
+			return sdkerrors.Wrap(err, "mint valset reward")
 		}
+		return nil
+	}
 
-		// Add to denom-erc20 mapping
-		a.keeper.setCosmosOriginatedDenomToERC20(ctx, claim.CosmosDenom, *tokenAddress)
-	case *types.MsgValsetUpdatedClaim:
-		rewardAddress, err := types.NewEthAddress(claim.RewardToken)
-		if err != nil {
-			return sdkerrors.Wrap(err, "invalid reward token on claim")
+	reserve := a.keeper.GetValsetRewardReserve(ctx, denom)
+	burned := sdk.MinInt(reserve, amount)
+	if burned.IsPositive() {
+		if err := a.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.Coins{sdk.NewCoin(denom, burned)}); err != nil {
+			return sdkerrors.Wrap(err, "burn valset reward")
 		}
-		// TODO here we should check the contents of the validator set against
-		// the store, if they differ we should take some action to indicate to the
-		// user that bridge highjacking has occurred
-		a.keeper.SetLastObservedValset(ctx, types.Valset{
-			Nonce:        claim.ValsetNonce,
-			Members:      claim.Members,
-			Height:       0,
-			RewardAmount: claim.RewardAmount,
-			RewardToken:  claim.RewardToken,
+		a.keeper.setValsetRewardReserve(ctx, denom, reserve.Sub(burned))
+	}
+	if burned.LT(amount) {
+		shortfall := sdk.NewCoin(denom, amount.Sub(burned))
+		a.keeper.logger(ctx).Error("valset reward reserve short of the reward paid on Ethereum", "shortfall", shortfall.String())
+		a.keeper.emitLegacyEvent(ctx, sdk.NewEvent(
+			types.EventTypeValsetRewardShortfall,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyRewardAmount, sdk.NewCoin(denom, amount).String()),
+			sdk.NewAttribute(types.AttributeKeyRewardShortfall, shortfall.String()),
+		))
+		return ctx.EventManager().EmitTypedEvent(&types.EventValsetRewardShortfall{
+			Reward:    sdk.NewCoin(denom, amount),
+			Shortfall: shortfall,
 		})
-		// if the reward is greater than zero and the reward token
-		// is valid then some reward was issued by this validator set
-		// and we need to either add to the total tokens for a Cosmos native
-		// token, or burn non cosmos native tokens
-		if claim.RewardAmount.GT(sdk.ZeroInt()) && claim.RewardToken != types.ZeroAddressString {
-			// Check if coin is Cosmos-originated asset and get denom
-			isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, *rewardAddress)
-			if isCosmosOriginated {
-				// If it is cosmos originated, mint some coins to account
-				// for coins that now exist on Ethereum and may eventually come
-				// back to Cosmos.
-				//
-				// Note the flow is
-				// user relays valset and gets reward -> event relayed to cosmos mints tokens to module
-				// -> user sends tokens to cosmos and gets the minted tokens from the module
-				//
-				// it is not possible for this to be a race condition thanks to the event nonces
-				// no matter how long it takes to relay the valset updated event the deposit event
-				// for the user will always come after.
-				//
-				// Note we are minting based on the claim! This is important as the reward value
-				// could change between when this event occurred and the present
-				coins := sdk.Coins{sdk.NewCoin(denom, claim.RewardAmount)}
-				a.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
-			} else {
-				// // If it is not cosmos originated, burn the coins (aka Vouchers)
-				// // so that we don't think we have more in the bridge than we actually do
-				// coins := sdk.Coins{sdk.NewCoin(denom, claim.RewardAmount)}
-				// a.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
-
-				// if you want to issue Ethereum originated tokens remove this panic and uncomment
-				// the above code but note that you will have to constantly replenish the tokens in the
-				// module or your chain will eventually halt.
-				panic("Can not use Ethereum originated token as reward!")
-			}
-		}
-
-	default:
-		panic(fmt.Sprintf("Invalid event type for attestations %s", claim.GetType()))
 	}
 	return nil
 }
+
+// handleLogicCallExecuted marks a logic call as executed
+func (a AttestationHandler) handleLogicCallExecuted(ctx sdk.Context, _ types.Attestation, c types.EthereumClaim) error {
+	claim := c.(*types.MsgLogicCallExecutedClaim)
+	a.keeper.OutgoingLogicCallExecuted(ctx, claim.InvalidationId, claim.InvalidationNonce)
+	a.keeper.gravityHooks.AfterContractCallExecuted(ctx, claim.InvalidationId, claim.InvalidationNonce)
+	return nil
+}
+
+// handleMigrationCompleted switches the bridge over to the contract it was migrated to
+func (a AttestationHandler) handleMigrationCompleted(ctx sdk.Context, _ types.Attestation, c types.EthereumClaim) error {
+	claim := c.(*types.MsgMigrationCompletedClaim)
+	newContract, err := types.NewEthAddress(claim.NewBridgeContract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid new bridge contract on claim")
+	}
+	return a.keeper.CompleteBridgeMigration(ctx, *newContract)
+}
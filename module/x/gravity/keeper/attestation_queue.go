@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//  ATTESTATION EXECUTION  //
+/////////////////////////////
+
+// enqueueAttestationExecution queues an observed attestation to be applied to the state
+func (k Keeper) enqueueAttestationExecution(ctx sdk.Context, eventNonce uint64, claimHash []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAttestationExecutionQueueKey(eventNonce, claimHash), []byte{})
+}
+
+// HasQueuedAttestations returns true while any observed attestation is still waiting to be applied
+func (k Keeper) HasQueuedAttestations(ctx sdk.Context) bool {
+	iter := ctx.KVStore(k.storeKey).Iterator(prefixRange(types.AttestationExecutionQueueKey))
+	defer iter.Close()
+	return iter.Valid()
+}
+
+// GetQueuedAttestationCount returns the number of observed attestations waiting to be applied
+func (k Keeper) GetQueuedAttestationCount(ctx sdk.Context) uint64 {
+	iter := ctx.KVStore(k.storeKey).Iterator(prefixRange(types.AttestationExecutionQueueKey))
+	defer iter.Close()
+	var count uint64
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count
+}
+
+// ExecuteQueuedAttestations applies up to budget queued attestations to the state in event nonce
+// order and returns the number applied. Whatever is left over stays queued for the next block, so
+// a flood of observed deposits is spread over several blocks instead of stalling one EndBlocker
+func (k Keeper) ExecuteQueuedAttestations(ctx sdk.Context, budget uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	prefixLen := len(types.AttestationExecutionQueueKey) + len(types.UInt64Bytes(0))
+
+	// collect the keys first, the store may not be written to while it is being iterated
+	var queued [][]byte
+	iter := store.Iterator(prefixRange(types.AttestationExecutionQueueKey))
+	for ; iter.Valid() && uint64(len(queued)) < budget; iter.Next() {
+		queued = append(queued, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+
+	// the queue is its own cursor, each attestation leaves it together with being applied
+	for _, key := range queued {
+		key := key
+		k.RunBudgetedUnit(ctx, func(ctx sdk.Context) {
+			ctx.KVStore(k.storeKey).Delete(key)
+			eventNonce := types.UInt64FromBytes(key[len(types.AttestationExecutionQueueKey):prefixLen])
+			claimHash := key[prefixLen:]
+			att := k.GetAttestation(ctx, eventNonce, claimHash)
+			if att == nil {
+				// this should never happen outside of programmer error, pruning skips pending attestations
+				panic("queued attestation not found")
+			}
+			claim, err := k.UnpackAttestationClaim(att)
+			if err != nil {
+				panic("could not cast to claim")
+			}
+
+			att.PendingExecution = false
+			k.SetAttestation(ctx, eventNonce, claimHash, att)
+			k.processAttestation(ctx, att, claim)
+		})
+	}
+	return uint64(len(queued))
+}
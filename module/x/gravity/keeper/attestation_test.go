@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
@@ -34,9 +35,10 @@ func TestGetMostRecentAttestations(t *testing.T) {
 		any, _ := codectypes.NewAnyWithValue(&msg)
 		anys = append(anys, *any)
 		att := &types.Attestation{
-			Observed: false,
-			Height:   uint64(ctx.BlockHeight()),
-			Claim:    any,
+			Observed:         false,
+			Height:           uint64(ctx.BlockHeight()),
+			Claim:            any,
+			ClaimHashVersion: types.ClaimHashVersion,
 		}
 		hash, err := msg.ClaimHash()
 		require.NoError(t, err)
@@ -51,3 +53,936 @@ func TestGetMostRecentAttestations(t *testing.T) {
 			"The %vth claim does not match our message: claim %v\n message %v", n, attest.Claim, msgs[n])
 	}
 }
+
+func TestAttestationVoteBreakdown(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	msg := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    1,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         sdktypes.NewInt(100),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: AccAddrs[0].String(),
+		Orchestrator:   AccAddrs[0].String(),
+	}
+	any, err := codectypes.NewAnyWithValue(&msg)
+	require.NoError(t, err)
+	hash, err := msg.ClaimHash()
+	require.NoError(t, err)
+	k.SetAttestation(ctx, 1, hash, &types.Attestation{
+		Observed:         false,
+		Votes:            []string{ValAddrs[0].String(), ValAddrs[1].String()},
+		Height:           uint64(ctx.BlockHeight()),
+		Claim:            any,
+		ClaimHashVersion: types.ClaimHashVersion,
+	})
+
+	var breakdowns []types.AttestationVoteBreakdown
+	k.IterateAttestationsByNonce(ctx, 1, func(claimHash []byte, att types.Attestation) bool {
+		require.Equal(t, hash, claimHash)
+		breakdowns = append(breakdowns, k.GetAttestationVoteBreakdown(ctx, 1, claimHash, att))
+		return false
+	})
+	require.Len(t, breakdowns, 1)
+
+	validatorPower := uint64(k.StakingKeeper.GetLastValidatorPower(ctx, ValAddrs[0]))
+	totalPower := k.StakingKeeper.GetLastTotalPower(ctx).Uint64()
+	breakdown := breakdowns[0]
+	require.Len(t, breakdown.Votes, 2)
+	require.Equal(t, validatorPower, breakdown.Votes[0].Power)
+	require.Equal(t, 2*validatorPower, breakdown.VotedPower)
+	require.Equal(t, totalPower, breakdown.TotalPower)
+	require.Equal(t, totalPower*66/100, breakdown.RequiredPower)
+	require.Equal(t, breakdown.RequiredPower-breakdown.VotedPower, breakdown.RemainingPower)
+	// the three validators that did not vote are listed with their power
+	require.Len(t, breakdown.MissingVotes, 3)
+	for _, missing := range breakdown.MissingVotes {
+		require.NotEqual(t, ValAddrs[0].String(), missing.Validator)
+		require.NotEqual(t, ValAddrs[1].String(), missing.Validator)
+		require.Equal(t, validatorPower, missing.Power)
+	}
+
+	// nothing is stored under other nonces
+	k.IterateAttestationsByNonce(ctx, 2, func([]byte, types.Attestation) bool {
+		t.Fatal("unexpected attestation")
+		return true
+	})
+}
+
+func TestAttestationVeto(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	msg := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    1,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         sdktypes.NewInt(100),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: AccAddrs[0].String(),
+		Orchestrator:   AccAddrs[4].String(),
+	}
+	any, err := codectypes.NewAnyWithValue(&msg)
+	require.NoError(t, err)
+	hash, err := msg.ClaimHash()
+	require.NoError(t, err)
+	// four of the five equally powered validators have voted, enough to observe the attestation
+	k.SetAttestation(ctx, 1, hash, &types.Attestation{
+		Observed:         false,
+		Votes:            []string{ValAddrs[0].String(), ValAddrs[1].String(), ValAddrs[2].String(), ValAddrs[3].String()},
+		Height:           uint64(ctx.BlockHeight()),
+		Claim:            any,
+		ClaimHashVersion: types.ClaimHashVersion,
+	})
+
+	proposal := types.NewAttestationVetoProposal("veto", "compromised contract", 1, hash)
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, k.HandleAttestationVetoProposal(ctx, proposal))
+	require.Error(t, k.HandleAttestationVetoProposal(ctx, proposal))
+
+	att := k.GetAttestation(ctx, 1, hash)
+	require.True(t, att.Vetoed)
+	require.Empty(t, att.Votes)
+
+	// the attestation is never observed
+	k.TryAttestation(ctx, att)
+	require.False(t, k.GetAttestation(ctx, 1, hash).Observed)
+	require.Equal(t, uint64(0), k.GetLastObservedEventNonce(ctx))
+
+	// and accepts no further votes
+	k.SetOrchestratorValidator(ctx, ValAddrs[4], AccAddrs[4])
+	_, err = k.Attest(ctx, &msg, any)
+	require.ErrorIs(t, err, types.ErrAttestationVetoed)
+
+	// unknown attestations can not be vetoed
+	require.Error(t, k.HandleAttestationVetoProposal(ctx, types.NewAttestationVetoProposal("veto", "unknown", 2, hash)))
+}
+
+// Tests that governance can observe one of the split attestations of a stuck event nonce, or skip it altogether
+func TestResolveEventNonce(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	claim := func(amount int64) (types.MsgSendToCosmosClaim, []byte) {
+		msg := types.MsgSendToCosmosClaim{
+			EventNonce:     1,
+			BlockHeight:    1,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdktypes.NewInt(amount),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[0].String(),
+			Orchestrator:   AccAddrs[4].String(),
+		}
+		any, err := codectypes.NewAnyWithValue(&msg)
+		require.NoError(t, err)
+		hash, err := msg.ClaimHash()
+		require.NoError(t, err)
+		k.SetAttestation(ctx, 1, hash, &types.Attestation{
+			Observed:         false,
+			Votes:            []string{},
+			Height:           uint64(ctx.BlockHeight()),
+			Claim:            any,
+			ClaimHashVersion: types.ClaimHashVersion,
+		})
+		return msg, hash
+	}
+	// the validators split two against two, neither attestation can be observed
+	msg, hash := claim(100)
+	_, otherHash := claim(200)
+	for i, h := range [][]byte{hash, hash, otherHash, otherHash} {
+		att := k.GetAttestation(ctx, 1, h)
+		att.Votes = append(att.Votes, ValAddrs[i].String())
+		k.SetAttestation(ctx, 1, h, att)
+	}
+	k.TryAttestation(ctx, k.GetAttestation(ctx, 1, hash))
+	require.Equal(t, uint64(0), k.GetLastObservedEventNonce(ctx))
+
+	// only the next event nonce and a stored attestation can be resolved
+	require.Error(t, k.HandleResolveEventNonceProposal(ctx, types.NewResolveEventNonceProposal("resolve", "later", 2, hash)))
+	unknown := types.NewResolveEventNonceProposal("resolve", "unknown", 1, make([]byte, len(hash)))
+	require.Error(t, k.HandleResolveEventNonceProposal(ctx, unknown))
+
+	proposal := types.NewResolveEventNonceProposal("resolve", "split vote", 1, hash)
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, k.HandleResolveEventNonceProposal(ctx, proposal))
+	att := k.GetAttestation(ctx, 1, hash)
+	require.True(t, att.Observed)
+	require.True(t, att.PendingExecution)
+	require.False(t, k.GetAttestation(ctx, 1, otherHash).Observed)
+	require.Equal(t, uint64(1), k.GetLastObservedEventNonce(ctx))
+
+	// the validator that never claimed the event nonce moves on to the next one
+	require.Equal(t, uint64(1), k.GetLastEventNonceByValidator(ctx, ValAddrs[4]))
+	k.SetOrchestratorValidator(ctx, ValAddrs[4], AccAddrs[4])
+	any, err := codectypes.NewAnyWithValue(&msg)
+	require.NoError(t, err)
+	_, err = k.Attest(ctx, &msg, any)
+	require.ErrorIs(t, err, types.ErrAlreadySubmitted)
+
+	// without a claim hash the event nonce is skipped
+	skip := types.NewResolveEventNonceProposal("resolve", "no consensus", 2, nil)
+	require.NoError(t, skip.ValidateBasic())
+	require.NoError(t, k.HandleResolveEventNonceProposal(ctx, skip))
+	require.Equal(t, uint64(2), k.GetLastObservedEventNonce(ctx))
+	for _, val := range ValAddrs {
+		require.Equal(t, uint64(2), k.GetLastEventNonceByValidator(ctx, val))
+	}
+	require.Len(t, k.GetAllAuditLogEntries(ctx), 2)
+}
+
+func TestAttestPreviousClaimHashVersion(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	msg := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    1,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         sdktypes.NewInt(100),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: AccAddrs[0].String(),
+		Orchestrator:   AccAddrs[4].String(),
+	}
+	any, err := codectypes.NewAnyWithValue(&msg)
+	require.NoError(t, err)
+	previousHash, err := msg.VersionedClaimHash(types.ClaimHashVersion - 1)
+	require.NoError(t, err)
+	currentHash, err := msg.ClaimHash()
+	require.NoError(t, err)
+	require.NotEqual(t, previousHash, currentHash)
+
+	// an attestation in flight at the upgrade, keyed under the previous claim hash version
+	k.SetAttestation(ctx, 1, previousHash, &types.Attestation{
+		Observed:         false,
+		Votes:            []string{ValAddrs[0].String(), ValAddrs[1].String(), ValAddrs[2].String()},
+		Height:           uint64(ctx.BlockHeight()),
+		Claim:            any,
+		ClaimHashVersion: types.ClaimHashVersion - 1,
+	})
+
+	// the next vote goes to it instead of starting a new attestation under the current version
+	k.SetOrchestratorValidator(ctx, ValAddrs[4], AccAddrs[4])
+	att, err := k.Attest(ctx, &msg, any)
+	require.NoError(t, err)
+	require.Len(t, att.Votes, 4)
+	require.Nil(t, k.GetAttestation(ctx, 1, currentHash))
+
+	k.TryAttestation(ctx, att)
+	require.True(t, k.GetAttestation(ctx, 1, previousHash).Observed)
+	require.Nil(t, k.GetAttestation(ctx, 1, currentHash))
+	require.Equal(t, uint64(1), k.GetLastObservedEventNonce(ctx))
+}
+
+func TestAttestationExecutionQueue(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	var hashes [][]byte
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		msg := types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    nonce,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdktypes.NewInt(100),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[0].String(),
+			Orchestrator:   AccAddrs[0].String(),
+		}
+		any, err := codectypes.NewAnyWithValue(&msg)
+		require.NoError(t, err)
+		hash, err := msg.ClaimHash()
+		require.NoError(t, err)
+		hashes = append(hashes, hash)
+		k.SetAttestation(ctx, nonce, hash, &types.Attestation{
+			Observed:         false,
+			Votes:            []string{ValAddrs[0].String(), ValAddrs[1].String(), ValAddrs[2].String(), ValAddrs[3].String()},
+			Height:           uint64(ctx.BlockHeight()),
+			Claim:            any,
+			ClaimHashVersion: types.ClaimHashVersion,
+		})
+		k.TryAttestation(ctx, k.GetAttestation(ctx, nonce, hash))
+	}
+
+	// every attestation is observed but none has been applied yet
+	require.Equal(t, uint64(3), k.GetLastObservedEventNonce(ctx))
+	require.Equal(t, uint64(3), k.GetQueuedAttestationCount(ctx))
+	for i, hash := range hashes {
+		att := k.GetAttestation(ctx, uint64(i+1), hash)
+		require.True(t, att.Observed)
+		require.True(t, att.PendingExecution)
+	}
+
+	// the budget is spent in event nonce order and the rest is deferred
+	require.Equal(t, uint64(2), k.ExecuteQueuedAttestations(ctx, 2))
+	require.False(t, k.GetAttestation(ctx, 1, hashes[0]).PendingExecution)
+	require.False(t, k.GetAttestation(ctx, 2, hashes[1]).PendingExecution)
+	require.True(t, k.GetAttestation(ctx, 3, hashes[2]).PendingExecution)
+	require.True(t, k.HasQueuedAttestations(ctx))
+
+	require.Equal(t, uint64(1), k.ExecuteQueuedAttestations(ctx, 2))
+	require.False(t, k.GetAttestation(ctx, 3, hashes[2]).PendingExecution)
+	require.False(t, k.HasQueuedAttestations(ctx))
+	require.Equal(t, uint64(0), k.ExecuteQueuedAttestations(ctx, 2))
+}
+
+func TestPruneAttestationsAcrossBlocks(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+
+	total := MaxPrunedPerBlock + 100
+	hashes := make([][]byte, total+1)
+	for nonce := 1; nonce <= total; nonce++ {
+		msg := types.MsgSendToCosmosClaim{
+			EventNonce:     uint64(nonce),
+			BlockHeight:    1,
+			TokenContract:  "0x00000000000000000001",
+			Amount:         sdktypes.NewInt(1),
+			EthereumSender: "0x00000000000000000002",
+			CosmosReceiver: "0x00000000000000000003",
+			Orchestrator:   "0x00000000000000000004",
+		}
+		any, err := codectypes.NewAnyWithValue(&msg)
+		require.NoError(t, err)
+		hash, err := msg.ClaimHash()
+		require.NoError(t, err)
+		hashes[nonce] = hash
+		k.SetAttestation(ctx, uint64(nonce), hash, &types.Attestation{
+			Observed:         true,
+			Height:           uint64(ctx.BlockHeight()),
+			Claim:            any,
+			ClaimHashVersion: types.ClaimHashVersion,
+			ObservedHeight:   1,
+		})
+	}
+	// the last attestation is still waiting to be applied and must survive pruning
+	pending := k.GetAttestation(ctx, uint64(total), hashes[total])
+	pending.PendingExecution = true
+	k.SetAttestation(ctx, uint64(total), hashes[total], pending)
+
+	cutoff, earliestToKeep := uint64(total+1), uint64(2)
+	require.Equal(t, uint64(MaxPrunedPerBlock), k.PruneAttestations(ctx, cutoff, earliestToKeep))
+	require.Nil(t, k.GetAttestation(ctx, uint64(MaxPrunedPerBlock), hashes[MaxPrunedPerBlock]))
+	require.NotNil(t, k.GetAttestation(ctx, uint64(MaxPrunedPerBlock+1), hashes[MaxPrunedPerBlock+1]))
+
+	// the next block picks up where the last one stopped
+	require.Equal(t, uint64(total-MaxPrunedPerBlock-1), k.PruneAttestations(ctx, cutoff, earliestToKeep))
+	require.NotNil(t, k.GetAttestation(ctx, uint64(total), hashes[total]))
+	require.Equal(t, uint64(0), k.PruneAttestations(ctx, cutoff, earliestToKeep))
+
+	// once it has been applied the pending attestation goes as well
+	pending.PendingExecution = false
+	k.SetAttestation(ctx, uint64(total), hashes[total], pending)
+	require.Equal(t, uint64(1), k.PruneAttestations(ctx, cutoff, earliestToKeep))
+	require.Nil(t, k.GetAttestation(ctx, uint64(total), hashes[total]))
+}
+
+// Tests that replaying the observed deposits issues what is outstanding and leaves the live state alone
+func TestReplayObservedClaims(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+	token, err := types.NewEthAddress(TokenContractAddrs[1])
+	require.NoError(t, err)
+	denom := types.GravityDenom(*token)
+
+	// the first deposit was applied, the second was vetoed and never paid out
+	for nonce, vetoed := range []bool{false, true} {
+		msg := types.MsgSendToCosmosClaim{
+			EventNonce:     uint64(nonce + 1),
+			BlockHeight:    1,
+			TokenContract:  token.GetAddress(),
+			Amount:         sdktypes.NewInt(100),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[0].String(),
+			Orchestrator:   AccAddrs[0].String(),
+		}
+		any, err := codectypes.NewAnyWithValue(&msg)
+		require.NoError(t, err)
+		hash, err := msg.ClaimHash()
+		require.NoError(t, err)
+		k.SetAttestation(ctx, msg.EventNonce, hash, &types.Attestation{
+			Observed:         true,
+			Vetoed:           vetoed,
+			Height:           uint64(ctx.BlockHeight()),
+			Claim:            any,
+			ClaimHashVersion: types.ClaimHashVersion,
+		})
+	}
+	vouchers := sdktypes.NewCoins(sdktypes.NewInt64Coin(denom, 100))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, AccAddrs[1], vouchers))
+	balance := input.BankKeeper.GetAllBalances(ctx, AccAddrs[0])
+
+	findToken := func(report types.ReplayReport) types.ReplayedToken {
+		for _, replayed := range report.Tokens {
+			if replayed.TokenContract == token.GetAddress() {
+				return replayed
+			}
+		}
+		require.Fail(t, "token not in replay report")
+		return types.ReplayedToken{}
+	}
+
+	report := k.ReplayObservedClaims(ctx)
+	require.True(t, report.Complete)
+	require.Equal(t, uint64(1), report.FirstEventNonce)
+	require.Equal(t, uint64(1), report.Replayed)
+	require.Empty(t, report.Failures)
+	replayed := findToken(report)
+	require.Equal(t, sdktypes.NewInt(100), replayed.Issued)
+	require.Equal(t, sdktypes.NewInt(100), replayed.Outstanding)
+	require.Empty(t, replayed.Discrepancy)
+	// nothing was paid out again
+	require.Equal(t, balance, input.BankKeeper.GetAllBalances(ctx, AccAddrs[0]))
+	receipts, _, err := k.GetDepositReceipts(ctx, AccAddrs[0], nil)
+	require.NoError(t, err)
+	require.Empty(t, receipts)
+
+	// vouchers no deposit accounts for
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdktypes.NewCoins(sdktypes.NewInt64Coin(denom, 5))))
+	res, err := k.ReplayAttestations(sdktypes.WrapSDKContext(ctx), &types.QueryReplayAttestationsRequest{})
+	require.NoError(t, err)
+	replayed = findToken(res.Report)
+	require.Equal(t, sdktypes.NewInt(105), replayed.Outstanding)
+	require.NotEmpty(t, replayed.Discrepancy)
+}
+
+func TestMaxClaimAge(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	k.SetOrchestratorValidator(ctx, ValAddrs[4], AccAddrs[4])
+
+	params := k.GetParams(ctx)
+	params.MaxClaimAge = 10
+	k.SetParams(ctx, params)
+	k.setLastObservedEventNonce(ctx, 50)
+	k.setLastEventNonceByValidator(ctx, ValAddrs[4], 5)
+
+	claim := func(nonce uint64) *types.MsgSendToCosmosClaim {
+		return &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    1,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdktypes.NewInt(100),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[0].String(),
+			Orchestrator:   AccAddrs[4].String(),
+		}
+	}
+	require.ErrorIs(t, k.checkClaimAge(ctx, claim(39)), types.ErrClaimTooOld)
+	require.NoError(t, k.checkClaimAge(ctx, claim(40)))
+	require.NoError(t, k.checkClaimAge(ctx, claim(51)))
+
+	// the validator fell behind the window, it resumes at its start rather than at its own last claim
+	require.Equal(t, uint64(39), k.GetResumeEventNonce(ctx, ValAddrs[4]))
+	require.Equal(t, uint64(5), k.GetLastEventNonceByValidator(ctx, ValAddrs[4]))
+	msg := claim(40)
+	any, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	_, err = k.Attest(ctx, msg, any)
+	require.NoError(t, err)
+	require.Equal(t, uint64(40), k.GetResumeEventNonce(ctx, ValAddrs[4]))
+
+	// a max claim age of 0 accepts claims of any age
+	params.MaxClaimAge = 0
+	k.SetParams(ctx, params)
+	require.NoError(t, k.checkClaimAge(ctx, claim(1)))
+	require.Equal(t, uint64(40), k.GetResumeEventNonce(ctx, ValAddrs[4]))
+}
+
+// customClaim stands in for a claim type added by a downstream chain
+type customClaim struct {
+	*types.MsgLogicCallExecutedClaim
+	claimType types.ClaimType
+}
+
+func (c customClaim) GetType() types.ClaimType {
+	return c.claimType
+}
+
+func TestRegisterClaimHandler(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+
+	var handled []uint64
+	k.RegisterClaimHandler(types.ClaimType(100), ClaimHandlerFunc(
+		func(ctx sdktypes.Context, att types.Attestation, claim types.EthereumClaim) error {
+			handled = append(handled, claim.GetEventNonce())
+			return nil
+		}))
+	require.Panics(t, func() {
+		k.RegisterClaimHandler(types.ClaimType(100), ClaimHandlerFunc(nil))
+	})
+	require.Panics(t, func() {
+		k.RegisterClaimHandler(types.CLAIM_TYPE_SEND_TO_COSMOS, ClaimHandlerFunc(nil))
+	})
+	require.Panics(t, func() {
+		k.RegisterClaimHandler(types.CLAIM_TYPE_UNSPECIFIED, ClaimHandlerFunc(nil))
+	})
+
+	// the registry is shared by the copies of the keeper, the attestation handler was created before the registration
+	claim := customClaim{&types.MsgLogicCallExecutedClaim{EventNonce: 7}, types.ClaimType(100)}
+	require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, claim))
+	require.Equal(t, []uint64{7}, handled)
+
+	// a claim type nobody registered is still refused
+	unknown := customClaim{&types.MsgLogicCallExecutedClaim{EventNonce: 8}, types.ClaimType(101)}
+	require.Panics(t, func() {
+		_ = k.AttestationHandler.Handle(ctx, types.Attestation{}, unknown)
+	})
+	require.Equal(t, []uint64{7}, handled)
+}
+
+// Tests that attestations are pruned with the vote records of their nonce once their event was observed
+// longer ago than the AttestationRetention param, and that they are left out of the exported genesis
+func TestPruneAttestationsAfterRetention(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context.WithBlockHeight(25)
+	params := k.GetParams(ctx)
+	params.AttestationRetention = 10
+	params.SignedClaimsWindow = 0
+	k.SetParams(ctx, params)
+
+	setAttestation := func(nonce uint64, amount int64, vote sdktypes.ValAddress, observedHeight uint64) []byte {
+		msg := types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    1,
+			TokenContract:  "0x00000000000000000001",
+			Amount:         sdktypes.NewInt(amount),
+			EthereumSender: "0x00000000000000000002",
+			CosmosReceiver: "0x00000000000000000003",
+			Orchestrator:   "0x00000000000000000004",
+		}
+		any, err := codectypes.NewAnyWithValue(&msg)
+		require.NoError(t, err)
+		hash, err := msg.ClaimHash()
+		require.NoError(t, err)
+		k.SetAttestation(ctx, nonce, hash, &types.Attestation{
+			Observed:         observedHeight != 0,
+			Votes:            []string{vote.String()},
+			Claim:            any,
+			ClaimHashVersion: types.ClaimHashVersion,
+			ObservedHeight:   observedHeight,
+		})
+		return hash
+	}
+	// nonce 1 was observed long enough ago, a conflicting claim lost against it
+	old := setAttestation(1, 1, ValAddrs[0], 5)
+	lost := setAttestation(1, 2, ValAddrs[1], 0)
+	recent := setAttestation(2, 1, ValAddrs[0], 20)
+	k.setLastObservedEventNonce(ctx, 2)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetConflictingClaimSlashedKey(ValAddrs[1], 1), []byte{0x1})
+
+	exported := ExportGenesis(ctx, k)
+	require.Len(t, exported.Attestations, 1)
+	require.Equal(t, uint64(20), exported.Attestations[0].ObservedHeight)
+
+	// events claim slashing has not checked yet are kept
+	params.SignedClaimsWindow = 5
+	k.SetParams(ctx, params)
+	cutoff, earliestToKeep, ok := k.AttestationPruneCutoff(ctx, params)
+	require.True(t, ok)
+	require.Equal(t, uint64(0), k.PruneAttestations(ctx, cutoff, earliestToKeep))
+	k.SetLastSlashedClaimEventNonce(ctx, 2)
+
+	cutoff, earliestToKeep, ok = k.AttestationPruneCutoff(ctx, params)
+	require.True(t, ok)
+	require.Equal(t, uint64(15), earliestToKeep)
+	require.Equal(t, uint64(2), k.PruneAttestations(ctx, cutoff, earliestToKeep))
+	require.Nil(t, k.GetAttestation(ctx, 1, old))
+	require.Nil(t, k.GetAttestation(ctx, 1, lost))
+	require.NotNil(t, k.GetAttestation(ctx, 2, recent))
+	require.False(t, store.Has(types.GetConflictingClaimSlashedKey(ValAddrs[1], 1)))
+
+	// the recent attestation goes once its retention has passed
+	ctx = ctx.WithBlockHeight(31)
+	cutoff, earliestToKeep, ok = k.AttestationPruneCutoff(ctx, params)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), k.PruneAttestations(ctx, cutoff, earliestToKeep))
+	require.Nil(t, k.GetAttestation(ctx, 2, recent))
+}
+
+// Tests that deposits from quarantined Ethereum senders are held in the module account until governance releases them
+func TestQuarantinedDeposits(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+	token, err := types.NewEthAddress(TokenContractAddrs[1])
+	require.NoError(t, err)
+	denom := types.GravityDenom(*token)
+
+	params := k.GetParams(ctx)
+	params.QuarantinedEthSenders = []string{EthAddrs[1].String()}
+	k.SetParams(ctx, params)
+
+	deposit := func(nonce uint64, sender string, tokenSender string) {
+		claim := types.MsgSendToCosmosClaim{
+			EventNonce:               nonce,
+			BlockHeight:              1,
+			TokenContract:            token.GetAddress(),
+			Amount:                   sdktypes.NewInt(100),
+			EthereumSender:           sender,
+			CosmosReceiver:           AccAddrs[0].String(),
+			Orchestrator:             AccAddrs[0].String(),
+			TokenSender:              tokenSender,
+			EthereumSenderIsContract: tokenSender != "",
+		}
+		require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+	}
+	// a deposit from any other sender is paid out, one sent directly or through a contract by the listed one is not
+	deposit(1, EthAddrs[0].String(), "")
+	deposit(2, strings.ToLower(EthAddrs[1].String()), "")
+	deposit(3, EthAddrs[2].String(), EthAddrs[1].String())
+
+	require.Equal(t, sdktypes.NewInt(100), input.BankKeeper.GetBalance(ctx, AccAddrs[0], denom).Amount)
+	quarantined := k.GetQuarantinedDeposits(ctx)
+	require.Len(t, quarantined, 2)
+	require.Equal(t, uint64(2), quarantined[0].EventNonce)
+	require.Equal(t, uint64(3), quarantined[1].EventNonce)
+	require.True(t, quarantined[1].EthereumSenderIsContract)
+	receipts, _, err := k.GetDepositReceipts(ctx, AccAddrs[0], nil)
+	require.NoError(t, err)
+	require.Len(t, receipts, 1)
+
+	// the vouchers held for them are owed, not left over
+	findToken := func() types.TokenSolvency {
+		for _, entry := range k.GetSolvencyReport(ctx) {
+			if entry.TokenContract == token.GetAddress() {
+				return entry
+			}
+		}
+		require.Fail(t, "token not in solvency report")
+		return types.TokenSolvency{}
+	}
+	solvency := findToken()
+	require.Equal(t, sdktypes.NewInt(200), solvency.EscrowBalance)
+	require.Equal(t, sdktypes.NewInt(200), solvency.QuarantinedAmount)
+	require.Empty(t, solvency.Discrepancy)
+
+	// released to the receiver of the deposit, and to another account
+	require.NoError(t, k.HandleProposal(ctx, types.NewReleaseQuarantinedDepositProposal("release", "cleared", 2, nil)))
+	require.NoError(t, k.HandleProposal(ctx, types.NewReleaseQuarantinedDepositProposal("release", "cleared", 3, AccAddrs[1])))
+	require.Error(t, k.HandleProposal(ctx, types.NewReleaseQuarantinedDepositProposal("release", "again", 3, nil)))
+
+	require.Empty(t, k.GetQuarantinedDeposits(ctx))
+	require.Equal(t, sdktypes.NewInt(200), input.BankKeeper.GetBalance(ctx, AccAddrs[0], denom).Amount)
+	require.Equal(t, sdktypes.NewInt(100), input.BankKeeper.GetBalance(ctx, AccAddrs[1], denom).Amount)
+	receipts, _, err = k.GetDepositReceipts(ctx, AccAddrs[1], nil)
+	require.NoError(t, err)
+	require.Len(t, receipts, 1)
+	require.Equal(t, uint64(3), receipts[0].EventNonce)
+	solvency = findToken()
+	require.True(t, solvency.EscrowBalance.IsZero())
+	require.Empty(t, solvency.Discrepancy)
+	require.Len(t, k.GetAllAuditLogEntries(ctx), 2)
+}
+
+// Tests that a deposit can be followed by the hash of its Ethereum transaction from the first vote until it is paid out
+func TestDepositByEthTxHash(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+	token, err := types.NewEthAddress(TokenContractAddrs[1])
+	require.NoError(t, err)
+
+	params := k.GetParams(ctx)
+	params.QuarantinedEthSenders = []string{EthAddrs[1].String()}
+	k.SetParams(ctx, params)
+
+	vote := func(nonce uint64, sender string, txHash string) (*types.Attestation, *types.MsgSendToCosmosClaim) {
+		claim := &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    1,
+			TokenContract:  token.GetAddress(),
+			Amount:         sdktypes.NewInt(100),
+			EthereumSender: sender,
+			CosmosReceiver: AccAddrs[0].String(),
+			Orchestrator:   AccAddrs[0].String(),
+			EthTxHash:      txHash,
+		}
+		any, err := codectypes.NewAnyWithValue(claim)
+		require.NoError(t, err)
+		hash, err := claim.ClaimHash()
+		require.NoError(t, err)
+		att := &types.Attestation{Votes: []string{ValAddrs[0].String()}, Claim: any, ClaimHashVersion: types.ClaimHashVersion}
+		k.SetAttestation(ctx, nonce, hash, att)
+		k.setDepositEthTxHash(ctx, claim)
+		return att, claim
+	}
+	observe := func(att *types.Attestation, claim *types.MsgSendToCosmosClaim) {
+		hash, err := claim.ClaimHash()
+		require.NoError(t, err)
+		att.Observed = true
+		k.SetAttestation(ctx, claim.EventNonce, hash, att)
+	}
+	status := func(txHash string) types.DepositStatus {
+		res, found := k.GetDepositByEthTxHash(ctx, txHash)
+		require.True(t, found)
+		return res.Status
+	}
+
+	mintedHash := "0x" + strings.Repeat("ab", 32)
+	att, claim := vote(1, EthAddrs[0].String(), mintedHash)
+	require.Equal(t, types.DEPOSIT_STATUS_PENDING, status(mintedHash))
+	observe(att, claim)
+	require.Equal(t, types.DEPOSIT_STATUS_OBSERVED, status(mintedHash))
+	require.NoError(t, k.AttestationHandler.Handle(ctx, *att, claim))
+	// the hash is matched in any case
+	res, found := k.GetDepositByEthTxHash(ctx, "0x"+strings.ToUpper(mintedHash[2:]))
+	require.True(t, found)
+	require.Equal(t, types.DEPOSIT_STATUS_MINTED, res.Status)
+	require.Equal(t, uint64(1), res.Receipt.EventNonce)
+
+	quarantinedHash := "0x" + strings.Repeat("cd", 32)
+	att, claim = vote(2, EthAddrs[1].String(), quarantinedHash)
+	observe(att, claim)
+	require.NoError(t, k.AttestationHandler.Handle(ctx, *att, claim))
+	require.Equal(t, types.DEPOSIT_STATUS_QUARANTINED, status(quarantinedHash))
+
+	_, found = k.GetDepositByEthTxHash(ctx, "0x"+strings.Repeat("ef", 32))
+	require.False(t, found)
+
+	// the index goes with the attestation
+	k.DeleteAttestation(ctx, *att)
+	_, found = k.GetDepositByEthTxHash(ctx, quarantinedHash)
+	require.False(t, found)
+}
+
+// recordingHooks records the events the gravity hooks are called with
+type recordingHooks struct {
+	deposits []types.DepositReceipt
+	batches  []uint64
+	valsets  []uint64
+	calls    []uint64
+}
+
+func (h *recordingHooks) AfterSendToCosmos(_ sdktypes.Context, deposit types.DepositReceipt) {
+	h.deposits = append(h.deposits, deposit)
+}
+
+func (h *recordingHooks) AfterBatchExecuted(_ sdktypes.Context, batch types.OutgoingTxBatch) {
+	h.batches = append(h.batches, batch.BatchNonce)
+}
+
+func (h *recordingHooks) AfterValsetUpdated(_ sdktypes.Context, valset types.Valset) {
+	h.valsets = append(h.valsets, valset.Nonce)
+}
+
+func (h *recordingHooks) AfterContractCallExecuted(_ sdktypes.Context, _ []byte, invalidationNonce uint64) {
+	h.calls = append(h.calls, invalidationNonce)
+}
+
+// Tests that the gravity hooks are called for the applied events, also by the attestation handler created before
+// they were set
+func TestEventNonceGap(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context.WithBlockHeight(100)
+	params := k.GetParams(ctx)
+	params.EventNonceStallThreshold = 50
+	k.SetParams(ctx, params)
+
+	vote := func(nonce uint64, amount int64, height int64) {
+		claim := &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    1,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdktypes.NewInt(amount),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[0].String(),
+			Orchestrator:   AccAddrs[0].String(),
+		}
+		any, err := codectypes.NewAnyWithValue(claim)
+		require.NoError(t, err)
+		hash, err := claim.ClaimHash()
+		require.NoError(t, err)
+		att := &types.Attestation{Votes: []string{ValAddrs[0].String()}, Height: uint64(height), Claim: any, ClaimHashVersion: types.ClaimHashVersion}
+		k.SetAttestation(ctx, nonce, hash, att)
+	}
+	stalls := func() int {
+		ctx = ctx.WithEventManager(sdktypes.NewEventManager())
+		k.CheckEventNonceStall(ctx)
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == "gravity.v1.EventNonceStalled" {
+				count++
+			}
+		}
+		return count
+	}
+
+	// nothing is pending before any claim for the next nonce arrives
+	k.setLastObservedEventNonce(ctx, 4)
+	gap := k.GetEventNonceGap(ctx)
+	require.Equal(t, uint64(4), gap.LastObservedEventNonce)
+	require.Equal(t, uint64(0), gap.PendingEventNonce)
+	require.Equal(t, 0, stalls())
+
+	// later nonces do not count, the oldest of the competing attestations does
+	vote(6, 100, 10)
+	vote(5, 100, 80)
+	vote(5, 200, 60)
+	gap = k.GetEventNonceGap(ctx)
+	require.Equal(t, uint64(5), gap.PendingEventNonce)
+	require.Equal(t, uint64(2), gap.Attestations)
+	require.Equal(t, uint64(60), gap.FirstClaimHeight)
+	require.Equal(t, uint64(40), gap.PendingBlocks)
+	require.False(t, gap.Stalled)
+	require.Equal(t, 0, stalls())
+
+	// past the threshold the stall is reported once per nonce
+	ctx = ctx.WithBlockHeight(111)
+	require.True(t, k.GetEventNonceGap(ctx).Stalled)
+	require.Equal(t, 1, stalls())
+	require.Equal(t, 0, stalls())
+	k.setLastObservedEventNonce(ctx, 5)
+	gap = k.GetEventNonceGap(ctx)
+	require.Equal(t, uint64(6), gap.PendingEventNonce)
+	require.Equal(t, uint64(101), gap.PendingBlocks)
+	require.Equal(t, 1, stalls())
+
+	// the alert is off with a zero threshold
+	params.EventNonceStallThreshold = 0
+	k.SetParams(ctx, params)
+	require.False(t, k.GetEventNonceGap(ctx).Stalled)
+}
+
+func TestGravityHooks(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+	token, err := types.NewEthAddress(TokenContractAddrs[1])
+	require.NoError(t, err)
+	receiver, err := types.NewEthAddress(EthAddrs[1].String())
+	require.NoError(t, err)
+
+	first, second := &recordingHooks{}, &recordingHooks{}
+	k.SetHooks(first, second)
+	require.Panics(t, func() { k.SetHooks(first) })
+
+	deposit := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    1,
+		TokenContract:  token.GetAddress(),
+		Amount:         sdktypes.NewInt(1000),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: AccAddrs[0].String(),
+		Orchestrator:   AccAddrs[0].String(),
+	}
+	require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, &deposit))
+
+	denom := types.GravityDenom(*token)
+	_, err = k.AddToOutgoingPool(ctx, AccAddrs[0], *receiver, sdktypes.NewInt64Coin(denom, 100), sdktypes.NewInt64Coin(denom, 10))
+	require.NoError(t, err)
+	batch, err := k.BuildOutgoingTXBatch(ctx, *token, 1)
+	require.NoError(t, err)
+	executed := types.MsgBatchSendToEthClaim{
+		EventNonce:    2,
+		BlockHeight:   2,
+		BatchNonce:    batch.BatchNonce,
+		TokenContract: token.GetAddress(),
+		Orchestrator:  AccAddrs[0].String(),
+	}
+	require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, &executed))
+
+	valset := types.MsgValsetUpdatedClaim{
+		EventNonce:   3,
+		ValsetNonce:  4,
+		BlockHeight:  3,
+		Members:      []*types.BridgeValidator{{Power: 100, EthereumAddress: EthAddrs[0].String()}},
+		RewardAmount: sdktypes.ZeroInt(),
+		RewardToken:  types.ZeroAddressString,
+		Orchestrator: AccAddrs[0].String(),
+	}
+	require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, &valset))
+
+	call := types.MsgLogicCallExecutedClaim{
+		EventNonce:        4,
+		BlockHeight:       4,
+		InvalidationId:    []byte("call"),
+		InvalidationNonce: 5,
+		Orchestrator:      AccAddrs[0].String(),
+	}
+	require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, &call))
+
+	for _, hooks := range []*recordingHooks{first, second} {
+		require.Len(t, hooks.deposits, 1)
+		require.Equal(t, uint64(1), hooks.deposits[0].EventNonce)
+		require.Equal(t, AccAddrs[0].String(), hooks.deposits[0].CosmosReceiver)
+		require.Equal(t, []uint64{batch.BatchNonce}, hooks.batches)
+		require.Equal(t, []uint64{4}, hooks.valsets)
+		require.Equal(t, []uint64{5}, hooks.calls)
+	}
+}
+
+// Tests that a valset reward in an Ethereum originated token is only offered while the reserve covers it, and that
+// observing it burns the vouchers from the reserve
+func TestValsetRewardReserve(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	token, err := types.NewEthAddress(TokenContractAddrs[1])
+	require.NoError(t, err)
+	denom := types.GravityDenom(*token)
+
+	// a Cosmos denom without an ERC20 can not be the reward, a voucher always can
+	require.Error(t, k.ValidateValsetReward(ctx, sdktypes.NewInt64Coin("unbridged", 1)))
+	require.NoError(t, k.ValidateValsetReward(ctx, sdktypes.NewInt64Coin(denom, 1)))
+
+	params := k.GetParams(ctx)
+	params.ValsetReward = sdktypes.NewInt64Coin(denom, 10)
+	k.SetParams(ctx, params)
+
+	// not offered until the reserve is funded
+	valset := k.GetCurrentValset(ctx)
+	require.Equal(t, types.ZeroAddressString, valset.RewardToken)
+	require.True(t, valset.RewardAmount.IsZero())
+
+	vouchers := sdktypes.NewCoins(sdktypes.NewInt64Coin(denom, 15))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, AccAddrs[0], vouchers))
+	require.Error(t, k.FundValsetReward(ctx, AccAddrs[0], sdktypes.NewInt64Coin(denom, 20)))
+	require.NoError(t, k.FundValsetReward(ctx, AccAddrs[0], sdktypes.NewInt64Coin(denom, 15)))
+	require.Equal(t, sdktypes.NewInt(15), k.GetValsetRewardReserve(ctx, denom))
+	require.Equal(t, sdktypes.NewCoins(sdktypes.NewInt64Coin(denom, 15)), k.GetAllValsetRewardReserves(ctx))
+
+	valset = k.GetCurrentValset(ctx)
+	require.Equal(t, token.GetAddress(), valset.RewardToken)
+	require.Equal(t, sdktypes.NewInt(10), valset.RewardAmount)
+
+	observe := func(nonce uint64) {
+		claim := types.MsgValsetUpdatedClaim{
+			EventNonce:   nonce,
+			ValsetNonce:  nonce,
+			BlockHeight:  nonce,
+			Members:      []*types.BridgeValidator{{Power: 100, EthereumAddress: EthAddrs[0].String()}},
+			RewardAmount: sdktypes.NewInt(10),
+			RewardToken:  token.GetAddress(),
+			Orchestrator: AccAddrs[0].String(),
+		}
+		require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+	}
+	supply := input.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(denom)
+
+	// the reward paid on Ethereum is burned from the reserve
+	observe(1)
+	require.Equal(t, sdktypes.NewInt(5), k.GetValsetRewardReserve(ctx, denom))
+	require.Equal(t, supply.SubRaw(10), input.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(denom))
+
+	// the reserve can not cover another one, what it can is burned and the rest reported
+	observe(2)
+	require.True(t, k.GetValsetRewardReserve(ctx, denom).IsZero())
+	require.Empty(t, k.GetAllValsetRewardReserves(ctx))
+	require.True(t, supply.SubRaw(15).Equal(input.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(denom)))
+	shortfall := false
+	for _, event := range ctx.EventManager().Events() {
+		shortfall = shortfall || event.Type == types.EventTypeValsetRewardShortfall
+	}
+	require.True(t, shortfall)
+	require.Equal(t, types.ZeroAddressString, k.GetCurrentValset(ctx).RewardToken)
+}
@@ -3,47 +3,74 @@ package keeper
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
+// OutgoingTxBatchSize is the default of the MaxBatchSize param
 const OutgoingTxBatchSize = 100
 
+// GetMaxBatchSize returns the most transactions a batch of tokenContract may carry, its entry in TokenMaxBatchSizes
+// if it has one and MaxBatchSize otherwise. Contracts are compared regardless of their checksum casing
+func (k Keeper) GetMaxBatchSize(ctx sdk.Context, tokenContract types.EthAddress) uint {
+	params := k.GetParams(ctx)
+	for _, size := range params.TokenMaxBatchSizes {
+		if strings.EqualFold(size.TokenContract, tokenContract.GetAddress()) {
+			return uint(size.MaxBatchSize)
+		}
+	}
+	return uint(params.MaxBatchSize)
+}
+
+// getLargestMaxBatchSize returns the largest batch size any token may use
+func (k Keeper) getLargestMaxBatchSize(ctx sdk.Context) uint {
+	params := k.GetParams(ctx)
+	largest := params.MaxBatchSize
+	for _, size := range params.TokenMaxBatchSizes {
+		if size.MaxBatchSize > largest {
+			largest = size.MaxBatchSize
+		}
+	}
+	return uint(largest)
+}
+
 // BuildOutgoingTXBatch starts the following process chain:
 // - find bridged denominator for given voucher type
-// - determine if an unexecuted batch is already waiting for this token type, if so confirm the new batch would
-//   have a higher total fees. If not exit without creating a batch
-// - select available transactions from the outgoing transaction pool sorted by fee desc
+// - limit maxElements to the MaxBatchSize of the token, see GetMaxBatchSize
+// - determine whether the fees of the new batch cover the estimated cost of relaying it, see
+//   checkBatchProfitable. If not exit without creating a batch
+// - select available transactions from the outgoing transaction pool sorted by priority and fee desc
+// - merge the selected transactions to the same destination if MergeBatchTransfers is set
 // - persist an outgoing batch object with an incrementing ID = nonce
 // - emit an event
 func (k Keeper) BuildOutgoingTXBatch(
 	ctx sdk.Context,
 	contract types.EthAddress,
 	maxElements uint) (*types.InternalOutgoingTxBatch, error) {
+	if maxSize := k.GetMaxBatchSize(ctx, contract); maxElements > maxSize {
+		maxElements = maxSize
+	}
 	if maxElements == 0 {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "max elements value")
 	}
+	if k.IsBridgeMigrating(ctx) {
+		return nil, sdkerrors.Wrap(types.ErrBridgeMigrating, "no new batches may be built")
+	}
 
-	lastBatch := k.GetLastOutgoingBatchByTokenType(ctx, contract)
-
-	// lastBatch may be nil if there are no existing batches, we only need
-	// to perform this check if a previous batch exists
-	if lastBatch != nil {
-		// this traverses the current tx pool for this token type and determines what
-		// fees a hypothetical batch would have if created
-		currentFees := k.GetBatchFeeByTokenType(ctx, contract, maxElements)
-		if currentFees == nil {
-			return nil, sdkerrors.Wrap(types.ErrInvalid, "error getting fees from tx pool")
-		}
-
-		lastFees := lastBatch.ToExternal().GetFees()
-		if lastFees.GT(currentFees.TotalFees) {
-			return nil, sdkerrors.Wrap(types.ErrInvalid, "new batch would not be more profitable")
-		}
+	// this traverses the current tx pool for this token type and determines what
+	// fees a hypothetical batch would have if created
+	currentFees := k.GetBatchFeeByTokenType(ctx, contract, maxElements)
+	if currentFees == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "error getting fees from tx pool")
+	}
+	if err := k.checkBatchProfitable(ctx, contract, *currentFees); err != nil {
+		return nil, err
 	}
 
 	selectedTx, err := k.pickUnbatchedTX(ctx, contract, maxElements)
@@ -51,11 +78,18 @@ func (k Keeper) BuildOutgoingTXBatch(
 		return nil, err
 	}
 	nextID := k.autoIncrementID(ctx, types.KeyLastOutgoingBatchID)
-	batch, err := types.NewInternalOutgingTxBatch(nextID, k.getBatchTimeoutHeight(ctx), selectedTx, contract, 0)
+	batchTxs := selectedTx
+	if k.GetParams(ctx).MergeBatchTransfers {
+		batchTxs = k.mergeBatchTransfers(ctx, nextID, selectedTx)
+	}
+	batch, err := types.NewInternalOutgingTxBatch(nextID, k.GetOutgoingTimeoutHeight(ctx), batchTxs, contract, 0)
 	if err != nil {
 		panic(sdkerrors.Wrap(err, "unable to create batch"))
 	}
 	k.StoreBatch(ctx, batch)
+	for _, tx := range selectedTx {
+		k.recordEthDestination(ctx, tx.Sender, *tx.DestAddress)
+	}
 
 	// Get the checkpoint and store it as a legit past batch
 	checkpoint := batch.GetCheckpoint(k.GetGravityID(ctx))
@@ -69,28 +103,44 @@ func (k Keeper) BuildOutgoingTXBatch(
 		sdk.NewAttribute(types.AttributeKeyOutgoingBatchID, fmt.Sprint(nextID)),
 		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(nextID)),
 	)
-	ctx.EventManager().EmitEvent(batchEvent)
+	k.emitLegacyEvent(ctx, batchEvent)
+	external := batch.ToExternal()
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOutgoingBatchCreated{
+		BatchNonce:    external.BatchNonce,
+		TokenContract: external.TokenContract,
+		BatchTimeout:  external.BatchTimeout,
+		Transactions:  external.Transactions,
+	}); err != nil {
+		return nil, err
+	}
 	return batch, nil
 }
 
-// This gets the batch timeout height in Ethereum blocks.
-func (k Keeper) getBatchTimeoutHeight(ctx sdk.Context) uint64 {
-	params := k.GetParams(ctx)
-	currentCosmosHeight := ctx.BlockHeight()
-	// we store the last observed Cosmos and Ethereum heights, we do not concern ourselves if these values are zero because
-	// no batch can be produced if the last Ethereum block height is not first populated by a deposit event.
-	heights := k.GetLastObservedEthereumBlockHeight(ctx)
-	if heights.CosmosBlockHeight == 0 || heights.EthereumBlockHeight == 0 {
+// PreviewOutgoingTXBatch runs BuildOutgoingTXBatch on a cached copy of the store that is thrown away afterwards, it
+// returns the batch that would be built right now and the checkpoint validators would sign for it. The batch is nil
+// if there is nothing to batch
+func (k Keeper) PreviewOutgoingTXBatch(
+	ctx sdk.Context,
+	contract types.EthAddress,
+	maxElements uint) (*types.InternalOutgoingTxBatch, []byte, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	batch, err := k.BuildOutgoingTXBatch(cacheCtx, contract, maxElements)
+	if batch == nil || err != nil {
+		return nil, nil, err
+	}
+	return batch, batch.GetCheckpoint(k.GetGravityID(cacheCtx)), nil
+}
+
+// GetOutgoingTimeoutHeight returns the Ethereum height at which a batch or logic call created now should time out.
+// This is the projected current Ethereum height plus the timeout margin. Zero is returned while no Ethereum height
+// has been observed, such batches are cleaned up as soon as a height is observed.
+func (k Keeper) GetOutgoingTimeoutHeight(ctx sdk.Context) uint64 {
+	projectedHeight := k.GetProjectedEthereumHeight(ctx)
+	if projectedHeight == 0 {
 		return 0
 	}
-	// we project how long it has been in milliseconds since the last Ethereum block height was observed
-	projectedMillis := (uint64(currentCosmosHeight) - heights.CosmosBlockHeight) * params.AverageBlockTime
-	// we convert that projection into the current Ethereum height using the average Ethereum block time in millis
-	projectedCurrentEthereumHeight := (projectedMillis / params.AverageEthereumBlockTime) + heights.EthereumBlockHeight
-	// we convert our target time for block timeouts (lets say 12 hours) into a number of blocks to
-	// place on top of our projection of the current Ethereum block height.
-	blocksToAdd := params.TargetBatchTimeout / params.AverageEthereumBlockTime
-	return projectedCurrentEthereumHeight + blocksToAdd
+	return projectedHeight + k.GetParams(ctx).EthereumTimeoutMargin
 }
 
 // OutgoingTxBatchExecuted is run when the Cosmos chain detects that a batch has been executed on Ethereum
@@ -103,7 +153,7 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.Eth
 	}
 
 	// Iterate through remaining batches
-	k.IterateOutgoingTXBatches(ctx, func(key []byte, iter_batch *types.InternalOutgoingTxBatch) bool {
+	k.IterateOutgoingTXBatches(ctx, IterationLimit{}, func(key []byte, iter_batch *types.InternalOutgoingTxBatch) bool {
 		// If the iterated batches nonce is lower than the one that was just executed, cancel it
 		if iter_batch.BatchNonce < b.BatchNonce && iter_batch.TokenContract.GetAddress() == tokenContract.GetAddress() {
 			err := k.CancelOutgoingTXBatch(ctx, tokenContract, iter_batch.BatchNonce)
@@ -114,9 +164,23 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.Eth
 		return false
 	})
 
+	// withdrawals are counted once their batch is executed, a transfer may be batched several times before
+	for _, tx := range k.GetBatchTransfers(ctx, *b) {
+		k.recordBridgeWithdrawal(ctx, tokenContract, tx.Sender.String(), tx.Erc20Token.Amount)
+		k.forgetOutflow(ctx, tx.Id)
+	}
+
 	// Delete batch since it is finished
 	k.DeleteBatch(ctx, *b)
 
+	external := b.ToExternal()
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOutgoingBatchExecuted{
+		BatchNonce:    external.BatchNonce,
+		TokenContract: external.TokenContract,
+		Transactions:  external.Transactions,
+	}); err != nil {
+		panic(sdkerrors.Wrap(err, "emit batch executed event"))
+	}
 }
 
 // StoreBatch stores a transaction batch
@@ -156,20 +220,129 @@ func (k Keeper) DeleteBatch(ctx sdk.Context, batch types.InternalOutgoingTxBatch
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce))
 	store.Delete(types.GetOutgoingTxBatchBlockKey(batch.Block))
+	for _, tx := range batch.Transactions {
+		store.Delete(types.GetMergedTransferKey(batch.BatchNonce, tx.Id))
+	}
 }
 
-// pickUnbatchedTX find TX in pool and remove from "available" second index
+// mergeBatchTransfers merges the transactions of txs to the same destination into a single transfer carrying their
+// summed amounts and fees, under the id and sender of the first of them. The transactions each transfer was merged
+// from are stored under batchNonce so that they can go back into the pool one by one, see GetBatchTransfers. A
+// transaction with a payload is never merged, its payload is meant for its own transfer
+func (k Keeper) mergeBatchTransfers(ctx sdk.Context, batchNonce uint64, txs []*types.InternalOutgoingTransferTx) []*types.InternalOutgoingTransferTx {
+	var (
+		merged   []*types.InternalOutgoingTransferTx
+		children = make(map[string][]*types.InternalOutgoingTransferTx)
+	)
+	for _, tx := range txs {
+		if len(tx.Payload) > 0 {
+			merged = append(merged, tx)
+			continue
+		}
+		dest := tx.DestAddress.GetAddress()
+		if len(children[dest]) == 0 {
+			merged = append(merged, tx)
+		}
+		children[dest] = append(children[dest], tx)
+	}
+
+	for i, first := range merged {
+		group := children[first.DestAddress.GetAddress()]
+		if len(first.Payload) > 0 || len(group) < 2 {
+			continue
+		}
+		record := types.MergedTransfer{BatchNonce: batchNonce, Id: first.Id, Children: make([]*types.OutgoingTransferTx, len(group))}
+		transfer := *first
+		transfer.Erc20Token = &types.InternalERC20Token{Amount: sdk.ZeroInt(), Contract: first.Erc20Token.Contract}
+		transfer.Erc20Fee = &types.InternalERC20Token{Amount: sdk.ZeroInt(), Contract: first.Erc20Fee.Contract}
+		ids := make([]string, len(group))
+		mergedIDs := make([]uint64, len(group))
+		for j, tx := range group {
+			transfer.Erc20Token.Amount = transfer.Erc20Token.Amount.Add(tx.Erc20Token.Amount)
+			transfer.Erc20Fee.Amount = transfer.Erc20Fee.Amount.Add(tx.Erc20Fee.Amount)
+			record.Children[j] = tx.ToExternal()
+			ids[j] = fmt.Sprint(tx.Id)
+			mergedIDs[j] = tx.Id
+		}
+		merged[i] = &transfer
+		k.setMergedTransfer(ctx, record)
+
+		k.emitLegacyEvent(ctx, sdk.NewEvent(
+			types.EventTypeBatchTransfersMerged,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(first.Id)),
+			sdk.NewAttribute(types.AttributeKeyBatchNonce, fmt.Sprint(batchNonce)),
+			sdk.NewAttribute(types.AttributeKeyMergedTxIDs, strings.Join(ids, ",")),
+		))
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventBatchTransfersMerged{
+			BatchNonce:  batchNonce,
+			TxId:        first.Id,
+			MergedTxIds: mergedIDs,
+		}); err != nil {
+			panic(sdkerrors.Wrap(err, "emit batch transfers merged event"))
+		}
+	}
+	return merged
+}
+
+// GetBatchTransfers returns the pool transactions batch was built from, the transactions merged into one of its
+// transfers are returned in place of the transfer
+func (k Keeper) GetBatchTransfers(ctx sdk.Context, batch types.InternalOutgoingTxBatch) []*types.InternalOutgoingTransferTx {
+	store := ctx.KVStore(k.storeKey)
+	out := make([]*types.InternalOutgoingTransferTx, 0, len(batch.Transactions))
+	for _, tx := range batch.Transactions {
+		bz := store.Get(types.GetMergedTransferKey(batch.BatchNonce, tx.Id))
+		if bz == nil {
+			out = append(out, tx)
+			continue
+		}
+		var record types.MergedTransfer
+		k.cdc.MustUnmarshalBinaryBare(bz, &record)
+		for _, child := range record.Children {
+			intChild, err := child.ToInternal()
+			if err != nil {
+				panic(sdkerrors.Wrapf(err, "invalid merged transaction in store: %v", child))
+			}
+			out = append(out, intChild)
+		}
+	}
+	return out
+}
+
+// GetAllMergedTransfers returns the records of the merged transfers of all batches, useful for genesis save/load
+func (k Keeper) GetAllMergedTransfers(ctx sdk.Context) (out []types.MergedTransfer) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.MergedTransferKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.MergedTransfer
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &record)
+		out = append(out, record)
+	}
+	return out
+}
+
+// setMergedTransfer stores the record of a merged transfer
+func (k Keeper) setMergedTransfer(ctx sdk.Context, record types.MergedTransfer) {
+	ctx.KVStore(k.storeKey).Set(types.GetMergedTransferKey(record.BatchNonce, record.Id), k.cdc.MustMarshalBinaryBare(&record))
+}
+
+// pickUnbatchedTX find TX in pool and remove from "available" second index,
+// held txs are left in the pool
 func (k Keeper) pickUnbatchedTX(
 	ctx sdk.Context,
 	contractAddress types.EthAddress,
 	maxElements uint) ([]*types.InternalOutgoingTransferTx, error) {
 	var selectedTx []*types.InternalOutgoingTransferTx
 	var err error
+	height := uint64(ctx.BlockHeight())
 	k.IterateUnbatchedTransactionsByContract(ctx, contractAddress, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
 		if tx != nil && tx.Erc20Fee != nil {
+			if tx.IsHeld(height) {
+				return false
+			}
 			selectedTx = append(selectedTx, tx)
-			err = k.removeUnbatchedTX(ctx, *tx.Erc20Fee, tx.Id)
-			oldTx, oldTxErr := k.GetUnbatchedTxByFeeAndId(ctx, *tx.Erc20Fee, tx.Id)
+			err = k.removeUnbatchedTX(ctx, *tx.Erc20Fee, tx.Priority, tx.Id)
+			oldTx, oldTxErr := k.GetUnbatchedTxByFeeAndId(ctx, *tx.Erc20Fee, tx.Priority, tx.Id)
 			if oldTx != nil || oldTxErr == nil {
 				panic("picked a duplicate transaction from the pool, duplicates should never exist!")
 			}
@@ -204,11 +377,20 @@ func (k Keeper) GetOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAddre
 
 // CancelOutgoingTXBatch releases all TX in the batch and deletes the batch
 func (k Keeper) CancelOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) error {
+	return k.cancelOutgoingTXBatch(ctx, tokenContract, nonce, types.BATCH_LIFECYCLE_STATUS_CANCELED)
+}
+
+// cancelOutgoingTXBatch cancels a batch, recording status as the way its lifecycle ended
+func (k Keeper) cancelOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64, status types.BatchLifecycleStatus) error {
 	batch := k.GetOutgoingTXBatch(ctx, tokenContract, nonce)
 	if batch == nil {
 		return types.ErrUnknown
 	}
-	for _, tx := range batch.Transactions {
+	k.recordFinishedBatch(ctx, batch, status, 0)
+	released := k.GetBatchTransfers(ctx, *batch)
+	for _, tx := range released {
+		// the pool timeout of a tx coming back from a batch starts over
+		tx.CreatedHeight = uint64(ctx.BlockHeight())
 		err := k.addUnbatchedTX(ctx, tx)
 		if err != nil {
 			panic(sdkerrors.Wrapf(err, "unable to add batched transaction back into pool %v", tx))
@@ -226,16 +408,140 @@ func (k Keeper) CancelOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAd
 		sdk.NewAttribute(types.AttributeKeyOutgoingBatchID, fmt.Sprint(nonce)),
 		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(nonce)),
 	)
-	ctx.EventManager().EmitEvent(batchEvent)
-	return nil
+	k.emitLegacyEvent(ctx, batchEvent)
+	// a timed out batch has its own typed event, emitted by TimeoutOutgoingTXBatch
+	if status != types.BATCH_LIFECYCLE_STATUS_CANCELED {
+		return nil
+	}
+	event := types.EventOutgoingBatchCanceled{
+		BatchNonce:    nonce,
+		TokenContract: tokenContract.GetAddress(),
+		Transactions:  make([]*types.OutgoingTransferTx, len(released)),
+	}
+	for i, tx := range released {
+		event.Transactions[i] = tx.ToExternal()
+	}
+	return ctx.EventManager().EmitTypedEvent(&event)
 }
 
-// IterateOutgoingTXBatches iterates through all outgoing batches in DESC order.
-func (k Keeper) IterateOutgoingTXBatches(ctx sdk.Context, cb func(key []byte, batch *types.InternalOutgoingTxBatch) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingTXBatchKey)
-	iter := prefixStore.ReverseIterator(nil, nil)
+// TimeoutOutgoingTXBatch cancels a batch that has passed its timeout on Ethereum, its transactions go back
+// into the unbatched pool and an event is emitted for each of them so their senders can follow the withdrawal
+// into whichever batch picks it up next
+func (k Keeper) TimeoutOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) error {
+	batch := k.GetOutgoingTXBatch(ctx, tokenContract, nonce)
+	if batch == nil {
+		return types.ErrUnknown
+	}
+	// the merged transfers are returned to the pool as the transactions they were merged from
+	released := k.GetBatchTransfers(ctx, *batch)
+	if err := k.cancelOutgoingTXBatch(ctx, tokenContract, nonce, types.BATCH_LIFECYCLE_STATUS_TIMED_OUT); err != nil {
+		return err
+	}
+	record := types.TimedOutBatch{
+		TokenContract:          tokenContract.GetAddress(),
+		BatchNonce:             nonce,
+		BatchTimeout:           batch.BatchTimeout,
+		ObservedEthereumHeight: k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight,
+		TimedOutHeight:         uint64(ctx.BlockHeight()),
+		TimedOutTime:           uint64(ctx.BlockTime().Unix()),
+		ReleasedTxIds:          make([]uint64, 0, len(released)),
+	}
+	for _, tx := range released {
+		record.ReleasedTxIds = append(record.ReleasedTxIds, tx.Id)
+		k.emitLegacyEvent(ctx, sdk.NewEvent(
+			types.EventTypeBatchTimeoutTxRequeued,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(tx.Id)),
+			sdk.NewAttribute(types.AttributeKeyBatchNonce, fmt.Sprint(nonce)),
+			sdk.NewAttribute(types.AttributeKeyBatchTimeout, fmt.Sprint(batch.BatchTimeout)),
+		))
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetTimedOutBatchKey(nonce), k.cdc.MustMarshalBinaryBare(&record))
+
+	event := types.EventOutgoingBatchTimedOut{
+		BatchNonce:    nonce,
+		TokenContract: tokenContract.GetAddress(),
+		BatchTimeout:  batch.BatchTimeout,
+		Transactions:  make([]*types.OutgoingTransferTx, len(released)),
+	}
+	for i, tx := range released {
+		event.Transactions[i] = tx.ToExternal()
+	}
+	return ctx.EventManager().EmitTypedEvent(&event)
+}
+
+// RebuildTimedOutBatches builds a new batch for each of tokenContracts, the tokens that had a batch time out in
+// this block, if the RebuildTimedOutBatches param is set. The transfers the timed out batches returned to the pool
+// are picked again in fee order together with the rest of the pool, instead of waiting for someone to request a
+// batch. The usual batch building rules apply, a token whose pool would not make a profitable batch is skipped
+func (k Keeper) RebuildTimedOutBatches(ctx sdk.Context, tokenContracts []types.EthAddress) {
+	if !k.GetParams(ctx).RebuildTimedOutBatches {
+		return
+	}
+	for _, tokenContract := range tokenContracts {
+		// a batch that can not be built must not leave anything behind
+		xCtx, commit := ctx.CacheContext()
+		batch, err := k.BuildOutgoingTXBatch(xCtx, tokenContract, k.GetMaxBatchSize(ctx, tokenContract))
+		if err != nil || batch == nil {
+			reason := "empty pool"
+			if err != nil {
+				reason = err.Error()
+			}
+			k.logger(ctx).Debug("timed out batch not rebuilt", "token", tokenContract.GetAddress(), "reason", reason)
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+	}
+}
+
+// GetTimedOutBatches returns the recorded timed out batches in nonce order, only those of tokenContract if it is set
+func (k Keeper) GetTimedOutBatches(ctx sdk.Context, tokenContract *types.EthAddress) (out []types.TimedOutBatch) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.TimedOutBatchKey).Iterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
+		var record types.TimedOutBatch
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &record)
+		if tokenContract != nil && record.TokenContract != tokenContract.GetAddress() {
+			continue
+		}
+		out = append(out, record)
+	}
+	return
+}
+
+// PruneTimedOutBatches drops the timed out batch records with a nonce more than keep below the latest batch nonce
+func (k Keeper) PruneTimedOutBatches(ctx sdk.Context, keep uint64) {
+	k.pruneBatchRecords(ctx, types.TimedOutBatchKey, types.GetTimedOutBatchKey, keep)
+}
+
+// pruneBatchRecords drops the records under recordPrefix, keyed by batch nonce with recordKey, with a nonce more than
+// keep below the latest batch nonce
+func (k Keeper) pruneBatchRecords(ctx sdk.Context, recordPrefix []byte, recordKey func(uint64) []byte, keep uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyLastOutgoingBatchID)
+	// the stored value is the nonce the next batch will get
+	if len(bz) == 0 || types.UInt64FromBytes(bz) <= keep+1 {
+		return
+	}
+	cutoff := types.UInt64FromBytes(bz) - 1 - keep
+	var keys [][]byte
+	iter := store.Iterator(recordPrefix, recordKey(cutoff))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+	k.deletePrunedKeys(ctx, keys)
+}
+
+// IterateOutgoingTXBatches iterates through the outgoing batches in DESC order, within limit
+func (k Keeper) IterateOutgoingTXBatches(ctx sdk.Context, limit IterationLimit, cb func(key []byte, batch *types.InternalOutgoingTxBatch) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingTXBatchKey)
+	iter := prefixStore.ReverseIterator(limit.bounds(nil, nil, true))
+	defer iter.Close()
+	var visited uint64
+	for ; iter.Valid() && !limit.reached(visited); iter.Next() {
+		visited++
 		var batch types.OutgoingTxBatch
 		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &batch)
 		intBatch, err := batch.ToInternal()
@@ -251,13 +557,51 @@ func (k Keeper) IterateOutgoingTXBatches(ctx sdk.Context, cb func(key []byte, ba
 
 // GetOutgoingTxBatches returns the outgoing tx batches
 func (k Keeper) GetOutgoingTxBatches(ctx sdk.Context) (out []*types.InternalOutgoingTxBatch) {
-	k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch *types.InternalOutgoingTxBatch) bool {
+	k.IterateOutgoingTXBatches(ctx, IterationLimit{}, func(_ []byte, batch *types.InternalOutgoingTxBatch) bool {
 		out = append(out, batch)
 		return false
 	})
 	return
 }
 
+// GetOutgoingTxBatchesPaged returns a page of the outgoing batches grouped by token contract, each token in batch
+// nonce order. Only the batches of tokenContract are returned if it is not nil, and only those orchestrator has not
+// confirmed yet if it is not nil
+func (k Keeper) GetOutgoingTxBatchesPaged(
+	ctx sdk.Context,
+	tokenContract *types.EthAddress,
+	orchestrator sdk.AccAddress,
+	pageReq *query.PageRequest,
+) ([]*types.InternalOutgoingTxBatch, *query.PageResponse, error) {
+	prefixKey := types.OutgoingTXBatchKey
+	if tokenContract != nil {
+		prefixKey = types.GetOutgoingTxBatchContractPrefix(*tokenContract)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+	var batches []*types.InternalOutgoingTxBatch
+	pageRes, err := query.FilteredPaginate(store, pageReq, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var batch types.OutgoingTxBatch
+		if err := k.cdc.UnmarshalBinaryBare(value, &batch); err != nil {
+			return false, err
+		}
+		intBatch, err := batch.ToInternal()
+		if err != nil {
+			return false, err
+		}
+		if orchestrator != nil && k.GetBatchConfirm(ctx, intBatch.BatchNonce, intBatch.TokenContract, orchestrator) != nil {
+			return false, nil
+		}
+		if accumulate {
+			batches = append(batches, intBatch)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return batches, pageRes, nil
+}
+
 // GetLastOutgoingBatchByTokenType gets the latest outgoing tx batch by token type
 func (k Keeper) GetLastOutgoingBatchByTokenType(ctx sdk.Context, token types.EthAddress) *types.InternalOutgoingTxBatch {
 	batches := k.GetOutgoingTxBatches(ctx)
@@ -289,6 +633,59 @@ func (k Keeper) GetLastSlashedBatchBlock(ctx sdk.Context) uint64 {
 	return types.UInt64FromBytes(bytes)
 }
 
+// GetSignedBatchesWindow returns the number of blocks validators have to sign a batch of tokenContract, its entry
+// in TokenSignedBatchesWindows if it has one and SignedBatchesWindow otherwise
+func (k Keeper) GetSignedBatchesWindow(ctx sdk.Context, tokenContract types.EthAddress) uint64 {
+	params := k.GetParams(ctx)
+	for _, window := range params.TokenSignedBatchesWindows {
+		if strings.EqualFold(window.TokenContract, tokenContract.GetAddress()) {
+			return window.SignedBatchesWindow
+		}
+	}
+	return params.SignedBatchesWindow
+}
+
+// GetShortestSignedBatchesWindow returns the shortest window any token gives validators to sign its batches, no
+// batch can be due for slashing before it has passed
+func (k Keeper) GetShortestSignedBatchesWindow(ctx sdk.Context) uint64 {
+	params := k.GetParams(ctx)
+	shortest := params.SignedBatchesWindow
+	for _, window := range params.TokenSignedBatchesWindows {
+		if window.SignedBatchesWindow < shortest {
+			shortest = window.SignedBatchesWindow
+		}
+	}
+	return shortest
+}
+
+// IsBatchSlashed returns true if the signers of batch were already slashed for, which is only tracked for the
+// batches above LastSlashedBatchBlock
+func (k Keeper) IsBatchSlashed(ctx sdk.Context, batch *types.InternalOutgoingTxBatch) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetBatchSlashedKey(batch.Block, batch.TokenContract, batch.BatchNonce))
+}
+
+// SetBatchSlashed marks the signers of batch as slashed for, while an earlier batch with a longer window holds back
+// LastSlashedBatchBlock
+func (k Keeper) SetBatchSlashed(ctx sdk.Context, batch *types.InternalOutgoingTxBatch) {
+	ctx.KVStore(k.storeKey).Set(types.GetBatchSlashedKey(batch.Block, batch.TokenContract, batch.BatchNonce), []byte{0x1})
+}
+
+// PruneSlashedBatchMarks removes the marks of the batches at or below blockHeight, LastSlashedBatchBlock already
+// keeps them from being slashed again
+func (k Keeper) PruneSlashedBatchMarks(ctx sdk.Context, blockHeight uint64) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BatchSlashedKey)
+	iter := prefixStore.Iterator(nil, types.UInt64Bytes(blockHeight+1))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
 // GetUnSlashedBatches returns all the unslashed batches in state
 func (k Keeper) GetUnSlashedBatches(ctx sdk.Context, maxHeight uint64) (out []*types.InternalOutgoingTxBatch) {
 	lastSlashedBatchBlock := k.GetLastSlashedBatchBlock(ctx)
@@ -3,17 +3,20 @@ package keeper
 import (
 	"fmt"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
-//nolint: exhaustivestruct
+// nolint: exhaustivestruct
 func TestBatches(t *testing.T) {
 	input := CreateTestEnv(t)
 	ctx := input.Context
@@ -65,25 +68,27 @@ func TestBatches(t *testing.T) {
 	// then batch is persisted
 	gotFirstBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, firstBatch.TokenContract, firstBatch.BatchNonce)
 	require.NotNil(t, gotFirstBatch)
-	// Should have txs 2: and 3: from above, as ties in fees are broken by transaction index
+	// Should have txs 2: and 1: from above, as ties in fees go to the oldest transaction
 	ctx.Logger().Info(fmt.Sprintf("found batch %+v", gotFirstBatch))
 
 	expFirstBatch := &types.OutgoingTxBatch{
 		BatchNonce: 1,
 		Transactions: []*types.OutgoingTransferTx{
 			{
-				Id:          2,
-				Erc20Fee:    types.NewERC20Token(3, myTokenContractAddr.GetAddress()),
-				Sender:      mySender.String(),
-				DestAddress: myReceiver.GetAddress(),
-				Erc20Token:  types.NewERC20Token(101, myTokenContractAddr.GetAddress()),
+				Id:            2,
+				Erc20Fee:      types.NewERC20Token(3, myTokenContractAddr.GetAddress()),
+				Sender:        mySender.String(),
+				DestAddress:   myReceiver.GetAddress(),
+				Erc20Token:    types.NewERC20Token(101, myTokenContractAddr.GetAddress()),
+				CreatedHeight: uint64(ctx.BlockHeight()),
 			},
 			{
-				Id:          3,
-				Erc20Fee:    types.NewERC20Token(2, myTokenContractAddr.GetAddress()),
-				Sender:      mySender.String(),
-				DestAddress: myReceiver.GetAddress(),
-				Erc20Token:  types.NewERC20Token(102, myTokenContractAddr.GetAddress()),
+				Id:            1,
+				Erc20Fee:      types.NewERC20Token(2, myTokenContractAddr.GetAddress()),
+				Sender:        mySender.String(),
+				DestAddress:   myReceiver.GetAddress(),
+				Erc20Token:    types.NewERC20Token(100, myTokenContractAddr.GetAddress()),
+				CreatedHeight: uint64(ctx.BlockHeight()),
 			},
 		},
 		TokenContract: myTokenContractAddr.GetAddress(),
@@ -99,26 +104,29 @@ func TestBatches(t *testing.T) {
 	}
 
 	// and verify remaining available Tx in the pool
-	// Should still have 1: and 4: above
+	// Should still have 3: and 4: above
 	gotUnbatchedTx := input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, *myTokenContractAddr)
 	oneFee, _ := types.NewInternalERC20Token(sdk.NewInt(1), myTokenContractAddr.GetAddress())
 	oneHundredTok, _ := types.NewInternalERC20Token(sdk.NewInt(100), myTokenContractAddr.GetAddress())
+	oneHundredTwoTok, _ := types.NewInternalERC20Token(sdk.NewInt(102), myTokenContractAddr.GetAddress())
 	twoFee, _ := types.NewInternalERC20Token(sdk.NewInt(2), myTokenContractAddr.GetAddress())
 	oneHundredThreeTok, _ := types.NewInternalERC20Token(sdk.NewInt(103), myTokenContractAddr.GetAddress())
 	expUnbatchedTx := []*types.InternalOutgoingTransferTx{
 		{
-			Id:          1,
-			Erc20Fee:    twoFee,
-			Sender:      mySender,
-			DestAddress: myReceiver,
-			Erc20Token:  oneHundredTok,
+			Id:            3,
+			Erc20Fee:      twoFee,
+			Sender:        mySender,
+			DestAddress:   myReceiver,
+			Erc20Token:    oneHundredTwoTok,
+			CreatedHeight: uint64(ctx.BlockHeight()),
 		},
 		{
-			Id:          4,
-			Erc20Fee:    oneFee,
-			Sender:      mySender,
-			DestAddress: myReceiver,
-			Erc20Token:  oneHundredThreeTok,
+			Id:            4,
+			Erc20Fee:      oneFee,
+			Sender:        mySender,
+			DestAddress:   myReceiver,
+			Erc20Token:    oneHundredThreeTok,
+			CreatedHeight: uint64(ctx.BlockHeight()),
 		},
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
@@ -154,18 +162,20 @@ func TestBatches(t *testing.T) {
 		BatchNonce: 2,
 		Transactions: []*types.OutgoingTransferTx{
 			{
-				Id:          6,
-				Erc20Fee:    types.NewERC20Token(5, myTokenContractAddr.GetAddress()),
-				Sender:      mySender.String(),
-				DestAddress: myReceiver.GetAddress(),
-				Erc20Token:  types.NewERC20Token(101, myTokenContractAddr.GetAddress()),
+				Id:            6,
+				Erc20Fee:      types.NewERC20Token(5, myTokenContractAddr.GetAddress()),
+				Sender:        mySender.String(),
+				DestAddress:   myReceiver.GetAddress(),
+				Erc20Token:    types.NewERC20Token(101, myTokenContractAddr.GetAddress()),
+				CreatedHeight: uint64(ctx.BlockHeight()),
 			},
 			{
-				Id:          5,
-				Erc20Fee:    types.NewERC20Token(4, myTokenContractAddr.GetAddress()),
-				Sender:      mySender.String(),
-				DestAddress: myReceiver.GetAddress(),
-				Erc20Token:  types.NewERC20Token(100, myTokenContractAddr.GetAddress()),
+				Id:            5,
+				Erc20Fee:      types.NewERC20Token(4, myTokenContractAddr.GetAddress()),
+				Sender:        mySender.String(),
+				DestAddress:   myReceiver.GetAddress(),
+				Erc20Token:    types.NewERC20Token(100, myTokenContractAddr.GetAddress()),
+				CreatedHeight: uint64(ctx.BlockHeight()),
 			},
 		},
 		TokenContract: myTokenContractAddr.GetAddress(),
@@ -195,35 +205,38 @@ func TestBatches(t *testing.T) {
 	gotUnbatchedTx = input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, *myTokenContractAddr)
 	threeFee, _ := types.NewInternalERC20Token(sdk.NewInt(3), myTokenContractAddr.GetAddress())
 	oneHundredOneTok, _ := types.NewInternalERC20Token(sdk.NewInt(101), myTokenContractAddr.GetAddress())
-	oneHundredTwoTok, _ := types.NewInternalERC20Token(sdk.NewInt(102), myTokenContractAddr.GetAddress())
 	expUnbatchedTx = []*types.InternalOutgoingTransferTx{
 		{
-			Id:          2,
-			Erc20Fee:    threeFee,
-			Sender:      mySender,
-			DestAddress: myReceiver,
-			Erc20Token:  oneHundredOneTok,
+			Id:            2,
+			Erc20Fee:      threeFee,
+			Sender:        mySender,
+			DestAddress:   myReceiver,
+			Erc20Token:    oneHundredOneTok,
+			CreatedHeight: uint64(ctx.BlockHeight()),
 		},
 		{
-			Id:          3,
-			Erc20Fee:    twoFee,
-			Sender:      mySender,
-			DestAddress: myReceiver,
-			Erc20Token:  oneHundredTwoTok,
+			Id:            1,
+			Erc20Fee:      twoFee,
+			Sender:        mySender,
+			DestAddress:   myReceiver,
+			Erc20Token:    oneHundredTok,
+			CreatedHeight: uint64(ctx.BlockHeight()),
 		},
 		{
-			Id:          1,
-			Erc20Fee:    twoFee,
-			Sender:      mySender,
-			DestAddress: myReceiver,
-			Erc20Token:  oneHundredTok,
+			Id:            3,
+			Erc20Fee:      twoFee,
+			Sender:        mySender,
+			DestAddress:   myReceiver,
+			Erc20Token:    oneHundredTwoTok,
+			CreatedHeight: uint64(ctx.BlockHeight()),
 		},
 		{
-			Id:          4,
-			Erc20Fee:    oneFee,
-			Sender:      mySender,
-			DestAddress: myReceiver,
-			Erc20Token:  oneHundredThreeTok,
+			Id:            4,
+			Erc20Fee:      oneFee,
+			Sender:        mySender,
+			DestAddress:   myReceiver,
+			Erc20Token:    oneHundredThreeTok,
+			CreatedHeight: uint64(ctx.BlockHeight()),
 		},
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
@@ -231,7 +244,7 @@ func TestBatches(t *testing.T) {
 
 // tests that batches work with large token amounts, mostly a duplicate of the above
 // tests but using much bigger numbers
-//nolint: exhaustivestruct
+// nolint: exhaustivestruct
 func TestBatchesFullCoins(t *testing.T) {
 	input := CreateTestEnv(t)
 	ctx := input.Context
@@ -288,18 +301,20 @@ func TestBatchesFullCoins(t *testing.T) {
 		BatchNonce: 1,
 		Transactions: []*types.OutgoingTransferTx{
 			{
-				Id:          2,
-				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
-				Sender:      mySender.String(),
-				DestAddress: myReceiver,
-				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
+				Id:            2,
+				Erc20Fee:      types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
+				Sender:        mySender.String(),
+				DestAddress:   myReceiver,
+				Erc20Token:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
+				CreatedHeight: uint64(ctx.BlockHeight()),
 			},
 			{
-				Id:          3,
-				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
-				Sender:      mySender.String(),
-				DestAddress: myReceiver,
-				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
+				Id:            3,
+				Erc20Fee:      types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
+				Sender:        mySender.String(),
+				DestAddress:   myReceiver,
+				Erc20Token:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
+				CreatedHeight: uint64(ctx.BlockHeight()),
 			},
 		},
 		TokenContract: myTokenContractAddr,
@@ -320,18 +335,20 @@ func TestBatchesFullCoins(t *testing.T) {
 	tenTok, _ := types.NewInternalERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr)
 	expUnbatchedTx := []*types.InternalOutgoingTransferTx{
 		{
-			Id:          1,
-			Erc20Fee:    twentyTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  twentyTok,
+			Id:            1,
+			Erc20Fee:      twentyTok,
+			Sender:        mySender,
+			DestAddress:   receiverAddr,
+			Erc20Token:    twentyTok,
+			CreatedHeight: uint64(ctx.BlockHeight()),
 		},
 		{
-			Id:          4,
-			Erc20Fee:    tenTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  tenTok,
+			Id:            4,
+			Erc20Fee:      tenTok,
+			Sender:        mySender,
+			DestAddress:   receiverAddr,
+			Erc20Token:    tenTok,
+			CreatedHeight: uint64(ctx.BlockHeight()),
 		},
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
@@ -364,18 +381,20 @@ func TestBatchesFullCoins(t *testing.T) {
 		BatchNonce: 2,
 		Transactions: []*types.OutgoingTransferTx{
 			{
-				Id:          1,
-				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(20)), myTokenContractAddr),
-				Sender:      mySender.String(),
-				DestAddress: myReceiver,
-				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(20)), myTokenContractAddr),
+				Id:            1,
+				Erc20Fee:      types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(20)), myTokenContractAddr),
+				Sender:        mySender.String(),
+				DestAddress:   myReceiver,
+				Erc20Token:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(20)), myTokenContractAddr),
+				CreatedHeight: uint64(ctx.BlockHeight()),
 			},
 			{
-				Id:          4,
-				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr),
-				Sender:      mySender.String(),
-				DestAddress: myReceiver,
-				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr),
+				Id:            4,
+				Erc20Fee:      types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr),
+				Sender:        mySender.String(),
+				DestAddress:   myReceiver,
+				Erc20Token:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr),
+				CreatedHeight: uint64(ctx.BlockHeight()),
 			},
 		},
 		TokenContract: myTokenContractAddr,
@@ -409,32 +428,36 @@ func TestBatchesFullCoins(t *testing.T) {
 	fourTok, _ := types.NewInternalERC20Token(oneEth.Mul(sdk.NewIntFromUint64(4)), myTokenContractAddr)
 	expUnbatchedTx = []*types.InternalOutgoingTransferTx{
 		{
-			Id:          2,
-			Erc20Fee:    threeHundredTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  threeHundredTok,
+			Id:            2,
+			Erc20Fee:      threeHundredTok,
+			Sender:        mySender,
+			DestAddress:   receiverAddr,
+			Erc20Token:    threeHundredTok,
+			CreatedHeight: uint64(ctx.BlockHeight()),
 		},
 		{
-			Id:          3,
-			Erc20Fee:    twentyFiveTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  twentyFiveTok,
+			Id:            3,
+			Erc20Fee:      twentyFiveTok,
+			Sender:        mySender,
+			DestAddress:   receiverAddr,
+			Erc20Token:    twentyFiveTok,
+			CreatedHeight: uint64(ctx.BlockHeight()),
 		},
 		{
-			Id:          6,
-			Erc20Fee:    fiveTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  fiveTok,
+			Id:            6,
+			Erc20Fee:      fiveTok,
+			Sender:        mySender,
+			DestAddress:   receiverAddr,
+			Erc20Token:    fiveTok,
+			CreatedHeight: uint64(ctx.BlockHeight()),
 		},
 		{
-			Id:          5,
-			Erc20Fee:    fourTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  fourTok,
+			Id:            5,
+			Erc20Fee:      fourTok,
+			Sender:        mySender,
+			DestAddress:   receiverAddr,
+			Erc20Token:    fourTok,
+			CreatedHeight: uint64(ctx.BlockHeight()),
 		},
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
@@ -442,7 +465,7 @@ func TestBatchesFullCoins(t *testing.T) {
 
 // TestManyBatches handles test cases around batch execution, specifically executing multiple batches
 // out of sequential order, which is exactly what happens on the
-//nolint: exhaustivestruct
+// nolint: exhaustivestruct
 func TestManyBatches(t *testing.T) {
 	input := CreateTestEnv(t)
 	ctx := input.Context
@@ -540,7 +563,7 @@ func TestManyBatches(t *testing.T) {
 	}
 }
 
-//nolint: exhaustivestruct
+// nolint: exhaustivestruct
 func TestPoolTxRefund(t *testing.T) {
 	input := CreateTestEnv(t)
 	ctx := input.Context
@@ -593,12 +616,12 @@ func TestPoolTxRefund(t *testing.T) {
 	ctx = ctx.WithBlockTime(now)
 
 	// tx batch size is 2, so that some of them stay behind
-	// Should have 2: and 3: from above
+	// Should have 2: and 1: from above
 	_, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, *contract, 2)
 	require.NoError(t, err)
 
 	// try to refund a tx that's in a batch
-	err1 := input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, 3, mySender)
+	err1 := input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, 1, mySender)
 	require.Error(t, err1)
 
 	// try to refund somebody else's tx
@@ -613,3 +636,662 @@ func TestPoolTxRefund(t *testing.T) {
 	balances := input.BankKeeper.GetAllBalances(ctx, mySender)
 	require.Equal(t, sdk.NewInt(104), balances.AmountOf(myDenom))
 }
+
+func TestTimedOutBatchHistory(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5" // Pickle
+		token, err          = types.NewInternalERC20Token(sdk.NewInt(1000), myTokenContractAddr)
+		allVouchers         = sdk.NewCoins(token.GravityCoin())
+	)
+	require.NoError(t, err)
+	contract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	otherContract, err := types.NewEthAddress("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8")
+	require.NoError(t, err)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	for i, v := range []uint64{2, 3, 2, 1} {
+		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr)
+		require.NoError(t, err)
+		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr)
+		require.NoError(t, err)
+		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amountToken.GravityCoin(), feeToken.GravityCoin())
+		require.NoError(t, err)
+	}
+
+	batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *contract, 2)
+	require.NoError(t, err)
+	input.GravityKeeper.SetLastObservedEthereumBlockHeight(ctx, batch.BatchTimeout+1)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// unknown batches can not time out
+	require.Error(t, input.GravityKeeper.TimeoutOutgoingTXBatch(ctx, *contract, batch.BatchNonce+1))
+	require.NoError(t, input.GravityKeeper.TimeoutOutgoingTXBatch(ctx, *contract, batch.BatchNonce))
+	require.Nil(t, input.GravityKeeper.GetOutgoingTXBatch(ctx, *contract, batch.BatchNonce))
+
+	history := input.GravityKeeper.GetTimedOutBatches(ctx, nil)
+	require.Len(t, history, 1)
+	assert.Equal(t, contract.GetAddress(), history[0].TokenContract)
+	assert.Equal(t, batch.BatchNonce, history[0].BatchNonce)
+	assert.Equal(t, batch.BatchTimeout, history[0].BatchTimeout)
+	assert.Equal(t, batch.BatchTimeout+1, history[0].ObservedEthereumHeight)
+	assert.Equal(t, uint64(ctx.BlockHeight()), history[0].TimedOutHeight)
+	require.Len(t, history[0].ReleasedTxIds, len(batch.Transactions))
+	for i, tx := range batch.Transactions {
+		assert.Equal(t, tx.Id, history[0].ReleasedTxIds[i])
+	}
+	assert.Len(t, input.GravityKeeper.GetTimedOutBatches(ctx, contract), 1)
+	assert.Empty(t, input.GravityKeeper.GetTimedOutBatches(ctx, otherContract))
+
+	// the record is kept until enough newer batches have been created
+	input.GravityKeeper.PruneTimedOutBatches(ctx, 0)
+	assert.Len(t, input.GravityKeeper.GetTimedOutBatches(ctx, nil), 1)
+	_, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, *contract, 2)
+	require.NoError(t, err)
+	input.GravityKeeper.PruneTimedOutBatches(ctx, 1)
+	assert.Len(t, input.GravityKeeper.GetTimedOutBatches(ctx, nil), 1)
+	input.GravityKeeper.PruneTimedOutBatches(ctx, 0)
+	assert.Empty(t, input.GravityKeeper.GetTimedOutBatches(ctx, nil))
+}
+
+// Tests that the lifecycle of a batch can be followed after it left the store, however it did
+func TestBatchLifecycle(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5" // Pickle
+		token, err          = types.NewInternalERC20Token(sdk.NewInt(1000), myTokenContractAddr)
+		allVouchers         = sdk.NewCoins(token.GravityCoin())
+	)
+	require.NoError(t, err)
+	contract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	otherContract, err := types.NewEthAddress("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8")
+	require.NoError(t, err)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	for i, v := range []uint64{4, 3, 2, 1} {
+		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr)
+		require.NoError(t, err)
+		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr)
+		require.NoError(t, err)
+		_, err = k.AddToOutgoingPool(ctx, mySender, *receiver, amountToken.GravityCoin(), feeToken.GravityCoin())
+		require.NoError(t, err)
+	}
+	canceled, err := k.BuildOutgoingTXBatch(ctx, *contract, 2)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	executed, err := k.BuildOutgoingTXBatch(ctx, *contract, 1)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	timedOut, err := k.BuildOutgoingTXBatch(ctx, *contract, 1)
+	require.NoError(t, err)
+
+	lifecycle, found := k.GetBatchLifecycle(ctx, *contract, canceled.BatchNonce)
+	require.True(t, found)
+	assert.Equal(t, types.BATCH_LIFECYCLE_STATUS_PENDING, lifecycle.Status)
+	assert.Equal(t, canceled.Block, lifecycle.CreatedHeight)
+	assert.Equal(t, canceled.BatchTimeout, lifecycle.BatchTimeout)
+	assert.Zero(t, lifecycle.Confirms)
+	// batch nonces are shared by all tokens
+	_, found = k.GetBatchLifecycle(ctx, *otherContract, canceled.BatchNonce)
+	assert.False(t, found)
+
+	// executing a batch cancels the earlier batches of the token
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	claim := types.MsgBatchSendToEthClaim{
+		EventNonce:    1,
+		BlockHeight:   1234,
+		BatchNonce:    executed.BatchNonce,
+		TokenContract: contract.GetAddress(),
+		Orchestrator:  mySender.String(),
+	}
+	require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+	lifecycle, found = k.GetBatchLifecycle(ctx, *contract, executed.BatchNonce)
+	require.True(t, found)
+	assert.Equal(t, types.BATCH_LIFECYCLE_STATUS_EXECUTED, lifecycle.Status)
+	assert.Equal(t, uint64(1234), lifecycle.ExecutionEthereumHeight)
+	assert.Equal(t, uint64(ctx.BlockHeight()), lifecycle.FinishedHeight)
+	lifecycle, found = k.GetBatchLifecycle(ctx, *contract, canceled.BatchNonce)
+	require.True(t, found)
+	assert.Equal(t, types.BATCH_LIFECYCLE_STATUS_CANCELED, lifecycle.Status)
+	assert.Zero(t, lifecycle.ExecutionEthereumHeight)
+
+	k.SetLastObservedEthereumBlockHeight(ctx, timedOut.BatchTimeout+1)
+	require.NoError(t, k.TimeoutOutgoingTXBatch(ctx, *contract, timedOut.BatchNonce))
+	lifecycle, found = k.GetBatchLifecycle(ctx, *contract, timedOut.BatchNonce)
+	require.True(t, found)
+	assert.Equal(t, types.BATCH_LIFECYCLE_STATUS_TIMED_OUT, lifecycle.Status)
+
+	// the records are pruned with the timed out batch history
+	k.PruneFinishedBatches(ctx, 0)
+	_, found = k.GetBatchLifecycle(ctx, *contract, canceled.BatchNonce)
+	assert.False(t, found)
+	_, found = k.GetBatchLifecycle(ctx, *contract, timedOut.BatchNonce)
+	assert.True(t, found)
+}
+
+// Tests that a batch is only built once its fees are worth more than the gas it is estimated to cost
+func TestBatchProfitability(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	var (
+		mySender, _            = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+	)
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+	addTx := func(fee int64) {
+		_, err := k.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewInt64Coin(token.GravityCoin().Denom, 100),
+			sdk.NewInt64Coin(token.GravityCoin().Denom, fee))
+		require.NoError(t, err)
+	}
+
+	params := k.GetParams(ctx)
+	params.BatchGasOverhead = 300000
+	params.BatchGasPerTx = 60000
+	k.SetParams(ctx, params)
+	for i := range ValAddrs[:3] {
+		k.SetOrchestratorHeartbeat(ctx, ValAddrs[i], types.OrchestratorHeartbeat{
+			Validator:         ValAddrs[i].String(),
+			EthGasPrice:       10,
+			CosmosBlockHeight: uint64(ctx.BlockHeight()),
+		})
+	}
+	k.PriceFeed = fixedPriceFeed{
+		types.WeiPriceDenom:       sdk.NewDecWithPrec(1, 12),
+		token.GravityCoin().Denom: sdk.NewDecWithPrec(1, 6),
+	}
+	fees := k.GetBatchFeeByTokenType(ctx, *myTokenContractAddr, 2)
+	require.Equal(t, uint64(0), fees.TxCount)
+
+	// two transfers are estimated at 420000 gas, 4.2e-6 USD at 10 wei, their fees of 4 are worth 4e-6 USD
+	addTx(2)
+	addTx(2)
+	addTx(1)
+	fees = k.GetBatchFeeByTokenType(ctx, *myTokenContractAddr, 2)
+	require.Equal(t, uint64(2), fees.TxCount)
+	require.Equal(t, uint64(420000), k.EstimateBatchRelayGas(ctx, fees.TxCount))
+	_, err = k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 2)
+	require.True(t, types.ErrBatchNotProfitable.Is(err))
+
+	addTx(10)
+	batch, err := k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 2)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 2)
+
+	// a custom engine decides instead
+	k.BatchProfitability = alwaysProfitable{}
+	_, err = k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 2)
+	require.NoError(t, err)
+}
+
+func TestSendToEthFeeSuggestion(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	var (
+		mySender, _            = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+	)
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+	addTx := func(fee int64) {
+		_, err := k.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewInt64Coin(token.GravityCoin().Denom, 100),
+			sdk.NewInt64Coin(token.GravityCoin().Denom, fee))
+		require.NoError(t, err)
+	}
+
+	params := k.GetParams(ctx)
+	params.MaxBatchSize = 2
+	params.BatchGasOverhead = 300000
+	params.BatchGasPerTx = 60000
+	k.SetParams(ctx, params)
+	for i := range ValAddrs[:3] {
+		k.SetOrchestratorHeartbeat(ctx, ValAddrs[i], types.OrchestratorHeartbeat{
+			Validator:         ValAddrs[i].String(),
+			EthGasPrice:       10,
+			CosmosBlockHeight: uint64(ctx.BlockHeight()),
+		})
+	}
+	k.PriceFeed = fixedPriceFeed{
+		types.WeiPriceDenom:       sdk.NewDecWithPrec(1, 12),
+		token.GravityCoin().Denom: sdk.NewDecWithPrec(1, 6),
+	}
+
+	// a lone transfer is estimated at 360000 gas, 3.6e-6 USD at 10 wei, so it pays 4 to be worth relaying
+	suggestion := k.SuggestSendToEthFee(ctx, *myTokenContractAddr)
+	require.Equal(t, sdk.NewInt(4), suggestion.SuggestedFee)
+	require.Equal(t, uint64(0), suggestion.PoolDepth)
+	require.Equal(t, uint64(2), suggestion.MaxBatchSize)
+
+	// in a full batch it replaces the fee of 1, the batch is worth relaying at 5
+	addTx(2)
+	addTx(1)
+	suggestion = k.SuggestSendToEthFee(ctx, *myTokenContractAddr)
+	require.Equal(t, sdk.NewInt(3), suggestion.SuggestedFee)
+	require.Equal(t, uint64(2), suggestion.NextBatch.TxCount)
+	require.Equal(t, sdk.NewInt(3), suggestion.NextBatch.TotalFees)
+
+	// once the batch pays for itself outbidding its lowest fee is enough
+	addTx(10)
+	suggestion = k.SuggestSendToEthFee(ctx, *myTokenContractAddr)
+	require.Equal(t, sdk.NewInt(3), suggestion.SuggestedFee)
+	require.Equal(t, uint64(3), suggestion.PoolDepth)
+
+	// the suggestion never goes below the minimum fee of the token
+	params.MinSendToEthFees = []types.ERC20Token{{Contract: myTokenContractAddr.GetAddress(), Amount: sdk.NewInt(20)}}
+	k.SetParams(ctx, params)
+	suggestion = k.SuggestSendToEthFee(ctx, *myTokenContractAddr)
+	require.Equal(t, sdk.NewInt(20), suggestion.SuggestedFee)
+}
+
+type alwaysProfitable struct{}
+
+func (alwaysProfitable) CheckBatchProfitable(_ sdk.Context, _ types.EthAddress, _ types.BatchFees) error {
+	return nil
+}
+
+func TestMaxBatchSize(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _     = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver, _   = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		smallToken, _   = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		defaultToken, _ = types.NewEthAddress("0x7580bfe88dd3d07947908fae12d95872a260f2d8")
+	)
+	params := k.GetParams(ctx)
+	params.MaxBatchSize = 3
+	// the override is matched regardless of the casing of the contract
+	params.TokenMaxBatchSizes = []types.TokenBatchSize{
+		{TokenContract: strings.ToLower(smallToken.GetAddress()), MaxBatchSize: 2},
+	}
+	k.SetParams(ctx, params)
+	require.Equal(t, uint(2), k.GetMaxBatchSize(ctx, *smallToken))
+	require.Equal(t, uint(3), k.GetMaxBatchSize(ctx, *defaultToken))
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	for _, contract := range []*types.EthAddress{smallToken, defaultToken} {
+		token, err := types.NewInternalERC20Token(sdk.NewInt(99999), contract.GetAddress())
+		require.NoError(t, err)
+		vouchers := sdk.NewCoins(token.GravityCoin())
+		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, vouchers))
+		for i := int64(1); i <= 5; i++ {
+			_, err := k.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewInt64Coin(token.GravityCoin().Denom, 100),
+				sdk.NewInt64Coin(token.GravityCoin().Denom, i))
+			require.NoError(t, err)
+		}
+	}
+
+	// the batch fees only count what a batch of each token may carry
+	for _, fees := range k.GetAllBatchFees(ctx, OutgoingTxBatchSize) {
+		if fees.Token == smallToken.GetAddress() {
+			require.Equal(t, uint64(2), fees.TxCount)
+		} else {
+			require.Equal(t, uint64(3), fees.TxCount)
+		}
+	}
+
+	batch, err := k.BuildOutgoingTXBatch(ctx, *smallToken, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 2)
+	batch, err = k.BuildOutgoingTXBatch(ctx, *defaultToken, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 3)
+}
+
+// Tests that the batch preview shows the batch a request would build without building it
+func TestPendingBatchPreview(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _            = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+		denom                  = token.GravityCoin().Denom
+	)
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	// an empty pool has nothing to preview
+	req := &types.QueryPendingBatchPreviewRequest{Denom: denom}
+	res, err := k.PendingBatchPreview(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	assert.Nil(t, res.Batch)
+	assert.Equal(t, sdk.ZeroInt(), res.TotalFees)
+
+	for _, fee := range []int64{1, 2, 3} {
+		_, err := k.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, fee))
+		require.NoError(t, err)
+	}
+	res, err = k.PendingBatchPreview(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.NotNil(t, res.Batch)
+	assert.Len(t, res.Batch.Transactions, 3)
+	assert.Equal(t, sdk.NewInt(6), res.TotalFees)
+	assert.NotEmpty(t, res.Checkpoint)
+
+	// nothing was built
+	assert.Empty(t, k.GetOutgoingTxBatches(ctx))
+	assert.Len(t, k.GetUnbatchedTransactions(ctx), 3)
+
+	// requesting the batch builds exactly the preview
+	batch, err := k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, k.GetMaxBatchSize(ctx, *myTokenContractAddr))
+	require.NoError(t, err)
+	assert.Equal(t, res.Batch.BatchNonce, batch.BatchNonce)
+	assert.Equal(t, res.Checkpoint, batch.GetCheckpoint(k.GetGravityID(ctx)))
+	for i, tx := range batch.Transactions {
+		assert.Equal(t, res.Batch.Transactions[i].Id, tx.Id)
+	}
+
+	// an unknown denom is rejected
+	_, err = k.PendingBatchPreview(sdk.WrapSDKContext(ctx), &types.QueryPendingBatchPreviewRequest{Denom: "unknown"})
+	require.Error(t, err)
+}
+
+// Tests that the pool and batch actions emit typed events carrying the full transfers
+func TestBatchTypedEvents(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _            = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+		denom                  = token.GravityCoin().Denom
+	)
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	// typedEvents returns the typed events emitted since the last call
+	typedEvents := func() (out []proto.Message) {
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			if msg, err := sdk.ParseTypedEvent(event); err == nil {
+				out = append(out, msg)
+			}
+		}
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		return out
+	}
+
+	id, err := k.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 2))
+	require.NoError(t, err)
+	events := typedEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, &types.EventSendToEthAdded{
+		TxId:          id,
+		Sender:        mySender.String(),
+		Receiver:      myReceiver.GetAddress(),
+		TokenContract: myTokenContractAddr.GetAddress(),
+		Amount:        sdk.NewInt(100),
+		Fee:           sdk.NewInt(2),
+	}, events[0])
+
+	batch, err := k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	events = typedEvents()
+	require.Len(t, events, 1)
+	created, ok := events[0].(*types.EventOutgoingBatchCreated)
+	require.True(t, ok)
+	assert.Equal(t, batch.BatchNonce, created.BatchNonce)
+	require.Len(t, created.Transactions, 1)
+	assert.Equal(t, id, created.Transactions[0].Id)
+	assert.Equal(t, mySender.String(), created.Transactions[0].Sender)
+	assert.Equal(t, sdk.NewInt(100), created.Transactions[0].Erc20Token.Amount)
+
+	// a timed out batch lists the transfers it returns to the pool
+	require.NoError(t, k.TimeoutOutgoingTXBatch(ctx, *myTokenContractAddr, batch.BatchNonce))
+	events = typedEvents()
+	require.Len(t, events, 1)
+	timedOut, ok := events[0].(*types.EventOutgoingBatchTimedOut)
+	require.True(t, ok)
+	assert.Equal(t, batch.BatchNonce, timedOut.BatchNonce)
+	require.Len(t, timedOut.Transactions, 1)
+	assert.Equal(t, id, timedOut.Transactions[0].Id)
+
+	batch, err = k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	typedEvents()
+	k.OutgoingTxBatchExecuted(ctx, *myTokenContractAddr, batch.BatchNonce)
+	events = typedEvents()
+	require.Len(t, events, 1)
+	executed, ok := events[0].(*types.EventOutgoingBatchExecuted)
+	require.True(t, ok)
+	assert.Equal(t, batch.BatchNonce, executed.BatchNonce)
+	assert.Equal(t, myTokenContractAddr.GetAddress(), executed.TokenContract)
+	require.Len(t, executed.Transactions, 1)
+	assert.Equal(t, myReceiver.GetAddress(), executed.Transactions[0].DestAddress)
+
+	// a canceled batch lists the transfers it returns to the pool
+	id, err = k.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 50), sdk.NewInt64Coin(denom, 1))
+	require.NoError(t, err)
+	batch, err = k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	typedEvents()
+	require.NoError(t, k.CancelOutgoingTXBatch(ctx, *myTokenContractAddr, batch.BatchNonce))
+	events = typedEvents()
+	require.Len(t, events, 1)
+	canceled, ok := events[0].(*types.EventOutgoingBatchCanceled)
+	require.True(t, ok)
+	assert.Equal(t, batch.BatchNonce, canceled.BatchNonce)
+	require.Len(t, canceled.Transactions, 1)
+	assert.Equal(t, id, canceled.Transactions[0].Id)
+
+	// a transfer taken out of the pool is refunded with its reason
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, id, mySender))
+	events = typedEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, &types.EventSendToEthRefunded{
+		TxId:          id,
+		Sender:        mySender.String(),
+		Recipient:     mySender.String(),
+		TokenContract: myTokenContractAddr.GetAddress(),
+		Amount:        sdk.NewInt(50),
+		Fee:           sdk.NewInt(1),
+		Reason:        types.REFUND_REASON_CANCELED,
+	}, events[0])
+}
+
+// Tests that the MinimalEvents param leaves out the untyped events duplicating the typed ones
+func TestMinimalEvents(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _            = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+		denom                  = token.GravityCoin().Denom
+	)
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	// gravityEvents returns the types of the typed and untyped events of the module emitted since the last call
+	gravityEvents := func() (out []string) {
+		for _, event := range ctx.EventManager().Events() {
+			if strings.HasPrefix(event.Type, "gravity.v1.") {
+				out = append(out, event.Type)
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == sdk.AttributeKeyModule && string(attr.Value) == types.ModuleName {
+					out = append(out, event.Type)
+				}
+			}
+		}
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		return out
+	}
+
+	_, err = k.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 2))
+	require.NoError(t, err)
+	assert.Equal(t, []string{types.EventTypeBridgeWithdrawalReceived, "gravity.v1.EventSendToEthAdded"}, gravityEvents())
+
+	params := k.GetParams(ctx)
+	params.MinimalEvents = true
+	k.SetParams(ctx, params)
+	_, err = k.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 2))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"gravity.v1.EventSendToEthAdded"}, gravityEvents())
+	_, err = k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"gravity.v1.EventOutgoingBatchCreated"}, gravityEvents())
+}
+
+// Tests that transactions to the same destination are merged into one transfer and come back into the pool one
+// by one when the batch is canceled
+func TestBatchMergeTransfers(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	var (
+		mySender, _            = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		otherReceiver, _       = types.NewEthAddress("0x2d9480eBA3A001033a0B8c3Df26039FD3433D55d")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+	)
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+	addTx := func(receiver *types.EthAddress, amount int64, fee int64) uint64 {
+		id, err := k.AddToOutgoingPool(ctx, mySender, *receiver, sdk.NewInt64Coin(token.GravityCoin().Denom, amount),
+			sdk.NewInt64Coin(token.GravityCoin().Denom, fee))
+		require.NoError(t, err)
+		return id
+	}
+
+	params := k.GetParams(ctx)
+	params.MergeBatchTransfers = true
+	k.SetParams(ctx, params)
+	first := addTx(myReceiver, 100, 5)
+	addTx(otherReceiver, 200, 4)
+	addTx(myReceiver, 300, 3)
+	addTx(myReceiver, 400, 2)
+
+	batch, err := k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 10)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 2)
+	merged := batch.Transactions[0]
+	assert.Equal(t, first, merged.Id)
+	assert.Equal(t, myReceiver.GetAddress(), merged.DestAddress.GetAddress())
+	assert.Equal(t, sdk.NewInt(800), merged.Erc20Token.Amount)
+	assert.Equal(t, sdk.NewInt(10), merged.Erc20Fee.Amount)
+	assert.Equal(t, sdk.NewInt(200), batch.Transactions[1].Erc20Token.Amount)
+	assert.Len(t, k.GetBatchTransfers(ctx, *batch), 4)
+	records := k.GetAllMergedTransfers(ctx)
+	require.Len(t, records, 1)
+	assert.Len(t, records[0].Children, 3)
+
+	// the stored batch carries the merged transfer, its children go back into the pool on their own
+	stored := k.GetOutgoingTXBatch(ctx, *myTokenContractAddr, batch.BatchNonce)
+	require.NotNil(t, stored)
+	assert.Equal(t, sdk.NewInt(800), stored.Transactions[0].Erc20Token.Amount)
+	require.NoError(t, k.CancelOutgoingTXBatch(ctx, *myTokenContractAddr, batch.BatchNonce))
+	pool := k.GetUnbatchedTransactionsByContract(ctx, *myTokenContractAddr)
+	require.Len(t, pool, 4)
+	assert.Equal(t, sdk.NewInt(100), pool[0].Erc20Token.Amount)
+	assert.Empty(t, k.GetAllMergedTransfers(ctx))
+
+	// without the param the transactions are batched as they are
+	params.MergeBatchTransfers = false
+	k.SetParams(ctx, params)
+	batch, err = k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 10)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 4)
+	assert.Empty(t, k.GetAllMergedTransfers(ctx))
+}
+
+func TestOutgoingTxBatchesPaged(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	pickle, err := types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, err)
+	other, err := types.NewEthAddress("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8")
+	require.NoError(t, err)
+	for nonce, contract := range map[uint64]*types.EthAddress{1: pickle, 2: pickle, 3: pickle, 4: other} {
+		k.StoreBatchUnsafe(ctx, &types.InternalOutgoingTxBatch{BatchNonce: nonce, BatchTimeout: 100, TokenContract: *contract, Block: nonce})
+	}
+	nonces := func(batches []*types.InternalOutgoingTxBatch) (out []uint64) {
+		for _, batch := range batches {
+			out = append(out, batch.BatchNonce)
+		}
+		return
+	}
+
+	// batches come grouped by token contract in nonce order
+	batches, pageRes, err := k.GetOutgoingTxBatchesPaged(ctx, nil, nil, &query.PageRequest{Limit: 2, CountTotal: true})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2}, nonces(batches))
+	assert.Equal(t, uint64(4), pageRes.Total)
+	batches, pageRes, err = k.GetOutgoingTxBatchesPaged(ctx, nil, nil, &query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3, 4}, nonces(batches))
+	assert.Empty(t, pageRes.NextKey)
+
+	batches, _, err = k.GetOutgoingTxBatchesPaged(ctx, other, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{4}, nonces(batches))
+
+	// a batch the orchestrator confirmed does not need its signature any more
+	k.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+		Nonce:         2,
+		TokenContract: pickle.GetAddress(),
+		EthSigner:     EthAddrs[0].String(),
+		Orchestrator:  AccAddrs[0].String(),
+		Signature:     "signature",
+	})
+	batches, pageRes, err = k.GetOutgoingTxBatchesPaged(ctx, nil, AccAddrs[0], &query.PageRequest{Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 3}, nonces(batches))
+	batches, _, err = k.GetOutgoingTxBatchesPaged(ctx, nil, AccAddrs[0], &query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{4}, nonces(batches))
+	batches, _, err = k.GetOutgoingTxBatchesPaged(ctx, pickle, AccAddrs[1], nil)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2, 3}, nonces(batches))
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
@@ -117,3 +118,32 @@ func (k Keeper) IterateERC20ToDenom(ctx sdk.Context, cb func([]byte, *types.ERC2
 		}
 	}
 }
+
+// GetCosmosOriginatedTokens returns a page of the Cosmos originated denoms with a deployed ERC20 in denom order,
+// along with the symbol and decimals of their ERC20 taken from the denom metadata. The symbol is left empty and the
+// decimals zero when the denom has no metadata
+func (k Keeper) GetCosmosOriginatedTokens(ctx sdk.Context, pageReq *query.PageRequest) ([]types.CosmosOriginatedToken, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomToERC20Key)
+	var tokens []types.CosmosOriginatedToken
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, value []byte) error {
+		token := types.CosmosOriginatedToken{Denom: string(key), Erc20: string(value)}
+		metadata := k.bankKeeper.GetDenomMetaData(ctx, token.Denom)
+		if metadata.Base != "" {
+			// the ERC20 was deployed with the display unit as symbol and its exponent as decimals,
+			// see handleERC20Deployed
+			token.Symbol = metadata.Display
+			for _, denomUnit := range metadata.DenomUnits {
+				if denomUnit.Denom == metadata.Display {
+					token.Decimals = denomUnit.Exponent
+					break
+				}
+			}
+		}
+		tokens = append(tokens, token)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return tokens, pageRes, nil
+}
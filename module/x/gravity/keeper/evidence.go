@@ -4,13 +4,21 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"strings"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
+// CheckBadSignatureEvidence slashes the bonded validator whose Ethereum key signed the checkpoint of the valset, batch
+// or logic call in the subject of msg, if the chain never produced that checkpoint. A validator is slashed at most
+// once per checkpoint
 func (k Keeper) CheckBadSignatureEvidence(
 	ctx sdk.Context,
 	msg *types.MsgSubmitBadSignatureEvidence) error {
@@ -46,13 +54,8 @@ func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types
 		return sdkerrors.Wrap(types.ErrInvalid, "Checkpoint exists, cannot slash")
 	}
 
-	// Decode Eth signature to bytes
-
-	// strip 0x prefix if needed
-	if signature[:2] == "0x" {
-		signature = signature[2:]
-	}
-	sigBytes, err := hex.DecodeString(signature)
+	// Decode Eth signature to bytes, stripping the 0x prefix if needed
+	sigBytes, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature decoding %s", signature))
 	}
@@ -68,6 +71,13 @@ func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types
 	if !found {
 		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Did not find validator for eth address %s from signature %s with checkpoint %s and GravityID %s", ethAddress, signature, hex.EncodeToString(checkpoint), gravityID))
 	}
+	if !val.IsBonded() {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("validator %s is not bonded", val.GetOperator()))
+	}
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.GetBadSignatureSlashedKey(val.GetOperator(), checkpoint)) {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("validator %s was already slashed for checkpoint %s", val.GetOperator(), hex.EncodeToString(checkpoint)))
+	}
 
 	// Slash the offending validator
 	cons, err := val.GetConsAddr()
@@ -80,10 +90,146 @@ func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types
 	if !val.IsJailed() {
 		k.StakingKeeper.Jail(ctx, cons)
 	}
+	store.Set(types.GetBadSignatureSlashedKey(val.GetOperator(), checkpoint), []byte{0x1})
 
 	return nil
 }
 
+// CheckConflictingClaimEvidence slashes the validator whose orchestrator signed a transaction holding a claim that
+// conflicts with the claim the orchestrator submitted for the same event nonce. The transaction must carry a
+// SIGN_MODE_DIRECT signature of the orchestrator for this chain. The submitted claim is found through the votes of
+// the attestations at that event nonce, so evidence is only accepted until they are pruned. A validator is slashed
+// at most once per event nonce
+func (k Keeper) CheckConflictingClaimEvidence(ctx sdk.Context, msg *types.MsgSubmitConflictingClaimEvidence) error {
+	var raw txtypes.TxRaw
+	if err := k.cdc.UnmarshalBinaryBare(msg.Tx, &raw); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("tx encoding %s", err))
+	}
+	var body txtypes.TxBody
+	if err := k.cdc.UnmarshalBinaryBare(raw.BodyBytes, &body); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("tx body encoding %s", err))
+	}
+	var authInfo txtypes.AuthInfo
+	if err := k.cdc.UnmarshalBinaryBare(raw.AuthInfoBytes, &authInfo); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("tx auth info encoding %s", err))
+	}
+	if len(raw.Signatures) != len(authInfo.SignerInfos) {
+		return sdkerrors.Wrap(types.ErrInvalid, "tx signature count does not match its signers")
+	}
+	signBytes, err := authtx.DirectSignBytes(raw.BodyBytes, raw.AuthInfoBytes, ctx.ChainID(), msg.AccountNumber)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("tx sign bytes %s", err))
+	}
+
+	// orchestrators bundle several claims in a transaction or a MsgSubmitEthereumEvents, the first one that conflicts
+	// is enough
+	err = sdkerrors.Wrap(types.ErrInvalid, "tx holds no claim")
+	var claims []types.EthereumClaim
+	for _, anyMsg := range body.Messages {
+		switch msg := anyMsg.GetCachedValue().(type) {
+		case types.EthereumClaim:
+			claims = append(claims, msg)
+		case *types.MsgSubmitEthereumEvents:
+			claims = append(claims, msg.GetClaims()...)
+		}
+	}
+	for _, claim := range claims {
+		if claim.ValidateBasic() != nil {
+			continue
+		}
+		if !isDirectlySignedBy(authInfo, raw.Signatures, signBytes, claim.GetClaimer()) {
+			err = sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("tx not signed by orchestrator %s", claim.GetClaimer()))
+			continue
+		}
+		if err = k.slashConflictingClaim(ctx, claim); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// isDirectlySignedBy returns true if signer is one of the signers of a transaction and its signature over the
+// SIGN_MODE_DIRECT signBytes is valid
+func isDirectlySignedBy(authInfo txtypes.AuthInfo, signatures [][]byte, signBytes []byte, signer sdk.AccAddress) bool {
+	for i, info := range authInfo.SignerInfos {
+		if info.PublicKey == nil {
+			continue
+		}
+		pubKey, ok := info.PublicKey.GetCachedValue().(cryptotypes.PubKey)
+		if !ok || !signer.Equals(sdk.AccAddress(pubKey.Address())) {
+			continue
+		}
+		single, ok := info.ModeInfo.GetSum().(*txtypes.ModeInfo_Single_)
+		if !ok || single.Single.Mode != signingtypes.SignMode_SIGN_MODE_DIRECT {
+			return false
+		}
+		return pubKey.VerifySignature(signBytes, signatures[i])
+	}
+	return false
+}
+
+// slashConflictingClaim slashes the validator of the orchestrator of claim if the orchestrator submitted a
+// different claim for the same event nonce
+func (k Keeper) slashConflictingClaim(ctx sdk.Context, claim types.EthereumClaim) error {
+	val, found := k.GetOrchestratorValidator(ctx, claim.GetClaimer())
+	if !found {
+		return sdkerrors.Wrap(types.ErrUnknown, fmt.Sprintf("no validator for orchestrator %s", claim.GetClaimer()))
+	}
+	valAddr := val.GetOperator()
+	nonce := claim.GetEventNonce()
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.GetConflictingClaimSlashedKey(valAddr, nonce)) {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("validator %s was already slashed for event nonce %d", valAddr, nonce))
+	}
+
+	evidenceHash, err := claim.ClaimHash()
+	if err != nil {
+		return sdkerrors.Wrap(err, "unable to compute claim hash")
+	}
+	submitted, err := k.getSubmittedClaimHash(ctx, valAddr, nonce)
+	if err != nil {
+		return err
+	}
+	if submitted == nil {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("no claim of validator %s at event nonce %d", valAddr, nonce))
+	}
+	if bytes.Equal(submitted, evidenceHash) {
+		return sdkerrors.Wrap(types.ErrInvalid, "claim does not conflict with the submitted claim, cannot slash")
+	}
+
+	cons, err := val.GetConsAddr()
+	if err != nil {
+		return sdkerrors.Wrap(err, "Could not get consensus key address for validator")
+	}
+	params := k.GetParams(ctx)
+	k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionConflictingClaim)
+	if !val.IsJailed() {
+		k.StakingKeeper.Jail(ctx, cons)
+	}
+	store.Set(types.GetConflictingClaimSlashedKey(valAddr, nonce), []byte{0x1})
+	return nil
+}
+
+// getSubmittedClaimHash returns the current claim hash of the claim validator voted for at eventNonce, nil if it
+// has no vote there. Every attestation records the validators that submitted its claim
+func (k Keeper) getSubmittedClaimHash(ctx sdk.Context, validator sdk.ValAddress, eventNonce uint64) (hash []byte, err error) {
+	k.IterateAttestationsByNonce(ctx, eventNonce, func(_ []byte, att types.Attestation) bool {
+		for _, vote := range att.Votes {
+			if vote != validator.String() {
+				continue
+			}
+			var claim types.EthereumClaim
+			if claim, err = k.UnpackAttestationClaim(&att); err == nil {
+				// attestations may be stored under an older claim hash version
+				hash, err = claim.ClaimHash()
+			}
+			return true
+		}
+		return false
+	})
+	return hash, err
+}
+
 // SetPastEthSignatureCheckpoint puts the checkpoint of a valset, batch, or logic call into a set
 // in order to prove later that it existed at one point.
 func (k Keeper) SetPastEthSignatureCheckpoint(ctx sdk.Context, checkpoint []byte) {
@@ -8,6 +8,9 @@ import (
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 )
@@ -145,4 +148,90 @@ func TestSubmitBadSignatureEvidenceSlash(t *testing.T) {
 
 	val := input.StakingKeeper.Validator(ctx, ValAddrs[0])
 	require.True(t, val.IsJailed())
+
+	// the same evidence can not slash the validator twice
+	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "already slashed")
+}
+
+// Tests that a validator is slashed once for a signed claim that conflicts with the claim its orchestrator submitted
+func TestSubmitConflictingClaimEvidence(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	ctx = ctx.WithChainID("gravity-test")
+	k.SetOrchestratorValidator(ctx, ValAddrs[0], AccAddrs[0])
+	const accountNumber = 7
+
+	claim := func(amount int64) *types.MsgSendToCosmosClaim {
+		return &types.MsgSendToCosmosClaim{
+			EventNonce:     1,
+			BlockHeight:    1,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[1].String(),
+			Orchestrator:   AccAddrs[0].String(),
+		}
+	}
+	submitted := claim(100)
+	any, err := codectypes.NewAnyWithValue(submitted)
+	require.NoError(t, err)
+	hash, err := submitted.ClaimHash()
+	require.NoError(t, err)
+	k.SetAttestation(ctx, 1, hash, &types.Attestation{
+		Votes:            []string{ValAddrs[0].String()},
+		Height:           uint64(ctx.BlockHeight()),
+		Claim:            any,
+		ClaimHashVersion: types.ClaimHashVersion,
+	})
+
+	// signedTx returns the raw encoding of a transaction holding msgs signed by the orchestrator for chainID
+	signedTx := func(chainID string, msgs ...sdk.Msg) []byte {
+		anys := make([]*codectypes.Any, len(msgs))
+		for i, msg := range msgs {
+			anys[i], err = codectypes.NewAnyWithValue(msg)
+			require.NoError(t, err)
+		}
+		bodyBytes, err := (&txtypes.TxBody{Messages: anys}).Marshal()
+		require.NoError(t, err)
+		pubKey, err := codectypes.NewAnyWithValue(AccPubKeys[0])
+		require.NoError(t, err)
+		authInfoBytes, err := (&txtypes.AuthInfo{
+			SignerInfos: []*txtypes.SignerInfo{{
+				PublicKey: pubKey,
+				ModeInfo: &txtypes.ModeInfo{Sum: &txtypes.ModeInfo_Single_{
+					Single: &txtypes.ModeInfo_Single{Mode: signingtypes.SignMode_SIGN_MODE_DIRECT},
+				}},
+			}},
+			Fee: &txtypes.Fee{},
+		}).Marshal()
+		require.NoError(t, err)
+		signBytes, err := authtx.DirectSignBytes(bodyBytes, authInfoBytes, chainID, accountNumber)
+		require.NoError(t, err)
+		sig, err := AccPrivKeys[0].Sign(signBytes)
+		require.NoError(t, err)
+		raw, err := (&txtypes.TxRaw{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes, Signatures: [][]byte{sig}}).Marshal()
+		require.NoError(t, err)
+		return raw
+	}
+	msgServer := NewMsgServerImpl(k)
+	submit := func(tx []byte) error {
+		msg := types.NewMsgSubmitConflictingClaimEvidence(AccAddrs[1], tx, accountNumber)
+		require.NoError(t, msg.ValidateBasic())
+		_, err := msgServer.SubmitConflictingClaimEvidence(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	// the submitted claim is no evidence, neither is a claim signed for another chain
+	require.Error(t, submit(signedTx(ctx.ChainID(), submitted)))
+	require.Error(t, submit(signedTx("other-chain", claim(200))))
+	require.False(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+
+	// the conflicting claim may be bundled with others
+	require.NoError(t, submit(signedTx(ctx.ChainID(), submitted, claim(200))))
+	require.True(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+
+	// a validator is slashed once per event nonce
+	require.Error(t, submit(signedTx(ctx.ChainID(), claim(300))))
 }
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// RunWithGasBudget runs a piece of EndBlocker work against a cached context whose gas meter is limited
+// to gasBudget, zero leaving it unmetered. Gas is the deterministic stand in for time here. A step does
+// its writes in units run with RunBudgetedUnit, each of which moves the cursor the step resumes from, so
+// when the step runs out of gas the units it completed are kept and only the unit in progress is discarded,
+// the step picks up from there in the next block. The gas used and the wall clock time taken by each step
+// are reported through telemetry. Returns false if the step ran out of gas
+func (k Keeper) RunWithGasBudget(ctx sdk.Context, step string, gasBudget uint64, fn func(ctx sdk.Context)) (completed bool) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), "end_blocker", step)
+
+	gasMeter := sdk.NewInfiniteGasMeter()
+	if gasBudget != 0 {
+		gasMeter = sdk.NewGasMeter(gasBudget)
+	}
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter).WithEventManager(sdk.NewEventManager())
+
+	defer func() {
+		telemetry.ModuleSetGauge(types.ModuleName, float32(gasMeter.GasConsumedToLimit()), "end_blocker", step, "gas_used")
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			telemetry.IncrCounter(1, types.ModuleName, "end_blocker", step, "out_of_gas")
+			k.logger(ctx).Error("end blocker step exceeded its gas budget, resuming next block",
+				"step", step,
+				"budget", gasBudget,
+			)
+			completed = false
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}()
+
+	fn(cacheCtx)
+	return true
+}
+
+// RunBudgetedUnit runs one unit of a step run with RunWithGasBudget against a cached context, its writes
+// and events are only passed on to the step once it completes. A unit that runs out of gas leaves nothing
+// behind, so it must update the cursor its step resumes from together with the work it did
+func (k Keeper) RunBudgetedUnit(ctx sdk.Context, fn func(ctx sdk.Context)) {
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	fn(cacheCtx)
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
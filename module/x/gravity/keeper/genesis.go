@@ -33,6 +33,16 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.StoreBatchUnsafe(ctx, intBatch)
 	}
 
+	// reset the records of the transactions merged into the transfers of those batches
+	for _, merged := range data.MergedTransfers {
+		k.setMergedTransfer(ctx, merged)
+	}
+
+	// reset the deposits held for quarantined senders, their coins are exported with the module account
+	for _, deposit := range data.QuarantinedDeposits {
+		k.setQuarantinedDeposit(ctx, deposit)
+	}
+
 	// reset batch confirmations in state
 	for _, conf := range data.BatchConfirms {
 		conf := conf
@@ -50,17 +60,66 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.SetLogicCallConfirm(ctx, &conf)
 	}
 
+	// reset the transfers with a callback delivered by those logic calls in state
+	for _, tx := range data.CallbackTransfers {
+		if _, err := tx.ToInternal(); err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid callback transfer in genesis: %v", tx))
+		}
+		k.setCallbackTransfer(ctx, types.GetCallbackInvalidationID(tx.Id), *tx)
+	}
+
 	// reset pool transactions in state
 	for _, tx := range data.UnbatchedTransfers {
 		intTx, err := tx.ToInternal()
 		if err != nil {
 			panic(sdkerrors.Wrapf(err, "invalid unbatched tx: %v", tx))
 		}
+		// a chain relaunched from an export starts at a lower height, the pool timeout starts over
+		if intTx.CreatedHeight > uint64(ctx.BlockHeight()) {
+			intTx.CreatedHeight = uint64(ctx.BlockHeight())
+		}
 		if err := k.addUnbatchedTX(ctx, intTx); err != nil {
 			panic(err)
 		}
 	}
 
+	// reset module send grants in state
+	for _, grant := range data.ModuleSendGrants {
+		k.SetModuleSendGrant(ctx, grant)
+	}
+
+	// reset eth destination address books in state
+	for _, entry := range data.EthDestinationLabels {
+		owner, err := sdk.AccAddressFromBech32(entry.Owner)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid eth destination label owner: %v", entry))
+		}
+		ethAddr, err := types.NewEthAddress(entry.EthAddress)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid eth destination label address: %v", entry))
+		}
+		k.SetEthDestinationLabel(ctx, owner, entry.Label, *ethAddr)
+	}
+
+	// reset first send delays and the destinations known to them in state
+	for _, entry := range data.FirstSendDelays {
+		account, err := sdk.AccAddressFromBech32(entry.Account)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid first send delay account: %v", entry))
+		}
+		k.SetFirstSendDelay(ctx, account, entry.DelayBlocks)
+		for _, dest := range entry.KnownEthDestinations {
+			ethAddr, err := types.NewEthAddress(dest)
+			if err != nil {
+				panic(sdkerrors.Wrapf(err, "invalid known eth destination: %v", entry))
+			}
+			k.recordEthDestination(ctx, account, *ethAddr)
+		}
+	}
+
+	// reset the audit log in state
+	k.importAuditLog(ctx, data.AuditLog)
+
 	// reset attestations in state
 	for _, att := range data.Attestations {
 		att := att
@@ -70,13 +129,21 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		}
 
 		// TODO: block height?
-		hash, err := claim.ClaimHash()
+		hash, err := attestationClaimHash(&att, claim)
 		if err != nil {
 			panic(fmt.Errorf("error when computing ClaimHash for %v", hash))
 		}
 		k.SetAttestation(ctx, claim.GetEventNonce(), hash, &att)
+		if deposit, ok := claim.(*types.MsgSendToCosmosClaim); ok {
+			k.setDepositEthTxHash(ctx, deposit)
+		}
+		// observed attestations that were still waiting to be applied at export go back in the queue
+		if att.PendingExecution {
+			k.enqueueAttestationExecution(ctx, claim.GetEventNonce(), hash)
+		}
 	}
 	k.setLastObservedEventNonce(ctx, data.LastObservedNonce)
+	k.startBridgeInstance(ctx, data.BridgeInstance, data.LastObservedNonce)
 
 	// reset attestation state of specific validators
 	// this must be done after the above to be correct
@@ -140,16 +207,18 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	}
 
 	// now that we have the denom-erc20 mapping we need to validate
-	// that the valset reward is possible and cosmos originated remove
-	// this if you want a non-cosmos originated reward
-	valsetReward := k.GetParams(ctx).ValsetReward
-	if valsetReward.IsValid() && !valsetReward.IsZero() {
-		_, exists := k.GetCosmosOriginatedERC20(ctx, valsetReward.Denom)
-		if !exists {
-			panic("Invalid Cosmos originated denom for valset reward")
-		}
+	// that the valset reward is possible
+	if err := k.ValidateValsetReward(ctx, k.GetParams(ctx).ValsetReward); err != nil {
+		panic(err)
+	}
+	if err := k.ValidateOutflowLimit(k.GetParams(ctx).OutflowUsdLimit); err != nil {
+		panic(err)
 	}
 
+	// reset the valset reward reserve, its vouchers are exported with the module account
+	for _, reserve := range data.ValsetRewardReserve {
+		k.setValsetRewardReserve(ctx, reserve.Denom, reserve.Amount)
+	}
 }
 
 // ExportGenesis exports all the state needed to restart the chain
@@ -169,6 +238,15 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		lastobserved       = k.GetLastObservedEventNonce(ctx)
 		erc20ToDenoms      = []*types.ERC20ToDenom{}
 		unbatchedTransfers = k.GetUnbatchedTransactions(ctx)
+		moduleSendGrants   = k.GetModuleSendGrants(ctx)
+		bridgeInstance     = k.GetBridgeInstance(ctx)
+		ethDestLabels      = k.GetAllEthDestinationLabels(ctx)
+		firstSendDelays    = k.GetAllFirstSendDelays(ctx)
+		auditLog           = k.GetAllAuditLogEntries(ctx)
+		callbackTransfers  = k.GetAllCallbackTransfers(ctx)
+		mergedTransfers    = k.GetAllMergedTransfers(ctx)
+		quarantined        = k.GetQuarantinedDeposits(ctx)
+		rewardReserve      = k.GetAllValsetRewardReserves(ctx)
 	)
 
 	// export valset confirmations from state
@@ -193,8 +271,12 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 			k.GetLogicConfirmByInvalidationIDAndNonce(ctx, call.InvalidationId, call.InvalidationNonce)...)
 	}
 
-	// export attestations from state
-	for _, atts := range attmap {
+	// export attestations from state, leaving out the ones already due to be pruned
+	cutoff, earliestToKeep, prune := k.AttestationPruneCutoff(ctx, p)
+	for nonce, atts := range attmap {
+		if prune && nonce < cutoff && allAttestationsPrunable(atts, earliestToKeep) {
+			continue
+		}
 		// TODO: set height = 0?
 		attestations = append(attestations, atts...)
 	}
@@ -211,17 +293,26 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	}
 
 	return types.GenesisState{
-		Params:             &p,
-		LastObservedNonce:  lastobserved,
-		Valsets:            valsets,
-		ValsetConfirms:     vsconfs,
-		Batches:            extBatches,
-		BatchConfirms:      batchconfs,
-		LogicCalls:         calls,
-		LogicCallConfirms:  callconfs,
-		Attestations:       attestations,
-		DelegateKeys:       delegates,
-		Erc20ToDenoms:      erc20ToDenoms,
-		UnbatchedTransfers: unbatchedTxs,
+		Params:               &p,
+		LastObservedNonce:    lastobserved,
+		Valsets:              valsets,
+		ValsetConfirms:       vsconfs,
+		Batches:              extBatches,
+		BatchConfirms:        batchconfs,
+		LogicCalls:           calls,
+		LogicCallConfirms:    callconfs,
+		Attestations:         attestations,
+		DelegateKeys:         delegates,
+		Erc20ToDenoms:        erc20ToDenoms,
+		UnbatchedTransfers:   unbatchedTxs,
+		ModuleSendGrants:     moduleSendGrants,
+		BridgeInstance:       &bridgeInstance,
+		EthDestinationLabels: ethDestLabels,
+		FirstSendDelays:      firstSendDelays,
+		AuditLog:             auditLog,
+		CallbackTransfers:    callbackTransfers,
+		MergedTransfers:      mergedTransfers,
+		QuarantinedDeposits:  quarantined,
+		ValsetRewardReserve:  rewardReserve,
 	}
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -173,3 +174,88 @@ func exportImport(t *testing.T, input *TestInput) {
 	require.Empty(t, batches)
 	InitGenesis(input.Context, input.GravityKeeper, genesisState)
 }
+
+// Tests that a chain relaunched from an export starts a new bridge instance which refuses the claims and
+// confirms of the previous one until governance resets it
+func TestBridgeInstanceRelaunch(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(5)
+	k := input.GravityKeeper
+	InitGenesis(ctx, k, *types.DefaultGenesisState())
+	first := k.GetBridgeInstance(ctx)
+	require.Len(t, first.Id, 64)
+	require.Empty(t, first.PreviousId)
+
+	k.setLastObservedEventNonce(ctx, 7)
+	// no validator has set an eth key, the valset is stored directly
+	valset := &types.Valset{
+		Nonce:        1,
+		Members:      types.BridgeValidators{{Power: math.MaxUint32, EthereumAddress: EthAddrs[0].String()}},
+		RewardAmount: sdk.ZeroInt(),
+		RewardToken:  types.ZeroAddress().GetAddress(),
+	}
+	k.StoreValset(ctx.WithBlockHeight(10), valset)
+	genesisState := ExportGenesis(ctx, k)
+	require.NoError(t, genesisState.ValidateBasic())
+
+	newEnv := CreateTestEnv(t)
+	ctx = newEnv.Context.WithBlockHeight(11).WithBlockTime(time.Now().UTC())
+	k = newEnv.GravityKeeper
+	InitGenesis(ctx, k, genesisState)
+	second := k.GetBridgeInstance(ctx)
+	require.NotEqual(t, first.Id, second.Id)
+	require.Equal(t, first.Id, second.PreviousId)
+	require.Equal(t, uint64(7), second.StartEventNonce)
+	require.Equal(t, uint64(11), second.StartHeight)
+
+	claim := &types.MsgSendToCosmosClaim{EventNonce: 7}
+	require.ErrorIs(t, k.checkBridgeInstanceClaim(ctx, claim), types.ErrBridgeInstanceReplay)
+	require.ErrorIs(t, k.checkBridgeInstanceConfirm(ctx, valset.Height), types.ErrBridgeInstanceReplay)
+	claim.EventNonce = 8
+	require.NoError(t, k.checkBridgeInstanceClaim(ctx, claim))
+	require.NoError(t, k.checkBridgeInstanceConfirm(ctx, 11))
+
+	require.NoError(t, k.HandleBridgeInstanceResetProposal(ctx, types.NewBridgeInstanceResetProposal("reset", "same bridge")))
+	claim.EventNonce = 7
+	require.NoError(t, k.checkBridgeInstanceClaim(ctx, claim))
+	require.NoError(t, k.checkBridgeInstanceConfirm(ctx, valset.Height))
+	require.Equal(t, second.Id, k.GetBridgeInstance(ctx).Id)
+}
+
+// Tests that passed proposals are appended to the audit log and that the log survives an export and import
+func TestAuditLog(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(5)
+	k := input.GravityKeeper
+	InitGenesis(ctx, k, *types.DefaultGenesisState())
+	instance := k.GetBridgeInstance(ctx)
+
+	require.NoError(t, k.HandleBridgeInstanceResetProposal(ctx, types.NewBridgeInstanceResetProposal("reset", "same bridge")))
+	cap := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, k.HandleModuleSendGrantProposal(ctx.WithBlockHeight(6), types.NewModuleSendGrantProposal("grant", "pol", "pol", cap, 10)))
+
+	entries, _, err := k.GetAuditLog(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, uint64(1), entries[0].Id)
+	require.Equal(t, uint64(5), entries[0].Height)
+	require.Equal(t, types.ProposalTypeBridgeInstanceReset, entries[0].ProposalType)
+	require.Equal(t, "reset", entries[0].ProposalTitle)
+	require.Equal(t, fmt.Sprintf("instance %s started after event nonce 0 at block 5", instance.Id), entries[0].Before)
+	require.Equal(t, uint64(2), entries[1].Id)
+	require.Equal(t, "module pol has no send grant", entries[1].Before)
+	require.Equal(t, "module pol may send 100stake per 10 blocks", entries[1].After)
+
+	genesisState := ExportGenesis(ctx, k)
+	require.NoError(t, genesisState.ValidateBasic())
+	newEnv := CreateTestEnv(t)
+	ctx = newEnv.Context.WithBlockHeight(1)
+	k = newEnv.GravityKeeper
+	InitGenesis(ctx, k, genesisState)
+	require.NoError(t, k.HandleBridgeInstanceResetProposal(ctx, types.NewBridgeInstanceResetProposal("again", "same bridge")))
+	entries, _, err = k.GetAuditLog(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, uint64(3), entries[2].Id)
+	require.Equal(t, "again", entries[2].ProposalTitle)
+}
@@ -1,10 +1,13 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
@@ -25,7 +28,7 @@ const QUERY_ATTESTATIONS_LIMIT uint64 = 1000
 // Params queries the params of the gravity module
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	var params types.Params
-	k.paramSpace.GetParamSet(sdk.UnwrapSDKContext(c), &params)
+	k.paramSpace.GetParamSet(k.queryContext(c), &params)
 	return &types.QueryParamsResponse{Params: params}, nil
 
 }
@@ -34,14 +37,14 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 func (k Keeper) CurrentValset(
 	c context.Context,
 	req *types.QueryCurrentValsetRequest) (*types.QueryCurrentValsetResponse, error) {
-	return &types.QueryCurrentValsetResponse{Valset: k.GetCurrentValset(sdk.UnwrapSDKContext(c))}, nil
+	return &types.QueryCurrentValsetResponse{Valset: k.GetCurrentValset(k.queryContext(c))}, nil
 }
 
 // ValsetRequest queries the ValsetRequest of the gravity module
 func (k Keeper) ValsetRequest(
 	c context.Context,
 	req *types.QueryValsetRequestRequest) (*types.QueryValsetRequestResponse, error) {
-	return &types.QueryValsetRequestResponse{Valset: k.GetValset(sdk.UnwrapSDKContext(c), req.Nonce)}, nil
+	return &types.QueryValsetRequestResponse{Valset: k.GetValset(k.queryContext(c), req.Nonce)}, nil
 }
 
 // ValsetConfirm queries the ValsetConfirm of the gravity module
@@ -52,7 +55,7 @@ func (k Keeper) ValsetConfirm(
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
 	}
-	return &types.QueryValsetConfirmResponse{Confirm: k.GetValsetConfirm(sdk.UnwrapSDKContext(c), req.Nonce, addr)}, nil
+	return &types.QueryValsetConfirmResponse{Confirm: k.GetValsetConfirm(k.queryContext(c), req.Nonce, addr)}, nil
 }
 
 // ValsetConfirmsByNonce queries the ValsetConfirmsByNonce of the gravity module
@@ -60,7 +63,7 @@ func (k Keeper) ValsetConfirmsByNonce(
 	c context.Context,
 	req *types.QueryValsetConfirmsByNonceRequest) (*types.QueryValsetConfirmsByNonceResponse, error) {
 	var confirms []*types.MsgValsetConfirm
-	k.IterateValsetConfirmByNonce(sdk.UnwrapSDKContext(c), req.Nonce, func(_ []byte, c types.MsgValsetConfirm) bool {
+	k.IterateValsetConfirmByNonce(k.queryContext(c), req.Nonce, func(_ []byte, c types.MsgValsetConfirm) bool {
 		confirms = append(confirms, &c)
 		return false
 	})
@@ -71,15 +74,79 @@ func (k Keeper) ValsetConfirmsByNonce(
 func (k Keeper) LastValsetRequests(
 	c context.Context,
 	req *types.QueryLastValsetRequestsRequest) (*types.QueryLastValsetRequestsResponse, error) {
-	valReq := k.GetValsets(sdk.UnwrapSDKContext(c))
-	valReqLen := len(valReq)
-	retLen := 0
-	if valReqLen < maxValsetRequestsReturned {
-		retLen = valReqLen
-	} else {
-		retLen = maxValsetRequestsReturned
+	var valReq []*types.Valset
+	k.IterateValsets(k.queryContext(c), IterationLimit{StartAfter: nil, MaxResults: maxValsetRequestsReturned}, func(_ []byte, val *types.Valset) bool {
+		valReq = append(valReq, val)
+		return false
+	})
+	return &types.QueryLastValsetRequestsResponse{Valsets: valReq}, nil
+}
+
+// HistoricalValsets returns a page of the valsets that were not pruned yet, oldest first
+func (k Keeper) HistoricalValsets(
+	c context.Context,
+	req *types.QueryHistoricalValsetsRequest) (*types.QueryHistoricalValsetsResponse, error) {
+	valsets, pageRes, err := k.GetHistoricalValsets(k.queryContext(c), req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryHistoricalValsetsResponse{Valsets: valsets, Pagination: pageRes}, nil
+}
+
+// RelaySignatures returns the signatures to relay a valset, or a batch if no valset nonce is given, to the Gravity
+// contract with, in the order the contract expects them
+func (k Keeper) RelaySignatures(
+	c context.Context,
+	req *types.QueryRelaySignaturesRequest) (*types.QueryRelaySignaturesResponse, error) {
+	ctx := k.queryContext(c)
+	if req.ValsetNonce != 0 {
+		return k.GetValsetRelaySignatures(ctx, req.ValsetNonce)
+	}
+	tokenContract, err := types.NewEthAddress(req.TokenContract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid token contract")
+	}
+	return k.GetBatchRelaySignatures(ctx, *tokenContract, req.BatchNonce)
+}
+
+// SigningObligations returns the valsets and batches the given validator still has to confirm, or those of every
+// bonded and unbonding validator if no validator is given
+func (k Keeper) SigningObligations(
+	c context.Context,
+	req *types.QuerySigningObligationsRequest) (*types.QuerySigningObligationsResponse, error) {
+	ctx := k.queryContext(c)
+	ret := types.QuerySigningObligationsResponse{Obligations: []types.ValidatorSigningObligations{}}
+	if req.ValidatorAddress == "" {
+		for _, validator := range k.getSigningValidators(ctx) {
+			ret.Obligations = append(ret.Obligations, k.GetSigningObligations(ctx, validator))
+		}
+		return &ret, nil
+	}
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.ValidatorAddress)
+	}
+	validator, found := k.StakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknown, "validator")
+	}
+	ret.Obligations = append(ret.Obligations, k.GetSigningObligations(ctx, validator))
+	return &ret, nil
+}
+
+// ValsetDeploymentArgs returns the Gravity contract constructor arguments for the valset of the given nonce, or
+// for the current valset if the nonce is 0
+func (k Keeper) ValsetDeploymentArgs(
+	c context.Context,
+	req *types.QueryValsetDeploymentArgsRequest) (*types.QueryValsetDeploymentArgsResponse, error) {
+	ctx := k.queryContext(c)
+	valset := k.GetCurrentValset(ctx)
+	if req.Nonce != 0 {
+		if valset = k.GetValset(ctx, req.Nonce); valset == nil {
+			return nil, sdkerrors.Wrapf(types.ErrUnknown, "valset %d", req.Nonce)
+		}
 	}
-	return &types.QueryLastValsetRequestsResponse{Valsets: valReq[0:retLen]}, nil
+	return valset.GetDeploymentArgs(k.GetGravityID(ctx), k.GetParams(ctx).EthereumPowerThreshold)
 }
 
 // LastPendingValsetRequestByAddr queries the LastPendingValsetRequestByAddr of the gravity module
@@ -92,9 +159,9 @@ func (k Keeper) LastPendingValsetRequestByAddr(
 	}
 
 	var pendingValsetReq []*types.Valset
-	k.IterateValsets(sdk.UnwrapSDKContext(c), func(_ []byte, val *types.Valset) bool {
+	k.IterateValsets(k.queryContext(c), IterationLimit{}, func(_ []byte, val *types.Valset) bool {
 		// foundConfirm is true if the operatorAddr has signed the valset we are currently looking at
-		foundConfirm := k.GetValsetConfirm(sdk.UnwrapSDKContext(c), val.Nonce, addr) != nil
+		foundConfirm := k.GetValsetConfirm(k.queryContext(c), val.Nonce, addr) != nil
 		// if this valset has NOT been signed by operatorAddr, store it in pendingValsetReq
 		// and exit the loop
 		if !foundConfirm {
@@ -115,7 +182,8 @@ func (k Keeper) LastPendingValsetRequestByAddr(
 func (k Keeper) BatchFees(
 	c context.Context,
 	req *types.QueryBatchFeeRequest) (*types.QueryBatchFeeResponse, error) {
-	return &types.QueryBatchFeeResponse{BatchFees: k.GetAllBatchFees(sdk.UnwrapSDKContext(c), OutgoingTxBatchSize)}, nil
+	ctx := k.queryContext(c)
+	return &types.QueryBatchFeeResponse{BatchFees: k.GetAllBatchFees(ctx, k.getLargestMaxBatchSize(ctx))}, nil
 }
 
 // LastPendingBatchRequestByAddr queries the LastPendingBatchRequestByAddr of the gravity module
@@ -128,8 +196,8 @@ func (k Keeper) LastPendingBatchRequestByAddr(
 	}
 
 	var pendingBatchReq *types.InternalOutgoingTxBatch
-	k.IterateOutgoingTXBatches(sdk.UnwrapSDKContext(c), func(_ []byte, batch *types.InternalOutgoingTxBatch) bool {
-		foundConfirm := k.GetBatchConfirm(sdk.UnwrapSDKContext(c), batch.BatchNonce, batch.TokenContract, addr) != nil
+	k.IterateOutgoingTXBatches(k.queryContext(c), IterationLimit{}, func(_ []byte, batch *types.InternalOutgoingTxBatch) bool {
+		foundConfirm := k.GetBatchConfirm(k.queryContext(c), batch.BatchNonce, batch.TokenContract, addr) != nil
 		if !foundConfirm {
 			pendingBatchReq = batch
 			return true
@@ -149,8 +217,8 @@ func (k Keeper) LastPendingLogicCallByAddr(
 	}
 
 	var pendingLogicReq *types.OutgoingLogicCall
-	k.IterateOutgoingLogicCalls(sdk.UnwrapSDKContext(c), func(_ []byte, logic *types.OutgoingLogicCall) bool {
-		foundConfirm := k.GetLogicCallConfirm(sdk.UnwrapSDKContext(c),
+	k.IterateOutgoingLogicCalls(k.queryContext(c), IterationLimit{}, func(_ []byte, logic *types.OutgoingLogicCall) bool {
+		foundConfirm := k.GetLogicCallConfirm(k.queryContext(c),
 			logic.InvalidationId, logic.InvalidationNonce, addr) != nil
 		if !foundConfirm {
 			pendingLogicReq = logic
@@ -161,16 +229,36 @@ func (k Keeper) LastPendingLogicCallByAddr(
 	return &types.QueryLastPendingLogicCallByAddrResponse{Call: pendingLogicReq}, nil
 }
 
-// OutgoingTxBatches queries the OutgoingTxBatches of the gravity module
+// OutgoingTxBatches returns a page of the outgoing batches, optionally only those of one token and those an
+// orchestrator still has to sign
 func (k Keeper) OutgoingTxBatches(
 	c context.Context,
 	req *types.QueryOutgoingTxBatchesRequest) (*types.QueryOutgoingTxBatchesResponse, error) {
-	var batches []*types.OutgoingTxBatch
-	k.IterateOutgoingTXBatches(sdk.UnwrapSDKContext(c), func(_ []byte, batch *types.InternalOutgoingTxBatch) bool {
-		batches = append(batches, batch.ToExternal())
-		return len(batches) == MaxResults
-	})
-	return &types.QueryOutgoingTxBatchesResponse{Batches: batches}, nil
+	var tokenContract *types.EthAddress
+	if req.TokenContract != "" {
+		contract, err := types.NewEthAddress(req.TokenContract)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "token contract invalid")
+		}
+		tokenContract = contract
+	}
+	var orchestrator sdk.AccAddress
+	if req.UnsignedBy != "" {
+		addr, err := sdk.AccAddressFromBech32(req.UnsignedBy)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unsigned by address invalid")
+		}
+		orchestrator = addr
+	}
+	batches, pageRes, err := k.GetOutgoingTxBatchesPaged(k.queryContext(c), tokenContract, orchestrator, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	res := &types.QueryOutgoingTxBatchesResponse{Batches: []*types.OutgoingTxBatch{}, Pagination: pageRes}
+	for _, batch := range batches {
+		res.Batches = append(res.Batches, batch.ToExternal())
+	}
+	return res, nil
 }
 
 // OutgoingLogicCalls queries the OutgoingLogicCalls of the gravity module
@@ -178,9 +266,9 @@ func (k Keeper) OutgoingLogicCalls(
 	c context.Context,
 	req *types.QueryOutgoingLogicCallsRequest) (*types.QueryOutgoingLogicCallsResponse, error) {
 	var calls []*types.OutgoingLogicCall
-	k.IterateOutgoingLogicCalls(sdk.UnwrapSDKContext(c), func(_ []byte, call *types.OutgoingLogicCall) bool {
+	k.IterateOutgoingLogicCalls(k.queryContext(c), IterationLimit{StartAfter: nil, MaxResults: MaxResults}, func(_ []byte, call *types.OutgoingLogicCall) bool {
 		calls = append(calls, call)
-		return len(calls) == MaxResults
+		return false
 	})
 	return &types.QueryOutgoingLogicCallsResponse{Calls: calls}, nil
 }
@@ -193,7 +281,7 @@ func (k Keeper) BatchRequestByNonce(
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
 	}
-	foundBatch := k.GetOutgoingTXBatch(sdk.UnwrapSDKContext(c), *addr, req.Nonce)
+	foundBatch := k.GetOutgoingTXBatch(k.queryContext(c), *addr, req.Nonce)
 	if foundBatch == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Can not find tx batch")
 	}
@@ -209,7 +297,7 @@ func (k Keeper) BatchConfirms(
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "invalid contract address in request")
 	}
-	k.IterateBatchConfirmByNonceAndTokenContract(sdk.UnwrapSDKContext(c),
+	k.IterateBatchConfirmByNonceAndTokenContract(k.queryContext(c),
 		req.Nonce, *contract, func(_ []byte, c types.MsgConfirmBatch) bool {
 			confirms = append(confirms, &c)
 			return false
@@ -222,7 +310,7 @@ func (k Keeper) LogicConfirms(
 	c context.Context,
 	req *types.QueryLogicConfirmsRequest) (*types.QueryLogicConfirmsResponse, error) {
 	var confirms []*types.MsgConfirmLogicCall
-	k.IterateLogicConfirmByInvalidationIDAndNonce(sdk.UnwrapSDKContext(c), req.InvalidationId,
+	k.IterateLogicConfirmByInvalidationIDAndNonce(k.queryContext(c), req.InvalidationId,
 		req.InvalidationNonce, func(_ []byte, c *types.MsgConfirmLogicCall) bool {
 			confirms = append(confirms, c)
 			return false
@@ -236,7 +324,7 @@ func (k Keeper) LogicConfirms(
 func (k Keeper) LastEventNonceByAddr(
 	c context.Context,
 	req *types.QueryLastEventNonceByAddrRequest) (*types.QueryLastEventNonceByAddrResponse, error) {
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := k.queryContext(c)
 	var ret types.QueryLastEventNonceByAddrResponse
 	addr, err := sdk.AccAddressFromBech32(req.Address)
 	if err != nil {
@@ -246,8 +334,49 @@ func (k Keeper) LastEventNonceByAddr(
 	if !found {
 		return nil, sdkerrors.Wrap(types.ErrUnknown, "address")
 	}
-	lastEventNonce := k.GetLastEventNonceByValidator(ctx, validator.GetOperator())
+	lastEventNonce := k.GetResumeEventNonce(ctx, validator.GetOperator())
 	ret.EventNonce = lastEventNonce
+	ret.EthBlocksToObserve = k.GetParams(ctx).EthBlocksToObserve
+	return &ret, nil
+}
+
+// OrchestratorSubmissions returns what the module already has from the given orchestrator address, the event
+// nonce its next claim has to follow and its confirms for the valsets, batches and logic calls still in the store
+func (k Keeper) OrchestratorSubmissions(
+	c context.Context,
+	req *types.QueryOrchestratorSubmissionsRequest) (*types.QueryOrchestratorSubmissionsResponse, error) {
+	ctx := k.queryContext(c)
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.Address)
+	}
+	validator, found := k.GetOrchestratorValidator(ctx, addr)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknown, "address")
+	}
+
+	ret := types.QueryOrchestratorSubmissionsResponse{
+		LastEventNonce:     k.GetResumeEventNonce(ctx, validator.GetOperator()),
+		ValsetConfirms:     []types.MsgValsetConfirm{},
+		BatchConfirms:      []types.MsgConfirmBatch{},
+		LogicCallConfirms:  []types.MsgConfirmLogicCall{},
+		EthBlocksToObserve: k.GetParams(ctx).EthBlocksToObserve,
+	}
+	for _, valset := range k.GetValsets(ctx) {
+		if confirm := k.GetValsetConfirm(ctx, valset.Nonce, addr); confirm != nil {
+			ret.ValsetConfirms = append(ret.ValsetConfirms, *confirm)
+		}
+	}
+	for _, batch := range k.GetOutgoingTxBatches(ctx) {
+		if confirm := k.GetBatchConfirm(ctx, batch.BatchNonce, batch.TokenContract, addr); confirm != nil {
+			ret.BatchConfirms = append(ret.BatchConfirms, *confirm)
+		}
+	}
+	for _, call := range k.GetOutgoingLogicCalls(ctx) {
+		if confirm := k.GetLogicCallConfirm(ctx, call.InvalidationId, call.InvalidationNonce, addr); confirm != nil {
+			ret.LogicCallConfirms = append(ret.LogicCallConfirms, *confirm)
+		}
+	}
 	return &ret, nil
 }
 
@@ -255,7 +384,7 @@ func (k Keeper) LastEventNonceByAddr(
 func (k Keeper) DenomToERC20(
 	c context.Context,
 	req *types.QueryDenomToERC20Request) (*types.QueryDenomToERC20Response, error) {
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := k.queryContext(c)
 	cosmosOriginated, erc20, err := k.DenomToERC20Lookup(ctx, req.Denom)
 	var ret types.QueryDenomToERC20Response
 	ret.Erc20 = erc20.GetAddress()
@@ -268,7 +397,7 @@ func (k Keeper) DenomToERC20(
 func (k Keeper) ERC20ToDenom(
 	c context.Context,
 	req *types.QueryERC20ToDenomRequest) (*types.QueryERC20ToDenomResponse, error) {
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := k.queryContext(c)
 	ethAddr, err := types.NewEthAddress(req.Erc20)
 	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "invalid Erc20 in request: %s", req.Erc20)
@@ -281,11 +410,22 @@ func (k Keeper) ERC20ToDenom(
 	return &ret, nil
 }
 
+// CosmosOriginatedTokens returns a page of the whole denom to ERC20 table of the Cosmos originated tokens
+func (k Keeper) CosmosOriginatedTokens(
+	c context.Context,
+	req *types.QueryCosmosOriginatedTokensRequest) (*types.QueryCosmosOriginatedTokensResponse, error) {
+	tokens, pageRes, err := k.GetCosmosOriginatedTokens(k.queryContext(c), req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryCosmosOriginatedTokensResponse{Tokens: tokens, Pagination: pageRes}, nil
+}
+
 // GetAttestations queries the attestation map
 func (k Keeper) GetAttestations(
 	c context.Context,
 	req *types.QueryAttestationsRequest) (*types.QueryAttestationsResponse, error) {
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := k.queryContext(c)
 	limit := req.Limit
 	if limit > QUERY_ATTESTATIONS_LIMIT {
 		limit = QUERY_ATTESTATIONS_LIMIT
@@ -298,7 +438,7 @@ func (k Keeper) GetAttestations(
 func (k Keeper) GetDelegateKeyByValidator(
 	c context.Context,
 	req *types.QueryDelegateKeysByValidatorAddress) (*types.QueryDelegateKeysByValidatorAddressResponse, error) {
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := k.queryContext(c)
 	keys := k.GetDelegateKeys(ctx)
 	reqValidator, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
 	if err != nil {
@@ -321,7 +461,7 @@ func (k Keeper) GetDelegateKeyByValidator(
 func (k Keeper) GetDelegateKeyByOrchestrator(
 	c context.Context,
 	req *types.QueryDelegateKeysByOrchestratorAddress) (*types.QueryDelegateKeysByOrchestratorAddressResponse, error) {
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := k.queryContext(c)
 	keys := k.GetDelegateKeys(ctx)
 	reqOrchestrator, err := sdk.AccAddressFromBech32(req.OrchestratorAddress)
 	if err != nil {
@@ -344,7 +484,7 @@ func (k Keeper) GetDelegateKeyByOrchestrator(
 func (k Keeper) GetDelegateKeyByEth(
 	c context.Context,
 	req *types.QueryDelegateKeysByEthAddress) (*types.QueryDelegateKeysByEthAddressResponse, error) {
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := k.queryContext(c)
 	keys := k.GetDelegateKeys(ctx)
 	if err := types.ValidateEthAddress(req.EthAddress); err != nil {
 		return nil, sdkerrors.Wrap(err, "invalid eth address")
@@ -364,26 +504,510 @@ func (k Keeper) GetDelegateKeyByEth(
 func (k Keeper) GetPendingSendToEth(
 	c context.Context,
 	req *types.QueryPendingSendToEth) (*types.QueryPendingSendToEthResponse, error) {
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := k.queryContext(c)
+	sender, err := sdk.AccAddressFromBech32(req.GetSenderAddress())
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.GetSenderAddress())
+	}
 	batches := k.GetOutgoingTxBatches(ctx)
-	unbatched_tx := k.GetUnbatchedTransactions(ctx)
-	sender_address := req.GetSenderAddress()
+	unbatched_tx, pageRes, err := k.GetUnbatchedTxsBySenderPaged(ctx, sender, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	sender_address := sender.String()
 	res := types.QueryPendingSendToEthResponse{
 		TransfersInBatches: []*types.OutgoingTransferTx{},
 		UnbatchedTransfers: []*types.OutgoingTransferTx{},
+		Pagination:         pageRes,
 	}
 	for _, batch := range batches {
-		for _, tx := range batch.Transactions {
+		for _, tx := range k.GetBatchTransfers(ctx, *batch) {
 			if tx.Sender.String() == sender_address {
 				res.TransfersInBatches = append(res.TransfersInBatches, tx.ToExternal())
 			}
 		}
 	}
 	for _, tx := range unbatched_tx {
-		if tx.Sender.String() == sender_address {
-			res.UnbatchedTransfers = append(res.UnbatchedTransfers, tx.ToExternal())
-		}
+		res.UnbatchedTransfers = append(res.UnbatchedTransfers, tx.ToExternal())
 	}
 
 	return &res, nil
 }
+
+// PendingSendToEthByReceiver returns the transfers to an Ethereum address waiting in batches and a page of
+// those still waiting in the pool, found through the receiver index
+func (k Keeper) PendingSendToEthByReceiver(
+	c context.Context,
+	req *types.QueryPendingSendToEthByReceiverRequest) (*types.QueryPendingSendToEthByReceiverResponse, error) {
+	receiver, err := types.NewEthAddress(req.Receiver)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "receiver invalid")
+	}
+	ctx := k.queryContext(c)
+	unbatched, pageRes, err := k.GetUnbatchedTxsByReceiverPaged(ctx, *receiver, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	res := &types.QueryPendingSendToEthByReceiverResponse{
+		TransfersInBatches: []*types.OutgoingTransferTx{},
+		UnbatchedTransfers: []*types.OutgoingTransferTx{},
+		Pagination:         pageRes,
+	}
+	for _, batch := range k.GetOutgoingTxBatches(ctx) {
+		for _, tx := range k.GetBatchTransfers(ctx, *batch) {
+			if tx.DestAddress.GetAddress() == receiver.GetAddress() {
+				res.TransfersInBatches = append(res.TransfersInBatches, tx.ToExternal())
+			}
+		}
+	}
+	for _, tx := range unbatched {
+		res.UnbatchedTransfers = append(res.UnbatchedTransfers, tx.ToExternal())
+	}
+	return res, nil
+}
+
+// UnbatchedTxsBySender returns a page of the transfers of a sender still waiting in the pool
+func (k Keeper) UnbatchedTxsBySender(
+	c context.Context,
+	req *types.QueryUnbatchedTxsBySenderRequest) (*types.QueryUnbatchedTxsBySenderResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sender invalid")
+	}
+	txs, pageRes, err := k.GetUnbatchedTxsBySenderPaged(k.queryContext(c), sender, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	res := &types.QueryUnbatchedTxsBySenderResponse{Transfers: []*types.OutgoingTransferTx{}, Pagination: pageRes}
+	for _, tx := range txs {
+		res.Transfers = append(res.Transfers, tx.ToExternal())
+	}
+	return res, nil
+}
+
+// UnbatchedTxs returns a page of the transfers waiting in the pool, optionally only those of one token
+func (k Keeper) UnbatchedTxs(
+	c context.Context,
+	req *types.QueryUnbatchedTxsRequest) (*types.QueryUnbatchedTxsResponse, error) {
+	var tokenContract *types.EthAddress
+	if req.TokenContract != "" {
+		contract, err := types.NewEthAddress(req.TokenContract)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "token contract invalid")
+		}
+		tokenContract = contract
+	}
+	txs, pageRes, err := k.GetUnbatchedTransactionsPaged(k.queryContext(c), tokenContract, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	res := &types.QueryUnbatchedTxsResponse{Transfers: []*types.OutgoingTransferTx{}, Pagination: pageRes}
+	for _, tx := range txs {
+		res.Transfers = append(res.Transfers, tx.ToExternal())
+	}
+	return res, nil
+}
+
+// AuditLog returns a page of the log of the changes governance made to the bridge
+func (k Keeper) AuditLog(
+	c context.Context,
+	req *types.QueryAuditLogRequest) (*types.QueryAuditLogResponse, error) {
+	entries, pageRes, err := k.GetAuditLog(k.queryContext(c), req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryAuditLogResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// FirstSendDelay returns the first send delay of an account and a page of the Ethereum destinations it has
+// sent to since setting it
+func (k Keeper) FirstSendDelay(
+	c context.Context,
+	req *types.QueryFirstSendDelayRequest) (*types.QueryFirstSendDelayResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "account invalid")
+	}
+	ctx := k.queryContext(c)
+	dests, pageRes, err := k.GetKnownEthDestinations(ctx, account, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryFirstSendDelayResponse{
+		DelayBlocks:          k.GetFirstSendDelay(ctx, account),
+		KnownEthDestinations: dests,
+		Pagination:           pageRes,
+	}, nil
+}
+
+// OrchestratorLiveness summarizes the heartbeats of the orchestrators of all bonded validators
+func (k Keeper) OrchestratorLiveness(
+	c context.Context,
+	req *types.QueryOrchestratorLivenessRequest) (*types.QueryOrchestratorLivenessResponse, error) {
+	ctx := k.queryContext(c)
+	orchestrators := k.GetOrchestratorLiveness(ctx, req.MaxHeartbeatAge)
+	var live uint64
+	for _, o := range orchestrators {
+		if o.Live {
+			live++
+		}
+	}
+	return &types.QueryOrchestratorLivenessResponse{
+		Orchestrators: orchestrators,
+		LiveCount:     live,
+		TotalCount:    uint64(len(orchestrators)),
+	}, nil
+}
+
+// BridgeMigration returns the in progress bridge contract migration, if any
+func (k Keeper) BridgeMigration(
+	c context.Context,
+	req *types.QueryBridgeMigrationRequest) (*types.QueryBridgeMigrationResponse, error) {
+	return &types.QueryBridgeMigrationResponse{Migration: k.GetBridgeMigration(k.queryContext(c))}, nil
+}
+
+// BridgeStats returns the deposit and withdrawal statistics of the last day and week
+func (k Keeper) BridgeStats(
+	c context.Context,
+	req *types.QueryBridgeStatsRequest) (*types.QueryBridgeStatsResponse, error) {
+	ctx := k.queryContext(c)
+	return &types.QueryBridgeStatsResponse{
+		LastDay:  k.GetBridgeStats(ctx, BridgeStatsDayBuckets),
+		LastWeek: k.GetBridgeStats(ctx, BridgeStatsWeekBuckets),
+	}, nil
+}
+
+// BridgeTokenStats returns the per token bridge statistics over a window of the given number of hours
+func (k Keeper) BridgeTokenStats(
+	c context.Context,
+	req *types.QueryBridgeTokenStatsRequest) (*types.QueryBridgeTokenStatsResponse, error) {
+	if req.WindowHours == 0 || req.WindowHours > BridgeStatsWeekBuckets {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "window must be between 1 and %d hours", BridgeStatsWeekBuckets)
+	}
+	window := k.GetBridgeStats(k.queryContext(c), req.WindowHours)
+	if req.TokenContract != "" {
+		tokenContract, err := types.NewEthAddress(req.TokenContract)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid token contract in request")
+		}
+		var tokens []types.BridgeTokenStats
+		for _, stats := range window.Tokens {
+			if stats.TokenContract == tokenContract.GetAddress() {
+				tokens = append(tokens, stats)
+			}
+		}
+		window.Tokens = tokens
+	}
+	return &types.QueryBridgeTokenStatsResponse{Window: window}, nil
+}
+
+// SolvencyReport compares the escrow and supply of every bridged token with the transfers still owed on it
+func (k Keeper) SolvencyReport(
+	c context.Context,
+	req *types.QuerySolvencyReportRequest) (*types.QuerySolvencyReportResponse, error) {
+	report := k.GetSolvencyReport(k.queryContext(c))
+	solvent := true
+	for _, token := range report {
+		if token.Discrepancy != "" {
+			solvent = false
+		}
+	}
+	return &types.QuerySolvencyReportResponse{Tokens: report, Solvent: solvent}, nil
+}
+
+// BridgeStatus returns an overview of the bridge for monitoring, in place of the separate queries of its parts
+func (k Keeper) BridgeStatus(
+	c context.Context,
+	req *types.QueryBridgeStatusRequest) (*types.QueryBridgeStatusResponse, error) {
+	status := k.GetBridgeStatus(k.queryContext(c))
+	return &status, nil
+}
+
+// DepositByEthTxHash returns the status of the deposit made by an Ethereum transaction
+func (k Keeper) DepositByEthTxHash(
+	c context.Context,
+	req *types.QueryDepositByEthTxHashRequest) (*types.QueryDepositByEthTxHashResponse, error) {
+	if err := types.ValidateEthTxHash(req.EthTxHash); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	res, found := k.GetDepositByEthTxHash(k.queryContext(c), req.EthTxHash)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "deposit with eth tx hash %s", req.EthTxHash)
+	}
+	return res, nil
+}
+
+// BatchLifecycle returns where a batch is in its life, from the signatures it collected to how it left the store
+func (k Keeper) BatchLifecycle(
+	c context.Context,
+	req *types.QueryBatchLifecycleRequest) (*types.QueryBatchLifecycleResponse, error) {
+	tokenContract, err := types.NewEthAddress(req.TokenContract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid token contract")
+	}
+	lifecycle, found := k.GetBatchLifecycle(k.queryContext(c), *tokenContract, req.BatchNonce)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "batch %d of %s", req.BatchNonce, tokenContract.GetAddress())
+	}
+	return &types.QueryBatchLifecycleResponse{Lifecycle: lifecycle}, nil
+}
+
+// EventNonceGap returns the lowest event nonce that has not reached attestation consensus and how long it has
+// been pending
+func (k Keeper) EventNonceGap(
+	c context.Context,
+	req *types.QueryEventNonceGapRequest) (*types.QueryEventNonceGapResponse, error) {
+	gap := k.GetEventNonceGap(k.queryContext(c))
+	return &gap, nil
+}
+
+// ReplayAttestations replays the stored observed claims against an empty bank and compares the result with the
+// live supply of every bridged token
+func (k Keeper) ReplayAttestations(
+	c context.Context,
+	req *types.QueryReplayAttestationsRequest) (*types.QueryReplayAttestationsResponse, error) {
+	return &types.QueryReplayAttestationsResponse{Report: k.ReplayObservedClaims(k.queryContext(c))}, nil
+}
+
+// TimedOutBatches returns the batches that were canceled after timing out on Ethereum and the transactions
+// they released back into the pool
+func (k Keeper) TimedOutBatches(
+	c context.Context,
+	req *types.QueryTimedOutBatchesRequest) (*types.QueryTimedOutBatchesResponse, error) {
+	var tokenContract *types.EthAddress
+	if req.TokenContract != "" {
+		contract, err := types.NewEthAddress(req.TokenContract)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid token contract in request")
+		}
+		tokenContract = contract
+	}
+	return &types.QueryTimedOutBatchesResponse{
+		Batches: k.GetTimedOutBatches(k.queryContext(c), tokenContract),
+	}, nil
+}
+
+// RefundReceipts returns a page of the receipts of the transfers to Ethereum refunded to a sender
+func (k Keeper) RefundReceipts(
+	c context.Context,
+	req *types.QueryRefundReceiptsRequest) (*types.QueryRefundReceiptsResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sender invalid")
+	}
+	receipts, pageRes, err := k.GetRefundReceipts(k.queryContext(c), sender, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryRefundReceiptsResponse{Receipts: receipts, Pagination: pageRes}, nil
+}
+
+// DepositReceipts returns a page of the receipts of the deposits from Ethereum paid out to a receiver
+func (k Keeper) DepositReceipts(
+	c context.Context,
+	req *types.QueryDepositReceiptsRequest) (*types.QueryDepositReceiptsResponse, error) {
+	receiver, err := sdk.AccAddressFromBech32(req.Receiver)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "receiver invalid")
+	}
+	receipts, pageRes, err := k.GetDepositReceipts(k.queryContext(c), receiver, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryDepositReceiptsResponse{Receipts: receipts, Pagination: pageRes}, nil
+}
+
+// QuarantinedDeposits returns the deposits from quarantined Ethereum senders held until governance releases them
+func (k Keeper) QuarantinedDeposits(
+	c context.Context,
+	req *types.QueryQuarantinedDepositsRequest) (*types.QueryQuarantinedDepositsResponse, error) {
+	return &types.QueryQuarantinedDepositsResponse{Deposits: k.GetQuarantinedDeposits(k.queryContext(c))}, nil
+}
+
+// ModuleSendGrants returns the send to Ethereum budgets governance granted to other modules
+func (k Keeper) ModuleSendGrants(
+	c context.Context,
+	req *types.QueryModuleSendGrantsRequest) (*types.QueryModuleSendGrantsResponse, error) {
+	return &types.QueryModuleSendGrantsResponse{Grants: k.GetModuleSendGrants(k.queryContext(c))}, nil
+}
+
+// BridgeInstance returns the bridge instance the chain is running
+func (k Keeper) BridgeInstance(
+	c context.Context,
+	req *types.QueryBridgeInstanceRequest) (*types.QueryBridgeInstanceResponse, error) {
+	return &types.QueryBridgeInstanceResponse{Instance: k.GetBridgeInstance(k.queryContext(c))}, nil
+}
+
+// EthDestinationLabels returns a page of the labeled Ethereum destinations of an account
+func (k Keeper) EthDestinationLabels(
+	c context.Context,
+	req *types.QueryEthDestinationLabelsRequest) (*types.QueryEthDestinationLabelsResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "owner invalid")
+	}
+	labels, pageRes, err := k.GetEthDestinationLabels(k.queryContext(c), owner, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryEthDestinationLabelsResponse{Labels: labels, Pagination: pageRes}, nil
+}
+
+// EthDestinationLabel returns the Ethereum destination an account labeled with the given label
+func (k Keeper) EthDestinationLabel(
+	c context.Context,
+	req *types.QueryEthDestinationLabelRequest) (*types.QueryEthDestinationLabelResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "owner invalid")
+	}
+	ethAddr, err := k.GetEthDestinationLabel(k.queryContext(c), owner, req.Label)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryEthDestinationLabelResponse{Label: types.EthDestinationLabel{
+		Owner:      req.Owner,
+		Label:      req.Label,
+		EthAddress: ethAddr.GetAddress(),
+	}}, nil
+}
+
+// ObservedEthereumHeight returns the observed Ethereum height along with the votes it was computed from
+func (k Keeper) ObservedEthereumHeight(
+	c context.Context,
+	req *types.QueryObservedEthereumHeightRequest) (*types.QueryObservedEthereumHeightResponse, error) {
+	ctx := k.queryContext(c)
+	height := k.GetLastObservedEthereumBlockHeight(ctx)
+	votes, _ := k.GetBondedEthereumHeightVotes(ctx)
+	median, _ := k.GetMedianEthereumHeight(ctx)
+	return &types.QueryObservedEthereumHeightResponse{
+		Height:       &height,
+		Votes:        votes,
+		MedianHeight: median,
+		Lags:         k.GetEthereumHeightLags(ctx, median),
+	}, nil
+}
+
+// EthereumBlockTimeCalibration returns the state of the Ethereum block time calibration
+func (k Keeper) EthereumBlockTimeCalibration(
+	c context.Context,
+	req *types.QueryEthereumBlockTimeCalibrationRequest) (*types.QueryEthereumBlockTimeCalibrationResponse, error) {
+	ctx := k.queryContext(c)
+	calibration := k.GetEthereumBlockTimeCalibration(ctx)
+	return &types.QueryEthereumBlockTimeCalibrationResponse{
+		Calibration:              &calibration,
+		AverageEthereumBlockTime: k.GetAverageEthereumBlockTime(ctx),
+	}, nil
+}
+
+// EthereumGasPrice returns the Ethereum gas price the relay cost of batches is estimated with and the median
+// type-2 fees it is made up of, along with the heartbeats they were computed from
+func (k Keeper) EthereumGasPrice(
+	c context.Context,
+	req *types.QueryEthereumGasPriceRequest) (*types.QueryEthereumGasPriceResponse, error) {
+	ctx := k.queryContext(c)
+	gasPrice, _ := k.GetEthereumRelayGasPrice(ctx)
+	baseFee, priorityFee, _ := k.GetMedianEthereumFees(ctx)
+	reports, _ := k.GetEthereumGasPriceReports(ctx)
+	return &types.QueryEthereumGasPriceResponse{
+		GasPrice:    gasPrice,
+		BaseFee:     baseFee,
+		PriorityFee: priorityFee,
+		Reports:     reports,
+	}, nil
+}
+
+// ProjectedEthereumHeight returns the projected current Ethereum height that outgoing timeouts are based on
+func (k Keeper) ProjectedEthereumHeight(
+	c context.Context,
+	req *types.QueryProjectedEthereumHeightRequest) (*types.QueryProjectedEthereumHeightResponse, error) {
+	ctx := k.queryContext(c)
+	observed := k.GetLastObservedEthereumBlockHeight(ctx)
+	return &types.QueryProjectedEthereumHeightResponse{
+		ProjectedHeight: k.GetProjectedEthereumHeight(ctx),
+		Observed:        &observed,
+	}, nil
+}
+
+// AttestationVotes returns the vote breakdown of the attestations for an event nonce
+func (k Keeper) AttestationVotes(
+	c context.Context,
+	req *types.QueryAttestationVotesRequest) (*types.QueryAttestationVotesResponse, error) {
+	ctx := k.queryContext(c)
+	var filter []byte
+	if req.ClaimHash != "" {
+		hash, err := hex.DecodeString(req.ClaimHash)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "claim hash is not hex encoded")
+		}
+		filter = hash
+	}
+
+	var breakdowns []*types.AttestationVoteBreakdown
+	k.IterateAttestationsByNonce(ctx, req.EventNonce, func(claimHash []byte, att types.Attestation) bool {
+		if filter == nil || bytes.Equal(filter, claimHash) {
+			breakdown := k.GetAttestationVoteBreakdown(ctx, req.EventNonce, claimHash, att)
+			breakdowns = append(breakdowns, &breakdown)
+		}
+		return false
+	})
+	return &types.QueryAttestationVotesResponse{Attestations: breakdowns}, nil
+}
+
+// SimulateProposal executes a gravity proposal against the current state without persisting anything and reports
+// what it would change
+func (k Keeper) SimulateProposal(
+	c context.Context,
+	req *types.QuerySimulateProposalRequest) (*types.QuerySimulateProposalResponse, error) {
+	if req.Proposal == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal missing")
+	}
+	var content govtypes.Content
+	if err := k.cdc.UnpackAny(req.Proposal, &content); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QuerySimulateProposalResponse{Simulation: k.DryRunProposal(k.queryContext(c), content)}, nil
+}
+
+// PendingBatchPreview returns the batch a MsgRequestBatch for the denom would build right now, without building it
+func (k Keeper) PendingBatchPreview(
+	c context.Context,
+	req *types.QueryPendingBatchPreviewRequest) (*types.QueryPendingBatchPreviewResponse, error) {
+	ctx := k.queryContext(c)
+	_, tokenContract, err := k.DenomToERC20Lookup(ctx, req.Denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	batch, checkpoint, err := k.PreviewOutgoingTXBatch(ctx, *tokenContract, k.GetMaxBatchSize(ctx, *tokenContract))
+	if err != nil {
+		return nil, err
+	}
+	res := &types.QueryPendingBatchPreviewResponse{TotalFees: sdk.ZeroInt()}
+	if batch != nil {
+		res.Batch = batch.ToExternal()
+		for _, tx := range batch.Transactions {
+			res.TotalFees = res.TotalFees.Add(tx.Erc20Fee.Amount)
+		}
+		res.Checkpoint = checkpoint
+	}
+	return res, nil
+}
+
+// SendToEthFeeSuggestion returns the fee a transfer of the denom should pay to make it into the next batch
+func (k Keeper) SendToEthFeeSuggestion(
+	c context.Context,
+	req *types.QuerySendToEthFeeSuggestionRequest) (*types.QuerySendToEthFeeSuggestionResponse, error) {
+	ctx := k.queryContext(c)
+	_, tokenContract, err := k.DenomToERC20Lookup(ctx, req.Denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return k.SuggestSendToEthFee(ctx, *tokenContract), nil
+}
+
+// FeeExemptSenders returns the Cosmos addresses exempt from the fees charged on transfers to Ethereum
+func (k Keeper) FeeExemptSenders(
+	c context.Context,
+	req *types.QueryFeeExemptSendersRequest) (*types.QueryFeeExemptSendersResponse, error) {
+	return &types.QueryFeeExemptSendersResponse{Senders: k.GetParams(k.queryContext(c)).FeeExemptSenders}, nil
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//       INVARIANTS        //
+/////////////////////////////
+
+// RegisterInvariants registers all gravity module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrowed-funds", EscrowedFundsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the gravity module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return EscrowedFundsInvariant(k)(ctx)
+	}
+}
+
+// EscrowedFundsInvariant checks that the module account holds enough of every cosmos originated token to pay out
+// the unbatched transfers, the unexecuted batches and the quarantined deposits of it. Sending a cosmos originated
+// token to Ethereum locks it in the module account while Ethereum originated vouchers are burned, so a refund or
+// batch that takes the wrong path shows up here. The escrow also backs the tokens already bridged to Ethereum, it may hold more than is owed
+// but never less. This is the same check the solvency report makes for cosmos originated tokens
+func EscrowedFundsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		for _, token := range k.GetSolvencyReport(ctx) {
+			if !token.CosmosOriginated || token.Discrepancy == "" {
+				continue
+			}
+			broken = true
+			msg += fmt.Sprintf("\t%s (%s): escrow %s, unbatched %s, batched %s, quarantined %s, %s\n", token.Denom,
+				token.TokenContract, token.EscrowBalance, token.PoolAmount, token.BatchedAmount, token.QuarantinedAmount,
+				token.Discrepancy)
+		}
+		return sdk.FormatInvariant(types.ModuleName, "escrowed funds",
+			fmt.Sprintf("cosmos originated tokens escrowed for pending transfers to Ethereum are missing:\n%s", msg)), broken
+	}
+}
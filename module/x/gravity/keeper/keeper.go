@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 
@@ -28,6 +29,21 @@ type Keeper struct {
 	AttestationHandler interface {
 		Handle(sdk.Context, types.Attestation, types.EthereumClaim) error
 	}
+
+	// PriceFeed values outgoing transfers for the OutflowUsdLimit, it is optional while the limit is disabled and
+	// the limit can not be set without it.
+	// Batches are only checked against their estimated relay gas if it also prices wei
+	PriceFeed types.PriceFeed
+
+	// BatchProfitability replaces the built in check of whether a batch is worth building, it is optional
+	BatchProfitability types.BatchProfitability
+
+	// claimHandlers holds the handlers of the claim types added with RegisterClaimHandler, it is shared by all
+	// copies of the keeper
+	claimHandlers map[types.ClaimType]ClaimHandler
+
+	// gravityHooks holds the hooks set with SetHooks, it is shared by all copies of the keeper
+	gravityHooks *types.MultiGravityHooks
 }
 
 // NewKeeper returns a new instance of the gravity keeper
@@ -45,15 +61,27 @@ func NewKeeper(cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, paramSpace para
 		bankKeeper:         bankKeeper,
 		SlashingKeeper:     slashingKeeper,
 		AttestationHandler: nil,
+		PriceFeed:          nil,
+		BatchProfitability: nil,
+		claimHandlers:      make(map[types.ClaimType]ClaimHandler),
+		gravityHooks:       &types.MultiGravityHooks{},
 	}
-	k.AttestationHandler = AttestationHandler{
-		keeper:     k,
-		bankKeeper: bankKeeper,
-	}
+	k.AttestationHandler = newAttestationHandler(k, bankKeeper)
 
 	return k
 }
 
+// SetHooks sets the hooks other modules react to the applied Ethereum events with. The hooks are shared by all copies
+// of the keeper, the attestation handler that was created with the keeper calls them as well. Setting them twice
+// panics, this is meant to be called once while the app is wired up
+func (k *Keeper) SetHooks(hooks ...types.GravityHooks) *Keeper {
+	if len(*k.gravityHooks) != 0 {
+		panic("cannot set gravity hooks twice")
+	}
+	*k.gravityHooks = types.NewMultiGravityHooks(hooks...)
+	return k
+}
+
 /////////////////////////////
 //       PARAMETERS        //
 /////////////////////////////
@@ -69,6 +97,22 @@ func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) {
 	k.paramSpace.SetParamSet(ctx, &ps)
 }
 
+// GetMinimalEvents returns whether the untyped events that duplicate a typed event are left out
+func (k Keeper) GetMinimalEvents(ctx sdk.Context) bool {
+	var a bool
+	k.paramSpace.Get(ctx, types.ParamStoreMinimalEvents, &a)
+	return a
+}
+
+// emitLegacyEvent emits a deprecated untyped event that a typed event of the same state change duplicates,
+// unless the MinimalEvents param leaves those out
+func (k Keeper) emitLegacyEvent(ctx sdk.Context, event sdk.Event) {
+	if k.GetMinimalEvents(ctx) {
+		return
+	}
+	ctx.EventManager().EmitEvent(event)
+}
+
 // GetBridgeContractAddress returns the bridge contract address on ETH
 func (k Keeper) GetBridgeContractAddress(ctx sdk.Context) *types.EthAddress {
 	var a string
@@ -280,6 +324,39 @@ func prefixRange(prefix []byte) ([]byte, []byte) {
 	return prefix, end
 }
 
+// IterationLimit bounds the entries an iteration visits, the zero value visits every entry. Query handlers use it
+// to page through a store instead of collecting everything and truncating
+type IterationLimit struct {
+	// StartAfter resumes the iteration after this key, it should be a key previously passed to the callback
+	StartAfter []byte
+	// MaxResults stops the iteration once this many entries were visited, zero means no limit
+	MaxResults uint64
+}
+
+// bounds narrows the (start, end) range of an iteration in the given direction to the keys after StartAfter
+func (l IterationLimit) bounds(start, end []byte, reverse bool) ([]byte, []byte) {
+	if l.StartAfter == nil {
+		return start, end
+	}
+	if reverse {
+		if end == nil || bytes.Compare(l.StartAfter, end) < 0 {
+			end = l.StartAfter
+		}
+		return start, end
+	}
+	// the smallest key sorting after StartAfter
+	next := append(append([]byte{}, l.StartAfter...), 0x00)
+	if start == nil || bytes.Compare(next, start) > 0 {
+		start = next
+	}
+	return start, end
+}
+
+// reached returns true once visited entries fill MaxResults
+func (l IterationLimit) reached(visited uint64) bool {
+	return l.MaxResults != 0 && visited >= l.MaxResults
+}
+
 // DeserializeValidatorIterator returns validators from the validator iterator.
 // Adding here in gravity keeper as cdc is not available inside endblocker.
 func (k Keeper) DeserializeValidatorIterator(vals []byte) stakingtypes.ValAddresses {
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//        AUDIT LOG        //
+/////////////////////////////
+
+// appendAuditLog records a change governance made to the bridge by passing p, with a description of the state it
+// changed before and after. Entries are never modified or removed
+func (k Keeper) appendAuditLog(ctx sdk.Context, p govtypes.Content, before string, after string) {
+	entry := types.AuditLogEntry{
+		Id:            k.autoIncrementID(ctx, types.KeyLastAuditLogID),
+		Height:        uint64(ctx.BlockHeight()),
+		Time:          uint64(ctx.BlockTime().Unix()),
+		ProposalType:  p.ProposalType(),
+		ProposalTitle: p.GetTitle(),
+		Before:        before,
+		After:         after,
+	}
+	k.setAuditLogEntry(ctx, entry)
+}
+
+func (k Keeper) setAuditLogEntry(ctx sdk.Context, entry types.AuditLogEntry) {
+	ctx.KVStore(k.storeKey).Set(types.GetAuditLogKey(entry.Id), k.cdc.MustMarshalBinaryBare(&entry))
+}
+
+// GetAuditLog returns a page of the audit log, oldest entry first
+func (k Keeper) GetAuditLog(ctx sdk.Context, pageReq *query.PageRequest) ([]types.AuditLogEntry, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuditLogKey)
+	var entries []types.AuditLogEntry
+	pageRes, err := query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
+		var entry types.AuditLogEntry
+		if err := k.cdc.UnmarshalBinaryBare(value, &entry); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return entries, pageRes, nil
+}
+
+// GetAllAuditLogEntries returns the whole audit log, useful for genesis save/load
+func (k Keeper) GetAllAuditLogEntries(ctx sdk.Context) (out []types.AuditLogEntry) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuditLogKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var entry types.AuditLogEntry
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &entry)
+		out = append(out, entry)
+	}
+	return
+}
+
+// importAuditLog restores the audit log of an exported chain, new entries continue after its last id
+func (k Keeper) importAuditLog(ctx sdk.Context, entries []types.AuditLogEntry) {
+	var lastID uint64
+	for _, entry := range entries {
+		k.setAuditLogEntry(ctx, entry)
+		if entry.Id > lastID {
+			lastID = entry.Id
+		}
+	}
+	if lastID > 0 {
+		ctx.KVStore(k.storeKey).Set(types.KeyLastAuditLogID, sdk.Uint64ToBigEndian(lastID+1))
+	}
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//     BATCH LIFECYCLE     //
+/////////////////////////////
+
+// getBatchLifecycle describes batch as it stands in the store, its signed power is counted in the last valset
+// observed on Ethereum and left at 0 until there is one
+func (k Keeper) getBatchLifecycle(ctx sdk.Context, batch *types.InternalOutgoingTxBatch) types.BatchLifecycle {
+	ret := types.BatchLifecycle{
+		TokenContract: batch.TokenContract.GetAddress(),
+		BatchNonce:    batch.BatchNonce,
+		Status:        types.BATCH_LIFECYCLE_STATUS_PENDING,
+		CreatedHeight: batch.Block,
+		BatchTimeout:  batch.BatchTimeout,
+		Confirms:      uint64(len(k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract))),
+	}
+	if signatures, err := k.GetBatchRelaySignatures(ctx, batch.TokenContract, batch.BatchNonce); err == nil {
+		ret.SignedPower, ret.PowerThreshold = signatures.SignedPower, signatures.PowerThreshold
+	}
+	return ret
+}
+
+// recordFinishedBatch keeps the lifecycle of batch, which is about to leave the store with status. The Ethereum
+// height is only known for an executed batch
+func (k Keeper) recordFinishedBatch(ctx sdk.Context, batch *types.InternalOutgoingTxBatch, status types.BatchLifecycleStatus, executionEthereumHeight uint64) {
+	lifecycle := k.getBatchLifecycle(ctx, batch)
+	lifecycle.Status = status
+	lifecycle.FinishedHeight = uint64(ctx.BlockHeight())
+	lifecycle.ExecutionEthereumHeight = executionEthereumHeight
+	ctx.KVStore(k.storeKey).Set(types.GetFinishedBatchKey(batch.BatchNonce), k.cdc.MustMarshalBinaryBare(&lifecycle))
+}
+
+// GetBatchLifecycle returns the lifecycle of the batch of tokenContract at nonce, live for a batch still in the store
+// and as recorded when it left the store otherwise. It returns false for a batch that never existed or whose record
+// was pruned
+func (k Keeper) GetBatchLifecycle(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) (types.BatchLifecycle, bool) {
+	if batch := k.GetOutgoingTXBatch(ctx, tokenContract, nonce); batch != nil {
+		return k.getBatchLifecycle(ctx, batch), true
+	}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFinishedBatchKey(nonce))
+	if bz == nil {
+		return types.BatchLifecycle{}, false
+	}
+	var lifecycle types.BatchLifecycle
+	k.cdc.MustUnmarshalBinaryBare(bz, &lifecycle)
+	// batch nonces are shared by all tokens, the nonce may belong to a batch of another token
+	if lifecycle.TokenContract != tokenContract.GetAddress() {
+		return types.BatchLifecycle{}, false
+	}
+	return lifecycle, true
+}
+
+// PruneFinishedBatches drops the lifecycle records of batches with a nonce more than keep below the latest batch nonce
+func (k Keeper) PruneFinishedBatches(ctx sdk.Context, keep uint64) {
+	k.pruneBatchRecords(ctx, types.FinishedBatchKey, types.GetFinishedBatchKey, keep)
+}
@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//   BATCH PROFITABILITY   //
+/////////////////////////////
+
+// GetEthereumGasPriceReports returns the heartbeats of all currently bonded validators that reported an Ethereum
+// gas price or base fee within the last DefaultMaxHeartbeatAge blocks, sorted by descending gas price, along with
+// the power of each reporter keyed by validator address
+func (k Keeper) GetEthereumGasPriceReports(ctx sdk.Context) ([]*types.OrchestratorHeartbeat, map[string]int64) {
+	height := uint64(ctx.BlockHeight())
+	var reports []*types.OrchestratorHeartbeat
+	powers := make(map[string]int64)
+	for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		heartbeat := k.GetOrchestratorHeartbeat(ctx, val.GetOperator())
+		if heartbeat == nil || (heartbeat.EthGasPrice == 0 && heartbeat.EthBaseFee == 0) ||
+			height-heartbeat.CosmosBlockHeight > DefaultMaxHeartbeatAge {
+			continue
+		}
+		reports = append(reports, heartbeat)
+		powers[heartbeat.Validator] = k.StakingKeeper.GetLastValidatorPower(ctx, val.GetOperator())
+	}
+	sort.SliceStable(reports, func(i, j int) bool {
+		return reports[i].EthGasPrice > reports[j].EthGasPrice
+	})
+	return reports, powers
+}
+
+// medianEthereumGasReport returns the power weighted median of the value the recent gas price reports give, that
+// is the highest value which validators holding more than half of the total power have reported at least. value
+// returns false for a report that does not give one. Returns false if the validators that gave one do not hold
+// more than half of the power
+func (k Keeper) medianEthereumGasReport(ctx sdk.Context, value func(*types.OrchestratorHeartbeat) (uint64, bool)) (uint64, bool) {
+	totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
+	if !totalPower.IsPositive() {
+		return 0, false
+	}
+
+	type weightedValue struct {
+		value uint64
+		power int64
+	}
+	reports, powers := k.GetEthereumGasPriceReports(ctx)
+	var values []weightedValue
+	for _, report := range reports {
+		if v, ok := value(report); ok {
+			values = append(values, weightedValue{v, powers[report.Validator]})
+		}
+	}
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].value > values[j].value
+	})
+	power := sdk.ZeroInt()
+	for _, v := range values {
+		power = power.Add(sdk.NewInt(v.power))
+		if power.MulRaw(2).GT(totalPower) {
+			return v.value, true
+		}
+	}
+	return 0, false
+}
+
+// GetMedianEthereumGasPrice returns the power weighted median of the Ethereum gas prices, in wei, reported by the
+// bonded validators, that is the highest gas price which validators holding more than half of the total power
+// have reported at least. Returns false if the validators that recently reported one do not hold more than half
+// of the power
+func (k Keeper) GetMedianEthereumGasPrice(ctx sdk.Context) (uint64, bool) {
+	return k.medianEthereumGasReport(ctx, func(report *types.OrchestratorHeartbeat) (uint64, bool) {
+		return report.EthGasPrice, report.EthGasPrice != 0
+	})
+}
+
+// GetMedianEthereumFees returns the power weighted medians of the Ethereum base fee and priority fee, in wei,
+// reported by the bonded validators for type-2 transactions. The priority fee is taken from the reports that
+// came with a base fee, a zero tip being a valid one. Returns false if the validators that recently reported a
+// base fee do not hold more than half of the power
+func (k Keeper) GetMedianEthereumFees(ctx sdk.Context) (baseFee uint64, priorityFee uint64, ok bool) {
+	baseFee, ok = k.medianEthereumGasReport(ctx, func(report *types.OrchestratorHeartbeat) (uint64, bool) {
+		return report.EthBaseFee, report.EthBaseFee != 0
+	})
+	if !ok {
+		return 0, 0, false
+	}
+	priorityFee, ok = k.medianEthereumGasReport(ctx, func(report *types.OrchestratorHeartbeat) (uint64, bool) {
+		return report.EthPriorityFee, report.EthBaseFee != 0
+	})
+	return baseFee, priorityFee, ok
+}
+
+// GetEthereumRelayGasPrice returns the gas price in wei the relay cost of batches is estimated with. A relayer
+// sending a type-2 transaction pays the base fee plus its tip, so once enough validators report the fees their
+// medians are added up. The median of the flat gas prices, which tends to overstate the cost since London, is
+// only used until then. Returns false if neither was reported by validators holding more than half of the power
+func (k Keeper) GetEthereumRelayGasPrice(ctx sdk.Context) (uint64, bool) {
+	if baseFee, priorityFee, ok := k.GetMedianEthereumFees(ctx); ok {
+		return baseFee + priorityFee, true
+	}
+	return k.GetMedianEthereumGasPrice(ctx)
+}
+
+// EstimateBatchRelayGas returns the Ethereum gas a batch of txCount transfers is estimated to cost its relayer
+func (k Keeper) EstimateBatchRelayGas(ctx sdk.Context, txCount uint64) uint64 {
+	params := k.GetParams(ctx)
+	return params.BatchGasOverhead + params.BatchGasPerTx*txCount
+}
+
+// checkBatchProfitable returns an error if a batch of tokenContract paying fees should not be built. The
+// BatchProfitability of the keeper decides if one is set. Otherwise the fees must be worth more in USD than the
+// estimated relay gas at the gas price from GetEthereumRelayGasPrice. Without the gas params, a gas price or a USD
+// price for both the token and wei the batch is only built if its fees are not lower than those of the last
+// unexecuted batch of the token, so that the few high fee transfers coming in every block can build up rather
+// than ending up in a stream of batches nobody relays
+func (k Keeper) checkBatchProfitable(ctx sdk.Context, tokenContract types.EthAddress, fees types.BatchFees) error {
+	if k.BatchProfitability != nil {
+		return k.BatchProfitability.CheckBatchProfitable(ctx, tokenContract, fees)
+	}
+
+	params := k.GetParams(ctx)
+	if params.BatchGasOverhead != 0 || params.BatchGasPerTx != 0 {
+		if cost, value, ok := k.valueBatchRelay(ctx, tokenContract, fees); ok {
+			// an empty pool builds no batch, there is nothing to reject
+			if fees.TxCount == 0 || value.GT(cost) {
+				return nil
+			}
+			return sdkerrors.Wrapf(types.ErrBatchNotProfitable, "fees worth %s USD, relaying %d transfers costs %s USD",
+				value, fees.TxCount, cost)
+		}
+	}
+
+	lastBatch := k.GetLastOutgoingBatchByTokenType(ctx, tokenContract)
+	if lastBatch == nil {
+		return nil
+	}
+	if lastFees := lastBatch.ToExternal().GetFees(); lastFees.GT(fees.TotalFees) {
+		return sdkerrors.Wrap(types.ErrBatchNotProfitable, "new batch would not be more profitable")
+	}
+	return nil
+}
+
+// minBatchFeesToRelay returns the lowest total fees a batch of tokenContract carrying txCount transfers needs for
+// checkBatchProfitable to let it be built, following the same rules. It returns false if a BatchProfitability is
+// set, whose rules are not known
+func (k Keeper) minBatchFeesToRelay(ctx sdk.Context, tokenContract types.EthAddress, txCount uint64) (sdk.Int, bool) {
+	if k.BatchProfitability != nil {
+		return sdk.ZeroInt(), false
+	}
+
+	params := k.GetParams(ctx)
+	if params.BatchGasOverhead != 0 || params.BatchGasPerTx != 0 {
+		// the value of a single unit of the token is its USD price
+		if cost, price, ok := k.valueBatchRelay(ctx, tokenContract, types.BatchFees{TxCount: txCount, TotalFees: sdk.OneInt()}); ok && price.IsPositive() {
+			// the fees have to be worth strictly more than the cost
+			return cost.Quo(price).TruncateInt().AddRaw(1), true
+		}
+	}
+
+	lastBatch := k.GetLastOutgoingBatchByTokenType(ctx, tokenContract)
+	if lastBatch == nil {
+		return sdk.ZeroInt(), true
+	}
+	return lastBatch.ToExternal().GetFees(), true
+}
+
+// valueBatchRelay returns the USD cost of relaying a batch of tokenContract with fees and the USD value of the
+// fees, ok is false if either can not be priced
+func (k Keeper) valueBatchRelay(ctx sdk.Context, tokenContract types.EthAddress, fees types.BatchFees) (cost sdk.Dec, value sdk.Dec, ok bool) {
+	if k.PriceFeed == nil {
+		return sdk.Dec{}, sdk.Dec{}, false
+	}
+	gasPrice, ok := k.GetEthereumRelayGasPrice(ctx)
+	if !ok {
+		return sdk.Dec{}, sdk.Dec{}, false
+	}
+	weiPrice, ok := k.PriceFeed.GetUSDPrice(ctx, types.WeiPriceDenom)
+	if !ok || weiPrice.IsNil() || weiPrice.IsNegative() {
+		return sdk.Dec{}, sdk.Dec{}, false
+	}
+	_, denom := k.ERC20ToDenomLookup(ctx, tokenContract)
+	tokenPrice, ok := k.PriceFeed.GetUSDPrice(ctx, denom)
+	if !ok || tokenPrice.IsNil() || tokenPrice.IsNegative() {
+		return sdk.Dec{}, sdk.Dec{}, false
+	}
+
+	gas := sdk.NewIntFromUint64(k.EstimateBatchRelayGas(ctx, fees.TxCount))
+	cost = weiPrice.MulInt(gas.Mul(sdk.NewIntFromUint64(gasPrice)))
+	value = tokenPrice.MulInt(fees.TotalFees)
+	return cost, value, true
+}
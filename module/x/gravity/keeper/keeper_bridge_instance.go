@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//     BRIDGE INSTANCE     //
+/////////////////////////////
+
+// startBridgeInstance starts a new bridge instance when the chain is initialized from genesis. A chain relaunched
+// from an export carries the instance it was exported from as previous, the new id is derived from it, the chain id
+// and the genesis time so that no two runs share one. Everything imported from the export was produced for the
+// previous instance: claims up to the last observed event nonce and confirms for anything created before this block
+func (k Keeper) startBridgeInstance(ctx sdk.Context, previous *types.BridgeInstance, lastObservedNonce uint64) {
+	instance := types.BridgeInstance{
+		Id:              "",
+		PreviousId:      "",
+		StartEventNonce: lastObservedNonce,
+		StartHeight:     uint64(ctx.BlockHeight()),
+	}
+	if previous != nil {
+		instance.PreviousId = previous.Id
+	}
+	seed := fmt.Sprintf("%s/%s/%d", instance.PreviousId, ctx.ChainID(), ctx.BlockTime().UnixNano())
+	instance.Id = hex.EncodeToString(tmhash.Sum([]byte(seed)))
+	k.setBridgeInstance(ctx, instance)
+}
+
+// GetBridgeInstance returns the bridge instance the chain is running
+func (k Keeper) GetBridgeInstance(ctx sdk.Context) types.BridgeInstance {
+	var instance types.BridgeInstance
+	bz := ctx.KVStore(k.storeKey).Get(types.BridgeInstanceKey)
+	if len(bz) == 0 {
+		return instance
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &instance)
+	return instance
+}
+
+func (k Keeper) setBridgeInstance(ctx sdk.Context, instance types.BridgeInstance) {
+	ctx.KVStore(k.storeKey).Set(types.BridgeInstanceKey, k.cdc.MustMarshalBinaryBare(&instance))
+}
+
+// HandleBridgeInstanceResetProposal makes the chain accept the claims and confirms produced for the previous
+// bridge instance again, the instance keeps its id
+func (k Keeper) HandleBridgeInstanceResetProposal(ctx sdk.Context, p *types.BridgeInstanceResetProposal) error {
+	instance := k.GetBridgeInstance(ctx)
+	before := fmt.Sprintf("instance %s started after event nonce %d at block %d", instance.Id, instance.StartEventNonce, instance.StartHeight)
+	instance.StartEventNonce = 0
+	instance.StartHeight = 0
+	k.setBridgeInstance(ctx, instance)
+	k.appendAuditLog(ctx, p, before, fmt.Sprintf("instance %s started after event nonce 0 at block 0", instance.Id))
+
+	k.emitLegacyEvent(ctx,
+		sdk.NewEvent(
+			types.EventTypeBridgeInstanceReset,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyBridgeInstanceID, instance.Id),
+		),
+	)
+	return ctx.EventManager().EmitTypedEvent(&types.EventBridgeInstanceReset{InstanceId: instance.Id})
+}
+
+// checkBridgeInstanceClaim refuses a claim at an event nonce that was already observed by the previous instance
+func (k Keeper) checkBridgeInstanceClaim(ctx sdk.Context, claim types.EthereumClaim) error {
+	instance := k.GetBridgeInstance(ctx)
+	if claim.GetEventNonce() <= instance.StartEventNonce {
+		return sdkerrors.Wrapf(types.ErrBridgeInstanceReplay, "claim at event nonce %d, instance %s started after event nonce %d",
+			claim.GetEventNonce(), instance.Id, instance.StartEventNonce)
+	}
+	return nil
+}
+
+// checkBridgeInstanceConfirm refuses a confirm for a valset, batch or logic call created at a height before the
+// instance started, its signatures belong to the previous instance
+func (k Keeper) checkBridgeInstanceConfirm(ctx sdk.Context, created uint64) error {
+	instance := k.GetBridgeInstance(ctx)
+	if created < instance.StartHeight {
+		return sdkerrors.Wrapf(types.ErrBridgeInstanceReplay, "confirm for block %d, instance %s started at block %d",
+			created, instance.Id, instance.StartHeight)
+	}
+	return nil
+}
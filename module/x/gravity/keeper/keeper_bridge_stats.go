@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//      BRIDGE STATS       //
+/////////////////////////////
+
+const (
+	// BridgeStatsBucketSeconds is the span of block time covered by a single bucket of bridge statistics
+	BridgeStatsBucketSeconds = 60 * 60
+	// BridgeStatsDayBuckets is the number of buckets summed for the daily statistics
+	BridgeStatsDayBuckets = 24
+	// BridgeStatsWeekBuckets is the number of buckets summed for the weekly statistics, older buckets are pruned
+	BridgeStatsWeekBuckets = 7 * 24
+)
+
+// bridgeStatsHour returns the bucket the current block falls into
+func bridgeStatsHour(ctx sdk.Context) uint64 {
+	unix := ctx.BlockTime().Unix()
+	if unix < 0 {
+		return 0
+	}
+	return uint64(unix) / BridgeStatsBucketSeconds
+}
+
+// recordBridgeDeposit counts a deposit from Ethereum in the current bucket
+func (k Keeper) recordBridgeDeposit(ctx sdk.Context, tokenContract types.EthAddress, sender string, amount sdk.Int) {
+	stats := k.getBridgeTokenStats(ctx, bridgeStatsHour(ctx), tokenContract)
+	stats.Deposits++
+	stats.DepositVolume = stats.DepositVolume.Add(amount)
+	k.setBridgeTokenStats(ctx, bridgeStatsHour(ctx), tokenContract, stats)
+	k.recordBridgeSender(ctx, tokenContract, sender)
+}
+
+// recordBridgeWithdrawal counts a withdrawal to Ethereum in the current bucket
+func (k Keeper) recordBridgeWithdrawal(ctx sdk.Context, tokenContract types.EthAddress, sender string, amount sdk.Int) {
+	stats := k.getBridgeTokenStats(ctx, bridgeStatsHour(ctx), tokenContract)
+	stats.Withdrawals++
+	stats.WithdrawalVolume = stats.WithdrawalVolume.Add(amount)
+	k.setBridgeTokenStats(ctx, bridgeStatsHour(ctx), tokenContract, stats)
+	k.recordBridgeSender(ctx, tokenContract, sender)
+}
+
+// recordBridgeSender marks the sender as active in the current bucket, both overall and for the token
+func (k Keeper) recordBridgeSender(ctx sdk.Context, tokenContract types.EthAddress, sender string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBridgeStatsSenderKey(bridgeStatsHour(ctx), sender), []byte{0x1})
+	store.Set(types.GetBridgeStatsTokenSenderKey(bridgeStatsHour(ctx), tokenContract, sender), []byte{0x1})
+}
+
+func (k Keeper) getBridgeTokenStats(ctx sdk.Context, hour uint64, tokenContract types.EthAddress) types.BridgeTokenStats {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetBridgeStatsKey(hour, tokenContract))
+	if len(bz) == 0 {
+		return types.BridgeTokenStats{
+			TokenContract:    tokenContract.GetAddress(),
+			Deposits:         0,
+			DepositVolume:    sdk.ZeroInt(),
+			Withdrawals:      0,
+			WithdrawalVolume: sdk.ZeroInt(),
+			UniqueSenders:    0,
+		}
+	}
+	var stats types.BridgeTokenStats
+	k.cdc.MustUnmarshalBinaryBare(bz, &stats)
+	return stats
+}
+
+func (k Keeper) setBridgeTokenStats(ctx sdk.Context, hour uint64, tokenContract types.EthAddress, stats types.BridgeTokenStats) {
+	ctx.KVStore(k.storeKey).Set(types.GetBridgeStatsKey(hour, tokenContract), k.cdc.MustMarshalBinaryBare(&stats))
+}
+
+// GetBridgeStats sums the bridge statistics of the last buckets hourly buckets, including the current one
+func (k Keeper) GetBridgeStats(ctx sdk.Context, buckets uint64) types.BridgeStatsWindow {
+	store := ctx.KVStore(k.storeKey)
+	window := types.BridgeStatsWindow{
+		WindowSeconds: buckets * BridgeStatsBucketSeconds,
+		Deposits:      0,
+		Withdrawals:   0,
+		UniqueSenders: 0,
+		Tokens:        nil,
+	}
+	var start uint64
+	if hour := bridgeStatsHour(ctx); hour+1 > buckets {
+		start = hour + 1 - buckets
+	}
+
+	tokens := make(map[string]*types.BridgeTokenStats)
+	_, end := prefixRange(types.BridgeStatsKey)
+	iter := store.Iterator(types.GetBridgeStatsPrefix(start), end)
+	for ; iter.Valid(); iter.Next() {
+		var stats types.BridgeTokenStats
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &stats)
+		window.Deposits += stats.Deposits
+		window.Withdrawals += stats.Withdrawals
+		total, ok := tokens[stats.TokenContract]
+		if !ok {
+			tokens[stats.TokenContract] = &stats
+			continue
+		}
+		total.Deposits += stats.Deposits
+		total.DepositVolume = total.DepositVolume.Add(stats.DepositVolume)
+		total.Withdrawals += stats.Withdrawals
+		total.WithdrawalVolume = total.WithdrawalVolume.Add(stats.WithdrawalVolume)
+	}
+	iter.Close()
+
+	tokenSenders := make(map[string]map[string]struct{})
+	prefixLen := len(types.GetBridgeStatsTokenSenderPrefix(0))
+	_, end = prefixRange(types.BridgeStatsTokenSenderKey)
+	iter = store.Iterator(types.GetBridgeStatsTokenSenderPrefix(start), end)
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[prefixLen:]
+		token := string(key[:types.ETHContractAddressLen])
+		if tokenSenders[token] == nil {
+			tokenSenders[token] = make(map[string]struct{})
+		}
+		tokenSenders[token][string(key[types.ETHContractAddressLen:])] = struct{}{}
+	}
+	iter.Close()
+
+	for _, stats := range tokens {
+		stats.UniqueSenders = uint64(len(tokenSenders[stats.TokenContract]))
+		window.Tokens = append(window.Tokens, *stats)
+	}
+	sort.Slice(window.Tokens, func(i, j int) bool {
+		return window.Tokens[i].TokenContract < window.Tokens[j].TokenContract
+	})
+
+	senders := make(map[string]struct{})
+	prefixLen = len(types.GetBridgeStatsSenderPrefix(0))
+	_, end = prefixRange(types.BridgeStatsSenderKey)
+	iter = store.Iterator(types.GetBridgeStatsSenderPrefix(start), end)
+	for ; iter.Valid(); iter.Next() {
+		senders[string(iter.Key()[prefixLen:])] = struct{}{}
+	}
+	iter.Close()
+	window.UniqueSenders = uint64(len(senders))
+
+	return window
+}
+
+// PruneBridgeStats removes the buckets that have fallen out of the weekly window
+func (k Keeper) PruneBridgeStats(ctx sdk.Context) {
+	hour := bridgeStatsHour(ctx)
+	if hour < BridgeStatsWeekBuckets {
+		return
+	}
+	cutoff := hour + 1 - BridgeStatsWeekBuckets
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	for _, r := range [][2][]byte{
+		{types.BridgeStatsKey, types.GetBridgeStatsPrefix(cutoff)},
+		{types.BridgeStatsSenderKey, types.GetBridgeStatsSenderPrefix(cutoff)},
+		{types.BridgeStatsTokenSenderKey, types.GetBridgeStatsTokenSenderPrefix(cutoff)},
+	} {
+		iter := store.Iterator(r[0], r[1])
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, append([]byte{}, iter.Key()...))
+		}
+		iter.Close()
+	}
+	k.deletePrunedKeys(ctx, keys)
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//      BRIDGE STATUS      //
+/////////////////////////////
+
+// GetBridgeStatus returns an overview of the bridge: how far the module observed Ethereum, the valsets still to be
+// relayed, the outgoing traffic of every token and the balances of the module account
+func (k Keeper) GetBridgeStatus(ctx sdk.Context) types.QueryBridgeStatusResponse {
+	ret := types.QueryBridgeStatusResponse{
+		LastObservedEthereumHeight: k.GetLastObservedEthereumBlockHeight(ctx),
+		LastObservedEventNonce:     k.GetLastObservedEventNonce(ctx),
+		LatestValsetNonce:          k.GetLatestValsetNonce(ctx),
+		UnrelayedValsets:           []types.Valset{},
+		Tokens:                     []types.TokenBridgeStatus{},
+		ModuleBalances:             k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)),
+	}
+	if observed := k.GetLastObservedValset(ctx); observed != nil {
+		ret.LastObservedValsetNonce = observed.Nonce
+	}
+	// valsets are sorted newest first
+	for _, valset := range k.GetValsets(ctx) {
+		if valset.Nonce <= ret.LastObservedValsetNonce || len(ret.UnrelayedValsets) >= maxValsetRequestsReturned {
+			break
+		}
+		ret.UnrelayedValsets = append(ret.UnrelayedValsets, *valset)
+	}
+
+	tokens := make(map[string]*types.TokenBridgeStatus)
+	token := func(contract types.EthAddress) *types.TokenBridgeStatus {
+		status, ok := tokens[contract.GetAddress()]
+		if !ok {
+			status = &types.TokenBridgeStatus{TokenContract: contract.GetAddress()}
+			tokens[contract.GetAddress()] = status
+		}
+		return status
+	}
+	for _, batch := range k.GetOutgoingTxBatches(ctx) {
+		token(batch.TokenContract).PendingBatches++
+	}
+	for _, tx := range k.GetUnbatchedTransactions(ctx) {
+		token(tx.Erc20Token.Contract).UnbatchedTxs++
+		ret.PoolDepth++
+	}
+	for _, status := range tokens {
+		ret.Tokens = append(ret.Tokens, *status)
+	}
+	sort.Slice(ret.Tokens, func(i, j int) bool { return ret.Tokens[i].TokenContract < ret.Tokens[j].TokenContract })
+	return ret
+}
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//   CALLBACK TRANSFERS    //
+/////////////////////////////
+
+// SendToEthWithCallback creates a transfer to Ethereum that delivers amount to target and then calls its receiver
+// hook, onTokenTransfer(beneficiary, amount, data), in the same Ethereum transaction. The batch format is fixed by
+// the Gravity contract, so rather than entering the pool the transfer is relayed on its own as a logic call paying
+// fee to its relayer. Returns the id of the transfer
+// - takes the amount and fee from the sender the same way AddToOutgoingPool does
+// - requires the fee to be at least the MinSendToEthFees of the token
+// - charges the sender CallbackDataByteFee for every byte of data
+// - persists the transfer so it can be refunded if the logic call times out
+func (k Keeper) SendToEthWithCallback(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	beneficiary types.EthAddress,
+	target types.EthAddress,
+	data []byte,
+	amount sdk.Coin,
+	fee sdk.Coin,
+) (uint64, error) {
+	if ctx.IsZero() || sender.Empty() || beneficiary.ValidateBasic() != nil || target.ValidateBasic() != nil ||
+		!amount.IsValid() || !fee.IsValid() || fee.Denom != amount.Denom {
+		return 0, sdkerrors.Wrap(types.ErrInvalid, "arguments")
+	}
+	if k.IsBridgeMigrating(ctx) {
+		return 0, sdkerrors.Wrap(types.ErrBridgeMigrating, "outgoing transfers are frozen")
+	}
+	params := k.GetParams(ctx)
+	if params.MaxCallbackDataSize == 0 {
+		return 0, sdkerrors.Wrap(types.ErrInvalid, "transfers with a callback are disabled")
+	}
+	if uint64(len(data)) > params.MaxCallbackDataSize {
+		return 0, sdkerrors.Wrapf(types.ErrInvalid, "callback data of %d bytes, the maximum is %d", len(data), params.MaxCallbackDataSize)
+	}
+	// a logic call could otherwise pay a relayer for a typo, there is no pool to hold it in until the sender confirms
+	if multiple := params.FeeConfirmationMultiple; multiple > 0 &&
+		fee.Amount.GT(amount.Amount.Mul(sdk.NewIntFromUint64(multiple))) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalid, "fee more than %d times the amount", multiple)
+	}
+
+	isCosmosOriginated, tokenContract, err := k.DenomToERC20Lookup(ctx, amount.Denom)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.checkMinSendToEthFee(ctx, sender, *tokenContract, fee.Amount); err != nil {
+		return 0, err
+	}
+	// the hook is called from the Gravity contract, it must not be pointed back at the contract or at the token,
+	// addresses are compared regardless of their checksum casing
+	if strings.EqualFold(target.GetAddress(), k.GetBridgeContractAddress(ctx).GetAddress()) ||
+		strings.EqualFold(target.GetAddress(), tokenContract.GetAddress()) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalid, "callback target %s", target.GetAddress())
+	}
+	totalAmount := amount.Add(fee)
+	outflow, err := k.checkOutflowLimit(ctx, totalAmount)
+	if err != nil {
+		return 0, err
+	}
+
+	if byteFee := params.CallbackDataByteFee; !byteFee.Amount.IsNil() && byteFee.IsPositive() && len(data) > 0 &&
+		!k.IsFeeExemptSender(ctx, sender) {
+		charge := sdk.NewCoin(byteFee.Denom, byteFee.Amount.MulRaw(int64(len(data))))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, authtypes.FeeCollectorName, sdk.Coins{charge}); err != nil {
+			return 0, sdkerrors.Wrap(err, "callback data fee")
+		}
+	}
+
+	// lock or burn the amount and fee the same way as a transfer in the pool
+	totalInVouchers := sdk.Coins{totalAmount}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, totalInVouchers); err != nil {
+		return 0, err
+	}
+	if !isCosmosOriginated {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, totalInVouchers); err != nil {
+			panic(err)
+		}
+	}
+
+	// the transfer shares its ids with the pool so that its refund receipt can not collide with theirs
+	nextID := k.autoIncrementID(ctx, types.KeyLastTXPoolID)
+	k.recordOutflow(ctx, nextID, outflow)
+	erc20Fee, err := types.NewInternalERC20Token(fee.Amount, tokenContract.GetAddress())
+	if err != nil {
+		return 0, sdkerrors.Wrapf(err, "invalid Erc20Fee from amount %d and contract %v", fee.Amount, tokenContract)
+	}
+	erc20Token, err := types.NewInternalERC20Token(amount.Amount, tokenContract.GetAddress())
+	if err != nil {
+		return 0, sdkerrors.Wrapf(err, "invalid ERC20Token from amount %d and contract %v", amount.Amount, tokenContract)
+	}
+	transfer := types.OutgoingTransferTx{
+		Id:            nextID,
+		Sender:        sender.String(),
+		DestAddress:   beneficiary.GetAddress(),
+		Erc20Token:    erc20Token.ToExternal(),
+		Erc20Fee:      erc20Fee.ToExternal(),
+		CreatedHeight: uint64(ctx.BlockHeight()),
+	}
+
+	call := &types.OutgoingLogicCall{
+		Transfers:            []*types.ERC20Token{erc20Token.ToExternal()},
+		Fees:                 []*types.ERC20Token{erc20Fee.ToExternal()},
+		LogicContractAddress: target.GetAddress(),
+		Payload:              types.EncodeCallbackPayload(beneficiary, amount.Amount, data),
+		Timeout:              k.GetOutgoingTimeoutHeight(ctx),
+		InvalidationId:       types.GetCallbackInvalidationID(nextID),
+		InvalidationNonce:    1,
+		Block:                uint64(ctx.BlockHeight()),
+	}
+	k.SetOutgoingLogicCall(ctx, call)
+	k.setCallbackTransfer(ctx, call.InvalidationId, transfer)
+
+	k.emitLegacyEvent(ctx, sdk.NewEvent(
+		types.EventTypeBridgeWithdrawalCallback,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, strconv.Itoa(int(nextID))),
+		sdk.NewAttribute(types.AttributeKeyInvalidationID, hex.EncodeToString(call.InvalidationId)),
+		sdk.NewAttribute(types.AttributeKeyCallbackTarget, target.GetAddress()),
+		sdk.NewAttribute(types.AttributeKeyLogicCallTimeout, fmt.Sprint(call.Timeout)),
+	))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSendToEthCallback{
+		TxId:           nextID,
+		InvalidationId: hex.EncodeToString(call.InvalidationId),
+		Target:         target.GetAddress(),
+		Timeout:        call.Timeout,
+	}); err != nil {
+		return 0, err
+	}
+	return nextID, nil
+}
+
+func (k Keeper) setCallbackTransfer(ctx sdk.Context, invalidationID []byte, transfer types.OutgoingTransferTx) {
+	ctx.KVStore(k.storeKey).Set(types.GetCallbackTransferKey(invalidationID), k.cdc.MustMarshalBinaryBare(&transfer))
+}
+
+// GetCallbackTransfer returns the transfer with a callback delivered by the logic call with invalidationID, nil if
+// there is none
+func (k Keeper) GetCallbackTransfer(ctx sdk.Context, invalidationID []byte) *types.InternalOutgoingTransferTx {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCallbackTransferKey(invalidationID))
+	if bz == nil {
+		return nil
+	}
+	var transfer types.OutgoingTransferTx
+	k.cdc.MustUnmarshalBinaryBare(bz, &transfer)
+	tx, err := transfer.ToInternal()
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid callback transfer in store: %v", transfer))
+	}
+	return tx
+}
+
+// GetAllCallbackTransfers returns the transfers with a callback whose logic calls are still outstanding, useful for
+// genesis save/load
+func (k Keeper) GetAllCallbackTransfers(ctx sdk.Context) (out []*types.OutgoingTransferTx) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.CallbackTransferKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var transfer types.OutgoingTransferTx
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &transfer)
+		out = append(out, &transfer)
+	}
+	return
+}
+
+// refundCallbackTransfer pays the transfer delivered by the canceled logic call with invalidationID back to its
+// sender, if the logic call delivered one. The refund is all or nothing so that a failure leaves the transfer to
+// be refunded later
+func (k Keeper) refundCallbackTransfer(ctx sdk.Context, invalidationID []byte) error {
+	tx := k.GetCallbackTransfer(ctx, invalidationID)
+	if tx == nil {
+		return nil
+	}
+	xCtx, commit := ctx.CacheContext()
+	xCtx.KVStore(k.storeKey).Delete(types.GetCallbackTransferKey(invalidationID))
+	if err := k.reissueRefund(xCtx, tx, types.REFUND_REASON_CALLBACK_TIMED_OUT); err != nil {
+		return sdkerrors.Wrapf(err, "refund callback transfer %d", tx.Id)
+	}
+	commit()
+	return nil
+}
+
+// OutgoingLogicCallExecuted is run when the Cosmos chain detects that a logic call has been executed on Ethereum.
+// The logic call and its confirms are removed, as is the transfer with a callback it delivered so it is never
+// refunded
+func (k Keeper) OutgoingLogicCallExecuted(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) {
+	for _, confirm := range k.GetLogicConfirmByInvalidationIDAndNonce(ctx, invalidationID, invalidationNonce) {
+		orchestrator, err := sdk.AccAddressFromBech32(confirm.Orchestrator)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid logic call confirm in store: %v", confirm))
+		}
+		k.DeleteLogicCallConfirm(ctx, invalidationID, invalidationNonce, orchestrator)
+	}
+	k.DeleteOutgoingLogicCall(ctx, invalidationID, invalidationNonce)
+	if tx := k.GetCallbackTransfer(ctx, invalidationID); tx != nil {
+		k.forgetOutflow(ctx, tx.Id)
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetCallbackTransferKey(invalidationID))
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//        CLAIM AGE        //
+/////////////////////////////
+
+// getOldestClaimEventNonce returns the lowest event nonce a claim may be for, which is MaxClaimAge nonces behind
+// the last observed event nonce. Returns 0 if claims of any age are accepted
+func (k Keeper) getOldestClaimEventNonce(ctx sdk.Context) uint64 {
+	maxAge := k.GetParams(ctx).MaxClaimAge
+	lastObserved := k.GetLastObservedEventNonce(ctx)
+	if maxAge == 0 || lastObserved <= maxAge {
+		return 0
+	}
+	return lastObserved - maxAge
+}
+
+// checkClaimAge refuses a claim more than MaxClaimAge event nonces behind the last observed event nonce. Such an
+// event was observed long ago, an orchestrator replaying it, for example after restoring an old snapshot, would
+// only leave an attestation behind that can never be observed
+func (k Keeper) checkClaimAge(ctx sdk.Context, claim types.EthereumClaim) error {
+	if oldest := k.getOldestClaimEventNonce(ctx); claim.GetEventNonce() < oldest {
+		return sdkerrors.Wrapf(types.ErrClaimTooOld, "claim at event nonce %d, the oldest accepted is %d",
+			claim.GetEventNonce(), oldest)
+	}
+	return nil
+}
+
+// GetResumeEventNonce returns the event nonce the next claim of validator has to follow. This is the last event
+// nonce it claimed, unless that is older than MaxClaimAge allows, a validator that fell that far behind resumes
+// right before the oldest event nonce a claim may still be for rather than being locked out
+func (k Keeper) GetResumeEventNonce(ctx sdk.Context, validator sdk.ValAddress) uint64 {
+	last := k.GetLastEventNonceByValidator(ctx, validator)
+	if oldest := k.getOldestClaimEventNonce(ctx); oldest > 0 && last < oldest-1 {
+		return oldest - 1
+	}
+	return last
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//     CLAIM SLASHING      //
+/////////////////////////////
+
+// SetLastSlashedClaimEventNonce sets the last event nonce checked for validators that did not claim it
+func (k Keeper) SetLastSlashedClaimEventNonce(ctx sdk.Context, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastSlashedClaimEventNonce, types.UInt64Bytes(nonce))
+}
+
+// GetLastSlashedClaimEventNonce returns the last event nonce checked for validators that did not claim it
+func (k Keeper) GetLastSlashedClaimEventNonce(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.LastSlashedClaimEventNonce)
+
+	if len(bytes) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bytes)
+}
+
+// GetUnSlashedClaimNonces returns the event nonces after the last one checked for missing claims, up to the last
+// observed event nonce, that still have attestations in the store. Pruned event nonces are skipped
+func (k Keeper) GetUnSlashedClaimNonces(ctx sdk.Context) (out []uint64) {
+	lastSlashed := k.GetLastSlashedClaimEventNonce(ctx)
+	lastObserved := k.GetLastObservedEventNonce(ctx)
+	if lastSlashed >= lastObserved {
+		return nil
+	}
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.OracleAttestationKey
+	start := append(append([]byte{}, prefix...), types.UInt64Bytes(lastSlashed+1)...)
+	end := append(append([]byte{}, prefix...), types.UInt64Bytes(lastObserved+1)...)
+	iter := store.Iterator(start, end)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		nonce := types.UInt64FromBytes(iter.Key()[len(prefix) : len(prefix)+8])
+		// several attestations may be stored under one event nonce
+		if len(out) == 0 || out[len(out)-1] != nonce {
+			out = append(out, nonce)
+		}
+	}
+	return out
+}
+
+// GetClaimVoters returns the observed attestation of eventNonce and every validator that submitted a claim at
+// that event nonce, whether for the observed claim or a conflicting one. The attestation is nil if none was observed
+func (k Keeper) GetClaimVoters(ctx sdk.Context, eventNonce uint64) (*types.Attestation, map[string]bool) {
+	var observed *types.Attestation
+	voters := make(map[string]bool)
+	k.IterateAttestationsByNonce(ctx, eventNonce, func(_ []byte, att types.Attestation) bool {
+		if att.Observed {
+			observed = &att
+		}
+		for _, validator := range att.Votes {
+			voters[validator] = true
+		}
+		return false
+	})
+	return observed, voters
+}
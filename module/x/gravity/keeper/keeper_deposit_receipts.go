@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//     DEPOSIT RECEIPTS    //
+/////////////////////////////
+
+// setDepositReceipt records the deposit from Ethereum of claim, paid out to receiver in denom. The receipt keeps
+// both the msg.sender of the deposit and the address the tokens came from, so that a deposit a contract made on
+// behalf of a user can still be attributed to that user. It is indexed by height as well so it can be pruned once
+// DepositReceiptRetention blocks have passed
+func (k Keeper) setDepositReceipt(ctx sdk.Context, claim *types.MsgSendToCosmosClaim, receiver sdk.AccAddress, denom string) types.DepositReceipt {
+	receipt := newDepositReceipt(ctx, claim, receiver, denom)
+	k.storeDepositReceipt(ctx, receiver, receipt)
+	return receipt
+}
+
+// newDepositReceipt describes the deposit of claim to receiver in denom, made in the current block
+func newDepositReceipt(ctx sdk.Context, claim *types.MsgSendToCosmosClaim, receiver sdk.AccAddress, denom string) types.DepositReceipt {
+	return types.DepositReceipt{
+		EventNonce:               claim.EventNonce,
+		EthereumSender:           claim.EthereumSender,
+		TokenSender:              claim.TokenSender,
+		CosmosReceiver:           receiver.String(),
+		TokenContract:            claim.TokenContract,
+		Amount:                   sdk.NewCoin(denom, claim.Amount),
+		EthBlockHeight:           claim.BlockHeight,
+		DepositHeight:            uint64(ctx.BlockHeight()),
+		DepositTime:              uint64(ctx.BlockTime().Unix()),
+		EthereumSenderIsContract: claim.EthereumSenderIsContract,
+	}
+}
+
+func (k Keeper) storeDepositReceipt(ctx sdk.Context, receiver sdk.AccAddress, receipt types.DepositReceipt) {
+	key := types.GetDepositReceiptKey(receiver, receipt.EventNonce)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&receipt))
+	store.Set(types.GetDepositReceiptHeightKey(receipt.DepositHeight, receipt.EventNonce), key)
+}
+
+// GetDepositReceipt returns the receipt of the deposit at eventNonce paid out to receiver, if it is still kept
+func (k Keeper) GetDepositReceipt(ctx sdk.Context, receiver sdk.AccAddress, eventNonce uint64) *types.DepositReceipt {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDepositReceiptKey(receiver, eventNonce))
+	if bz == nil {
+		return nil
+	}
+	var receipt types.DepositReceipt
+	k.cdc.MustUnmarshalBinaryBare(bz, &receipt)
+	return &receipt
+}
+
+// GetDepositReceipts returns a page of the deposit receipts of receiver in event nonce order
+func (k Keeper) GetDepositReceipts(ctx sdk.Context, receiver sdk.AccAddress, pageReq *query.PageRequest) ([]types.DepositReceipt, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetDepositReceiptPrefix(receiver))
+	var receipts []types.DepositReceipt
+	pageRes, err := query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
+		var receipt types.DepositReceipt
+		if err := k.cdc.UnmarshalBinaryBare(value, &receipt); err != nil {
+			return err
+		}
+		receipts = append(receipts, receipt)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return receipts, pageRes, nil
+}
+
+// PruneDepositReceipts removes the receipts of deposits paid out more than DepositReceiptRetention blocks ago
+func (k Keeper) PruneDepositReceipts(ctx sdk.Context) {
+	retention := k.GetParams(ctx).DepositReceiptRetention
+	height := uint64(ctx.BlockHeight())
+	if retention == 0 || height <= retention {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	iter := store.Iterator(types.DepositReceiptHeightKey, types.GetDepositReceiptHeightKey(height-retention, 0))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, append([]byte{}, iter.Key()...), append([]byte{}, iter.Value()...))
+	}
+	iter.Close()
+	// the receipt goes before its height index entry, a receipt whose deletion ran out of gas is found again
+	for i := 0; i < len(keys); i += 2 {
+		k.deletePrunedKeys(ctx, [][]byte{keys[i+1], keys[i]})
+	}
+}
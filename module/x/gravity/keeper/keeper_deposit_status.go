@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//      DEPOSIT STATUS     //
+/////////////////////////////
+
+// setDepositEthTxHash indexes the event nonce of claim by the hash of the Ethereum transaction it reports, claims
+// from orchestrators that do not report the hash are not indexed
+func (k Keeper) setDepositEthTxHash(ctx sdk.Context, claim *types.MsgSendToCosmosClaim) {
+	if claim.EthTxHash == "" {
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetDepositEthTxHashKey(claim.EthTxHash), types.UInt64Bytes(claim.EventNonce))
+}
+
+// deleteDepositEthTxHash removes the index of claim, as long as it still points at the event nonce of claim
+func (k Keeper) deleteDepositEthTxHash(ctx sdk.Context, claim *types.MsgSendToCosmosClaim) {
+	if claim.EthTxHash == "" {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetDepositEthTxHashKey(claim.EthTxHash)
+	if bz := store.Get(key); bz != nil && types.UInt64FromBytes(bz) == claim.EventNonce {
+		store.Delete(key)
+	}
+}
+
+// GetDepositByEthTxHash returns the status of the deposit made by the Ethereum transaction txHash, along with its
+// attestation and its receipt once it was paid out or quarantined. Of several attestations for the event of the
+// deposit the observed one is preferred. It returns false if no attestation reporting the hash is left
+func (k Keeper) GetDepositByEthTxHash(ctx sdk.Context, txHash string) (*types.QueryDepositByEthTxHashResponse, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDepositEthTxHashKey(txHash))
+	if bz == nil {
+		return nil, false
+	}
+	eventNonce := types.UInt64FromBytes(bz)
+
+	var (
+		att   *types.Attestation
+		claim *types.MsgSendToCosmosClaim
+	)
+	k.IterateAttestationsByNonce(ctx, eventNonce, func(_ []byte, a types.Attestation) bool {
+		c, err := k.UnpackAttestationClaim(&a)
+		if err != nil {
+			panic("couldn't cast to claim")
+		}
+		deposit, ok := c.(*types.MsgSendToCosmosClaim)
+		if !ok || !strings.EqualFold(deposit.EthTxHash, txHash) {
+			return false
+		}
+		att, claim = &a, deposit
+		return a.Observed
+	})
+	if att == nil {
+		return nil, false
+	}
+
+	ret := &types.QueryDepositByEthTxHashResponse{Status: types.DEPOSIT_STATUS_PENDING, Attestation: att}
+	if !att.Observed {
+		return ret, true
+	}
+	ret.Status = types.DEPOSIT_STATUS_OBSERVED
+	if quarantined := k.GetQuarantinedDeposit(ctx, eventNonce); quarantined != nil {
+		ret.Status, ret.Receipt = types.DEPOSIT_STATUS_QUARANTINED, quarantined
+		return ret, true
+	}
+	receiver, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
+	if err != nil {
+		return ret, true
+	}
+	if receipt := k.GetDepositReceipt(ctx, receiver, eventNonce); receipt != nil {
+		ret.Status, ret.Receipt = types.DEPOSIT_STATUS_MINTED, receipt
+	}
+	return ret, true
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+// ETH DESTINATION LABELS  //
+/////////////////////////////
+
+// SetEthDestinationLabel adds a labeled Ethereum destination to the address book of owner, replacing the address
+// of an existing label
+func (k Keeper) SetEthDestinationLabel(ctx sdk.Context, owner sdk.AccAddress, label string, ethAddress types.EthAddress) {
+	entry := types.EthDestinationLabel{
+		Owner:      owner.String(),
+		Label:      label,
+		EthAddress: ethAddress.GetAddress(),
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetEthDestinationLabelKey(owner, label), k.cdc.MustMarshalBinaryBare(&entry))
+}
+
+// DeleteEthDestinationLabel removes a label from the address book of owner
+func (k Keeper) DeleteEthDestinationLabel(ctx sdk.Context, owner sdk.AccAddress, label string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetEthDestinationLabelKey(owner, label))
+}
+
+// GetEthDestinationLabel returns the Ethereum destination owner labeled label, or an error if there is none so
+// that a mistyped label is never sent to
+func (k Keeper) GetEthDestinationLabel(ctx sdk.Context, owner sdk.AccAddress, label string) (*types.EthAddress, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetEthDestinationLabelKey(owner, label))
+	if len(bz) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "destination label %q of %s", label, owner)
+	}
+	var entry types.EthDestinationLabel
+	k.cdc.MustUnmarshalBinaryBare(bz, &entry)
+	return types.NewEthAddress(entry.EthAddress)
+}
+
+// GetEthDestinationLabels returns a page of the address book of owner in label order
+func (k Keeper) GetEthDestinationLabels(ctx sdk.Context, owner sdk.AccAddress, pageReq *query.PageRequest) ([]types.EthDestinationLabel, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEthDestinationLabelPrefix(owner))
+	var labels []types.EthDestinationLabel
+	pageRes, err := query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
+		var entry types.EthDestinationLabel
+		if err := k.cdc.UnmarshalBinaryBare(value, &entry); err != nil {
+			return err
+		}
+		labels = append(labels, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return labels, pageRes, nil
+}
+
+// GetAllEthDestinationLabels returns the address books of every account, useful for genesis save/load
+func (k Keeper) GetAllEthDestinationLabels(ctx sdk.Context) (out []types.EthDestinationLabel) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.EthDestinationLabelKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var entry types.EthDestinationLabel
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &entry)
+		out = append(out, entry)
+	}
+	return
+}
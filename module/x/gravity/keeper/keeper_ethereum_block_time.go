@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// MinEthereumBlockTimeCalibrationBlocks is the minimum number of Ethereum blocks a calibration window must
+// span before it is used, shorter windows are too noisy to derive an average block time from
+const MinEthereumBlockTimeCalibrationBlocks uint64 = 100
+
+/////////////////////////////
+// ETHEREUM BLOCK TIME CAL //
+/////////////////////////////
+
+// GetEthereumBlockTimeCalibration returns the state of the Ethereum block time calibration
+func (k Keeper) GetEthereumBlockTimeCalibration(ctx sdk.Context) types.EthereumBlockTimeCalibration {
+	var calibration types.EthereumBlockTimeCalibration
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EthereumBlockTimeCalibrationKey)
+	if bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &calibration)
+	}
+	return calibration
+}
+
+func (k Keeper) setEthereumBlockTimeCalibration(ctx sdk.Context, calibration types.EthereumBlockTimeCalibration) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EthereumBlockTimeCalibrationKey, k.cdc.MustMarshalBinaryBare(&calibration))
+}
+
+// RecordEthereumBlockTimeSample adds an observed Ethereum (height, unix timestamp) pair to the current
+// calibration window, samples that do not move the window forward in both height and time are ignored
+func (k Keeper) RecordEthereumBlockTimeSample(ctx sdk.Context, ethereumHeight uint64, ethereumTime uint64) {
+	if ethereumHeight == 0 || ethereumTime == 0 {
+		return
+	}
+	calibration := k.GetEthereumBlockTimeCalibration(ctx)
+	switch {
+	case calibration.AnchorEthereumHeight == 0:
+		calibration.AnchorEthereumHeight = ethereumHeight
+		calibration.AnchorEthereumTime = ethereumTime
+		calibration.LatestEthereumHeight = ethereumHeight
+		calibration.LatestEthereumTime = ethereumTime
+	case ethereumHeight > calibration.LatestEthereumHeight && ethereumTime >= calibration.LatestEthereumTime:
+		calibration.LatestEthereumHeight = ethereumHeight
+		calibration.LatestEthereumTime = ethereumTime
+	default:
+		return
+	}
+	k.setEthereumBlockTimeCalibration(ctx, calibration)
+}
+
+// GetAverageEthereumBlockTime returns the Ethereum block time in milliseconds to project Ethereum heights with,
+// the calibrated block time once there is one and the AverageEthereumBlockTime param until then or while the
+// recalibration is disabled
+func (k Keeper) GetAverageEthereumBlockTime(ctx sdk.Context) uint64 {
+	params := k.GetParams(ctx)
+	if params.EthereumBlockTimeCalibrationPeriod == 0 {
+		return params.AverageEthereumBlockTime
+	}
+	if calibrated := k.GetEthereumBlockTimeCalibration(ctx).CalibratedBlockTime; calibrated != 0 {
+		return calibrated
+	}
+	return params.AverageEthereumBlockTime
+}
+
+// CalibrateEthereumBlockTime runs every EndBlock, once per calibration period it stores the average block time
+// across the current calibration window as the calibrated block time and starts a new window from the latest
+// sample. Windows spanning too few blocks are kept open until the next period. The AverageEthereumBlockTime
+// param is left as governance set it
+func (k Keeper) CalibrateEthereumBlockTime(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.EthereumBlockTimeCalibrationPeriod == 0 {
+		return
+	}
+	calibration := k.GetEthereumBlockTimeCalibration(ctx)
+	currentHeight := uint64(ctx.BlockHeight())
+	if currentHeight < calibration.LastCalibrationHeight+params.EthereumBlockTimeCalibrationPeriod {
+		return
+	}
+	calibration.LastCalibrationHeight = currentHeight
+
+	blocks := calibration.LatestEthereumHeight - calibration.AnchorEthereumHeight
+	if blocks >= MinEthereumBlockTimeCalibrationBlocks {
+		blockTime := (calibration.LatestEthereumTime - calibration.AnchorEthereumTime) * 1000 / blocks
+		// the calibrated block time has to be one the param could be set to
+		params.AverageEthereumBlockTime = blockTime
+		if err := params.ValidateBasic(); err != nil {
+			k.logger(ctx).Error("ignoring Ethereum block time calibration", "block_time", blockTime, "error", err)
+		} else {
+			calibration.CalibratedBlockTime = blockTime
+		}
+		calibration.AnchorEthereumHeight = calibration.LatestEthereumHeight
+		calibration.AnchorEthereumTime = calibration.LatestEthereumTime
+	}
+	k.setEthereumBlockTimeCalibration(ctx, calibration)
+}
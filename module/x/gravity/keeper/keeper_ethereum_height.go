@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//  ETHEREUM HEIGHT VOTES  //
+/////////////////////////////
+
+// SetEthereumHeightVote records the Ethereum height reported by a validators orchestrator, reports
+// lower than the validators previous vote are ignored as Ethereum heights only move forward
+func (k Keeper) SetEthereumHeightVote(ctx sdk.Context, validator sdk.ValAddress, ethereumHeight uint64) {
+	if ethereumHeight == 0 {
+		return
+	}
+	if prev := k.GetEthereumHeightVote(ctx, validator); prev != nil && prev.EthereumBlockHeight >= ethereumHeight {
+		return
+	}
+	vote := types.EthereumHeightVote{
+		Validator:           validator.String(),
+		EthereumBlockHeight: ethereumHeight,
+		CosmosBlockHeight:   uint64(ctx.BlockHeight()),
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEthereumHeightVoteKey(validator), k.cdc.MustMarshalBinaryBare(&vote))
+}
+
+// GetEthereumHeightVote returns the latest Ethereum height reported by a validator, or nil if it never reported one
+func (k Keeper) GetEthereumHeightVote(ctx sdk.Context, validator sdk.ValAddress) *types.EthereumHeightVote {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEthereumHeightVoteKey(validator))
+	if bz == nil {
+		return nil
+	}
+	var vote types.EthereumHeightVote
+	k.cdc.MustUnmarshalBinaryBare(bz, &vote)
+	return &vote
+}
+
+// recordClaimEthereumHeight records the Ethereum height of a claim as a vote of the claimers validator
+func (k Keeper) recordClaimEthereumHeight(ctx sdk.Context, claim types.EthereumClaim) {
+	validator, found := k.GetOrchestratorValidator(ctx, claim.GetClaimer())
+	if !found {
+		return
+	}
+	k.SetEthereumHeightVote(ctx, validator.GetOperator(), claim.GetBlockHeight())
+}
+
+// GetBondedEthereumHeightVotes returns the votes of all currently bonded validators that have reported a
+// height sorted by descending Ethereum height, along with the power of each voter keyed by validator address
+func (k Keeper) GetBondedEthereumHeightVotes(ctx sdk.Context) ([]*types.EthereumHeightVote, map[string]int64) {
+	var votes []*types.EthereumHeightVote
+	powers := make(map[string]int64)
+	for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		vote := k.GetEthereumHeightVote(ctx, val.GetOperator())
+		if vote == nil {
+			continue
+		}
+		votes = append(votes, vote)
+		powers[vote.Validator] = k.StakingKeeper.GetLastValidatorPower(ctx, val.GetOperator())
+	}
+	sort.SliceStable(votes, func(i, j int) bool {
+		return votes[i].EthereumBlockHeight > votes[j].EthereumBlockHeight
+	})
+	return votes, powers
+}
+
+// GetMedianEthereumHeight returns the power weighted median of the Ethereum heights reported by the bonded
+// validators, that is the highest height which validators holding more than half of the total power have
+// reached. Returns false if the validators that reported at all do not hold more than half of the power
+func (k Keeper) GetMedianEthereumHeight(ctx sdk.Context) (uint64, bool) {
+	totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
+	if !totalPower.IsPositive() {
+		return 0, false
+	}
+
+	votes, powers := k.GetBondedEthereumHeightVotes(ctx)
+	power := sdk.ZeroInt()
+	for _, vote := range votes {
+		power = power.Add(sdk.NewInt(powers[vote.Validator]))
+		if power.MulRaw(2).GT(totalPower) {
+			return vote.EthereumBlockHeight, true
+		}
+	}
+	return 0, false
+}
+
+// UpdateObservedEthereumHeight replaces the last observed Ethereum height with the median of the
+// validators votes, it never moves the observed height backwards
+func (k Keeper) UpdateObservedEthereumHeight(ctx sdk.Context) {
+	median, ok := k.GetMedianEthereumHeight(ctx)
+	if !ok {
+		return
+	}
+	if median > k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight {
+		k.SetLastObservedEthereumBlockHeight(ctx, median)
+	}
+}
+
+// ethereumHeightLag returns how many Ethereum blocks height is behind median
+func ethereumHeightLag(median, height uint64) uint64 {
+	if median > height {
+		return median - height
+	}
+	return 0
+}
+
+// GetEthereumHeightLags returns how far the latest Ethereum height reported by each bonded validator is behind
+// median, in the order of GetBondedEthereumHeightVotes
+func (k Keeper) GetEthereumHeightLags(ctx sdk.Context, median uint64) []types.EthereumHeightLag {
+	threshold := k.GetParams(ctx).EthereumHeightDriftThreshold
+	votes, _ := k.GetBondedEthereumHeightVotes(ctx)
+	lags := make([]types.EthereumHeightLag, 0, len(votes))
+	for _, vote := range votes {
+		lag := ethereumHeightLag(median, vote.EthereumBlockHeight)
+		lags = append(lags, types.EthereumHeightLag{
+			Validator: vote.Validator,
+			Lag:       lag,
+			Drifting:  threshold != 0 && lag > threshold,
+		})
+	}
+	return lags
+}
+
+// CheckEthereumHeightDrift emits an EventEthereumHeightDrift for every bonded validator whose reported Ethereum
+// height fell more than the EthereumHeightDriftThreshold param behind the median. A drifting validator is marked
+// so that the event is emitted once, the mark is cleared when the validator catches up or is no longer bonded
+func (k Keeper) CheckEthereumHeightDrift(ctx sdk.Context) {
+	median, ok := k.GetMedianEthereumHeight(ctx)
+	if !ok {
+		return
+	}
+	threshold := k.GetParams(ctx).EthereumHeightDriftThreshold
+	votes, _ := k.GetBondedEthereumHeightVotes(ctx)
+	drifting := make(map[string]bool)
+	for _, vote := range votes {
+		if threshold != 0 && ethereumHeightLag(median, vote.EthereumBlockHeight) > threshold {
+			drifting[vote.Validator] = true
+		}
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EthereumHeightDriftKey)
+	marked := make(map[string]bool)
+	var caughtUp [][]byte
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		validator := sdk.ValAddress(iter.Key()).String()
+		if drifting[validator] {
+			marked[validator] = true
+		} else {
+			caughtUp = append(caughtUp, iter.Key())
+		}
+	}
+	iter.Close()
+	for _, key := range caughtUp {
+		store.Delete(key)
+	}
+
+	for _, vote := range votes {
+		if !drifting[vote.Validator] || marked[vote.Validator] {
+			continue
+		}
+		valAddr, err := sdk.ValAddressFromBech32(vote.Validator)
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "invalid validator in ethereum height vote"))
+		}
+		ctx.KVStore(k.storeKey).Set(types.GetEthereumHeightDriftKey(valAddr), []byte{0x1})
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventEthereumHeightDrift{
+			Validator:           vote.Validator,
+			EthereumBlockHeight: vote.EthereumBlockHeight,
+			MedianHeight:        median,
+			Lag:                 ethereumHeightLag(median, vote.EthereumBlockHeight),
+		}); err != nil {
+			panic(sdkerrors.Wrap(err, "emit ethereum height drift event"))
+		}
+	}
+}
+
+// GetProjectedEthereumHeight extrapolates the observed Ethereum height to the current block using the time that
+// has passed since it was observed and the Ethereum block time from GetAverageEthereumBlockTime. This is the
+// height outgoing timeouts are based on and is served to relayers by the ProjectedEthereumHeight query so that
+// both agree on it. It must never be used to time out batches or logic calls, only the observed height is safe
+// for that
+func (k Keeper) GetProjectedEthereumHeight(ctx sdk.Context) uint64 {
+	observed := k.GetLastObservedEthereumBlockHeight(ctx)
+	if observed.EthereumBlockHeight == 0 {
+		return 0
+	}
+	params := k.GetParams(ctx)
+
+	var elapsedMillis uint64
+	currentTime := uint64(ctx.BlockTime().UnixNano() / int64(time.Millisecond))
+	currentHeight := uint64(ctx.BlockHeight())
+	switch {
+	case observed.CosmosBlockTime != 0:
+		if currentTime > observed.CosmosBlockTime {
+			elapsedMillis = currentTime - observed.CosmosBlockTime
+		}
+	case currentHeight > observed.CosmosBlockHeight:
+		// heights stored before the block time was recorded are projected from the Cosmos block count
+		elapsedMillis = (currentHeight - observed.CosmosBlockHeight) * params.AverageBlockTime
+	}
+	return observed.EthereumBlockHeight + elapsedMillis/k.GetAverageEthereumBlockTime(ctx)
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//     EVENT NONCE GAP     //
+/////////////////////////////
+
+// GetEventNonceGap returns the event nonce after the last observed one, which has to reach attestation consensus
+// before any later event can be observed, along with how long its attestations have been waiting for it. The pending
+// event nonce is 0 while no orchestrator has claimed it
+func (k Keeper) GetEventNonceGap(ctx sdk.Context) types.QueryEventNonceGapResponse {
+	ret := types.QueryEventNonceGapResponse{LastObservedEventNonce: k.GetLastObservedEventNonce(ctx)}
+	next := ret.LastObservedEventNonce + 1
+	k.IterateAttestationsByNonce(ctx, next, func(_ []byte, att types.Attestation) bool {
+		// a vetoed attestation is not waiting for votes
+		if att.Vetoed {
+			return false
+		}
+		ret.Attestations++
+		if ret.FirstClaimHeight == 0 || att.Height < ret.FirstClaimHeight {
+			ret.FirstClaimHeight = att.Height
+		}
+		return false
+	})
+	if ret.Attestations == 0 {
+		return ret
+	}
+	ret.PendingEventNonce = next
+	if height := uint64(ctx.BlockHeight()); height > ret.FirstClaimHeight {
+		ret.PendingBlocks = height - ret.FirstClaimHeight
+	}
+	threshold := k.GetParams(ctx).EventNonceStallThreshold
+	ret.Stalled = threshold != 0 && ret.PendingBlocks > threshold
+	return ret
+}
+
+// CheckEventNonceStall emits an EventNonceStalled once the event nonce after the last observed one has gone more
+// than EventNonceStallThreshold blocks without reaching consensus, so operators are warned before the bridge halts.
+// It is emitted once per event nonce
+func (k Keeper) CheckEventNonceStall(ctx sdk.Context) {
+	gap := k.GetEventNonceGap(ctx)
+	if !gap.Stalled {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.LastStalledEventNonceKey); bz != nil && types.UInt64FromBytes(bz) == gap.PendingEventNonce {
+		return
+	}
+	store.Set(types.LastStalledEventNonceKey, types.UInt64Bytes(gap.PendingEventNonce))
+	k.logger(ctx).Error("event nonce stalled",
+		"event nonce", gap.PendingEventNonce,
+		"pending blocks", gap.PendingBlocks,
+	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNonceStalled{
+		EventNonce:       gap.PendingEventNonce,
+		FirstClaimHeight: gap.FirstClaimHeight,
+		PendingBlocks:    gap.PendingBlocks,
+	}); err != nil {
+		panic(sdkerrors.Wrap(err, "emit event nonce stalled event"))
+	}
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//     FEE SUGGESTION      //
+/////////////////////////////
+
+// SuggestSendToEthFee returns the lowest fee a transfer of tokenContract sent right now should pay to be picked by
+// the next batch of the token and for that batch to be built, along with that batch as it stands and the number of
+// transfers waiting in the pool. The transfer has to outbid the lowest fee of a full batch, transfers of a higher
+// priority class are picked first whatever their fee, so the suggestion is only an estimate for the default
+// priority. The fee is never below the MinSendToEthFees of the token
+func (k Keeper) SuggestSendToEthFee(ctx sdk.Context, tokenContract types.EthAddress) *types.QuerySendToEthFeeSuggestionResponse {
+	maxElements := k.GetMaxBatchSize(ctx, tokenContract)
+	height := uint64(ctx.BlockHeight())
+	var fees []sdk.Int
+	var depth uint64
+	k.IterateUnbatchedTransactionsByContract(ctx, tokenContract, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+		if tx.IsHeld(height) {
+			return false
+		}
+		depth++
+		if len(fees) < int(maxElements) {
+			fees = append(fees, tx.Erc20Fee.Amount)
+		}
+		return false
+	})
+	next := newBatchFees(tokenContract.GetAddress(), fees)
+
+	suggested := k.GetMinSendToEthFee(ctx, tokenContract)
+	// the fees the rest of the batch pays once the transfer made it in
+	others, txCount := next.TotalFees, next.TxCount+1
+	if next.TxCount >= uint64(maxElements) {
+		suggested = sdk.MaxInt(suggested, next.MinFee.AddRaw(1))
+		others, txCount = next.TotalFees.Sub(next.MinFee), next.TxCount
+	}
+	if needed, ok := k.minBatchFeesToRelay(ctx, tokenContract, txCount); ok && needed.GT(others) {
+		suggested = sdk.MaxInt(suggested, needed.Sub(others))
+	}
+
+	return &types.QuerySendToEthFeeSuggestionResponse{
+		TokenContract: tokenContract.GetAddress(),
+		SuggestedFee:  suggested,
+		NextBatch:     *next,
+		PoolDepth:     depth,
+		MaxBatchSize:  uint64(maxElements),
+	}
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//    FIRST SEND DELAY     //
+/////////////////////////////
+
+// SetFirstSendDelay holds the sends of account to Ethereum destinations it has not sent to before for delayBlocks
+// blocks. A delay of zero opts the account out and forgets the destinations it has sent to
+func (k Keeper) SetFirstSendDelay(ctx sdk.Context, account sdk.AccAddress, delayBlocks uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if delayBlocks > 0 {
+		store.Set(types.GetFirstSendDelayKey(account), types.UInt64Bytes(delayBlocks))
+		return
+	}
+	store.Delete(types.GetFirstSendDelayKey(account))
+	known := prefix.NewStore(store, types.GetKnownEthDestinationPrefix(account))
+	var keys [][]byte
+	iter := known.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+	for _, key := range keys {
+		known.Delete(key)
+	}
+}
+
+// GetFirstSendDelay returns the number of blocks the sends of account to a new Ethereum destination are held,
+// zero if it has not opted in
+func (k Keeper) GetFirstSendDelay(ctx sdk.Context, account sdk.AccAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFirstSendDelayKey(account))
+	if len(bz) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bz)
+}
+
+// firstSendHeldUntil returns the height before which a send of sender to dest must be held out of batches, zero
+// if sender has no first send delay or has sent to dest before
+func (k Keeper) firstSendHeldUntil(ctx sdk.Context, sender sdk.AccAddress, dest types.EthAddress) uint64 {
+	delay := k.GetFirstSendDelay(ctx, sender)
+	if delay == 0 || ctx.KVStore(k.storeKey).Has(types.GetKnownEthDestinationKey(sender, dest)) {
+		return 0
+	}
+	return uint64(ctx.BlockHeight()) + delay
+}
+
+// recordEthDestination remembers that sender has sent to dest once one of its transfers is batched, only for
+// accounts with a first send delay
+func (k Keeper) recordEthDestination(ctx sdk.Context, sender sdk.AccAddress, dest types.EthAddress) {
+	if k.GetFirstSendDelay(ctx, sender) == 0 {
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetKnownEthDestinationKey(sender, dest), []byte{1})
+}
+
+// GetKnownEthDestinations returns a page of the Ethereum destinations account has sent to since setting its first
+// send delay, in lexicographic order
+func (k Keeper) GetKnownEthDestinations(ctx sdk.Context, account sdk.AccAddress, pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKnownEthDestinationPrefix(account))
+	var dests []string
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, _ []byte) error {
+		dests = append(dests, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return dests, pageRes, nil
+}
+
+// GetAllFirstSendDelays returns the first send delay and known destinations of every account that opted in,
+// useful for genesis save/load
+func (k Keeper) GetAllFirstSendDelays(ctx sdk.Context) (out []types.FirstSendDelay) {
+	store := ctx.KVStore(k.storeKey)
+	iter := prefix.NewStore(store, types.FirstSendDelayKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// the key is the length prefixed account
+		account := sdk.AccAddress(iter.Key()[1:])
+		entry := types.FirstSendDelay{
+			Account:              account.String(),
+			DelayBlocks:          types.UInt64FromBytes(iter.Value()),
+			KnownEthDestinations: []string{},
+		}
+		known := prefix.NewStore(store, types.GetKnownEthDestinationPrefix(account)).Iterator(nil, nil)
+		for ; known.Valid(); known.Next() {
+			entry.KnownEthDestinations = append(entry.KnownEthDestinations, string(known.Key()))
+		}
+		known.Close()
+		out = append(out, entry)
+	}
+	return
+}
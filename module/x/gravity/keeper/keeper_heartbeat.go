@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// DefaultMaxHeartbeatAge is the number of Cosmos blocks after which an orchestrator
+// that has not sent a newer heartbeat is no longer considered live
+const DefaultMaxHeartbeatAge uint64 = 100
+
+/////////////////////////////
+//  ORCHESTRATOR HEARTBEAT //
+/////////////////////////////
+
+// SetOrchestratorHeartbeat stores the latest heartbeat for a validator, replacing any previous one
+func (k Keeper) SetOrchestratorHeartbeat(ctx sdk.Context, validator sdk.ValAddress, heartbeat types.OrchestratorHeartbeat) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOrchestratorHeartbeatKey(validator), k.cdc.MustMarshalBinaryBare(&heartbeat))
+}
+
+// GetOrchestratorHeartbeat returns the latest heartbeat for a validator, or nil if none was ever received
+func (k Keeper) GetOrchestratorHeartbeat(ctx sdk.Context, validator sdk.ValAddress) *types.OrchestratorHeartbeat {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOrchestratorHeartbeatKey(validator))
+	if bz == nil {
+		return nil
+	}
+	var heartbeat types.OrchestratorHeartbeat
+	k.cdc.MustUnmarshalBinaryBare(bz, &heartbeat)
+	return &heartbeat
+}
+
+// IterateOrchestratorHeartbeats iterates through all stored heartbeats
+// cb returns true to stop early
+func (k Keeper) IterateOrchestratorHeartbeats(ctx sdk.Context, cb func([]byte, types.OrchestratorHeartbeat) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(prefixRange(types.OrchestratorHeartbeatKey))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var heartbeat types.OrchestratorHeartbeat
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &heartbeat)
+		if cb(iter.Key(), heartbeat) {
+			break
+		}
+	}
+}
+
+// GetOrchestratorLiveness summarizes the liveness of every registered orchestrator whose validator
+// is currently bonded. An orchestrator is live if it has sent a heartbeat within maxHeartbeatAge blocks
+func (k Keeper) GetOrchestratorLiveness(ctx sdk.Context, maxHeartbeatAge uint64) []*types.OrchestratorLiveness {
+	if maxHeartbeatAge == 0 {
+		maxHeartbeatAge = DefaultMaxHeartbeatAge
+	}
+	currentHeight := uint64(ctx.BlockHeight())
+
+	var out []*types.OrchestratorLiveness
+	for _, key := range k.GetDelegateKeys(ctx) {
+		valAddr, err := sdk.ValAddressFromBech32(key.Validator)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid validator address %s in store", key.Validator))
+		}
+		val := k.StakingKeeper.Validator(ctx, valAddr)
+		if val == nil || !val.IsBonded() {
+			continue
+		}
+		heartbeat := k.GetOrchestratorHeartbeat(ctx, valAddr)
+		live := heartbeat != nil && currentHeight-heartbeat.CosmosBlockHeight <= maxHeartbeatAge
+		out = append(out, &types.OrchestratorLiveness{
+			Validator:      key.Validator,
+			Orchestrator:   key.Orchestrator,
+			LastHeartbeat:  heartbeat,
+			LastEventNonce: k.GetLastEventNonceByValidator(ctx, valAddr),
+			Live:           live,
+		})
+	}
+	return out
+}
@@ -48,12 +48,14 @@ func (k Keeper) DeleteOutgoingLogicCall(ctx sdk.Context, invalidationID []byte,
 	ctx.KVStore(k.storeKey).Delete(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce))
 }
 
-// IterateOutgoingLogicCalls iterates over outgoing logic calls
-func (k Keeper) IterateOutgoingLogicCalls(ctx sdk.Context, cb func([]byte, *types.OutgoingLogicCall) bool) {
+// IterateOutgoingLogicCalls iterates over outgoing logic calls, within limit
+func (k Keeper) IterateOutgoingLogicCalls(ctx sdk.Context, limit IterationLimit, cb func([]byte, *types.OutgoingLogicCall) bool) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyOutgoingLogicCall)
-	iter := prefixStore.Iterator(nil, nil)
+	iter := prefixStore.Iterator(limit.bounds(nil, nil, false))
 	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
+	var visited uint64
+	for ; iter.Valid() && !limit.reached(visited); iter.Next() {
+		visited++
 		var call types.OutgoingLogicCall
 		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &call)
 		// cb returns true to stop early
@@ -65,7 +67,7 @@ func (k Keeper) IterateOutgoingLogicCalls(ctx sdk.Context, cb func([]byte, *type
 
 // GetOutgoingLogicCalls returns the outgoing logic calls
 func (k Keeper) GetOutgoingLogicCalls(ctx sdk.Context) (out []*types.OutgoingLogicCall) {
-	k.IterateOutgoingLogicCalls(ctx, func(_ []byte, call *types.OutgoingLogicCall) bool {
+	k.IterateOutgoingLogicCalls(ctx, IterationLimit{}, func(_ []byte, call *types.OutgoingLogicCall) bool {
 		out = append(out, call)
 		return false
 	})
@@ -78,6 +80,10 @@ func (k Keeper) CancelOutgoingLogicCall(ctx sdk.Context, invalidationId []byte,
 	if call == nil {
 		return types.ErrUnknown
 	}
+	// a transfer with a callback delivered by the call goes back to its sender, the call is kept if that fails
+	if err := k.refundCallbackTransfer(ctx, call.InvalidationId); err != nil {
+		return err
+	}
 	// Delete batch since it is finished
 	k.DeleteOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce)
 
@@ -88,8 +94,11 @@ func (k Keeper) CancelOutgoingLogicCall(ctx sdk.Context, invalidationId []byte,
 		sdk.NewAttribute(types.AttributeKeyInvalidationID, fmt.Sprint(call.InvalidationId)),
 		sdk.NewAttribute(types.AttributeKeyInvalidationNonce, fmt.Sprint(call.InvalidationNonce)),
 	)
-	ctx.EventManager().EmitEvent(batchEvent)
-	return nil
+	k.emitLegacyEvent(ctx, batchEvent)
+	return ctx.EventManager().EmitTypedEvent(&types.EventOutgoingLogicCallCanceled{
+		InvalidationId:    hex.EncodeToString(call.InvalidationId),
+		InvalidationNonce: call.InvalidationNonce,
+	})
 }
 
 /////////////////////////////
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//    BRIDGE MIGRATION     //
+/////////////////////////////
+
+// GetBridgeMigration returns the in progress bridge contract migration, or nil if there is none
+func (k Keeper) GetBridgeMigration(ctx sdk.Context) *types.BridgeMigration {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BridgeMigrationKey)
+	if bz == nil {
+		return nil
+	}
+	var migration types.BridgeMigration
+	k.cdc.MustUnmarshalBinaryBare(bz, &migration)
+	return &migration
+}
+
+// setBridgeMigration stores the in progress bridge contract migration
+func (k Keeper) setBridgeMigration(ctx sdk.Context, migration types.BridgeMigration) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BridgeMigrationKey, k.cdc.MustMarshalBinaryBare(&migration))
+}
+
+// deleteBridgeMigration removes the bridge contract migration once it has completed
+func (k Keeper) deleteBridgeMigration(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.BridgeMigrationKey)
+}
+
+// IsBridgeMigrating returns true while a bridge contract migration is in progress, during
+// which no new transfers may be added to the outgoing pool and no new batches may be built
+func (k Keeper) IsBridgeMigrating(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(types.BridgeMigrationKey)
+}
+
+// HandleBridgeMigrationProposal starts the migration to the contract named by a passed
+// BridgeMigrationProposal, only one migration may be in progress at a time
+func (k Keeper) HandleBridgeMigrationProposal(ctx sdk.Context, p *types.BridgeMigrationProposal) error {
+	newContract, err := types.NewEthAddress(p.NewBridgeContract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "new bridge contract")
+	}
+	if k.IsBridgeMigrating(ctx) {
+		return sdkerrors.Wrap(types.ErrBridgeMigrating, "a migration is already in progress")
+	}
+	if newContract.GetAddress() == k.GetBridgeContractAddress(ctx).GetAddress() {
+		return sdkerrors.Wrap(types.ErrInvalid, "new bridge contract is the current bridge contract")
+	}
+
+	k.setBridgeMigration(ctx, types.BridgeMigration{
+		NewBridgeContract:    newContract.GetAddress(),
+		Status:               types.BRIDGE_MIGRATION_STATUS_DRAINING,
+		StartedHeight:        uint64(ctx.BlockHeight()),
+		MigrationValsetNonce: 0,
+	})
+	current := k.GetBridgeContractAddress(ctx).GetAddress()
+	k.appendAuditLog(ctx, p, fmt.Sprintf("bridge contract %s", current),
+		fmt.Sprintf("bridge contract %s, migrating to %s", current, newContract.GetAddress()))
+
+	k.emitLegacyEvent(ctx,
+		sdk.NewEvent(
+			types.EventTypeBridgeMigrationStarted,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, k.GetBridgeContractAddress(ctx).GetAddress()),
+			sdk.NewAttribute(types.AttributeKeyNewContract, newContract.GetAddress()),
+		),
+	)
+	return ctx.EventManager().EmitTypedEvent(&types.EventBridgeMigrationStarted{
+		BridgeContract:    current,
+		NewBridgeContract: newContract.GetAddress(),
+	})
+}
+
+// AdvanceBridgeMigration is called every EndBlock. Once every outgoing batch and logic call on the
+// old contract has been executed or has timed out it emits the migration valset, which is the
+// valset the new contract must be deployed with, and waits for the MsgMigrationCompletedClaim
+func (k Keeper) AdvanceBridgeMigration(ctx sdk.Context) {
+	migration := k.GetBridgeMigration(ctx)
+	if migration == nil || migration.Status != types.BRIDGE_MIGRATION_STATUS_DRAINING {
+		return
+	}
+	if len(k.GetOutgoingTxBatches(ctx)) != 0 || len(k.GetOutgoingLogicCalls(ctx)) != 0 {
+		return
+	}
+
+	valset := k.SetValsetRequest(ctx)
+	migration.Status = types.BRIDGE_MIGRATION_STATUS_AWAITING_COMPLETION
+	migration.MigrationValsetNonce = valset.Nonce
+	k.setBridgeMigration(ctx, *migration)
+
+	k.emitLegacyEvent(ctx,
+		sdk.NewEvent(
+			types.EventTypeBridgeMigrationValset,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyNewContract, migration.NewBridgeContract),
+			sdk.NewAttribute(types.AttributeKeyValsetNonce, fmt.Sprint(valset.Nonce)),
+		),
+	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBridgeMigrationValset{
+		NewBridgeContract: migration.NewBridgeContract,
+		ValsetNonce:       valset.Nonce,
+	}); err != nil {
+		panic(sdkerrors.Wrap(err, "emit bridge migration valset event"))
+	}
+}
+
+// CompleteBridgeMigration switches the module over to the new bridge contract, it is called
+// when a MsgMigrationCompletedClaim is observed and fails unless the migration valset has
+// been emitted and the claim names the contract selected by governance
+func (k Keeper) CompleteBridgeMigration(ctx sdk.Context, newContract types.EthAddress) error {
+	migration := k.GetBridgeMigration(ctx)
+	if migration == nil || migration.Status != types.BRIDGE_MIGRATION_STATUS_AWAITING_COMPLETION {
+		return sdkerrors.Wrap(types.ErrInvalid, "no bridge migration awaiting completion")
+	}
+	if migration.NewBridgeContract != newContract.GetAddress() {
+		return sdkerrors.Wrapf(types.ErrMismatched, "expected new bridge contract %s got %s",
+			migration.NewBridgeContract, newContract.GetAddress())
+	}
+
+	oldContract := k.GetBridgeContractAddress(ctx)
+	params := k.GetParams(ctx)
+	params.BridgeEthereumAddress = newContract.GetAddress()
+	k.SetParams(ctx, params)
+	k.deleteBridgeMigration(ctx)
+
+	k.emitLegacyEvent(ctx,
+		sdk.NewEvent(
+			types.EventTypeBridgeMigrationCompleted,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, oldContract.GetAddress()),
+			sdk.NewAttribute(types.AttributeKeyNewContract, newContract.GetAddress()),
+			sdk.NewAttribute(types.AttributeKeyValsetNonce, fmt.Sprint(migration.MigrationValsetNonce)),
+		),
+	)
+	return ctx.EventManager().EmitTypedEvent(&types.EventBridgeMigrationCompleted{
+		BridgeContract:    oldContract.GetAddress(),
+		NewBridgeContract: newContract.GetAddress(),
+		ValsetNonce:       migration.MigrationValsetNonce,
+	})
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//   MODULE SEND GRANTS    //
+/////////////////////////////
+
+// HandleModuleSendGrantProposal sets the send to Ethereum budget of the module named by a passed
+// ModuleSendGrantProposal, starting a fresh epoch, or revokes it if the proposal has an empty cap
+func (k Keeper) HandleModuleSendGrantProposal(ctx sdk.Context, p *types.ModuleSendGrantProposal) error {
+	before := fmt.Sprintf("module %s has no send grant", p.Module)
+	if grant, found := k.GetModuleSendGrant(ctx, p.Module); found {
+		before = fmt.Sprintf("module %s may send %s per %d blocks", p.Module, grant.Cap, grant.EpochBlocks)
+	}
+	after := fmt.Sprintf("module %s may send %s per %d blocks", p.Module, p.Cap, p.EpochBlocks)
+	if p.Cap.Empty() {
+		after = fmt.Sprintf("module %s has no send grant", p.Module)
+		ctx.KVStore(k.storeKey).Delete(types.GetModuleSendGrantKey(p.Module))
+	} else {
+		k.SetModuleSendGrant(ctx, types.ModuleSendGrant{
+			Module:      p.Module,
+			Cap:         p.Cap,
+			EpochBlocks: p.EpochBlocks,
+			EpochStart:  uint64(ctx.BlockHeight()),
+			Spent:       sdk.NewCoins(),
+		})
+	}
+	k.appendAuditLog(ctx, p, before, after)
+
+	k.emitLegacyEvent(ctx,
+		sdk.NewEvent(
+			types.EventTypeModuleSendGrantUpdated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyGrantModule, p.Module),
+			sdk.NewAttribute(types.AttributeKeyGrantCap, p.Cap.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantEpochBlocks, fmt.Sprint(p.EpochBlocks)),
+		),
+	)
+	return ctx.EventManager().EmitTypedEvent(&types.EventModuleSendGrantUpdated{
+		Module:      p.Module,
+		Cap:         p.Cap,
+		EpochBlocks: p.EpochBlocks,
+	})
+}
+
+// SendToEthFromModule is the programmatic SendToEth for other modules. It adds a transfer from the module
+// account of module to the pool, like MsgSendToEth, as long as the amount and fee fit in what is left of the
+// budget governance granted the module for the current epoch. Returns the id of the pooled transfer
+func (k Keeper) SendToEthFromModule(ctx sdk.Context, module string, receiver types.EthAddress, amount sdk.Coin, fee sdk.Coin) (uint64, error) {
+	grant, found := k.GetModuleSendGrant(ctx, module)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrModuleSendGrantExceeded, "module %s has no send grant", module)
+	}
+	height := uint64(ctx.BlockHeight())
+	if height < grant.EpochStart {
+		// the chain was relaunched from an export at a lower height
+		grant.EpochStart = height
+		grant.Spent = sdk.NewCoins()
+	} else if height >= grant.EpochStart+grant.EpochBlocks {
+		grant.EpochStart = height - (height-grant.EpochStart)%grant.EpochBlocks
+		grant.Spent = sdk.NewCoins()
+	}
+	// added one at a time, Coins.Add does not merge equal denoms within its arguments
+	spent := grant.Spent.Add(amount).Add(fee)
+	if !spent.IsAllLTE(grant.Cap) {
+		return 0, sdkerrors.Wrapf(types.ErrModuleSendGrantExceeded, "%s would be sent by %s this epoch, cap is %s",
+			spent, module, grant.Cap)
+	}
+
+	id, err := k.AddToOutgoingPool(ctx, authtypes.NewModuleAddress(module), receiver, amount, fee)
+	if err != nil {
+		return 0, err
+	}
+	grant.Spent = spent
+	k.SetModuleSendGrant(ctx, grant)
+	return id, nil
+}
+
+// GetModuleSendGrant returns the send to Ethereum budget of module
+func (k Keeper) GetModuleSendGrant(ctx sdk.Context, module string) (types.ModuleSendGrant, bool) {
+	var grant types.ModuleSendGrant
+	bz := ctx.KVStore(k.storeKey).Get(types.GetModuleSendGrantKey(module))
+	if len(bz) == 0 {
+		return grant, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// SetModuleSendGrant stores the send to Ethereum budget of a module
+func (k Keeper) SetModuleSendGrant(ctx sdk.Context, grant types.ModuleSendGrant) {
+	ctx.KVStore(k.storeKey).Set(types.GetModuleSendGrantKey(grant.Module), k.cdc.MustMarshalBinaryBare(&grant))
+}
+
+// GetModuleSendGrants returns the send to Ethereum budgets of all modules ordered by module name
+func (k Keeper) GetModuleSendGrants(ctx sdk.Context) (out []types.ModuleSendGrant) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.ModuleSendGrantKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var grant types.ModuleSendGrant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+		out = append(out, grant)
+	}
+	return
+}
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//      OUTFLOW LIMIT      //
+/////////////////////////////
+
+// ValidateOutflowLimit checks that the keeper has a price feed to value transfers with if limit is set, without one
+// every transfer to Ethereum would be rejected. A zero limit is always valid, it disables the limit
+func (k Keeper) ValidateOutflowLimit(limit sdk.Dec) error {
+	if limit.IsNil() || limit.IsZero() {
+		return nil
+	}
+	if k.PriceFeed == nil {
+		return sdkerrors.Wrap(types.ErrUnsupported, "outflow limit is set but no price feed is configured")
+	}
+	return nil
+}
+
+// checkOutflowLimit values a transfer to Ethereum in USD and returns the value to record with recordOutflow,
+// the transfer is rejected if it would push the value requested within the last OutflowLimitWindow blocks,
+// across every token, over the OutflowUsdLimit. The value is zero while the limit is zero
+func (k Keeper) checkOutflowLimit(ctx sdk.Context, coin sdk.Coin) (sdk.Dec, error) {
+	params := k.GetParams(ctx)
+	if params.OutflowUsdLimit.IsNil() || params.OutflowUsdLimit.IsZero() {
+		return sdk.ZeroDec(), nil
+	}
+	if k.PriceFeed == nil {
+		return sdk.Dec{}, sdkerrors.Wrap(types.ErrUnsupported, "outflow limit is enabled but no price feed is configured")
+	}
+	price, ok := k.PriceFeed.GetUSDPrice(ctx, coin.Denom)
+	if !ok || price.IsNil() || price.IsNegative() {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrUnsupported, "no USD price for %s", coin.Denom)
+	}
+	value := price.MulInt(coin.Amount)
+
+	total := k.pruneOutflow(ctx, params.OutflowLimitWindow)
+	if total.Add(value).GT(params.OutflowUsdLimit) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrOutflowLimitExceeded, "%s USD requested, %s of %s USD used in the last %d blocks",
+			value, total, params.OutflowUsdLimit, params.OutflowLimitWindow)
+	}
+	return value, nil
+}
+
+// recordOutflow adds value, as returned by checkOutflowLimit, to the outflow of the current block on behalf of
+// the transfer with txID so that releaseOutflow can return it if the transfer is refunded
+func (k Keeper) recordOutflow(ctx sdk.Context, txID uint64, value sdk.Dec) {
+	if !value.IsPositive() {
+		return
+	}
+	height := uint64(ctx.BlockHeight())
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range [][]byte{types.GetOutflowKey(height), types.GetOutflowTxKey(txID, height)} {
+		recorded := sdk.ZeroDec()
+		if bz := store.Get(key); len(bz) != 0 {
+			recorded = sdk.MustNewDecFromStr(string(bz))
+		}
+		store.Set(key, []byte(recorded.Add(value).String()))
+	}
+	k.setOutflowTotal(ctx, k.GetOutflowTotal(ctx).Add(value))
+}
+
+// releaseOutflow takes the value the transfer with txID added to the outflow back out of the blocks still in the
+// window, a refunded transfer never left for Ethereum and must not use up the limit
+func (k Keeper) releaseOutflow(ctx sdk.Context, txID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	total := k.GetOutflowTotal(ctx)
+	released := false
+	for _, entry := range k.takeOutflowEntries(ctx, txID) {
+		key := types.GetOutflowKey(entry.height)
+		bz := store.Get(key)
+		if len(bz) == 0 {
+			// the block has left the window and was pruned with the value of the transfer
+			continue
+		}
+		blockValue := sdk.MustNewDecFromStr(string(bz))
+		value := sdk.MinDec(entry.value, blockValue)
+		if blockValue.Equal(value) {
+			store.Delete(key)
+		} else {
+			store.Set(key, []byte(blockValue.Sub(value).String()))
+		}
+		total = total.Sub(value)
+		released = true
+	}
+	if released {
+		k.setOutflowTotal(ctx, total)
+	}
+}
+
+// forgetOutflow drops the record of the value the transfer with txID added to the outflow, once it is executed
+// the value stays in the window until it is pruned
+func (k Keeper) forgetOutflow(ctx sdk.Context, txID uint64) {
+	k.takeOutflowEntries(ctx, txID)
+}
+
+type outflowEntry struct {
+	height uint64
+	value  sdk.Dec
+}
+
+// takeOutflowEntries removes and returns the values the transfer with txID added to the outflow by block height
+func (k Keeper) takeOutflowEntries(ctx sdk.Context, txID uint64) (entries []outflowEntry) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetOutflowTxPrefix(txID))
+	var keys [][]byte
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		entries = append(entries, outflowEntry{
+			height: types.UInt64FromBytes(iter.Key()),
+			value:  sdk.MustNewDecFromStr(string(iter.Value())),
+		})
+		keys = append(keys, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+	return entries
+}
+
+// pruneOutflow drops the outflow of the blocks that have left the window and returns the outflow still in it
+func (k Keeper) pruneOutflow(ctx sdk.Context, window uint64) sdk.Dec {
+	total := k.GetOutflowTotal(ctx)
+	height := uint64(ctx.BlockHeight())
+	if height < window {
+		return total
+	}
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	iter := store.Iterator(types.OutflowKey, types.GetOutflowKey(height-window+1))
+	for ; iter.Valid(); iter.Next() {
+		total = total.Sub(sdk.MustNewDecFromStr(string(iter.Value())))
+		keys = append(keys, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+	if len(keys) == 0 {
+		return total
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	k.setOutflowTotal(ctx, total)
+	return total
+}
+
+// GetOutflowTotal returns the USD value of the transfers to Ethereum recorded within the outflow limit window
+func (k Keeper) GetOutflowTotal(ctx sdk.Context) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(types.OutflowTotalKey)
+	if len(bz) == 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.MustNewDecFromStr(string(bz))
+}
+
+func (k Keeper) setOutflowTotal(ctx sdk.Context, total sdk.Dec) {
+	ctx.KVStore(k.storeKey).Set(types.OutflowTotalKey, []byte(total.String()))
+}
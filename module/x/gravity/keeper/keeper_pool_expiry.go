@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//       POOL EXPIRY       //
+/////////////////////////////
+
+// PoolTxExpiryBudget is the maximum number of expired pool transfers refunded per block, the rest are refunded
+// in the following blocks
+const PoolTxExpiryBudget = 100
+
+// RefundExpiredPool refunds the transfers that have waited in the pool for PoolTxTimeout blocks or longer to their
+// senders, oldest first and at most PoolTxExpiryBudget of them per block. A transfer whose fee is too low to ever
+// be batched would otherwise stay locked until its sender cancels it. Returns the number of transfers refunded
+func (k Keeper) RefundExpiredPool(ctx sdk.Context) uint64 {
+	timeout := k.GetParams(ctx).PoolTxTimeout
+	height := uint64(ctx.BlockHeight())
+	if timeout == 0 || height < timeout {
+		return 0
+	}
+
+	// the height index is walked up to the transfers created at height - timeout, they have waited timeout blocks
+	store := ctx.KVStore(k.storeKey)
+	var idxKeys [][]byte
+	iter := store.Iterator(types.OutgoingTXPoolHeightKey, types.GetOutgoingTxPoolHeightKey(height-timeout+1, 0))
+	for ; iter.Valid() && len(idxKeys) < PoolTxExpiryBudget; iter.Next() {
+		idxKeys = append(idxKeys, append([]byte{}, iter.Value()...))
+	}
+	iter.Close()
+
+	var refunded uint64
+	for _, idxKey := range idxKeys {
+		var ext types.OutgoingTransferTx
+		k.cdc.MustUnmarshalBinaryBare(store.Get(idxKey), &ext)
+		tx, err := ext.ToInternal()
+		if err != nil {
+			panic(fmt.Sprintf("invalid unbatched tx in store: %v", ext))
+		}
+		// a refund that fails half way must not leave the tx removed from the pool without paying it out
+		xCtx, commit := ctx.CacheContext()
+		if err := k.refundUnbatchedTX(xCtx, tx, types.REFUND_REASON_EXPIRED); err != nil {
+			k.logger(ctx).Error("pool expiry refund failed", "id", tx.Id, "error", err.Error())
+			continue
+		}
+		commit()
+		refunded++
+
+		k.emitLegacyEvent(ctx, sdk.NewEvent(
+			types.EventTypeBridgeWithdrawalExpired,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, strconv.Itoa(int(tx.Id))),
+			sdk.NewAttribute(sdk.AttributeKeySender, tx.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyCreatedHeight, fmt.Sprint(tx.CreatedHeight)),
+		))
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventSendToEthExpired{
+			TxId:          tx.Id,
+			Sender:        tx.Sender.String(),
+			CreatedHeight: tx.CreatedHeight,
+		}); err != nil {
+			panic(sdkerrors.Wrap(err, "emit send to eth expired event"))
+		}
+	}
+	return refunded
+}
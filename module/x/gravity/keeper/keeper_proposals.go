@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// HandleProposal executes a passed gravity proposal, the gov module routes the proposals of the gravity module here
+func (k Keeper) HandleProposal(ctx sdk.Context, content govtypes.Content) error {
+	switch c := content.(type) {
+	case *types.BridgeMigrationProposal:
+		return k.HandleBridgeMigrationProposal(ctx, c)
+
+	case *types.AttestationVetoProposal:
+		return k.HandleAttestationVetoProposal(ctx, c)
+
+	case *types.EvacuatePoolProposal:
+		return k.HandleEvacuatePoolProposal(ctx, c)
+
+	case *types.CancelOutgoingBatchProposal:
+		return k.HandleCancelOutgoingBatchProposal(ctx, c)
+
+	case *types.ModuleSendGrantProposal:
+		return k.HandleModuleSendGrantProposal(ctx, c)
+
+	case *types.BridgeInstanceResetProposal:
+		return k.HandleBridgeInstanceResetProposal(ctx, c)
+
+	case *types.ResolveEventNonceProposal:
+		return k.HandleResolveEventNonceProposal(ctx, c)
+
+	case *types.ReleaseQuarantinedDepositProposal:
+		return k.HandleReleaseQuarantinedDepositProposal(ctx, c)
+
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gravity proposal content type: %T", c)
+	}
+}
+
+/////////////////////////////
+//   PROPOSAL SIMULATION   //
+/////////////////////////////
+
+// simulationStore is the gravity store of a proposal simulation, it records the keys written to it
+type simulationStore struct {
+	sdk.KVStore
+	written map[string]struct{}
+}
+
+func (s simulationStore) Set(key, value []byte) {
+	s.written[string(key)] = struct{}{}
+	s.KVStore.Set(key, value)
+}
+
+func (s simulationStore) Delete(key []byte) {
+	s.written[string(key)] = struct{}{}
+	s.KVStore.Delete(key)
+}
+
+// simulationMultiStore hands out the gravity store of a simulation as a simulationStore. A branch of it, as made by
+// CacheContext, records its own written keys and adds them to its parent's when it is written
+type simulationMultiStore struct {
+	sdk.MultiStore
+	storeKey sdk.StoreKey
+	written  map[string]struct{}
+	parent   map[string]struct{}
+	write    func()
+}
+
+func (s simulationMultiStore) GetKVStore(key sdk.StoreKey) sdk.KVStore {
+	if key != s.storeKey {
+		return s.MultiStore.GetKVStore(key)
+	}
+	return simulationStore{KVStore: s.MultiStore.GetKVStore(key), written: s.written}
+}
+
+func (s simulationMultiStore) CacheMultiStore() sdk.CacheMultiStore {
+	branch := s.MultiStore.CacheMultiStore()
+	return simulationMultiStore{
+		MultiStore: branch,
+		storeKey:   s.storeKey,
+		written:    make(map[string]struct{}),
+		parent:     s.written,
+		write:      branch.Write,
+	}
+}
+
+func (s simulationMultiStore) Write() {
+	s.write()
+	for key := range s.written {
+		s.parent[key] = struct{}{}
+	}
+}
+
+// simulationBank passes everything on to the live bank of a proposal simulation and records the funds moved
+type simulationBank struct {
+	types.BankKeeper
+	moved []types.FundsMovement
+}
+
+var _ types.BankKeeper = &simulationBank{}
+
+func (b *simulationBank) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := b.BankKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt); err != nil {
+		return err
+	}
+	b.record(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+	return nil
+}
+
+func (b *simulationBank) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := b.BankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt); err != nil {
+		return err
+	}
+	b.record(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+	return nil
+}
+
+func (b *simulationBank) MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error {
+	if err := b.BankKeeper.MintCoins(ctx, name, amt); err != nil {
+		return err
+	}
+	b.record(nil, authtypes.NewModuleAddress(name), amt)
+	return nil
+}
+
+func (b *simulationBank) BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error {
+	if err := b.BankKeeper.BurnCoins(ctx, name, amt); err != nil {
+		return err
+	}
+	b.record(authtypes.NewModuleAddress(name), nil, amt)
+	return nil
+}
+
+func (b *simulationBank) record(from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) {
+	movement := types.FundsMovement{From: "", To: "", Amount: amt}
+	if from != nil {
+		movement.From = from.String()
+	}
+	if to != nil {
+		movement.To = to.String()
+	}
+	b.moved = append(b.moved, movement)
+}
+
+// DryRunProposal executes content against a branch of the current state that is thrown away afterwards and reports
+// the gravity store entries it would create, update or delete and the funds it would move, so that governance can
+// see what a proposal does before voting on it. The state of other modules is not listed, the bank state only
+// changes by the funds moved
+func (k Keeper) DryRunProposal(ctx sdk.Context, content govtypes.Content) types.ProposalSimulation {
+	simulation := types.ProposalSimulation{
+		ProposalType: content.ProposalType(),
+		Error:        "",
+		StateChanges: []types.StateChange{},
+		FundsMoved:   []types.FundsMovement{},
+	}
+
+	// the proposal handlers pay out through the keeper, it has to use the recording bank
+	bank := &simulationBank{BankKeeper: k.bankKeeper, moved: []types.FundsMovement{}}
+	simKeeper := k
+	simKeeper.bankKeeper = bank
+	store := simulationMultiStore{
+		MultiStore: ctx.MultiStore().CacheMultiStore(),
+		storeKey:   k.storeKey,
+		written:    make(map[string]struct{}),
+		parent:     nil,
+		write:      nil,
+	}
+	sCtx := ctx.WithMultiStore(store).WithEventManager(sdk.NewEventManager())
+	if err := dryRunProposal(sCtx, simKeeper, content); err != nil {
+		simulation.Error = err.Error()
+		return simulation
+	}
+
+	keys := make([]string, 0, len(store.written))
+	for key := range store.written {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	live := ctx.KVStore(k.storeKey)
+	simulated := sCtx.KVStore(k.storeKey)
+	for _, key := range keys {
+		before, after := live.Get([]byte(key)), simulated.Get([]byte(key))
+		var operation string
+		switch {
+		// written back as it was, or created and deleted again
+		case bytes.Equal(before, after):
+			continue
+		case before == nil:
+			operation = "created"
+		case after == nil:
+			operation = "deleted"
+		default:
+			operation = "updated"
+		}
+		simulation.StateChanges = append(simulation.StateChanges, types.StateChange{
+			Key:       hex.EncodeToString([]byte(key)),
+			Operation: operation,
+		})
+	}
+	simulation.FundsMoved = bank.moved
+	return simulation
+}
+
+// dryRunProposal executes content with k, turning a panic into an error
+func dryRunProposal(ctx sdk.Context, k Keeper, content govtypes.Content) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return k.HandleProposal(ctx, content)
+}
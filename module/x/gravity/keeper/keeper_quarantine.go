@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//   QUARANTINED DEPOSITS  //
+/////////////////////////////
+
+// isQuarantinedDeposit returns true if the msg.sender of the deposit of claim or the address its tokens came from
+// is listed in the QuarantinedEthSenders param
+func (k Keeper) isQuarantinedDeposit(ctx sdk.Context, claim *types.MsgSendToCosmosClaim) bool {
+	for _, sender := range k.GetParams(ctx).QuarantinedEthSenders {
+		if strings.EqualFold(sender, claim.EthereumSender) ||
+			(claim.TokenSender != "" && strings.EqualFold(sender, claim.TokenSender)) {
+			return true
+		}
+	}
+	return false
+}
+
+// quarantineDeposit holds the deposit of claim to receiver in denom, whose coins the caller left in the module
+// account, until governance releases it with a ReleaseQuarantinedDepositProposal
+func (k Keeper) quarantineDeposit(ctx sdk.Context, claim *types.MsgSendToCosmosClaim, receiver sdk.AccAddress, denom string) {
+	deposit := newDepositReceipt(ctx, claim, receiver, denom)
+	k.setQuarantinedDeposit(ctx, deposit)
+	k.emitLegacyEvent(ctx, sdk.NewEvent(
+		types.EventTypeBridgeDepositQuarantined,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(deposit.EventNonce)),
+		sdk.NewAttribute(types.AttributeKeyEthereumSender, claim.GetDepositor()),
+		sdk.NewAttribute(types.AttributeKeyDepositReceiver, deposit.CosmosReceiver),
+	))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDepositQuarantined{
+		EventNonce:     deposit.EventNonce,
+		EthereumSender: claim.GetDepositor(),
+		CosmosReceiver: deposit.CosmosReceiver,
+		Amount:         deposit.Amount,
+	}); err != nil {
+		panic(sdkerrors.Wrap(err, "emit deposit quarantined event"))
+	}
+}
+
+func (k Keeper) setQuarantinedDeposit(ctx sdk.Context, deposit types.DepositReceipt) {
+	ctx.KVStore(k.storeKey).Set(types.GetQuarantinedDepositKey(deposit.EventNonce), k.cdc.MustMarshalBinaryBare(&deposit))
+}
+
+// GetQuarantinedDeposit returns the deposit held in quarantine from the event at eventNonce, if there is one
+func (k Keeper) GetQuarantinedDeposit(ctx sdk.Context, eventNonce uint64) *types.DepositReceipt {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetQuarantinedDepositKey(eventNonce))
+	if bz == nil {
+		return nil
+	}
+	var deposit types.DepositReceipt
+	k.cdc.MustUnmarshalBinaryBare(bz, &deposit)
+	return &deposit
+}
+
+// GetQuarantinedDeposits returns every deposit held in quarantine, in event nonce order
+func (k Keeper) GetQuarantinedDeposits(ctx sdk.Context) (out []types.DepositReceipt) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.QuarantinedDepositKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.DepositReceipt
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &deposit)
+		out = append(out, deposit)
+	}
+	return
+}
+
+// HandleReleaseQuarantinedDepositProposal pays out the quarantined deposit named by a passed
+// ReleaseQuarantinedDepositProposal from the module account, to the recipient of the proposal or to the receiver
+// of the deposit if it has none. The deposit gets a receipt like any other, dated to its release
+func (k Keeper) HandleReleaseQuarantinedDepositProposal(ctx sdk.Context, p *types.ReleaseQuarantinedDepositProposal) error {
+	deposit := k.GetQuarantinedDeposit(ctx, p.EventNonce)
+	if deposit == nil {
+		return sdkerrors.Wrapf(types.ErrUnknown, "quarantined deposit at event nonce %d", p.EventNonce)
+	}
+	recipient := deposit.CosmosReceiver
+	if p.Recipient != "" {
+		recipient = p.Recipient
+	}
+	addr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid recipient")
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.Coins{deposit.Amount}); err != nil {
+		return sdkerrors.Wrap(err, "release quarantined deposit")
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetQuarantinedDepositKey(p.EventNonce))
+
+	released := *deposit
+	released.CosmosReceiver = addr.String()
+	released.DepositHeight = uint64(ctx.BlockHeight())
+	released.DepositTime = uint64(ctx.BlockTime().Unix())
+	k.storeDepositReceipt(ctx, addr, released)
+	k.gravityHooks.AfterSendToCosmos(ctx, released)
+
+	k.appendAuditLog(ctx, p,
+		fmt.Sprintf("deposit of %s at event nonce %d quarantined for %s", deposit.Amount, p.EventNonce, deposit.CosmosReceiver),
+		fmt.Sprintf("deposit released to %s", released.CosmosReceiver))
+	k.emitLegacyEvent(ctx, sdk.NewEvent(
+		types.EventTypeBridgeDepositReleased,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(p.EventNonce)),
+		sdk.NewAttribute(types.AttributeKeyDepositReceiver, released.CosmosReceiver),
+	))
+	return ctx.EventManager().EmitTypedEvent(&types.EventDepositReleased{
+		EventNonce:     p.EventNonce,
+		CosmosReceiver: released.CosmosReceiver,
+		Amount:         released.Amount,
+	})
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//     REFUND RECEIPTS     //
+/////////////////////////////
+
+// setRefundReceipt records the refund of a transfer to Ethereum, paid to recipient in denom. The receipt is
+// indexed by refund height as well so it can be pruned once RefundReceiptRetention blocks have passed
+func (k Keeper) setRefundReceipt(ctx sdk.Context, tx *types.InternalOutgoingTransferTx, denom string, reason types.RefundReason, recipient sdk.AccAddress) {
+	receipt := types.RefundReceipt{
+		TxId:          tx.Id,
+		Sender:        tx.Sender.String(),
+		DestAddress:   tx.DestAddress.GetAddress(),
+		TokenContract: tx.Erc20Token.Contract.GetAddress(),
+		Amount:        sdk.NewCoin(denom, tx.Erc20Token.Amount),
+		Fee:           sdk.NewCoin(denom, tx.Erc20Fee.Amount),
+		Reason:        reason,
+		RefundHeight:  uint64(ctx.BlockHeight()),
+		RefundTime:    uint64(ctx.BlockTime().Unix()),
+	}
+	if !recipient.Equals(tx.Sender) {
+		receipt.RefundAddress = recipient.String()
+	}
+	key := types.GetRefundReceiptKey(tx.Sender, tx.Id)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&receipt))
+	store.Set(types.GetRefundReceiptHeightKey(receipt.RefundHeight, tx.Id), key)
+}
+
+// GetRefundReceipts returns a page of the refund receipts of sender in tx id order
+func (k Keeper) GetRefundReceipts(ctx sdk.Context, sender sdk.AccAddress, pageReq *query.PageRequest) ([]types.RefundReceipt, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRefundReceiptPrefix(sender))
+	var receipts []types.RefundReceipt
+	pageRes, err := query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
+		var receipt types.RefundReceipt
+		if err := k.cdc.UnmarshalBinaryBare(value, &receipt); err != nil {
+			return err
+		}
+		receipts = append(receipts, receipt)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return receipts, pageRes, nil
+}
+
+// PruneRefundReceipts removes the receipts of refunds paid more than RefundReceiptRetention blocks ago
+func (k Keeper) PruneRefundReceipts(ctx sdk.Context) {
+	retention := k.GetParams(ctx).RefundReceiptRetention
+	height := uint64(ctx.BlockHeight())
+	if retention == 0 || height <= retention {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	iter := store.Iterator(types.RefundReceiptHeightKey, types.GetRefundReceiptHeightKey(height-retention, 0))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, append([]byte{}, iter.Key()...), append([]byte{}, iter.Value()...))
+	}
+	iter.Close()
+	// the receipt goes before its height index entry, a receipt whose deletion ran out of gas is found again
+	for i := 0; i < len(keys); i += 2 {
+		k.deletePrunedKeys(ctx, [][]byte{keys[i+1], keys[i]})
+	}
+}
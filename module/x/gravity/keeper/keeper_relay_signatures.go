@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//    RELAY SIGNATURES     //
+/////////////////////////////
+
+// zeroSignatureWord is the r and s of a valset member that did not sign, the Gravity contract skips members with a
+// zero v
+var zeroSignatureWord = "0x" + strings.Repeat("0", 64)
+
+// GetValsetRelaySignatures returns the signatures to relay the valset of nonce to Ethereum with
+func (k Keeper) GetValsetRelaySignatures(ctx sdk.Context, nonce uint64) (*types.QueryRelaySignaturesResponse, error) {
+	valset := k.GetValset(ctx, nonce)
+	if valset == nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "valset %d", nonce)
+	}
+	signatures := make(map[string]string)
+	for _, confirm := range k.GetValsetConfirms(ctx, nonce) {
+		signatures[strings.ToLower(confirm.EthAddress)] = confirm.Signature
+	}
+	return k.getRelaySignatures(ctx, valset.GetCheckpoint(k.GetGravityID(ctx)), signatures)
+}
+
+// GetBatchRelaySignatures returns the signatures to relay the batch of nonce for tokenContract to Ethereum with
+func (k Keeper) GetBatchRelaySignatures(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) (*types.QueryRelaySignaturesResponse, error) {
+	batch := k.GetOutgoingTXBatch(ctx, tokenContract, nonce)
+	if batch == nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "batch %d of %s", nonce, tokenContract.GetAddress())
+	}
+	signatures := make(map[string]string)
+	for _, confirm := range k.GetBatchConfirmByNonceAndTokenContract(ctx, nonce, tokenContract) {
+		signatures[strings.ToLower(confirm.EthSigner)] = confirm.Signature
+	}
+	return k.getRelaySignatures(ctx, batch.GetCheckpoint(k.GetGravityID(ctx)), signatures)
+}
+
+// getRelaySignatures lays out the signatures over checkpoint the way the Gravity contract takes them, in the order
+// of the members of the last valset observed on Ethereum with a zero placeholder for every member that did not
+// sign. signatures holds the hex encoded signature of each member that did by its lowercase Ethereum address
+func (k Keeper) getRelaySignatures(ctx sdk.Context, checkpoint []byte, signatures map[string]string) (*types.QueryRelaySignaturesResponse, error) {
+	signers := k.GetLastObservedValset(ctx)
+	if signers == nil {
+		return nil, sdkerrors.Wrap(types.ErrUnknown, "no valset observed on Ethereum yet")
+	}
+	// a valset without a threshold was checkpointed with the legacy encoding, the contract then checks the
+	// threshold it hardcodes
+	powerThreshold := signers.PowerThreshold
+	if powerThreshold == 0 {
+		powerThreshold = types.LegacyEthereumPowerThreshold
+	}
+
+	res := &types.QueryRelaySignaturesResponse{
+		SignerValsetNonce: signers.Nonce,
+		Signatures:        make([]types.RelaySignature, len(signers.Members)),
+		SignedPower:       0,
+		PowerThreshold:    powerThreshold,
+		Checkpoint:        "0x" + hex.EncodeToString(checkpoint),
+	}
+	for i, member := range signers.Members {
+		sig := types.RelaySignature{
+			EthAddress: member.EthereumAddress,
+			Power:      member.Power,
+			V:          0,
+			R:          zeroSignatureWord,
+			S:          zeroSignatureWord,
+		}
+		if bz, err := hex.DecodeString(signatures[strings.ToLower(member.EthereumAddress)]); err == nil && len(bz) == 65 {
+			// the contract expects v in the legacy 27 or 28 form
+			sig.V = uint32(bz[64])
+			if sig.V < 27 {
+				sig.V += 27
+			}
+			sig.R = "0x" + hex.EncodeToString(bz[:32])
+			sig.S = "0x" + hex.EncodeToString(bz[32:64])
+			res.SignedPower += member.Power
+		}
+		res.Signatures[i] = sig
+	}
+	return res, nil
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//     RELAYER LOTTERY     //
+/////////////////////////////
+
+// recordRelay counts a batch executed by relayer in the current block
+func (k Keeper) recordRelay(ctx sdk.Context, relayer types.EthAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetRelayActivityKey(uint64(ctx.BlockHeight()), relayer)
+	var count uint64
+	if bz := store.Get(key); len(bz) != 0 {
+		count = types.UInt64FromBytes(bz)
+	}
+	store.Set(key, types.UInt64Bytes(count+1))
+}
+
+// pruneRelayActivity removes the relay activity recorded before the given block height
+func (k Keeper) pruneRelayActivity(ctx sdk.Context, beforeHeight uint64) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	iter := store.Iterator(types.RelayActivityKey, types.GetRelayActivityPrefix(beforeHeight))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetRelayerWeights returns the relayers that executed batches since the given block height, sorted by
+// address, along with the number of batches each of them executed
+func (k Keeper) GetRelayerWeights(ctx sdk.Context, sinceHeight uint64) ([]string, map[string]uint64) {
+	store := ctx.KVStore(k.storeKey)
+	weights := make(map[string]uint64)
+	_, end := prefixRange(types.RelayActivityKey)
+	iter := store.Iterator(types.GetRelayActivityPrefix(sinceHeight), end)
+	defer iter.Close()
+	prefixLen := len(types.GetRelayActivityPrefix(0))
+	for ; iter.Valid(); iter.Next() {
+		weights[string(iter.Key()[prefixLen:])] += types.UInt64FromBytes(iter.Value())
+	}
+
+	relayers := make([]string, 0, len(weights))
+	for relayer := range weights {
+		relayers = append(relayers, relayer)
+	}
+	sort.Strings(relayers)
+	return relayers, weights
+}
+
+// RunRelayerLottery is called for every executed batch. It records the relay and, when the
+// RelayerLotteryReward param is set, draws a winner among the relayers of the last RelayerLotteryWindow
+// blocks with a chance proportional to the number of batches each relayed. The draw is seeded from the
+// block header hash and the event nonce, so every validator picks the same winner. Only relayers whose
+// Ethereum address belongs to a validator can win, the reward is minted to that validator's account
+func (k Keeper) RunRelayerLottery(ctx sdk.Context, relayer *types.EthAddress, eventNonce uint64) {
+	params := k.GetParams(ctx)
+	height := uint64(ctx.BlockHeight())
+	if height > params.RelayerLotteryWindow {
+		k.pruneRelayActivity(ctx, height-params.RelayerLotteryWindow+1)
+	}
+	if relayer != nil {
+		k.recordRelay(ctx, *relayer)
+	}
+	reward := params.RelayerLotteryReward
+	if reward.Amount.IsNil() || !reward.IsPositive() {
+		return
+	}
+
+	// only relayers the reward can be paid to take part in the draw
+	relayers, weights := k.GetRelayerWeights(ctx, 0)
+	var eligible []string
+	receivers := make(map[string]sdk.AccAddress)
+	var totalWeight uint64
+	for _, r := range relayers {
+		addr, err := types.NewEthAddress(r)
+		if err != nil {
+			continue
+		}
+		val, found := k.GetValidatorByEthAddress(ctx, *addr)
+		if !found {
+			continue
+		}
+		eligible = append(eligible, r)
+		receivers[r] = sdk.AccAddress(val.GetOperator())
+		totalWeight += weights[r]
+	}
+	if totalWeight == 0 {
+		return
+	}
+
+	seed := sha256.Sum256(append(append([]byte{}, ctx.HeaderHash()...), types.UInt64Bytes(eventNonce)...))
+	draw := binary.BigEndian.Uint64(seed[:8]) % totalWeight
+	var winner string
+	for _, r := range eligible {
+		if draw < weights[r] {
+			winner = r
+			break
+		}
+		draw -= weights[r]
+	}
+
+	// a failed payout must not hold up the executed batch, so the reward is paid in its own cache
+	coins := sdk.NewCoins(reward)
+	xCtx, commit := ctx.CacheContext()
+	if err := k.bankKeeper.MintCoins(xCtx, types.ModuleName, coins); err != nil {
+		k.logger(ctx).Error("could not mint relayer lottery reward", "cause", err.Error())
+		return
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(xCtx, types.ModuleName, receivers[winner], coins); err != nil {
+		k.logger(ctx).Error("could not pay relayer lottery reward", "cause", err.Error())
+		return
+	}
+	commit()
+
+	k.emitLegacyEvent(ctx,
+		sdk.NewEvent(
+			types.EventTypeRelayerLotteryWon,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(eventNonce)),
+			sdk.NewAttribute(types.AttributeKeyRelayer, winner),
+			sdk.NewAttribute(types.AttributeKeyRewardReceiver, receivers[winner].String()),
+			sdk.NewAttribute(types.AttributeKeyRewardAmount, coins.String()),
+		),
+	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRelayerLotteryWon{
+		EventNonce: eventNonce,
+		Relayer:    winner,
+		Receiver:   receivers[winner].String(),
+		Reward:     reward,
+	}); err != nil {
+		panic(sdkerrors.Wrap(err, "emit relayer lottery won event"))
+	}
+}
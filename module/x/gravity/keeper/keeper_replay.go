@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//   ATTESTATION REPLAY    //
+/////////////////////////////
+
+// replayBank is the bank the observed claims are replayed against. It starts out empty and only adds up what the
+// attestation handler mints, burns and releases from the module account, nothing can fail for lack of funds.
+// Denom metadata is read from the live bank
+type replayBank struct {
+	live     types.BankKeeper
+	minted   sdk.Coins
+	burned   sdk.Coins
+	released sdk.Coins
+}
+
+var _ types.BankKeeper = &replayBank{}
+
+func (b *replayBank) SendCoinsFromModuleToAccount(_ sdk.Context, _ string, _ sdk.AccAddress, amt sdk.Coins) error {
+	b.released = b.released.Add(amt...)
+	return nil
+}
+
+func (b *replayBank) SendCoinsFromAccountToModule(_ sdk.Context, _ sdk.AccAddress, _ string, _ sdk.Coins) error {
+	return nil
+}
+
+func (b *replayBank) MintCoins(_ sdk.Context, _ string, amt sdk.Coins) error {
+	b.minted = b.minted.Add(amt...)
+	return nil
+}
+
+func (b *replayBank) BurnCoins(_ sdk.Context, _ string, amt sdk.Coins) error {
+	b.burned = b.burned.Add(amt...)
+	return nil
+}
+
+func (b *replayBank) GetAllBalances(_ sdk.Context, _ sdk.AccAddress) sdk.Coins {
+	return sdk.Coins{}
+}
+
+func (b *replayBank) GetDenomMetaData(ctx sdk.Context, denom string) banktypes.Metadata {
+	return b.live.GetDenomMetaData(ctx, denom)
+}
+
+func (b *replayBank) GetSupply(_ sdk.Context) bankexported.SupplyI {
+	supply, _ := b.minted.SafeSub(b.burned)
+	return banktypes.NewSupply(supply)
+}
+
+// ReplayObservedClaims re-executes the attestation handler over the stored observed claims, in event nonce order,
+// against a bank that starts out empty and compares what the replayed deposits issued of every Ethereum originated
+// token with what is outstanding of it now. A deposit can only ever be paid out once, so more vouchers outstanding
+// than the deposits issued points to an accounting bug, for example in an upgrade. Nothing the replay does is
+// persisted, the live state is only read. When the history is complete the Cosmos originated token mappings are
+// rebuilt from the replayed claims as well
+func (k Keeper) ReplayObservedClaims(ctx sdk.Context) types.ReplayReport {
+	live := k.GetSolvencyReport(ctx)
+
+	var atts []types.Attestation
+	k.IterateAttestaions(ctx, IterationLimit{}, func(_ []byte, att types.Attestation) bool {
+		// vetoed and queued attestations were never applied, there is nothing to replay
+		if att.Observed && !att.Vetoed && !att.PendingExecution {
+			atts = append(atts, att)
+		}
+		return false
+	})
+
+	report := types.ReplayReport{
+		FirstEventNonce: 0,
+		LastEventNonce:  0,
+		Replayed:        0,
+		Complete:        k.GetLastObservedEventNonce(ctx) == 0,
+		Tokens:          []types.ReplayedToken{},
+		Failures:        []types.ReplayFailure{},
+	}
+
+	// the handler pays out through its keeper as well, both have to use the replay bank
+	bank := &replayBank{live: k.bankKeeper, minted: sdk.Coins{}, burned: sdk.Coins{}, released: sdk.Coins{}}
+	replayKeeper := k
+	replayKeeper.bankKeeper = bank
+	handler := newAttestationHandler(replayKeeper, bank)
+	xCtx, _ := ctx.CacheContext()
+	xCtx = xCtx.WithEventManager(sdk.NewEventManager())
+
+	for i, att := range atts {
+		claim, err := k.UnpackAttestationClaim(&att)
+		if err != nil {
+			panic(fmt.Sprintf("invalid attestation in store: %v", err))
+		}
+		if i == 0 {
+			report.FirstEventNonce = claim.GetEventNonce()
+			// older claims are pruned first, so the history is complete if it starts at the first event
+			report.Complete = report.FirstEventNonce == 1
+			if report.Complete {
+				k.clearReplayPrefix(xCtx, types.DenomToERC20Key)
+				k.clearReplayPrefix(xCtx, types.ERC20ToDenomKey)
+			}
+		}
+		report.LastEventNonce = claim.GetEventNonce()
+		report.Replayed++
+
+		// each claim is applied on its own like processAttestation does, a failure leaves no trace
+		cCtx, commit := xCtx.CacheContext()
+		if err := replayClaim(cCtx, handler, att, claim); err != nil {
+			report.Failures = append(report.Failures, types.ReplayFailure{
+				EventNonce: claim.GetEventNonce(),
+				ClaimType:  claim.GetType().String(),
+				Error:      err.Error(),
+			})
+			continue
+		}
+		commit()
+	}
+
+	report.Tokens = replayedTokens(live, bank, report.Complete)
+	return report
+}
+
+// clearReplayPrefix deletes everything under prefix from the store of the replay
+func (k Keeper) clearReplayPrefix(ctx sdk.Context, prefix []byte) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	iter := store.Iterator(prefixRange(prefix))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// replayClaim applies claim with handler, turning a panic into an error so that one claim can not end the replay
+func replayClaim(ctx sdk.Context, handler AttestationHandler, att types.Attestation, claim types.EthereumClaim) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return handler.Handle(ctx, att, claim)
+}
+
+// replayedTokens compares the amounts the replay issued with the live solvency report, the issued amounts are
+// only checked if the replay covered the complete history
+func replayedTokens(live []types.TokenSolvency, bank *replayBank, complete bool) []types.ReplayedToken {
+	seen := make(map[string]struct{})
+	var tokens []types.ReplayedToken
+	for _, entry := range live {
+		seen[entry.TokenContract] = struct{}{}
+		token := types.ReplayedToken{
+			TokenContract:    entry.TokenContract,
+			Denom:            entry.Denom,
+			CosmosOriginated: entry.CosmosOriginated,
+			Issued:           sdk.ZeroInt(),
+			Outstanding:      sdk.ZeroInt(),
+			Discrepancy:      "",
+		}
+		if entry.CosmosOriginated {
+			token.Issued = bank.released.AmountOf(entry.Denom)
+			token.Outstanding = entry.EscrowBalance
+		} else {
+			token.Issued = bank.minted.AmountOf(entry.Denom).Sub(bank.burned.AmountOf(entry.Denom))
+			token.Outstanding = entry.Supply.Add(entry.PoolAmount).Add(entry.BatchedAmount)
+			if complete && token.Outstanding.GT(token.Issued) {
+				token.Discrepancy = fmt.Sprintf("%s%s outstanding but the observed deposits only issued %s",
+					token.Outstanding, entry.Denom, token.Issued)
+			}
+		}
+		tokens = append(tokens, token)
+	}
+
+	// vouchers that were all withdrawn again are not in the live report
+	for _, coin := range bank.minted {
+		contract, err := types.GravityDenomToERC20(coin.Denom)
+		if err != nil {
+			continue
+		}
+		if _, ok := seen[contract.GetAddress()]; ok {
+			continue
+		}
+		tokens = append(tokens, types.ReplayedToken{
+			TokenContract:    contract.GetAddress(),
+			Denom:            coin.Denom,
+			CosmosOriginated: false,
+			Issued:           coin.Amount.Sub(bank.burned.AmountOf(coin.Denom)),
+			Outstanding:      sdk.ZeroInt(),
+			Discrepancy:      "",
+		})
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].TokenContract < tokens[j].TokenContract
+	})
+	return tokens
+}
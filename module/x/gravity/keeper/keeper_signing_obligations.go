@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//   SIGNING OBLIGATIONS   //
+/////////////////////////////
+
+// GetUnbondingValidators returns the validators in the unbonding queue of the staking module
+func (k Keeper) GetUnbondingValidators(ctx sdk.Context) (out []stakingtypes.Validator) {
+	endTime := ctx.BlockTime().Add(k.StakingKeeper.GetParams(ctx).UnbondingTime)
+	iter := k.StakingKeeper.ValidatorQueueIterator(ctx, endTime, ctx.BlockHeight())
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		for _, valAddr := range k.DeserializeValidatorIterator(iter.Value()).Addresses {
+			addr, err := sdk.ValAddressFromBech32(valAddr)
+			if err != nil {
+				panic(err)
+			}
+			if validator, found := k.StakingKeeper.GetValidator(ctx, addr); found && validator.IsUnbonding() {
+				out = append(out, validator)
+			}
+		}
+	}
+	return
+}
+
+// getSigningValidators returns the validators that may have to sign valsets and batches, the bonded set followed by
+// the unbonding validators
+func (k Keeper) getSigningValidators(ctx sdk.Context) []stakingtypes.Validator {
+	return append(k.StakingKeeper.GetBondedValidatorsByPower(ctx), k.GetUnbondingValidators(ctx)...)
+}
+
+// GetSigningExemptHeight returns the height from which validator no longer has to sign the valsets and batches
+// created, UnbondSlashingValsetsWindow blocks after it started unbonding. Bonded validators have no such height, for
+// them it is 0. An unbonding validator is also left out of the valsets created from then on, since valsets are only
+// made of the bonded set
+func (k Keeper) GetSigningExemptHeight(ctx sdk.Context, validator stakingtypes.Validator) uint64 {
+	if validator.IsBonded() {
+		return 0
+	}
+	return uint64(validator.UnbondingHeight) + k.GetParams(ctx).UnbondSlashingValsetsWindow
+}
+
+// IsSigningObligated returns true if validator has to confirm a valset or batch created at height, or be slashed.
+// Only validators that joined before height have to, and unbonding ones only below their signing exempt height
+func (k Keeper) IsSigningObligated(ctx sdk.Context, validator stakingtypes.Validator, height uint64) bool {
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return false
+	}
+	signingInfo, found := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	if !found || uint64(signingInfo.StartHeight) >= height {
+		return false
+	}
+	switch {
+	case validator.IsBonded():
+		return true
+	case validator.IsUnbonding():
+		return height < k.GetSigningExemptHeight(ctx, validator)
+	default:
+		return false
+	}
+}
+
+// hasConfirmedValset returns true if one of confirms was signed with the Ethereum key of validator
+func (k Keeper) hasConfirmedValset(ctx sdk.Context, validator stakingtypes.Validator, confirms []*types.MsgValsetConfirm) bool {
+	ethAddress, found := k.GetEthAddressByValidator(ctx, validator.GetOperator())
+	if !found {
+		return false
+	}
+	for _, conf := range confirms {
+		// problem site for delegate key rotation, see issue #344
+		if strings.EqualFold(conf.EthAddress, ethAddress.GetAddress()) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasConfirmedBatch returns true if one of confirms was sent by the orchestrator of validator
+func (k Keeper) hasConfirmedBatch(ctx sdk.Context, validator stakingtypes.Validator, confirms []types.MsgConfirmBatch) bool {
+	for _, conf := range confirms {
+		// TODO this presents problems for delegate key rotation see issue #344
+		orch, err := sdk.AccAddressFromBech32(conf.Orchestrator)
+		if err != nil {
+			continue
+		}
+		if confVal, found := k.GetOrchestratorValidator(ctx, orch); found && confVal.GetOperator().Equals(validator.GetOperator()) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetValsetNonSigners returns the validators obligated to confirm valset that have not
+func (k Keeper) GetValsetNonSigners(ctx sdk.Context, valset *types.Valset) (out []stakingtypes.Validator) {
+	confirms := k.GetValsetConfirms(ctx, valset.Nonce)
+	for _, val := range k.getSigningValidators(ctx) {
+		if k.IsSigningObligated(ctx, val, valset.Height) && !k.hasConfirmedValset(ctx, val, confirms) {
+			out = append(out, val)
+		}
+	}
+	return
+}
+
+// GetBatchNonSigners returns the validators obligated to confirm batch that have not
+func (k Keeper) GetBatchNonSigners(ctx sdk.Context, batch *types.InternalOutgoingTxBatch) (out []stakingtypes.Validator) {
+	confirms := k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract)
+	for _, val := range k.getSigningValidators(ctx) {
+		if k.IsSigningObligated(ctx, val, batch.Block) && !k.hasConfirmedBatch(ctx, val, confirms) {
+			out = append(out, val)
+		}
+	}
+	return
+}
+
+// GetSigningObligations returns the valsets and batches validator has not confirmed yet that it would be slashed for
+// once their window passed, those after LastSlashedValsetNonce and LastSlashedBatchBlock
+func (k Keeper) GetSigningObligations(ctx sdk.Context, validator stakingtypes.Validator) types.ValidatorSigningObligations {
+	ret := types.ValidatorSigningObligations{
+		ValidatorAddress: validator.GetOperator().String(),
+		Status:           validator.GetStatus().String(),
+		UnbondingHeight:  uint64(validator.UnbondingHeight),
+		ExemptFromHeight: k.GetSigningExemptHeight(ctx, validator),
+		ValsetNonces:     []uint64{},
+		Batches:          []types.BatchObligation{},
+	}
+	lastSlashedValsetNonce := k.GetLastSlashedValsetNonce(ctx)
+	for _, valset := range k.GetValsets(ctx) {
+		if valset.Nonce <= lastSlashedValsetNonce || !k.IsSigningObligated(ctx, validator, valset.Height) {
+			continue
+		}
+		if !k.hasConfirmedValset(ctx, validator, k.GetValsetConfirms(ctx, valset.Nonce)) {
+			ret.ValsetNonces = append(ret.ValsetNonces, valset.Nonce)
+		}
+	}
+	lastSlashedBatchBlock := k.GetLastSlashedBatchBlock(ctx)
+	for _, batch := range k.GetOutgoingTxBatches(ctx) {
+		if batch.Block <= lastSlashedBatchBlock || k.IsBatchSlashed(ctx, batch) || !k.IsSigningObligated(ctx, validator, batch.Block) {
+			continue
+		}
+		if !k.hasConfirmedBatch(ctx, validator, k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract)) {
+			ret.Batches = append(ret.Batches, types.BatchObligation{
+				TokenContract: batch.TokenContract.GetAddress(),
+				BatchNonce:    batch.BatchNonce,
+			})
+		}
+	}
+	return ret
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//        SOLVENCY         //
+/////////////////////////////
+
+// GetSolvencyReport checks every bridged token against what the module owes on it. Cosmos originated tokens
+// are locked in the module account when sent to Ethereum, so its balance must cover the unbatched transfers and
+// the unexecuted batches. Ethereum originated vouchers are burned when sent, so the module account should never
+// hold any beyond those of quarantined deposits, which like Cosmos originated quarantined deposits it owes on top,
+// and those of the valset reward reserve.
+// The report only reads state, a discrepancy is returned rather than halting the chain
+func (k Keeper) GetSolvencyReport(ctx sdk.Context) []types.TokenSolvency {
+	pool := make(map[string]sdk.Int)
+	batched := make(map[string]sdk.Int)
+	owe := func(owed map[string]sdk.Int, tx *types.InternalOutgoingTransferTx) {
+		contract := tx.Erc20Token.Contract.GetAddress()
+		total, ok := owed[contract]
+		if !ok {
+			total = sdk.ZeroInt()
+		}
+		owed[contract] = total.Add(tx.Erc20Token.Amount).Add(tx.Erc20Fee.Amount)
+	}
+	for _, tx := range k.GetUnbatchedTransactions(ctx) {
+		owe(pool, tx)
+	}
+	for _, batch := range k.GetOutgoingTxBatches(ctx) {
+		for _, tx := range batch.Transactions {
+			owe(batched, tx)
+		}
+	}
+	quarantined := make(map[string]sdk.Int)
+	for _, deposit := range k.GetQuarantinedDeposits(ctx) {
+		contract, err := types.NewEthAddress(deposit.TokenContract)
+		if err != nil {
+			k.logger(ctx).Error("invalid token contract on quarantined deposit", "nonce", deposit.EventNonce, "error", err.Error())
+			continue
+		}
+		total, ok := quarantined[contract.GetAddress()]
+		if !ok {
+			total = sdk.ZeroInt()
+		}
+		quarantined[contract.GetAddress()] = total.Add(deposit.Amount.Amount)
+	}
+
+	reserved := make(map[string]sdk.Int)
+	for _, reserve := range k.GetAllValsetRewardReserves(ctx) {
+		contract, err := types.GravityDenomToERC20(reserve.Denom)
+		if err != nil {
+			k.logger(ctx).Error("invalid denom in valset reward reserve", "denom", reserve.Denom, "error", err.Error())
+			continue
+		}
+		reserved[contract.GetAddress()] = reserve.Amount
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx).GetTotal()
+	escrow := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+
+	// the tokens to report are the ones with transfers pending, a cosmos originated mapping or vouchers in circulation
+	contracts := make(map[string]struct{})
+	for contract := range pool {
+		contracts[contract] = struct{}{}
+	}
+	for contract := range batched {
+		contracts[contract] = struct{}{}
+	}
+	for contract := range quarantined {
+		contracts[contract] = struct{}{}
+	}
+	for contract := range reserved {
+		contracts[contract] = struct{}{}
+	}
+	k.IterateERC20ToDenom(ctx, func(_ []byte, erc20ToDenom *types.ERC20ToDenom) bool {
+		contracts[erc20ToDenom.Erc20] = struct{}{}
+		return false
+	})
+	for _, coins := range []sdk.Coins{supply, escrow} {
+		for _, coin := range coins {
+			if contract, err := types.GravityDenomToERC20(coin.Denom); err == nil {
+				contracts[contract.GetAddress()] = struct{}{}
+			}
+		}
+	}
+	sorted := make([]string, 0, len(contracts))
+	for contract := range contracts {
+		sorted = append(sorted, contract)
+	}
+	sort.Strings(sorted)
+
+	report := make([]types.TokenSolvency, 0, len(sorted))
+	for _, c := range sorted {
+		contract, err := types.NewEthAddress(c)
+		if err != nil {
+			k.logger(ctx).Error("invalid token contract in solvency report", "contract", c, "error", err.Error())
+			continue
+		}
+		cosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, *contract)
+		entry := types.TokenSolvency{
+			TokenContract:     c,
+			Denom:             denom,
+			CosmosOriginated:  cosmosOriginated,
+			Supply:            supply.AmountOf(denom),
+			EscrowBalance:     escrow.AmountOf(denom),
+			PoolAmount:        sdk.ZeroInt(),
+			BatchedAmount:     sdk.ZeroInt(),
+			Discrepancy:       "",
+			QuarantinedAmount: sdk.ZeroInt(),
+			ReservedAmount:    sdk.ZeroInt(),
+		}
+		if amount, ok := pool[c]; ok {
+			entry.PoolAmount = amount
+		}
+		if amount, ok := batched[c]; ok {
+			entry.BatchedAmount = amount
+		}
+		if amount, ok := quarantined[c]; ok {
+			entry.QuarantinedAmount = amount
+		}
+		if amount, ok := reserved[c]; ok {
+			entry.ReservedAmount = amount
+		}
+
+		owed := entry.PoolAmount.Add(entry.BatchedAmount).Add(entry.QuarantinedAmount)
+		held := entry.QuarantinedAmount.Add(entry.ReservedAmount)
+		switch {
+		case cosmosOriginated && entry.EscrowBalance.LT(owed):
+			entry.Discrepancy = fmt.Sprintf("escrow of %s%s is %s short of the amount owed to pending transfers and quarantined deposits",
+				entry.EscrowBalance, denom, owed.Sub(entry.EscrowBalance))
+		case !cosmosOriginated && entry.EscrowBalance.LT(held):
+			entry.Discrepancy = fmt.Sprintf("module account holds %s%s of vouchers, %s short of the quarantined deposits and the valset reward reserve",
+				entry.EscrowBalance, denom, held.Sub(entry.EscrowBalance))
+		case !cosmosOriginated && entry.EscrowBalance.GT(held):
+			entry.Discrepancy = fmt.Sprintf("module account holds %s%s of vouchers that should have been burned",
+				entry.EscrowBalance.Sub(held), denom)
+		}
+		report = append(report, entry)
+	}
+	return report
+}
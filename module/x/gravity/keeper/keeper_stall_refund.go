@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//      STALL REFUNDS      //
+/////////////////////////////
+
+// IsBridgeStalled returns true once the bonded validators whose orchestrators sent a heartbeat within the last
+// StallRefundThreshold blocks hold less than the AttestationVotesPowerThreshold of the power, so that no valset or
+// batch can be confirmed and nothing in the pool will be relayed. It does not depend on deposits, a quiet bridge with
+// live orchestrators is not stalled. A bridge no bonded orchestrator has sent a heartbeat for has not started yet and
+// is not considered stalled
+func (k Keeper) IsBridgeStalled(ctx sdk.Context) bool {
+	threshold := k.GetParams(ctx).StallRefundThreshold
+	if threshold == 0 {
+		return false
+	}
+	totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
+	if !totalPower.IsPositive() {
+		return false
+	}
+
+	height := uint64(ctx.BlockHeight())
+	started := false
+	livePower := sdk.ZeroInt()
+	for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		heartbeat := k.GetOrchestratorHeartbeat(ctx, val.GetOperator())
+		if heartbeat == nil {
+			continue
+		}
+		started = true
+		if height <= heartbeat.CosmosBlockHeight+threshold {
+			livePower = livePower.AddRaw(k.StakingKeeper.GetLastValidatorPower(ctx, val.GetOperator()))
+		}
+	}
+	requiredPower := types.AttestationVotesPowerThreshold.Mul(totalPower).Quo(sdk.NewInt(100))
+	return started && livePower.LT(requiredPower)
+}
+
+// RefundStalledPool refunds the transfers waiting in the pool to their senders, oldest first and at most
+// StallRefundBudget of them per block, for as long as the bridge is stalled. Batched transfers are left alone
+// since their batches may still be relayed. Returns the number of transfers refunded
+func (k Keeper) RefundStalledPool(ctx sdk.Context) uint64 {
+	if !k.IsBridgeStalled(ctx) {
+		return 0
+	}
+	txs := k.GetUnbatchedTransactions(ctx)
+	if len(txs) == 0 {
+		return 0
+	}
+	sort.Slice(txs, func(i, j int) bool { return txs[i].Id < txs[j].Id })
+
+	budget := k.GetParams(ctx).StallRefundBudget
+	var refunded uint64
+	for _, tx := range txs {
+		if refunded == budget {
+			break
+		}
+		// a refund that fails half way must not leave the tx removed from the pool without paying it out
+		xCtx, commit := ctx.CacheContext()
+		if err := k.refundUnbatchedTX(xCtx, tx, types.REFUND_REASON_STALLED); err != nil {
+			k.logger(ctx).Error("stall refund failed", "id", tx.Id, "error", err.Error())
+			continue
+		}
+		commit()
+		refunded++
+	}
+	k.logger(ctx).Info("refunded pool transactions of stalled bridge", "count", fmt.Sprint(refunded))
+	return refunded
+}
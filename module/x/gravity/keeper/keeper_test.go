@@ -3,9 +3,12 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -94,8 +97,9 @@ func TestAttestationIterator(t *testing.T) {
 	// add some attestations to the store
 
 	att1 := &types.Attestation{
-		Observed: true,
-		Votes:    []string{},
+		Observed:         true,
+		Votes:            []string{},
+		ClaimHashVersion: types.ClaimHashVersion,
 	}
 	dep1 := &types.MsgSendToCosmosClaim{
 		EventNonce:     1,
@@ -106,8 +110,9 @@ func TestAttestationIterator(t *testing.T) {
 		Orchestrator:   AccAddrs[0].String(),
 	}
 	att2 := &types.Attestation{
-		Observed: true,
-		Votes:    []string{},
+		Observed:         true,
+		Votes:            []string{},
+		ClaimHashVersion: types.ClaimHashVersion,
 	}
 	dep2 := &types.MsgSendToCosmosClaim{
 		EventNonce:     2,
@@ -126,7 +131,7 @@ func TestAttestationIterator(t *testing.T) {
 	input.GravityKeeper.SetAttestation(ctx, dep2.EventNonce, hash2, att2)
 
 	atts := []types.Attestation{}
-	input.GravityKeeper.IterateAttestaions(ctx, func(_ []byte, att types.Attestation) bool {
+	input.GravityKeeper.IterateAttestaions(ctx, IterationLimit{}, func(_ []byte, att types.Attestation) bool {
 		atts = append(atts, att)
 		return false
 	})
@@ -176,6 +181,174 @@ func TestDelegateKeys(t *testing.T) {
 
 }
 
+func TestIterationLimitBounds(t *testing.T) {
+	cases := map[string]struct {
+		limit      IterationLimit
+		start, end []byte
+		reverse    bool
+		expStart   []byte
+		expEnd     []byte
+	}{
+		"no start key":       {start: []byte{1}, end: []byte{2}, expStart: []byte{1}, expEnd: []byte{2}},
+		"forward":            {limit: IterationLimit{StartAfter: []byte{1, 5}}, start: []byte{1}, end: []byte{2}, expStart: []byte{1, 5, 0}, expEnd: []byte{2}},
+		"forward unbounded":  {limit: IterationLimit{StartAfter: []byte{1, 5}}, expStart: []byte{1, 5, 0}},
+		"forward before":     {limit: IterationLimit{StartAfter: []byte{0, 5}}, start: []byte{1}, end: []byte{2}, expStart: []byte{1}, expEnd: []byte{2}},
+		"reverse":            {limit: IterationLimit{StartAfter: []byte{1, 5}}, start: []byte{1}, end: []byte{2}, reverse: true, expStart: []byte{1}, expEnd: []byte{1, 5}},
+		"reverse unbounded":  {limit: IterationLimit{StartAfter: []byte{1, 5}}, reverse: true, expEnd: []byte{1, 5}},
+		"reverse past range": {limit: IterationLimit{StartAfter: []byte{3}}, start: []byte{1}, end: []byte{2}, reverse: true, expStart: []byte{1}, expEnd: []byte{2}},
+	}
+
+	for testName, tc := range cases {
+		tc := tc
+		t.Run(testName, func(t *testing.T) {
+			start, end := tc.limit.bounds(tc.start, tc.end, tc.reverse)
+			assert.Equal(t, tc.expStart, start)
+			assert.Equal(t, tc.expEnd, end)
+		})
+	}
+}
+
+//nolint: exhaustivestruct
+func TestIterateValsetsPaged(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	vs := k.GetCurrentValset(ctx)
+	for i := 1; i < 10; i++ {
+		vs.Height = uint64(i)
+		vs.Nonce = uint64(i)
+		k.StoreValsetUnsafe(ctx, vs)
+	}
+	var all []uint64
+	k.IterateValsets(ctx, IterationLimit{}, func(_ []byte, val *types.Valset) bool {
+		all = append(all, val.Nonce)
+		return false
+	})
+	require.GreaterOrEqual(t, len(all), 9)
+
+	// page through the valsets three at a time, resuming after the last key of the previous page
+	var paged []uint64
+	var lastKey []byte
+	for {
+		var page int
+		k.IterateValsets(ctx, IterationLimit{StartAfter: lastKey, MaxResults: 3}, func(key []byte, val *types.Valset) bool {
+			paged = append(paged, val.Nonce)
+			lastKey = append([]byte{}, key...)
+			page++
+			return false
+		})
+		require.LessOrEqual(t, page, 3)
+		if page < 3 {
+			break
+		}
+	}
+	assert.Equal(t, all, paged)
+}
+
+//nolint: exhaustivestruct
+func TestPruneValsetsWithConfirms(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	vs := k.GetCurrentValset(ctx)
+	confirm := func(nonce uint64) {
+		k.SetValsetConfirm(ctx, types.MsgValsetConfirm{
+			Nonce:        nonce,
+			Orchestrator: AccAddrs[0].String(),
+			EthAddress:   EthAddrs[0].String(),
+			Signature:    "signature",
+		})
+	}
+	for i := uint64(1); i <= 5; i++ {
+		vs.Height = i
+		vs.Nonce = i
+		k.StoreValsetUnsafe(ctx, vs)
+		confirm(i)
+	}
+
+	// valsets below the nonce go together with their confirms
+	require.Equal(t, uint64(2), k.PruneValsets(ctx, 3, 100))
+	for i := uint64(1); i <= 5; i++ {
+		require.Equal(t, i >= 3, k.GetValset(ctx, i) != nil)
+		require.Equal(t, i >= 3, k.GetValsetConfirm(ctx, i, AccAddrs[0]) != nil)
+	}
+
+	// a confirm left behind by a valset pruned before is cleared as well
+	confirm(1)
+	require.Zero(t, k.PruneValsets(ctx, 3, 100))
+	require.Nil(t, k.GetValsetConfirm(ctx, 1, AccAddrs[0]))
+
+	// the retained valsets are paged through oldest first
+	page, pageRes, err := k.GetHistoricalValsets(ctx, &query.PageRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, uint64(3), page[0].Nonce)
+	require.Equal(t, uint64(4), page[1].Nonce)
+	page, _, err = k.GetHistoricalValsets(ctx, &query.PageRequest{Key: pageRes.NextKey})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, uint64(5), page[0].Nonce)
+}
+
+//nolint: exhaustivestruct
+func TestValsetRelaySignatures(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	valset := k.SetValsetRequest(ctx)
+
+	// the signer set is the valset on Ethereum, which is unknown until one was observed
+	_, err := k.GetValsetRelaySignatures(ctx, valset.Nonce)
+	require.Error(t, err)
+	_, err = k.GetValsetRelaySignatures(ctx, valset.Nonce+1)
+	require.Error(t, err)
+
+	k.SetLastObservedValset(ctx, types.Valset{
+		Nonce: 7,
+		Members: []*types.BridgeValidator{
+			{Power: 3000, EthereumAddress: EthAddrs[0].String()},
+			{Power: 2000, EthereumAddress: EthAddrs[1].String()},
+			{Power: 1000, EthereumAddress: EthAddrs[2].String()},
+		},
+		RewardAmount: sdk.ZeroInt(),
+		RewardToken:  types.ZeroAddressString,
+	})
+	signature := func(v byte) string {
+		sig := append(bytes.Repeat([]byte{0x1}, 32), bytes.Repeat([]byte{0x2}, 32)...)
+		return fmt.Sprintf("%x", append(sig, v))
+	}
+	k.SetValsetConfirm(ctx, types.MsgValsetConfirm{
+		Nonce:        valset.Nonce,
+		Orchestrator: AccAddrs[0].String(),
+		EthAddress:   EthAddrs[2].String(),
+		Signature:    signature(1),
+	})
+	k.SetValsetConfirm(ctx, types.MsgValsetConfirm{
+		Nonce:        valset.Nonce,
+		Orchestrator: AccAddrs[1].String(),
+		EthAddress:   strings.ToLower(EthAddrs[0].String()),
+		Signature:    signature(28),
+	})
+
+	res, err := k.GetValsetRelaySignatures(ctx, valset.Nonce)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), res.SignerValsetNonce)
+	assert.Equal(t, uint64(4000), res.SignedPower)
+	assert.Equal(t, types.LegacyEthereumPowerThreshold, res.PowerThreshold)
+	assert.Equal(t, "0x"+fmt.Sprintf("%x", valset.GetCheckpoint(k.GetGravityID(ctx))), res.Checkpoint)
+	require.Len(t, res.Signatures, 3)
+
+	// in the order of the observed members, the one that did not sign gets a zero placeholder
+	for i, member := range []string{EthAddrs[0].String(), EthAddrs[1].String(), EthAddrs[2].String()} {
+		assert.Equal(t, member, res.Signatures[i].EthAddress)
+	}
+	assert.Equal(t, uint32(28), res.Signatures[0].V)
+	assert.Equal(t, "0x"+strings.Repeat("01", 32), res.Signatures[0].R)
+	assert.Equal(t, "0x"+strings.Repeat("02", 32), res.Signatures[0].S)
+	assert.Equal(t, uint32(0), res.Signatures[1].V)
+	assert.Equal(t, "0x"+strings.Repeat("0", 64), res.Signatures[1].R)
+	assert.Equal(t, uint32(28), res.Signatures[2].V)
+}
+
 //nolint: exhaustivestruct
 func TestLastSlashedValsetNonce(t *testing.T) {
 	input, ctx := SetupFiveValChain(t)
@@ -224,3 +397,604 @@ func TestLastSlashedValsetNonce(t *testing.T) {
 	assert.Equal(t, len(unslashedValsets), 6)
 	fmt.Println("unslashedValsetsRange", unslashedValsets)
 }
+
+//nolint: exhaustivestruct
+func TestBridgeMigration(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	oldContract := k.GetBridgeContractAddress(ctx)
+	newContract, err := types.NewEthAddress("0x2c3a83a4c6b1ef7a1e5f6a45b1f0a9b5d2fa0c12")
+	require.NoError(t, err)
+	otherContract, err := types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, err)
+
+	// the current contract can not be migrated to
+	err = k.HandleBridgeMigrationProposal(ctx, types.NewBridgeMigrationProposal("t", "d", *oldContract))
+	require.Error(t, err)
+
+	require.NoError(t, k.HandleBridgeMigrationProposal(ctx, types.NewBridgeMigrationProposal("t", "d", *newContract)))
+	require.True(t, k.IsBridgeMigrating(ctx))
+	// only one migration may run at a time
+	err = k.HandleBridgeMigrationProposal(ctx, types.NewBridgeMigrationProposal("t", "d", *otherContract))
+	require.True(t, types.ErrBridgeMigrating.Is(err))
+
+	// new sends are frozen
+	receiver, err := types.NewEthAddress(EthAddrs[0].String())
+	require.NoError(t, err)
+	amount := sdk.NewCoin("stake", sdk.NewInt(100))
+	fee := sdk.NewCoin("stake", sdk.NewInt(1))
+	_, err = k.AddToOutgoingPool(ctx, AccAddrs[0], *receiver, amount, fee)
+	require.True(t, types.ErrBridgeMigrating.Is(err))
+
+	// a pending logic call holds the migration in the draining state
+	call := types.OutgoingLogicCall{InvalidationId: []byte("call"), InvalidationNonce: 1, Timeout: 10000}
+	k.SetOutgoingLogicCall(ctx, &call)
+	k.AdvanceBridgeMigration(ctx)
+	require.Equal(t, types.BRIDGE_MIGRATION_STATUS_DRAINING, k.GetBridgeMigration(ctx).Status)
+
+	// the migration can not complete before the migration valset was emitted
+	require.Error(t, k.CompleteBridgeMigration(ctx, *newContract))
+
+	k.DeleteOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce)
+	k.AdvanceBridgeMigration(ctx)
+	migration := k.GetBridgeMigration(ctx)
+	require.Equal(t, types.BRIDGE_MIGRATION_STATUS_AWAITING_COMPLETION, migration.Status)
+	require.Equal(t, k.GetLatestValsetNonce(ctx), migration.MigrationValsetNonce)
+
+	// only the contract chosen by governance completes the migration
+	err = k.CompleteBridgeMigration(ctx, *otherContract)
+	require.True(t, types.ErrMismatched.Is(err))
+	require.Equal(t, oldContract.GetAddress(), k.GetBridgeContractAddress(ctx).GetAddress())
+
+	require.NoError(t, k.CompleteBridgeMigration(ctx, *newContract))
+	require.False(t, k.IsBridgeMigrating(ctx))
+	require.Nil(t, k.GetBridgeMigration(ctx))
+	require.Equal(t, newContract.GetAddress(), k.GetBridgeContractAddress(ctx).GetAddress())
+}
+
+func TestMedianEthereumHeight(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	// two of five equally powered validators are not a majority
+	k.SetEthereumHeightVote(ctx, ValAddrs[0], 100)
+	k.SetEthereumHeightVote(ctx, ValAddrs[1], 200)
+	_, ok := k.GetMedianEthereumHeight(ctx)
+	require.False(t, ok)
+	k.UpdateObservedEthereumHeight(ctx)
+	require.Equal(t, uint64(0), k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight)
+
+	k.SetEthereumHeightVote(ctx, ValAddrs[2], 300)
+	median, ok := k.GetMedianEthereumHeight(ctx)
+	require.True(t, ok)
+	require.Equal(t, uint64(100), median)
+
+	// a single orchestrator reporting a far away height only moves the median to the next vote
+	k.SetEthereumHeightVote(ctx, ValAddrs[3], 1000000)
+	k.UpdateObservedEthereumHeight(ctx)
+	require.Equal(t, uint64(200), k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight)
+
+	// votes never move backwards
+	k.SetEthereumHeightVote(ctx, ValAddrs[1], 50)
+	require.Equal(t, uint64(200), k.GetEthereumHeightVote(ctx, ValAddrs[1]).EthereumBlockHeight)
+}
+
+func TestEthereumHeightDrift(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	drifts := func() int {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		k.CheckEthereumHeightDrift(ctx)
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == "gravity.v1.EventEthereumHeightDrift" {
+				count++
+			}
+		}
+		return count
+	}
+	for i := 0; i < 4; i++ {
+		k.SetEthereumHeightVote(ctx, ValAddrs[i], 1000)
+	}
+	k.SetEthereumHeightVote(ctx, ValAddrs[4], 850)
+
+	// a validator too far behind the median is reported once
+	require.Equal(t, 1, drifts())
+	require.Equal(t, 0, drifts())
+	lags := k.GetEthereumHeightLags(ctx, 1000)
+	require.Len(t, lags, 5)
+	assert.Equal(t, ValAddrs[4].String(), lags[4].Validator)
+	assert.Equal(t, uint64(150), lags[4].Lag)
+	assert.True(t, lags[4].Drifting)
+	assert.False(t, lags[0].Drifting)
+
+	// once it caught up a later drift is reported again
+	k.SetEthereumHeightVote(ctx, ValAddrs[4], 950)
+	require.Equal(t, 0, drifts())
+	for i := 0; i < 4; i++ {
+		k.SetEthereumHeightVote(ctx, ValAddrs[i], 1200)
+	}
+	require.Equal(t, 1, drifts())
+
+	// the alert is off with a zero threshold
+	params := k.GetParams(ctx)
+	params.EthereumHeightDriftThreshold = 0
+	k.SetParams(ctx, params)
+	require.Equal(t, 0, drifts())
+}
+
+func TestMedianEthereumGasPrice(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	ctx = ctx.WithBlockHeight(200)
+	report := func(i int, gasPrice uint64, height int64) {
+		k.SetOrchestratorHeartbeat(ctx, ValAddrs[i], types.OrchestratorHeartbeat{
+			Validator:         ValAddrs[i].String(),
+			Orchestrator:      AccAddrs[i].String(),
+			EthGasPrice:       gasPrice,
+			CosmosBlockHeight: uint64(height),
+		})
+	}
+
+	// a heartbeat without a gas price or one too old to count is not a report
+	report(0, 20, 200)
+	report(1, 0, 200)
+	report(2, 10, 99)
+	_, ok := k.GetMedianEthereumGasPrice(ctx)
+	require.False(t, ok)
+	reports, _ := k.GetEthereumGasPriceReports(ctx)
+	require.Len(t, reports, 1)
+
+	report(2, 10, 150)
+	report(3, 30, 190)
+	gasPrice, ok := k.GetMedianEthereumGasPrice(ctx)
+	require.True(t, ok)
+	require.Equal(t, uint64(10), gasPrice)
+
+	report(4, 40, 200)
+	gasPrice, ok = k.GetMedianEthereumGasPrice(ctx)
+	require.True(t, ok)
+	require.Equal(t, uint64(20), gasPrice)
+}
+
+func TestMedianEthereumFees(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	ctx = ctx.WithBlockHeight(200)
+	report := func(i int, gasPrice, baseFee, priorityFee uint64) {
+		k.SetOrchestratorHeartbeat(ctx, ValAddrs[i], types.OrchestratorHeartbeat{
+			Validator:         ValAddrs[i].String(),
+			Orchestrator:      AccAddrs[i].String(),
+			EthGasPrice:       gasPrice,
+			EthBaseFee:        baseFee,
+			EthPriorityFee:    priorityFee,
+			CosmosBlockHeight: 200,
+		})
+	}
+
+	// the flat gas price is used until a majority reports the fees
+	report(0, 50, 0, 0)
+	report(1, 50, 0, 0)
+	report(2, 50, 0, 0)
+	report(3, 0, 30, 2)
+	report(4, 0, 30, 2)
+	_, _, ok := k.GetMedianEthereumFees(ctx)
+	require.False(t, ok)
+	gasPrice, ok := k.GetEthereumRelayGasPrice(ctx)
+	require.True(t, ok)
+	require.Equal(t, uint64(50), gasPrice)
+
+	// a zero tip counts towards the median priority fee
+	report(0, 50, 20, 0)
+	baseFee, priorityFee, ok := k.GetMedianEthereumFees(ctx)
+	require.True(t, ok)
+	require.Equal(t, uint64(20), baseFee)
+	require.Equal(t, uint64(0), priorityFee)
+
+	report(1, 0, 40, 3)
+	baseFee, priorityFee, ok = k.GetMedianEthereumFees(ctx)
+	require.True(t, ok)
+	require.Equal(t, uint64(30), baseFee)
+	require.Equal(t, uint64(2), priorityFee)
+	gasPrice, ok = k.GetEthereumRelayGasPrice(ctx)
+	require.True(t, ok)
+	require.Equal(t, uint64(32), gasPrice)
+}
+
+func TestEthereumBlockTimeCalibration(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	params := k.GetParams(ctx)
+	require.Equal(t, uint64(100), params.EthereumBlockTimeCalibrationPeriod)
+
+	k.RecordEthereumBlockTimeSample(ctx, 1000, 1600000000)
+	k.RecordEthereumBlockTimeSample(ctx, 1050, 1600000600)
+	// samples going backwards are ignored
+	k.RecordEthereumBlockTimeSample(ctx, 1020, 1600000300)
+	calibration := k.GetEthereumBlockTimeCalibration(ctx)
+	require.Equal(t, uint64(1000), calibration.AnchorEthereumHeight)
+	require.Equal(t, uint64(1050), calibration.LatestEthereumHeight)
+
+	// a window of only 50 blocks is too short to calibrate from
+	k.CalibrateEthereumBlockTime(ctx)
+	require.Equal(t, params.AverageEthereumBlockTime, k.GetAverageEthereumBlockTime(ctx))
+	require.Equal(t, uint64(ctx.BlockHeight()), k.GetEthereumBlockTimeCalibration(ctx).LastCalibrationHeight)
+
+	// 200 blocks in 2400 seconds
+	k.RecordEthereumBlockTimeSample(ctx, 1200, 1600002400)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 50)
+	k.CalibrateEthereumBlockTime(ctx)
+	require.Equal(t, params.AverageEthereumBlockTime, k.GetAverageEthereumBlockTime(ctx))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 50)
+	k.CalibrateEthereumBlockTime(ctx)
+	require.Equal(t, uint64(12000), k.GetAverageEthereumBlockTime(ctx))
+	calibration = k.GetEthereumBlockTimeCalibration(ctx)
+	require.Equal(t, uint64(12000), calibration.CalibratedBlockTime)
+	require.Equal(t, uint64(1200), calibration.AnchorEthereumHeight)
+	// the governance param is left alone, it applies again once the recalibration is disabled
+	require.Equal(t, params.AverageEthereumBlockTime, k.GetParams(ctx).AverageEthereumBlockTime)
+	params.EthereumBlockTimeCalibrationPeriod = 0
+	k.SetParams(ctx, params)
+	require.Equal(t, params.AverageEthereumBlockTime, k.GetAverageEthereumBlockTime(ctx))
+}
+
+func TestProjectedEthereumHeight(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	now := time.Now().UTC()
+	ctx := input.Context.WithBlockTime(now)
+
+	require.Equal(t, uint64(0), k.GetProjectedEthereumHeight(ctx))
+	require.Equal(t, uint64(0), k.GetOutgoingTimeoutHeight(ctx))
+
+	k.SetLastObservedEthereumBlockHeight(ctx, 1000)
+	require.Equal(t, uint64(1000), k.GetProjectedEthereumHeight(ctx))
+
+	// 150 seconds at the 15 second testing Ethereum block time
+	ctx = ctx.WithBlockTime(now.Add(150 * time.Second)).WithBlockHeight(ctx.BlockHeight() + 30)
+	require.Equal(t, uint64(1010), k.GetProjectedEthereumHeight(ctx))
+	require.Equal(t, uint64(1010)+k.GetParams(ctx).EthereumTimeoutMargin, k.GetOutgoingTimeoutHeight(ctx))
+}
+
+func TestRunWithGasBudget(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+
+	// a single store write is more than the budget, the step is discarded
+	completed := k.RunWithGasBudget(ctx, "test", 1000, func(ctx sdk.Context) {
+		k.setLastObservedEventNonce(ctx, 5)
+	})
+	require.False(t, completed)
+	require.Equal(t, uint64(0), k.GetLastObservedEventNonce(ctx))
+
+	completed = k.RunWithGasBudget(ctx, "test", 100000, func(ctx sdk.Context) {
+		k.setLastObservedEventNonce(ctx, 5)
+	})
+	require.True(t, completed)
+	require.Equal(t, uint64(5), k.GetLastObservedEventNonce(ctx))
+
+	// a zero budget is unmetered
+	completed = k.RunWithGasBudget(ctx, "test", 0, func(ctx sdk.Context) {
+		k.setLastObservedEventNonce(ctx, 6)
+	})
+	require.True(t, completed)
+	require.Equal(t, uint64(6), k.GetLastObservedEventNonce(ctx))
+
+	// the units completed before the budget ran out are kept, the one in progress is discarded
+	completed = k.RunWithGasBudget(ctx, "test", 3000, func(ctx sdk.Context) {
+		for nonce := uint64(7); nonce < 10; nonce++ {
+			nonce := nonce
+			k.RunBudgetedUnit(ctx, func(ctx sdk.Context) {
+				k.setLastObservedEventNonce(ctx, nonce)
+			})
+		}
+	})
+	require.False(t, completed)
+	require.Equal(t, uint64(7), k.GetLastObservedEventNonce(ctx))
+
+	// other panics are not swallowed
+	require.Panics(t, func() {
+		k.RunWithGasBudget(ctx, "test", 0, func(sdk.Context) { panic("unexpected") })
+	})
+}
+
+func TestRelayerLottery(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	params := k.GetParams(ctx)
+	params.RelayerLotteryReward = sdk.NewInt64Coin("stake", 10)
+	params.RelayerLotteryWindow = 100
+	k.SetParams(ctx, params)
+
+	validatorRelayer, err := types.NewEthAddress(EthAddrs[0].String())
+	require.NoError(t, err)
+	unknownRelayer, err := types.NewEthAddress("0x0000000000000000000000000000000000000abc")
+	require.NoError(t, err)
+	receiver := sdk.AccAddress(ValAddrs[0])
+	before := input.BankKeeper.GetBalance(ctx, receiver, "stake")
+
+	// the unknown relayer takes part in the weights but can not win
+	k.RunRelayerLottery(ctx, unknownRelayer, 1)
+	k.RunRelayerLottery(ctx, validatorRelayer, 2)
+	relayers, weights := k.GetRelayerWeights(ctx, 0)
+	require.Len(t, relayers, 2)
+	require.Equal(t, uint64(1), weights[validatorRelayer.GetAddress()])
+	require.Equal(t, uint64(1), weights[unknownRelayer.GetAddress()])
+
+	after := input.BankKeeper.GetBalance(ctx, receiver, "stake")
+	require.Equal(t, before.Amount.AddRaw(10), after.Amount)
+
+	// once the window has passed only the new relay counts, and nobody eligible is left
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 200)
+	k.RunRelayerLottery(ctx, unknownRelayer, 3)
+	relayers, _ = k.GetRelayerWeights(ctx, 0)
+	require.Equal(t, []string{unknownRelayer.GetAddress()}, relayers)
+	require.Equal(t, after, input.BankKeeper.GetBalance(ctx, receiver, "stake"))
+}
+
+func TestBridgeStats(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	start := time.Unix(1_600_000_000, 0)
+	ctx := input.Context.WithBlockTime(start)
+
+	tokenA, err := types.NewEthAddress(TokenContractAddrs[0])
+	require.NoError(t, err)
+	tokenB, err := types.NewEthAddress(TokenContractAddrs[1])
+	require.NoError(t, err)
+
+	k.recordBridgeDeposit(ctx, *tokenA, EthAddrs[0].String(), sdk.NewInt(100))
+	k.recordBridgeWithdrawal(ctx, *tokenA, AccAddrs[0].String(), sdk.NewInt(40))
+	// two days later only the weekly window still covers the first bucket
+	ctx = ctx.WithBlockTime(start.Add(48 * time.Hour))
+	k.recordBridgeDeposit(ctx, *tokenA, EthAddrs[0].String(), sdk.NewInt(5))
+	k.recordBridgeDeposit(ctx, *tokenB, EthAddrs[1].String(), sdk.NewInt(7))
+
+	day := k.GetBridgeStats(ctx, BridgeStatsDayBuckets)
+	assert.Equal(t, uint64(2), day.Deposits)
+	assert.Equal(t, uint64(0), day.Withdrawals)
+	assert.Equal(t, uint64(2), day.UniqueSenders)
+	require.Len(t, day.Tokens, 2)
+
+	week := k.GetBridgeStats(ctx, BridgeStatsWeekBuckets)
+	assert.Equal(t, uint64(3), week.Deposits)
+	assert.Equal(t, uint64(1), week.Withdrawals)
+	assert.Equal(t, uint64(3), week.UniqueSenders)
+	for _, stats := range week.Tokens {
+		if stats.TokenContract == tokenA.GetAddress() {
+			assert.Equal(t, sdk.NewInt(105), stats.DepositVolume)
+			assert.Equal(t, sdk.NewInt(40), stats.WithdrawalVolume)
+			assert.Equal(t, uint64(2), stats.UniqueSenders)
+		} else {
+			assert.Equal(t, uint64(1), stats.UniqueSenders)
+		}
+	}
+
+	// a one hour window of a single token only covers the current bucket
+	res, err := k.BridgeTokenStats(sdk.WrapSDKContext(ctx), &types.QueryBridgeTokenStatsRequest{
+		WindowHours:   1,
+		TokenContract: tokenA.GetAddress(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), res.Window.Deposits)
+	require.Len(t, res.Window.Tokens, 1)
+	assert.Equal(t, sdk.NewInt(5), res.Window.Tokens[0].DepositVolume)
+	assert.Equal(t, uint64(1), res.Window.Tokens[0].UniqueSenders)
+	_, err = k.BridgeTokenStats(sdk.WrapSDKContext(ctx), &types.QueryBridgeTokenStatsRequest{
+		WindowHours:   BridgeStatsWeekBuckets + 1,
+		TokenContract: "",
+	})
+	require.Error(t, err)
+
+	// pruning after a week drops the first bucket but keeps the recent one
+	ctx = ctx.WithBlockTime(start.Add(8 * 24 * time.Hour))
+	k.PruneBridgeStats(ctx)
+	ctx = ctx.WithBlockTime(start.Add(48 * time.Hour))
+	week = k.GetBridgeStats(ctx, BridgeStatsWeekBuckets)
+	assert.Equal(t, uint64(2), week.Deposits)
+	assert.Equal(t, uint64(0), week.Withdrawals)
+}
+
+// Tests that a withdrawal is counted once when its batch is executed, however often it was batched before
+func TestBridgeStatsRebatchedWithdrawal(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context.WithBlockTime(time.Unix(1_600_000_000, 0))
+
+	token, err := types.NewInternalERC20Token(sdk.NewInt(1000), TokenContractAddrs[0])
+	require.NoError(t, err)
+	vouchers := sdk.NewCoins(token.GravityCoin())
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, AccAddrs[0], vouchers))
+	receiver, err := types.NewEthAddress(EthAddrs[0].String())
+	require.NoError(t, err)
+	denom := token.GravityCoin().Denom
+	_, err = k.AddToOutgoingPool(ctx, AccAddrs[0], *receiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 1))
+	require.NoError(t, err)
+
+	first, err := k.BuildOutgoingTXBatch(ctx, token.Contract, 10)
+	require.NoError(t, err)
+	require.NoError(t, k.CancelOutgoingTXBatch(ctx, token.Contract, first.BatchNonce))
+	second, err := k.BuildOutgoingTXBatch(ctx, token.Contract, 10)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), k.GetBridgeStats(ctx, 1).Withdrawals)
+
+	k.OutgoingTxBatchExecuted(ctx, token.Contract, second.BatchNonce)
+	stats := k.GetBridgeStats(ctx, 1)
+	assert.Equal(t, uint64(1), stats.Withdrawals)
+	require.Len(t, stats.Tokens, 1)
+	assert.Equal(t, sdk.NewInt(100), stats.Tokens[0].WithdrawalVolume)
+}
+
+func TestWithStoredBlockHeader(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	start := time.Unix(1_600_000_000, 0).UTC()
+	ctx := input.Context.WithBlockHeight(10).WithBlockTime(start)
+
+	// nothing recorded yet, the context is left as is
+	assert.Equal(t, int64(10), k.WithStoredBlockHeader(ctx).BlockHeight())
+
+	token, err := types.NewEthAddress(TokenContractAddrs[0])
+	require.NoError(t, err)
+	k.recordBridgeDeposit(ctx, *token, EthAddrs[0].String(), sdk.NewInt(100))
+	k.SetLastBlockHeader(ctx)
+
+	// a query context built from this state but carrying the header of a block two days later
+	latest := ctx.WithBlockHeight(20).WithBlockTime(start.Add(48 * time.Hour))
+	stored := k.WithStoredBlockHeader(latest)
+	assert.Equal(t, int64(10), stored.BlockHeight())
+	assert.True(t, start.Equal(stored.BlockTime()))
+
+	// the daily stats are computed relative to the stored block, so the deposit is still in the window
+	res, err := k.BridgeStats(sdk.WrapSDKContext(latest), &types.QueryBridgeStatsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), res.LastDay.Deposits)
+}
+
+func TestSolvencyReport(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	cosmosToken, err := types.NewEthAddress(TokenContractAddrs[0])
+	require.NoError(t, err)
+	ethToken, err := types.NewEthAddress(TokenContractAddrs[1])
+	require.NoError(t, err)
+	receiver, err := types.NewEthAddress(EthAddrs[0].String())
+	require.NoError(t, err)
+	k.setCosmosOriginatedDenomToERC20(ctx, "ufoo", *cosmosToken)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ufoo", 1000))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, AccAddrs[0], coins))
+	_, err = k.AddToOutgoingPool(ctx, AccAddrs[0], *receiver, sdk.NewInt64Coin("ufoo", 100), sdk.NewInt64Coin("ufoo", 10))
+	require.NoError(t, err)
+
+	// the locked coins cover the pooled transfer exactly
+	report := k.GetSolvencyReport(ctx)
+	require.Len(t, report, 1)
+	assert.True(t, report[0].CosmosOriginated)
+	assert.Equal(t, sdk.NewInt(1000), report[0].Supply)
+	assert.Equal(t, sdk.NewInt(110), report[0].EscrowBalance)
+	assert.Equal(t, sdk.NewInt(110), report[0].PoolAmount)
+	assert.Empty(t, report[0].Discrepancy)
+
+	// escrow moved out from under the pool and vouchers left in the module account are both reported
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, AccAddrs[1],
+		sdk.NewCoins(sdk.NewInt64Coin("ufoo", 10))))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName,
+		sdk.NewCoins(sdk.NewInt64Coin(types.GravityDenom(*ethToken), 5))))
+
+	res, err := k.SolvencyReport(sdk.WrapSDKContext(ctx), &types.QuerySolvencyReportRequest{})
+	require.NoError(t, err)
+	assert.False(t, res.Solvent)
+	require.Len(t, res.Tokens, 2)
+	for _, token := range res.Tokens {
+		assert.NotEmpty(t, token.Discrepancy, token.TokenContract)
+		if token.TokenContract == ethToken.GetAddress() {
+			assert.False(t, token.CosmosOriginated)
+			assert.Equal(t, sdk.NewInt(5), token.EscrowBalance)
+		}
+	}
+}
+
+func TestBridgeStatus(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	cosmosToken, err := types.NewEthAddress(TokenContractAddrs[0])
+	require.NoError(t, err)
+	receiver, err := types.NewEthAddress(EthAddrs[0].String())
+	require.NoError(t, err)
+	k.setCosmosOriginatedDenomToERC20(ctx, "ufoo", *cosmosToken)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ufoo", 1000))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, AccAddrs[0], coins))
+	for _, fee := range []int64{10, 20, 30} {
+		_, err = k.AddToOutgoingPool(ctx, AccAddrs[0], *receiver, sdk.NewInt64Coin("ufoo", 100), sdk.NewInt64Coin("ufoo", fee))
+		require.NoError(t, err)
+	}
+	_, err = k.BuildOutgoingTXBatch(ctx, *cosmosToken, 1)
+	require.NoError(t, err)
+
+	// valset 1 is the one on Ethereum, 2 and 3 still have to be relayed
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		k.StoreValset(ctx, &types.Valset{Nonce: nonce, Members: types.BridgeValidators{}, RewardAmount: sdk.ZeroInt(), RewardToken: types.ZeroAddress().GetAddress()})
+	}
+	k.SetLastObservedValset(ctx, *k.GetValset(ctx, 1))
+
+	res, err := k.BridgeStatus(sdk.WrapSDKContext(ctx), &types.QueryBridgeStatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), res.LatestValsetNonce)
+	assert.Equal(t, uint64(1), res.LastObservedValsetNonce)
+	require.Len(t, res.UnrelayedValsets, 2)
+	assert.Equal(t, uint64(3), res.UnrelayedValsets[0].Nonce)
+	assert.Equal(t, uint64(2), res.UnrelayedValsets[1].Nonce)
+	assert.Equal(t, []types.TokenBridgeStatus{{TokenContract: cosmosToken.GetAddress(), PendingBatches: 1, UnbatchedTxs: 2}}, res.Tokens)
+	assert.Equal(t, uint64(2), res.PoolDepth)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufoo", 360)), res.ModuleBalances)
+}
+
+func TestEscrowedFundsInvariant(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	cosmosToken, err := types.NewEthAddress(TokenContractAddrs[0])
+	require.NoError(t, err)
+	receiver, err := types.NewEthAddress(EthAddrs[0].String())
+	require.NoError(t, err)
+	k.setCosmosOriginatedDenomToERC20(ctx, "ufoo", *cosmosToken)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ufoo", 1000))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, AccAddrs[0], coins))
+	for _, fee := range []int64{10, 20} {
+		_, err = k.AddToOutgoingPool(ctx, AccAddrs[0], *receiver, sdk.NewInt64Coin("ufoo", 100), sdk.NewInt64Coin("ufoo", fee))
+		require.NoError(t, err)
+	}
+	_, err = k.BuildOutgoingTXBatch(ctx, *cosmosToken, 1)
+	require.NoError(t, err)
+
+	// the escrow covers both the batched and the unbatched transfer
+	_, broken := AllInvariants(k)(ctx)
+	assert.False(t, broken)
+
+	// escrow leaving the module account without a transfer leaving the pool breaks it
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, AccAddrs[1],
+		sdk.NewCoins(sdk.NewInt64Coin("ufoo", 1))))
+	msg, broken := EscrowedFundsInvariant(k)(ctx)
+	assert.True(t, broken)
+	assert.Contains(t, msg, "ufoo")
+}
+
+// Tests that orchestrators are told the confirmation depth to wait for along with the event nonce to resume from
+func TestOrchestratorConfirmationDepth(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	k.SetOrchestratorValidator(ctx, ValAddrs[0], AccAddrs[0])
+	params := k.GetParams(ctx)
+	params.EthBlocksToObserve = 12
+	k.SetParams(ctx, params)
+
+	nonce, err := k.LastEventNonceByAddr(sdk.WrapSDKContext(ctx), &types.QueryLastEventNonceByAddrRequest{
+		Address: AccAddrs[0].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), nonce.EthBlocksToObserve)
+
+	submissions, err := k.OrchestratorSubmissions(sdk.WrapSDKContext(ctx), &types.QueryOrchestratorSubmissionsRequest{
+		Address: AccAddrs[0].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), submissions.EthBlocksToObserve)
+	require.Equal(t, nonce.EventNonce, submissions.LastEventNonce)
+}
@@ -5,10 +5,12 @@ import (
 	"math"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
@@ -32,7 +34,7 @@ func (k Keeper) SetValsetRequest(ctx sdk.Context) *types.Valset {
 	k.SetPastEthSignatureCheckpoint(ctx, checkpoint)
 
 	bridgeAddr := k.GetBridgeContractAddress(ctx)
-	ctx.EventManager().EmitEvent(
+	k.emitLegacyEvent(ctx,
 		sdk.NewEvent(
 			types.EventTypeMultisigUpdateRequest,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -42,6 +44,13 @@ func (k Keeper) SetValsetRequest(ctx sdk.Context) *types.Valset {
 			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(valset.Nonce)),
 		),
 	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventValsetUpdateRequested{
+		BridgeContract: bridgeAddr.GetAddress(),
+		BridgeChainId:  k.GetBridgeChainID(ctx),
+		Valset:         valset,
+	}); err != nil {
+		panic(sdkerrors.Wrap(err, "emit valset update requested event"))
+	}
 
 	return valset
 }
@@ -101,12 +110,14 @@ func (k Keeper) GetValset(ctx sdk.Context, nonce uint64) *types.Valset {
 	return &valset
 }
 
-// IterateValsets retruns all valsetRequests
-func (k Keeper) IterateValsets(ctx sdk.Context, cb func(key []byte, val *types.Valset) bool) {
+// IterateValsets retruns the valsetRequests in DESC nonce order, within limit
+func (k Keeper) IterateValsets(ctx sdk.Context, limit IterationLimit, cb func(key []byte, val *types.Valset) bool) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValsetRequestKey)
-	iter := prefixStore.ReverseIterator(nil, nil)
+	iter := prefixStore.ReverseIterator(limit.bounds(nil, nil, true))
 	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
+	var visited uint64
+	for ; iter.Valid() && !limit.reached(visited); iter.Next() {
+		visited++
 		var valset types.Valset
 		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &valset)
 		// cb returns true to stop early
@@ -118,7 +129,7 @@ func (k Keeper) IterateValsets(ctx sdk.Context, cb func(key []byte, val *types.V
 
 // GetValsets returns all the validator sets in state
 func (k Keeper) GetValsets(ctx sdk.Context) (out []*types.Valset) {
-	k.IterateValsets(ctx, func(_ []byte, val *types.Valset) bool {
+	k.IterateValsets(ctx, IterationLimit{}, func(_ []byte, val *types.Valset) bool {
 		out = append(out, val)
 		return false
 	})
@@ -126,6 +137,24 @@ func (k Keeper) GetValsets(ctx sdk.Context) (out []*types.Valset) {
 	return
 }
 
+// GetHistoricalValsets returns a page of the validator sets in store, oldest first
+func (k Keeper) GetHistoricalValsets(ctx sdk.Context, pageReq *query.PageRequest) ([]types.Valset, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValsetRequestKey)
+	var valsets []types.Valset
+	pageRes, err := query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
+		var valset types.Valset
+		if err := k.cdc.UnmarshalBinaryBare(value, &valset); err != nil {
+			return err
+		}
+		valsets = append(valsets, valset)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return valsets, pageRes, nil
+}
+
 // GetLatestValset returns the latest validator set in store. This is different
 // from the CurrrentValset because this one has been saved and is therefore *the* valset
 // for this nonce. GetCurrentValset shows you what could be, if you chose to save it, this function
@@ -268,6 +297,10 @@ func (k Keeper) GetCurrentValset(ctx sdk.Context) *types.Valset {
 		rewardToken = types.ZeroAddress()
 		rewardAmount = sdk.NewIntFromUint64(0)
 
+	} else if !k.isValsetRewardCovered(ctx, reward) {
+		// an Ethereum originated reward the reserve can not burn vouchers for is not offered
+		rewardToken = types.ZeroAddress()
+		rewardAmount = sdk.NewIntFromUint64(0)
 	} else {
 		rewardToken, rewardAmount = k.RewardToERC20Lookup(ctx, reward)
 	}
@@ -279,6 +312,9 @@ func (k Keeper) GetCurrentValset(ctx sdk.Context) *types.Valset {
 	if err != nil {
 		panic(sdkerrors.Wrap(err, "generated invalid valset"))
 	}
+	// the threshold is fixed at creation time so that a later param change can not alter
+	// the checkpoint of a valset validators may have already signed
+	valset.PowerThreshold = k.GetParams(ctx).EthereumPowerThreshold
 	return valset
 }
 
@@ -303,6 +339,40 @@ func (k Keeper) GetValsetConfirm(ctx sdk.Context, nonce uint64, validator sdk.Ac
 	return &confirm
 }
 
+// ValidateValsetConfirm checks msg against the state before it is stored. The valset must exist and still take
+// confirms, the orchestrator must not have confirmed it yet, and the signature must recover over the checkpoint of
+// the valset to the Ethereum address registered for the validator of the orchestrator, which the confirm has to name.
+// The ante handler runs it at CheckTx as well, so that confirms relayers could not use do not make it into a block
+func (k Keeper) ValidateValsetConfirm(ctx sdk.Context, msg *types.MsgValsetConfirm) error {
+	valset := k.GetValset(ctx, msg.Nonce)
+	if valset == nil {
+		return sdkerrors.Wrap(types.ErrInvalid, "couldn't find valset")
+	}
+	orchaddr, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid orchestrator")
+	}
+	// a resubmitted confirm is reported as such before its signature is checked again
+	if k.GetValsetConfirm(ctx, msg.Nonce, orchaddr) != nil {
+		return sdkerrors.Wrapf(types.ErrAlreadySubmitted, "confirm for valset %d", msg.Nonce)
+	}
+	if err := k.checkBridgeInstanceConfirm(ctx, valset.Height); err != nil {
+		return err
+	}
+
+	checkpoint := valset.GetCheckpoint(k.GetGravityID(ctx))
+	if err := k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, checkpoint); err != nil {
+		return err
+	}
+	// relayers match confirms to the members of the valset by the Ethereum address they name
+	validator, _ := k.GetOrchestratorValidator(ctx, orchaddr)
+	ethAddress, _ := k.GetEthAddressByValidator(ctx, validator.GetOperator())
+	if !strings.EqualFold(msg.EthAddress, ethAddress.GetAddress()) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "confirm names eth address %s instead of %s", msg.EthAddress, ethAddress.GetAddress())
+	}
+	return nil
+}
+
 // SetValsetConfirm sets a valset confirmation
 func (k Keeper) SetValsetConfirm(ctx sdk.Context, valsetConf types.MsgValsetConfirm) []byte {
 	store := ctx.KVStore(k.storeKey)
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//  VALSET REWARD RESERVE  //
+/////////////////////////////
+
+// ValidateValsetReward checks that the contract can pay reward to the relayers of valsets. A Cosmos originated
+// denom must have a deployed ERC20, the voucher of an Ethereum originated token always maps to its ERC20. A zero
+// reward is always valid, it means no reward
+func (k Keeper) ValidateValsetReward(ctx sdk.Context, reward sdk.Coin) error {
+	if !reward.IsValid() || reward.IsZero() {
+		return nil
+	}
+	if _, _, err := k.DenomToERC20Lookup(ctx, reward.Denom); err != nil {
+		return sdkerrors.Wrapf(err, "valset reward denom %s has no deployed ERC20", reward.Denom)
+	}
+	return nil
+}
+
+// HandleParamChangeProposal applies a passed params change proposal with paramsHandler, the handler of the params
+// module, and then checks the valset reward and the outflow limit against the state. The params can only check a
+// reward is a valid coin, a reward the contract could not pay, or an outflow limit the keeper has no price feed
+// for, fails the proposal and leaves the params unchanged
+func (k Keeper) HandleParamChangeProposal(ctx sdk.Context, content govtypes.Content, paramsHandler govtypes.Handler) error {
+	xCtx, commit := ctx.CacheContext()
+	if err := paramsHandler(xCtx, content); err != nil {
+		return err
+	}
+	params := k.GetParams(xCtx)
+	if err := k.ValidateValsetReward(xCtx, params.ValsetReward); err != nil {
+		return sdkerrors.Wrap(err, "invalid gravity params")
+	}
+	if err := k.ValidateOutflowLimit(params.OutflowUsdLimit); err != nil {
+		return sdkerrors.Wrap(err, "invalid gravity params")
+	}
+	commit()
+	ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+	return nil
+}
+
+// isValsetRewardCovered returns true if a valset can offer reward. An Ethereum originated reward is paid out of
+// the tokens backing its vouchers, so it is only offered while the reserve holds enough vouchers to burn for it
+func (k Keeper) isValsetRewardCovered(ctx sdk.Context, reward sdk.Coin) bool {
+	if _, err := types.GravityDenomToERC20(reward.Denom); err != nil {
+		return true
+	}
+	return k.GetValsetRewardReserve(ctx, reward.Denom).GTE(reward.Amount)
+}
+
+// GetValsetRewardReserve returns the vouchers of denom the module account holds to pay valset rewards with
+func (k Keeper) GetValsetRewardReserve(ctx sdk.Context, denom string) sdk.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetValsetRewardReserveKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	amount, ok := sdk.NewIntFromString(string(bz))
+	if !ok {
+		panic(fmt.Sprintf("invalid valset reward reserve of %s: %s", denom, bz))
+	}
+	return amount
+}
+
+// GetAllValsetRewardReserves returns the valset reward reserve of every denom
+func (k Keeper) GetAllValsetRewardReserves(ctx sdk.Context) sdk.Coins {
+	reserves := sdk.NewCoins()
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValsetRewardReserveKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		amount, ok := sdk.NewIntFromString(string(iter.Value()))
+		if !ok {
+			panic(fmt.Sprintf("invalid valset reward reserve of %s: %s", iter.Key(), iter.Value()))
+		}
+		reserves = reserves.Add(sdk.NewCoin(string(iter.Key()), amount))
+	}
+	return reserves
+}
+
+func (k Keeper) setValsetRewardReserve(ctx sdk.Context, denom string, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if !amount.IsPositive() {
+		store.Delete(types.GetValsetRewardReserveKey(denom))
+		return
+	}
+	store.Set(types.GetValsetRewardReserveKey(denom), []byte(amount.String()))
+}
+
+// FundValsetReward moves amount from sender into the valset reward reserve
+func (k Keeper) FundValsetReward(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) error {
+	if _, err := types.GravityDenomToERC20(amount.Denom); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalid, "%s is not the voucher of an Ethereum originated token", amount.Denom)
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.Coins{amount}); err != nil {
+		return sdkerrors.Wrap(err, "fund valset reward reserve")
+	}
+	k.setValsetRewardReserve(ctx, amount.Denom, k.GetValsetRewardReserve(ctx, amount.Denom).Add(amount.Amount))
+	k.emitLegacyEvent(ctx, sdk.NewEvent(
+		types.EventTypeValsetRewardFunded,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	))
+	return ctx.EventManager().EmitTypedEvent(&types.EventValsetRewardFunded{
+		Sender: sender.String(),
+		Amount: amount,
+	})
+}
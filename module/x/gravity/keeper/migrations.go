@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//       MIGRATIONS        //
+/////////////////////////////
+
+// MigratePoolKeys re-keys the transactions in the outgoing pool from the layout they were stored under before
+// priority classes, by token contract, fee and id, to the current one that puts their priority before the fee
+// and inverts the id, so that equal fees are batched oldest first for the transactions already in the pool too.
+// Every transaction is read from its stored value, so entries already under the current layout are written back
+// unchanged, and the sender, receiver and height indexes are rebuilt to point at the new keys. Transactions that
+// entered the pool before their entry height was kept are aged from the migration
+func (k Keeper) MigratePoolKeys(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+
+	// collect the entries first, the store may not be written to while it is being iterated
+	var (
+		keys [][]byte
+		txs  []*types.InternalOutgoingTransferTx
+	)
+	iter := store.Iterator(prefixRange(types.OutgoingTXPoolKey))
+	for ; iter.Valid(); iter.Next() {
+		var tx types.OutgoingTransferTx
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &tx); err != nil {
+			iter.Close()
+			return sdkerrors.Wrapf(err, "pool entry %X", iter.Key())
+		}
+		internal, err := tx.ToInternal()
+		if err != nil {
+			iter.Close()
+			return sdkerrors.Wrapf(err, "pool entry %X", iter.Key())
+		}
+		keys = append(keys, append([]byte{}, iter.Key()...))
+		txs = append(txs, internal)
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	for _, tx := range txs {
+		if tx.CreatedHeight == 0 {
+			tx.CreatedHeight = uint64(ctx.BlockHeight())
+		}
+		if err := k.addUnbatchedTX(ctx, tx); err != nil {
+			return sdkerrors.Wrapf(err, "re-key pool transaction %d", tx.Id)
+		}
+	}
+	return nil
+}
+
+// MigrateMissingParams sets every parameter that is missing from the params store to its default, so that an
+// upgrade introducing new parameters leaves GetParams, which panics on any missing key, working. Parameters
+// already set, including ones changed by governance, are left as they are
+func (k Keeper) MigrateMissingParams(ctx sdk.Context) {
+	for _, pair := range types.DefaultParams().ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
@@ -58,6 +58,13 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 		return nil, sdkerrors.Wrap(types.ErrResetDelegateKeys, val.String())
 	}
 
+	// the Ethereum key has to prove the validator controls it, confirms signed by any other key are rejected
+	// and the validator would be slashed for every valset and batch it could not confirm
+	sig, _ := hex.DecodeString(msg.EthSignature)
+	if err := types.ValidateEthereumSignature(types.DelegateKeysSignHash(val), sig, *addr); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "ethereum signature does not prove control of %s", addr.GetAddress())
+	}
+
 	// set the orchestrator address
 	k.SetOrchestratorValidator(ctx, val, orch)
 	// set the ethereum address
@@ -78,23 +85,11 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 // ValsetConfirm handles MsgValsetConfirm
 func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm) (*types.MsgValsetConfirmResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	valset := k.GetValset(ctx, msg.Nonce)
-	if valset == nil {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "couldn't find valset")
-	}
-
-	gravityID := k.GetGravityID(ctx)
-	checkpoint := valset.GetCheckpoint(gravityID)
-	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
-	err := k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, checkpoint)
-	if err != nil {
+	if err := k.ValidateValsetConfirm(ctx, msg); err != nil {
 		return nil, err
 	}
 
 	// persist signature
-	if k.GetValsetConfirm(ctx, msg.Nonce, orchaddr) != nil {
-		return nil, sdkerrors.Wrap(types.ErrDuplicate, "signature duplicate")
-	}
 	key := k.SetValsetConfirm(ctx, *msg)
 
 	ctx.EventManager().EmitEvent(
@@ -115,11 +110,35 @@ func (k msgServer) SendToEth(c context.Context, msg *types.MsgSendToEth) (*types
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "invalid sender")
 	}
-	dest, err := types.NewEthAddress(msg.EthDest)
+	var dest *types.EthAddress
+	if msg.EthDestLabel != "" {
+		dest, err = k.GetEthDestinationLabel(ctx, sender, msg.EthDestLabel)
+	} else {
+		dest, err = types.NewEthAddress(msg.EthDest)
+	}
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "invalid eth dest")
 	}
-	txID, err := k.AddToOutgoingPool(ctx, sender, *dest, msg.Amount, msg.BridgeFee)
+	// a transfer with a receiver hook is relayed on its own, there is no batch to preview
+	if msg.CallbackTarget != "" {
+		target, err := types.NewEthAddress(msg.CallbackTarget)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid callback target")
+		}
+		txID, err := k.SendToEthWithCallback(ctx, sender, *dest, *target, msg.CallbackData, msg.Amount, msg.BridgeFee)
+		if err != nil {
+			return nil, err
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+				sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(txID)),
+			),
+		)
+		return &types.MsgSendToEthResponse{InNextBatch: false, BatchPosition: 0, NextBatchMinFee: sdk.ZeroInt()}, nil
+	}
+	txID, err := k.AddToOutgoingPoolWithPayload(ctx, sender, *dest, msg.Amount, msg.BridgeFee, msg.Priority, msg.Payload)
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +151,16 @@ func (k msgServer) SendToEth(c context.Context, msg *types.MsgSendToEth) (*types
 		),
 	)
 
-	return &types.MsgSendToEthResponse{}, nil
+	res := &types.MsgSendToEthResponse{InNextBatch: false, BatchPosition: 0, NextBatchMinFee: sdk.ZeroInt()}
+	// messages only run outside of DeliverTx when simulated, a wallet learns if the fee is high enough to be batched
+	if ctx.IsCheckTx() {
+		tx, err := k.GetUnbatchedTxById(ctx, txID)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "preview next batch")
+		}
+		res.BatchPosition, res.InNextBatch, res.NextBatchMinFee = k.PreviewNextBatch(ctx, tx, k.GetMaxBatchSize(ctx, tx.Erc20Fee.Contract))
+	}
+	return res, nil
 }
 
 // RequestBatch handles MsgRequestBatch
@@ -146,7 +174,7 @@ func (k msgServer) RequestBatch(c context.Context, msg *types.MsgRequestBatch) (
 		return nil, err
 	}
 
-	batch, err := k.BuildOutgoingTXBatch(ctx, *tokenContract, OutgoingTxBatchSize)
+	batch, err := k.BuildOutgoingTXBatch(ctx, *tokenContract, k.GetMaxBatchSize(ctx, *tokenContract))
 	if err != nil {
 		return nil, err
 	}
@@ -176,19 +204,22 @@ func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (
 	if batch == nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "couldn't find batch")
 	}
+	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+	// a resubmitted confirm is reported as such before its signature is checked again
+	if k.GetBatchConfirm(ctx, msg.Nonce, *contract, orchaddr) != nil {
+		return nil, sdkerrors.Wrapf(types.ErrAlreadySubmitted, "confirm for batch %d of %s", msg.Nonce, contract.GetAddress())
+	}
+	if err := k.checkBridgeInstanceConfirm(ctx, batch.Block); err != nil {
+		return nil, err
+	}
 
 	gravityID := k.GetGravityID(ctx)
 	checkpoint := batch.GetCheckpoint(gravityID)
-	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
 	err = k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, checkpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	// check if we already have this confirm
-	if k.GetBatchConfirm(ctx, msg.Nonce, *contract, orchaddr) != nil {
-		return nil, sdkerrors.Wrap(types.ErrDuplicate, "duplicate signature")
-	}
 	key := k.SetBatchConfirm(ctx, msg)
 
 	ctx.EventManager().EmitEvent(
@@ -215,20 +246,22 @@ func (k msgServer) ConfirmLogicCall(c context.Context, msg *types.MsgConfirmLogi
 	if logic == nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "couldn't find logic")
 	}
+	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+	// a resubmitted confirm is reported as such before its signature is checked again
+	if k.GetLogicCallConfirm(ctx, invalidationIdBytes, msg.InvalidationNonce, orchaddr) != nil {
+		return nil, sdkerrors.Wrapf(types.ErrAlreadySubmitted, "confirm for logic call %s %d", msg.InvalidationId, msg.InvalidationNonce)
+	}
+	if err := k.checkBridgeInstanceConfirm(ctx, logic.Block); err != nil {
+		return nil, err
+	}
 
 	gravityID := k.GetGravityID(ctx)
 	checkpoint := logic.GetCheckpoint(gravityID)
-	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
 	err = k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, checkpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	// check if we already have this confirm
-	if k.GetLogicCallConfirm(ctx, invalidationIdBytes, msg.InvalidationNonce, orchaddr) != nil {
-		return nil, sdkerrors.Wrap(types.ErrDuplicate, "duplicate signature")
-	}
-
 	k.SetLogicCallConfirm(ctx, msg)
 
 	ctx.EventManager().EmitEvent(
@@ -241,6 +274,100 @@ func (k msgServer) ConfirmLogicCall(c context.Context, msg *types.MsgConfirmLogi
 	return nil, nil
 }
 
+// FundValsetReward handles MsgFundValsetReward, adding Ethereum originated vouchers to the valset reward reserve
+func (k msgServer) FundValsetReward(c context.Context, msg *types.MsgFundValsetReward) (*types.MsgFundValsetRewardResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid MsgFundValsetReward")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+
+	if err := k.Keeper.FundValsetReward(ctx, sender, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+		),
+	)
+
+	return &types.MsgFundValsetRewardResponse{}, nil
+}
+
+// SubmitConfirms handles MsgSubmitConfirms, processing every bundled confirm in its own cache context as if it had
+// been sent alone. A refused confirm is reported in the response rather than failing the others, the message only
+// fails when every confirm is refused for another reason than having been submitted already
+func (k msgServer) SubmitConfirms(c context.Context, msg *types.MsgSubmitConfirms) (*types.MsgSubmitConfirmsResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid MsgSubmitConfirms")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	accepted := 0
+	var alreadySubmitted uint64
+	var firstErr error
+	submit := func(handle func(xCtx context.Context) error) string {
+		xCtx, commit := ctx.CacheContext()
+		if err := handle(sdk.WrapSDKContext(xCtx)); err != nil {
+			if types.ErrAlreadySubmitted.Is(err) {
+				alreadySubmitted++
+			} else if firstErr == nil {
+				firstErr = err
+			}
+			return err.Error()
+		}
+		commit()
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+		accepted++
+		return ""
+	}
+
+	res := &types.MsgSubmitConfirmsResponse{
+		ValsetConfirmErrors:    make([]string, len(msg.ValsetConfirms)),
+		BatchConfirmErrors:     make([]string, len(msg.BatchConfirms)),
+		LogicCallConfirmErrors: make([]string, len(msg.LogicCallConfirms)),
+	}
+	for i := range msg.ValsetConfirms {
+		confirm := msg.ValsetConfirms[i]
+		res.ValsetConfirmErrors[i] = submit(func(xCtx context.Context) error {
+			_, err := k.ValsetConfirm(xCtx, &confirm)
+			return err
+		})
+	}
+	for i := range msg.BatchConfirms {
+		confirm := msg.BatchConfirms[i]
+		res.BatchConfirmErrors[i] = submit(func(xCtx context.Context) error {
+			_, err := k.ConfirmBatch(xCtx, &confirm)
+			return err
+		})
+	}
+	for i := range msg.LogicCallConfirms {
+		confirm := msg.LogicCallConfirms[i]
+		res.LogicCallConfirmErrors[i] = submit(func(xCtx context.Context) error {
+			_, err := k.ConfirmLogicCall(xCtx, &confirm)
+			return err
+		})
+	}
+	if accepted == 0 && alreadySubmitted == 0 {
+		return nil, sdkerrors.Wrap(firstErr, "every confirm was refused")
+	}
+	res.AlreadySubmitted = alreadySubmitted
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyAcceptedConfirms, fmt.Sprint(accepted)),
+		),
+	)
+
+	return res, nil
+}
+
 // checkOrchestratorValidatorInSet checks that the orchestrator refers to a validator that is
 // currently in the set
 func (k msgServer) checkOrchestratorValidatorInSet(ctx sdk.Context, orchestrator string) error {
@@ -262,12 +389,27 @@ func (k msgServer) checkOrchestratorValidatorInSet(ctx sdk.Context, orchestrator
 // claimHandlerCommon is an internal function that provides common code for processing claims once they are
 // translated from the message to the Ethereum claim interface
 func (k msgServer) claimHandlerCommon(ctx sdk.Context, msgAny *codectypes.Any, msg types.EthereumClaim) error {
+	// An orchestrator pointed at a testnet or a fork must not vote on mainnet events, orchestrators that do not
+	// report a chain id leave it at 0 and are not checked
+	if chainID := k.GetBridgeChainID(ctx); msg.GetBridgeChainId() != 0 && msg.GetBridgeChainId() != chainID {
+		return sdkerrors.Wrapf(types.ErrMismatched, "claim is for Ethereum chain id %d, the bridge is on %d", msg.GetBridgeChainId(), chainID)
+	}
+	// A claim observed before the chain was relaunched must not be voted on again by the new instance
+	if err := k.checkBridgeInstanceClaim(ctx, msg); err != nil {
+		return err
+	}
+	// An event observed long ago must not be voted on again, it would never be observed
+	if err := k.checkClaimAge(ctx, msg); err != nil {
+		return err
+	}
 	// Add the claim to the store
-	_, err := k.Attest(ctx, msg, msgAny)
+	att, err := k.Attest(ctx, msg, msgAny)
 	if err != nil {
 		return sdkerrors.Wrap(err, "create attestation")
 	}
-	hash, err := msg.ClaimHash()
+	k.recordClaimEthereumHeight(ctx, msg)
+	k.TryFastPathAttestation(ctx, att)
+	hash, err := attestationClaimHash(att, msg)
 	if err != nil {
 		return sdkerrors.Wrap(err, "unable to compute claim hash")
 	}
@@ -286,7 +428,7 @@ func (k msgServer) claimHandlerCommon(ctx sdk.Context, msgAny *codectypes.Any, m
 }
 
 // confirmHandlerCommon is an internal function that provides common code for processing claim messages
-func (k msgServer) confirmHandlerCommon(ctx sdk.Context, orchestrator string, signature string, checkpoint []byte) error {
+func (k Keeper) confirmHandlerCommon(ctx sdk.Context, orchestrator string, signature string, checkpoint []byte) error {
 	sigBytes, err := hex.DecodeString(signature)
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrInvalid, "signature decoding")
@@ -330,6 +472,7 @@ func (k msgServer) SendToCosmosClaim(c context.Context, msg *types.MsgSendToCosm
 	if err != nil {
 		return nil, err
 	}
+	k.setDepositEthTxHash(ctx, msg)
 
 	return &types.MsgSendToCosmosClaimResponse{}, nil
 }
@@ -417,13 +560,68 @@ func (k msgServer) ValsetUpdateClaim(c context.Context, msg *types.MsgValsetUpda
 	return &types.MsgValsetUpdatedClaimResponse{}, nil
 }
 
+// MigrationCompletedClaim handles claims that the replacement bridge contract has taken over
+func (k msgServer) MigrationCompletedClaim(c context.Context, msg *types.MsgMigrationCompletedClaim) (*types.MsgMigrationCompletedClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	err := k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
+	if err != nil {
+		return nil, err
+	}
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	err = k.claimHandlerCommon(ctx, any, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMigrationCompletedClaimResponse{}, nil
+}
+
+// SubmitEthereumEvents handles MsgSubmitEthereumEvents, processing the bundled claims in event nonce order exactly as
+// if they had been sent one by one. The claims are applied atomically, a refused claim fails the message and with it
+// the whole transaction, so none of the claims are stored
+func (k msgServer) SubmitEthereumEvents(c context.Context, msg *types.MsgSubmitEthereumEvents) (*types.MsgSubmitEthereumEventsResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid MsgSubmitEthereumEvents")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	err = k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
+	if err != nil {
+		return nil, err
+	}
+	for _, claim := range msg.GetClaims() {
+		any, err := codectypes.NewAnyWithValue(claim)
+		if err != nil {
+			return nil, err
+		}
+		err = k.claimHandlerCommon(ctx, any, claim)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "claim at event nonce %d", claim.GetEventNonce())
+		}
+	}
+
+	return &types.MsgSubmitEthereumEventsResponse{}, nil
+}
+
 func (k msgServer) CancelSendToEth(c context.Context, msg *types.MsgCancelSendToEth) (*types.MsgCancelSendToEthResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, err
 	}
-	err = k.RemoveFromOutgoingPoolAndRefund(ctx, msg.TransactionId, sender)
+	refundAddress := sender
+	if msg.RefundAddress != "" {
+		refundAddress, err = sdk.AccAddressFromBech32(msg.RefundAddress)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "refund address")
+		}
+	}
+	err = k.RemoveFromOutgoingPoolAndRefundTo(ctx, msg.TransactionId, sender, refundAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -439,10 +637,77 @@ func (k msgServer) CancelSendToEth(c context.Context, msg *types.MsgCancelSendTo
 	return &types.MsgCancelSendToEthResponse{}, nil
 }
 
+func (k msgServer) CancelAllSendToEth(c context.Context, msg *types.MsgCancelAllSendToEth) (*types.MsgCancelAllSendToEthResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	txIds, err := k.RemoveAllFromOutgoingPoolAndRefund(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := []sdk.Attribute{sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type())}
+	for _, txId := range txIds {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(txId)))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeMessage, attributes...))
+
+	return &types.MsgCancelAllSendToEthResponse{TransactionIds: txIds}, nil
+}
+
+func (k msgServer) ReleaseSendToEth(c context.Context, msg *types.MsgReleaseSendToEth) (*types.MsgReleaseSendToEthResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	err = k.ReleaseFromOutgoingPool(ctx, msg.TransactionId, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(msg.TransactionId)),
+		),
+	)
+
+	return &types.MsgReleaseSendToEthResponse{}, nil
+}
+
+func (k msgServer) BumpSendToEthFee(c context.Context, msg *types.MsgBumpSendToEthFee) (*types.MsgBumpSendToEthFeeResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid MsgBumpSendToEthFee")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	err = k.BumpOutgoingPoolFee(ctx, msg.TransactionId, sender, msg.ExtraFee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(msg.TransactionId)),
+		),
+	)
+
+	return &types.MsgBumpSendToEthFeeResponse{}, nil
+}
+
 func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgSubmitBadSignatureEvidence) (*types.MsgSubmitBadSignatureEvidenceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	err := k.CheckBadSignatureEvidence(ctx, msg)
+	if err := k.CheckBadSignatureEvidence(ctx, msg); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -453,5 +718,122 @@ func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgS
 		),
 	)
 
-	return &types.MsgSubmitBadSignatureEvidenceResponse{}, err
+	return &types.MsgSubmitBadSignatureEvidenceResponse{}, nil
+}
+
+// SubmitConflictingClaimEvidence handles MsgSubmitConflictingClaimEvidence, slashing the validator whose
+// orchestrator signed a claim conflicting with the one it submitted
+func (k msgServer) SubmitConflictingClaimEvidence(c context.Context, msg *types.MsgSubmitConflictingClaimEvidence) (*types.MsgSubmitConflictingClaimEvidenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := k.CheckConflictingClaimEvidence(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+		),
+	)
+
+	return &types.MsgSubmitConflictingClaimEvidenceResponse{}, nil
+}
+
+// OrchestratorHeartbeat handles MsgOrchestratorHeartbeat, recording the heartbeat
+// against the validator the orchestrator key is delegated from
+func (k msgServer) OrchestratorHeartbeat(c context.Context, msg *types.MsgOrchestratorHeartbeat) (*types.MsgOrchestratorHeartbeatResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid MsgOrchestratorHeartbeat")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	err = k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
+	if err != nil {
+		return nil, err
+	}
+	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+	validator, _ := k.GetOrchestratorValidator(ctx, orchaddr)
+
+	k.SetOrchestratorHeartbeat(ctx, validator.GetOperator(), types.OrchestratorHeartbeat{
+		Validator:         validator.GetOperator().String(),
+		Orchestrator:      msg.Orchestrator,
+		EthBlockHeight:    msg.EthBlockHeight,
+		Version:           msg.Version,
+		CosmosBlockHeight: uint64(ctx.BlockHeight()),
+		CosmosBlockTime:   uint64(ctx.BlockTime().Unix()),
+		EthGasPrice:       msg.EthGasPrice,
+		EthBaseFee:        msg.EthBaseFee,
+		EthPriorityFee:    msg.EthPriorityFee,
+	})
+	k.SetEthereumHeightVote(ctx, validator.GetOperator(), msg.EthBlockHeight)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyHeartbeatEthHeight, fmt.Sprint(msg.EthBlockHeight)),
+			sdk.NewAttribute(types.AttributeKeyHeartbeatVersion, msg.Version),
+			sdk.NewAttribute(types.AttributeKeyHeartbeatEthGasPrice, fmt.Sprint(msg.EthGasPrice)),
+			sdk.NewAttribute(types.AttributeKeyHeartbeatEthBaseFee, fmt.Sprint(msg.EthBaseFee)),
+			sdk.NewAttribute(types.AttributeKeyHeartbeatEthPriorityFee, fmt.Sprint(msg.EthPriorityFee)),
+		),
+	)
+
+	return &types.MsgOrchestratorHeartbeatResponse{}, nil
+}
+
+// SetEthDestinationLabel handles MsgSetEthDestinationLabel, adding the labeled destination to the address
+// book of the owner or removing the label if no address is given
+func (k msgServer) SetEthDestinationLabel(c context.Context, msg *types.MsgSetEthDestinationLabel) (*types.MsgSetEthDestinationLabelResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid MsgSetEthDestinationLabel")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+
+	if msg.EthAddress == "" {
+		if _, err := k.GetEthDestinationLabel(ctx, owner, msg.Label); err != nil {
+			return nil, err
+		}
+		k.DeleteEthDestinationLabel(ctx, owner, msg.Label)
+	} else {
+		ethAddr, _ := types.NewEthAddress(msg.EthAddress) // already validated in msg.ValidateBasic()
+		k.Keeper.SetEthDestinationLabel(ctx, owner, msg.Label, *ethAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyEthDestinationLabel, msg.Label),
+			sdk.NewAttribute(types.AttributeKeyEthDestination, msg.EthAddress),
+		),
+	)
+
+	return &types.MsgSetEthDestinationLabelResponse{}, nil
+}
+
+// SetFirstSendDelay handles MsgSetFirstSendDelay, opting the sender in or out of holding its sends to new
+// Ethereum destinations
+func (k msgServer) SetFirstSendDelay(c context.Context, msg *types.MsgSetFirstSendDelay) (*types.MsgSetFirstSendDelayResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid MsgSetFirstSendDelay")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+
+	k.Keeper.SetFirstSendDelay(ctx, sender, msg.DelayBlocks)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyFirstSendDelay, fmt.Sprint(msg.DelayBlocks)),
+		),
+	)
+
+	return &types.MsgSetFirstSendDelayResponse{}, nil
 }
@@ -5,31 +5,86 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
-// AddToOutgoingPool creates a transaction and adds it to the pool, returns the id of the unbatched transaction
+// AddToOutgoingPool adds a transaction of the default priority class to the pool, see AddToOutgoingPoolWithPriority
+func (k Keeper) AddToOutgoingPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	counterpartReceiver types.EthAddress,
+	amount sdk.Coin,
+	fee sdk.Coin,
+) (uint64, error) {
+	return k.AddToOutgoingPoolWithPriority(ctx, sender, counterpartReceiver, amount, fee, 0)
+}
+
+// AddToOutgoingPoolWithPriority creates a transaction of the given priority class and adds it to the pool, returns the id
+// of the unbatched transaction
+func (k Keeper) AddToOutgoingPoolWithPriority(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	counterpartReceiver types.EthAddress,
+	amount sdk.Coin,
+	fee sdk.Coin,
+	priority uint32,
+) (uint64, error) {
+	return k.AddToOutgoingPoolWithPayload(ctx, sender, counterpartReceiver, amount, fee, priority, nil)
+}
+
+// AddToOutgoingPoolWithPayload creates a transaction and adds it to the pool, returns the id of the unbatched transaction
+// - checks the payload is no larger than the MaxSendToEthPayloadSize
+// - checks the sender has fewer than MaxPendingTxsPerSender transactions in the pool
 // - checks a counterpart denominator exists for the given voucher type
+// - checks the fee is at least the MinSendToEthFees of the token, unless the sender is fee exempt
+// - charges the SendToEthPriorityFees of the priority class to the sender, unless the sender is fee exempt
 // - burns the voucher for transfer amount and fees
 // - persists an OutgoingTx
+// - flags the TX as needing confirmation if the fee dominates the amount
+// - holds the TX for the first send delay of the sender if it has not sent to the receiver before
 // - adds the TX to the `available` TX pool
-func (k Keeper) AddToOutgoingPool(
+func (k Keeper) AddToOutgoingPoolWithPayload(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
 	counterpartReceiver types.EthAddress,
 	amount sdk.Coin,
 	fee sdk.Coin,
+	priority uint32,
+	payload []byte,
 ) (uint64, error) {
 	if ctx.IsZero() || sender.Empty() || counterpartReceiver.ValidateBasic() != nil ||
 		!amount.IsValid() || !fee.IsValid() || fee.Denom != amount.Denom {
 		return 0, sdkerrors.Wrap(types.ErrInvalid, "arguments")
 	}
+	if k.IsBridgeMigrating(ctx) {
+		return 0, sdkerrors.Wrap(types.ErrBridgeMigrating, "outgoing transfers are frozen")
+	}
+	if len(payload) > 0 {
+		maxSize := k.GetParams(ctx).MaxSendToEthPayloadSize
+		if maxSize == 0 {
+			return 0, sdkerrors.Wrap(types.ErrInvalid, "transfers with a payload are disabled")
+		}
+		if uint64(len(payload)) > maxSize {
+			return 0, sdkerrors.Wrapf(types.ErrInvalid, "payload of %d bytes, the maximum is %d", len(payload), maxSize)
+		}
+	}
+	if err := k.checkPendingTxLimit(ctx, sender); err != nil {
+		return 0, err
+	}
 	totalAmount := amount.Add(fee)
 	totalInVouchers := sdk.Coins{totalAmount}
+	outflow, err := k.checkOutflowLimit(ctx, totalAmount)
+	if err != nil {
+		return 0, err
+	}
 
 	// If the coin is a gravity voucher, burn the coins. If not, check if there is a deployed ERC20 contract representing it.
 	// If there is, lock the coins.
@@ -38,6 +93,12 @@ func (k Keeper) AddToOutgoingPool(
 	if err != nil {
 		return 0, err
 	}
+	if err := k.checkMinSendToEthFee(ctx, sender, *tokenContract, fee.Amount); err != nil {
+		return 0, err
+	}
+	if err := k.chargeSendToEthPriorityFee(ctx, sender, priority); err != nil {
+		return 0, err
+	}
 
 	// If it is a cosmos-originated asset we lock it
 	if isCosmosOriginated {
@@ -60,6 +121,7 @@ func (k Keeper) AddToOutgoingPool(
 
 	// get next tx id from keeper
 	nextID := k.autoIncrementID(ctx, types.KeyLastTXPoolID)
+	k.recordOutflow(ctx, nextID, outflow)
 
 	erc20Fee, err := types.NewInternalERC20Token(fee.Amount, tokenContract.GetAddress())
 	if err != nil {
@@ -75,25 +137,35 @@ func (k Keeper) AddToOutgoingPool(
 	// the token as an ERC20 token since it is preparing to go to ETH
 	// rather than the denom that is the input to this function.
 	outgoing, err := types.OutgoingTransferTx{
-		Id:          nextID,
-		Sender:      sender.String(),
-		DestAddress: counterpartReceiver.GetAddress(),
-		Erc20Token:  erc20Token.ToExternal(),
-		Erc20Fee:    erc20Fee.ToExternal(),
+		Id:            nextID,
+		Sender:        sender.String(),
+		DestAddress:   counterpartReceiver.GetAddress(),
+		Erc20Token:    erc20Token.ToExternal(),
+		Erc20Fee:      erc20Fee.ToExternal(),
+		CreatedHeight: uint64(ctx.BlockHeight()),
+		Priority:      priority,
+		Payload:       payload,
 	}.ToInternal()
 	if err != nil { // This should never happen since all the components are validated
 		panic(sdkerrors.Wrap(err, "unable to create InternalOutgoingTransferTx"))
 	}
 
-	// add a second index with the fee
+	// a fee this far above the amount is most likely a typo, hold the tx until the sender
+	// confirms it rather than letting a relayer collect it
+	if multiple := k.GetParams(ctx).FeeConfirmationMultiple; multiple > 0 &&
+		fee.Amount.GT(amount.Amount.Mul(sdk.NewIntFromUint64(multiple))) {
+		outgoing.NeedsConfirmation = true
+	}
+	// a sender that opted into a first send delay gets time to cancel a send to an address it never used,
+	// in case the address was substituted
+	outgoing.HeldUntil = k.firstSendHeldUntil(ctx, sender, counterpartReceiver)
+
+	// add the tx to the pool, indexed by fee, by sender and by receiver
 	err = k.addUnbatchedTX(ctx, outgoing)
 	if err != nil {
 		panic(err)
 	}
 
-	// todo: add second index for sender so that we can easily query: give pending Tx by sender
-	// todo: what about a second index for receiver?
-
 	poolEvent := sdk.NewEvent(
 		types.EventTypeBridgeWithdrawalReceived,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -102,17 +174,212 @@ func (k Keeper) AddToOutgoingPool(
 		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, strconv.Itoa(int(nextID))),
 		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(nextID)),
 	)
-	ctx.EventManager().EmitEvent(poolEvent)
+	k.emitLegacyEvent(ctx, poolEvent)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSendToEthAdded{
+		TxId:          nextID,
+		Sender:        sender.String(),
+		Receiver:      counterpartReceiver.GetAddress(),
+		TokenContract: tokenContract.GetAddress(),
+		Amount:        amount.Amount,
+		Fee:           fee.Amount,
+	}); err != nil {
+		return 0, err
+	}
+	if outgoing.NeedsConfirmation {
+		k.emitLegacyEvent(ctx, sdk.NewEvent(
+			types.EventTypeBridgeWithdrawalHeld,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, strconv.Itoa(int(nextID))),
+		))
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventSendToEthNeedsConfirmation{TxId: nextID}); err != nil {
+			return 0, err
+		}
+	}
+	if outgoing.HeldUntil > 0 {
+		k.emitLegacyEvent(ctx, sdk.NewEvent(
+			types.EventTypeBridgeWithdrawalDelayed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, strconv.Itoa(int(nextID))),
+			sdk.NewAttribute(types.AttributeKeyHeldUntil, fmt.Sprint(outgoing.HeldUntil)),
+		))
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventSendToEthDelayed{
+			TxId:      nextID,
+			HeldUntil: outgoing.HeldUntil,
+		}); err != nil {
+			return 0, err
+		}
+	}
 
 	return nextID, nil
 }
 
+// checkMinSendToEthFee returns an error if fee is below the minimum fee MinSendToEthFees sets for tokenContract,
+// so that relayers are not left with a pool of dust nobody pays to move. Contracts are compared regardless of
+// their checksum casing. Fee exempt senders may pay any fee
+func (k Keeper) checkMinSendToEthFee(ctx sdk.Context, sender sdk.AccAddress, tokenContract types.EthAddress, fee sdk.Int) error {
+	if k.IsFeeExemptSender(ctx, sender) {
+		return nil
+	}
+	if minFee := k.GetMinSendToEthFee(ctx, tokenContract); fee.LT(minFee) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "fee of %s is below the minimum of %s for %s",
+			fee, minFee, tokenContract.GetAddress())
+	}
+	return nil
+}
+
+// IsFeeExemptSender returns true if sender is listed in the FeeExemptSenders param
+func (k Keeper) IsFeeExemptSender(ctx sdk.Context, sender sdk.AccAddress) bool {
+	for _, exempt := range k.GetParams(ctx).FeeExemptSenders {
+		if address, err := sdk.AccAddressFromBech32(exempt); err == nil && address.Equals(sender) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetMinSendToEthFee returns the minimum fee MinSendToEthFees sets for tokenContract, zero if it sets none
+func (k Keeper) GetMinSendToEthFee(ctx sdk.Context, tokenContract types.EthAddress) sdk.Int {
+	for _, minFee := range k.GetParams(ctx).MinSendToEthFees {
+		if strings.EqualFold(minFee.Contract, tokenContract.GetAddress()) {
+			return minFee.Amount
+		}
+	}
+	return sdk.ZeroInt()
+}
+
+// chargeSendToEthPriorityFee pays the SendToEthPriorityFees entry of priority from sender to the fee collector,
+// the default priority 0 is free and a priority without an entry is refused. Fee exempt senders are not charged
+func (k Keeper) chargeSendToEthPriorityFee(ctx sdk.Context, sender sdk.AccAddress, priority uint32) error {
+	if priority == 0 {
+		return nil
+	}
+	fees := k.GetParams(ctx).SendToEthPriorityFees
+	if int(priority) > len(fees) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "priority %d, the highest priority is %d", priority, len(fees))
+	}
+	fee := fees[priority-1]
+	if !fee.IsPositive() || k.IsFeeExemptSender(ctx, sender) {
+		return nil
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, authtypes.FeeCollectorName, sdk.Coins{fee}); err != nil {
+		return sdkerrors.Wrap(err, "priority fee")
+	}
+	return nil
+}
+
+// ReleaseFromOutgoingPool confirms a transaction that was held out of batches because
+// its fee dominated the amount, making it available for batching again. Only the sender
+// of the transaction may release it, the sender can also cancel it with RemoveFromOutgoingPoolAndRefund
+func (k Keeper) ReleaseFromOutgoingPool(ctx sdk.Context, txId uint64, sender sdk.AccAddress) error {
+	if ctx.IsZero() || txId < 1 || sender.Empty() {
+		return sdkerrors.Wrap(types.ErrInvalid, "arguments")
+	}
+	tx, err := k.GetUnbatchedTxById(ctx, txId)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "unknown transaction with id %d from sender %s", txId, sender.String())
+	}
+	if !tx.Sender.Equals(sender) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "Sender %s did not send Id %d", sender, txId)
+	}
+	if !tx.NeedsConfirmation {
+		return sdkerrors.Wrapf(types.ErrInvalid, "tx with id %d does not need confirmation", txId)
+	}
+
+	// the fee is unchanged so the tx keeps its place in the pool index
+	tx.NeedsConfirmation = false
+	bz, err := k.cdc.MarshalBinaryBare(tx.ToExternal())
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetOutgoingTxPoolKey(*tx.Erc20Fee, tx.Priority, tx.Id), bz)
+
+	k.emitLegacyEvent(ctx, sdk.NewEvent(
+		types.EventTypeBridgeWithdrawalReleased,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, strconv.Itoa(int(txId))),
+	))
+	return ctx.EventManager().EmitTypedEvent(&types.EventSendToEthReleased{TxId: txId})
+}
+
+// BumpOutgoingPoolFee adds extraFee to the fee of a transaction in the pool, taking it from the sender like
+// AddToOutgoingPool does, and moves the transaction to its new place in the fee index. Only the sender of the
+// transaction may bump its fee and only in the denom of the transfer
+func (k Keeper) BumpOutgoingPoolFee(ctx sdk.Context, txId uint64, sender sdk.AccAddress, extraFee sdk.Coin) error {
+	if ctx.IsZero() || txId < 1 || sender.Empty() || !extraFee.IsValid() || !extraFee.IsPositive() {
+		return sdkerrors.Wrap(types.ErrInvalid, "arguments")
+	}
+	if k.IsBridgeMigrating(ctx) {
+		return sdkerrors.Wrap(types.ErrBridgeMigrating, "outgoing transfers are frozen")
+	}
+	tx, err := k.GetUnbatchedTxById(ctx, txId)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "unknown transaction with id %d from sender %s", txId, sender.String())
+	}
+	if !tx.Sender.Equals(sender) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "Sender %s did not send Id %d", sender, txId)
+	}
+	isCosmosOriginated, tokenContract, err := k.DenomToERC20Lookup(ctx, extraFee.Denom)
+	if err != nil {
+		return err
+	}
+	if tokenContract.GetAddress() != tx.Erc20Fee.Contract.GetAddress() {
+		return sdkerrors.Wrapf(types.ErrInvalid, "extra fee in %s for a transfer of %s", extraFee.Denom, tx.Erc20Fee.Contract.GetAddress())
+	}
+	outflow, err := k.checkOutflowLimit(ctx, extraFee)
+	if err != nil {
+		return err
+	}
+	k.recordOutflow(ctx, txId, outflow)
+
+	// lock or burn the extra fee the same way as the rest of the transfer
+	extraCoins := sdk.Coins{extraFee}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, extraCoins); err != nil {
+		return err
+	}
+	if !isCosmosOriginated {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, extraCoins); err != nil {
+			panic(err)
+		}
+	}
+
+	// the fee is part of the pool key, so the tx is removed and added back under its new fee
+	if err := k.removeUnbatchedTX(ctx, *tx.Erc20Fee, tx.Priority, tx.Id); err != nil {
+		return sdkerrors.Wrapf(err, "txId %d not in unbatched index", txId)
+	}
+	tx.Erc20Fee.Amount = tx.Erc20Fee.Amount.Add(extraFee.Amount)
+	if multiple := k.GetParams(ctx).FeeConfirmationMultiple; multiple > 0 &&
+		tx.Erc20Fee.Amount.GT(tx.Erc20Token.Amount.Mul(sdk.NewIntFromUint64(multiple))) {
+		tx.NeedsConfirmation = true
+	}
+	if err := k.addUnbatchedTX(ctx, tx); err != nil {
+		panic(err)
+	}
+
+	k.emitLegacyEvent(ctx, sdk.NewEvent(
+		types.EventTypeBridgeWithdrawalFeeBumped,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, strconv.Itoa(int(txId))),
+		sdk.NewAttribute(types.AttributeKeyBridgeFee, tx.Erc20Fee.Amount.String()),
+	))
+	return ctx.EventManager().EmitTypedEvent(&types.EventSendToEthFeeBumped{
+		TxId:          txId,
+		TokenContract: tx.Erc20Fee.Contract.GetAddress(),
+		Fee:           tx.Erc20Fee.Amount,
+	})
+}
+
 // RemoveFromOutgoingPoolAndRefund
 // - checks that the provided tx actually exists
 // - deletes the unbatched tx from the pool
 // - issues the tokens back to the sender
 func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, sender sdk.AccAddress) error {
-	if ctx.IsZero() || txId < 1 || sender.Empty() {
+	return k.RemoveFromOutgoingPoolAndRefundTo(ctx, txId, sender, sender)
+}
+
+// RemoveFromOutgoingPoolAndRefundTo works like RemoveFromOutgoingPoolAndRefund but issues the tokens to
+// refundAddress, only the sender of the tx may choose where its refund goes
+func (k Keeper) RemoveFromOutgoingPoolAndRefundTo(ctx sdk.Context, txId uint64, sender sdk.AccAddress, refundAddress sdk.AccAddress) error {
+	if ctx.IsZero() || txId < 1 || sender.Empty() || refundAddress.Empty() {
 		return sdkerrors.Wrap(types.ErrInvalid, "arguments")
 	}
 	// check that we actually have a tx with that id and what it's details are
@@ -127,6 +394,40 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 		return sdkerrors.Wrapf(types.ErrInvalid, "Sender %s did not send Id %d", sender, txId)
 	}
 
+	return k.refundUnbatchedTXTo(ctx, tx, types.REFUND_REASON_CANCELED, refundAddress)
+}
+
+// RemoveAllFromOutgoingPoolAndRefund refunds every transaction of sender that is still in the pool, found through
+// the sender index, and returns their ids. It fails if sender has nothing in the pool
+func (k Keeper) RemoveAllFromOutgoingPoolAndRefund(ctx sdk.Context, sender sdk.AccAddress) ([]uint64, error) {
+	if ctx.IsZero() || sender.Empty() {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "arguments")
+	}
+	txs := k.GetUnbatchedTxsBySender(ctx, sender)
+	if len(txs) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "no transactions in the pool from sender %s", sender.String())
+	}
+
+	txIds := make([]uint64, len(txs))
+	for i, tx := range txs {
+		if err := k.refundUnbatchedTX(ctx, tx, types.REFUND_REASON_CANCELED); err != nil {
+			return nil, sdkerrors.Wrapf(err, "refund tx %d", tx.Id)
+		}
+		txIds[i] = tx.Id
+	}
+	return txIds, nil
+}
+
+// refundUnbatchedTX deletes the unbatched tx from the pool, issues the tokens back to its sender and keeps a
+// receipt of the refund
+func (k Keeper) refundUnbatchedTX(ctx sdk.Context, tx *types.InternalOutgoingTransferTx, reason types.RefundReason) error {
+	return k.refundUnbatchedTXTo(ctx, tx, reason, tx.Sender)
+}
+
+// refundUnbatchedTXTo works like refundUnbatchedTX but issues the tokens to recipient
+func (k Keeper) refundUnbatchedTXTo(ctx sdk.Context, tx *types.InternalOutgoingTransferTx, reason types.RefundReason, recipient sdk.AccAddress) error {
+	txId := tx.Id
+
 	// An inconsistent entry should never enter the store, but this is the ideal place to exploit
 	// it such a bug if it did ever occur, so we should double check to be really sure
 	if tx.Erc20Fee.Contract != tx.Erc20Token.Contract {
@@ -134,16 +435,28 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 	}
 
 	// delete this tx from the pool
-	err = k.removeUnbatchedTX(ctx, *tx.Erc20Fee, txId)
+	err := k.removeUnbatchedTX(ctx, *tx.Erc20Fee, tx.Priority, txId)
 	if err != nil {
 		return sdkerrors.Wrapf(types.ErrInvalid, "txId %d not in unbatched index! Must be in a batch!", txId)
 	}
 	// Make sure the tx was removed
-	oldTx, oldTxErr := k.GetUnbatchedTxByFeeAndId(ctx, *tx.Erc20Fee, tx.Id)
+	oldTx, oldTxErr := k.GetUnbatchedTxByFeeAndId(ctx, *tx.Erc20Fee, tx.Priority, tx.Id)
 	if oldTx != nil || oldTxErr == nil {
 		return sdkerrors.Wrapf(types.ErrInvalid, "tx with id %d was not fully removed from the pool, a duplicate must exist", txId)
 	}
 
+	return k.reissueRefundTo(ctx, tx, reason, recipient)
+}
+
+// reissueRefund issues the amount and fee of a transfer to Ethereum that will not be sent back to its sender,
+// keeping a receipt of the refund
+func (k Keeper) reissueRefund(ctx sdk.Context, tx *types.InternalOutgoingTransferTx, reason types.RefundReason) error {
+	return k.reissueRefundTo(ctx, tx, reason, tx.Sender)
+}
+
+// reissueRefundTo works like reissueRefund but issues the amount and fee to recipient, the receipt is still
+// kept under the sender of the transfer
+func (k Keeper) reissueRefundTo(ctx sdk.Context, tx *types.InternalOutgoingTransferTx, reason types.RefundReason, recipient sdk.AccAddress) error {
 	// reissue the amount and the fee
 	totalToRefund := tx.Erc20Token.GravityCoin()
 	totalToRefund.Amount = totalToRefund.Amount.Add(tx.Erc20Fee.Amount)
@@ -153,7 +466,7 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 
 	// If it is a cosmos-originated the coins are in the module (see AddToOutgoingPool) so we can just take them out
 	if isCosmosOriginated {
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, totalToRefundCoins); err != nil {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, totalToRefundCoins); err != nil {
 			return err
 		}
 	} else {
@@ -162,10 +475,12 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, totalToRefundCoins); err != nil {
 			return sdkerrors.Wrapf(err, "mint vouchers coins: %s", totalToRefundCoins)
 		}
-		if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, totalToRefundCoins); err != nil {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, totalToRefundCoins); err != nil {
 			return sdkerrors.Wrap(err, "transfer vouchers")
 		}
 	}
+	k.setRefundReceipt(ctx, tx, totalToRefund.Denom, reason, recipient)
+	k.releaseOutflow(ctx, tx.Id)
 
 	poolEvent := sdk.NewEvent(
 		types.EventTypeBridgeWithdrawCanceled,
@@ -173,16 +488,89 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 		sdk.NewAttribute(types.AttributeKeyContract, k.GetBridgeContractAddress(ctx).GetAddress()),
 		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.GetBridgeChainID(ctx)))),
 	)
-	ctx.EventManager().EmitEvent(poolEvent)
+	k.emitLegacyEvent(ctx, poolEvent)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventSendToEthRefunded{
+		TxId:          tx.Id,
+		Sender:        tx.Sender.String(),
+		Recipient:     recipient.String(),
+		TokenContract: tx.Erc20Token.Contract.GetAddress(),
+		Amount:        tx.Erc20Token.Amount,
+		Fee:           tx.Erc20Fee.Amount,
+		Reason:        reason,
+	})
+}
+
+// HandleEvacuatePoolProposal cancels every batch that has not been executed and refunds every transaction
+// in the pool, including those that were in the canceled batches. It is meant for a compromised Ethereum
+// contract, any batch that is still relayed afterwards would pay out funds that were already refunded
+func (k Keeper) HandleEvacuatePoolProposal(ctx sdk.Context, p *types.EvacuatePoolProposal) error {
+	batches := k.GetOutgoingTxBatches(ctx)
+	for _, batch := range batches {
+		if err := k.CancelOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce); err != nil {
+			return sdkerrors.Wrapf(err, "cancel batch %d of %s", batch.BatchNonce, batch.TokenContract.GetAddress())
+		}
+	}
+	txs := k.GetUnbatchedTransactions(ctx)
+	for _, tx := range txs {
+		if err := k.refundUnbatchedTX(ctx, tx, types.REFUND_REASON_EVACUATED); err != nil {
+			return sdkerrors.Wrapf(err, "refund tx %d", tx.Id)
+		}
+	}
+	k.appendAuditLog(ctx, p,
+		fmt.Sprintf("%d unexecuted batches, %d transfers in the pool", len(batches), len(txs)),
+		"0 unexecuted batches, 0 transfers in the pool")
+
+	k.emitLegacyEvent(ctx,
+		sdk.NewEvent(
+			types.EventTypeBridgePoolEvacuated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyCanceledBatches, fmt.Sprint(len(batches))),
+			sdk.NewAttribute(types.AttributeKeyRefundedTxs, fmt.Sprint(len(txs))),
+		),
+	)
+	return ctx.EventManager().EmitTypedEvent(&types.EventPoolEvacuated{
+		CanceledBatches: uint64(len(batches)),
+		RefundedTxs:     uint64(len(txs)),
+	})
+}
 
+// HandleCancelOutgoingBatchProposal cancels one unexecuted batch, for when the ERC20 it withdraws is exploited on
+// Ethereum. Its transactions go back into the pool, or are refunded to their senders if the proposal asks for it
+func (k Keeper) HandleCancelOutgoingBatchProposal(ctx sdk.Context, p *types.CancelOutgoingBatchProposal) error {
+	tokenContract, err := types.NewEthAddress(p.TokenContract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "token contract")
+	}
+	batch := k.GetOutgoingTXBatch(ctx, *tokenContract, p.BatchNonce)
+	if batch == nil {
+		return sdkerrors.Wrapf(types.ErrUnknown, "batch %d of %s", p.BatchNonce, tokenContract.GetAddress())
+	}
+	// merged transfers go back into the pool as the transactions they were merged from
+	transfers := k.GetBatchTransfers(ctx, *batch)
+	if err := k.CancelOutgoingTXBatch(ctx, *tokenContract, p.BatchNonce); err != nil {
+		return sdkerrors.Wrapf(err, "cancel batch %d of %s", p.BatchNonce, tokenContract.GetAddress())
+	}
+	after := fmt.Sprintf("%d transfers returned to the pool", len(transfers))
+	if p.Refund {
+		for _, tx := range transfers {
+			if err := k.refundUnbatchedTX(ctx, tx, types.REFUND_REASON_BATCH_CANCELED); err != nil {
+				return sdkerrors.Wrapf(err, "refund tx %d", tx.Id)
+			}
+		}
+		after = fmt.Sprintf("%d transfers refunded", len(transfers))
+	}
+	k.appendAuditLog(ctx, p,
+		fmt.Sprintf("batch %d of %s with %d transfers", p.BatchNonce, tokenContract.GetAddress(), len(batch.Transactions)),
+		after)
 	return nil
 }
 
-// addUnbatchedTx creates a new transaction in the pool
+// addUnbatchedTx creates a new transaction in the pool, the sender index points at its fee index key
 // WARNING: Do not make this function public
 func (k Keeper) addUnbatchedTX(ctx sdk.Context, val *types.InternalOutgoingTransferTx) error {
 	store := ctx.KVStore(k.storeKey)
-	idxKey := types.GetOutgoingTxPoolKey(*val.Erc20Fee, val.Id)
+	idxKey := types.GetOutgoingTxPoolKey(*val.Erc20Fee, val.Priority, val.Id)
 	if store.Has(idxKey) {
 		return sdkerrors.Wrap(types.ErrDuplicate, "transaction already in pool")
 	}
@@ -195,25 +583,31 @@ func (k Keeper) addUnbatchedTX(ctx sdk.Context, val *types.InternalOutgoingTrans
 	}
 
 	store.Set(idxKey, bz)
+	store.Set(types.GetOutgoingTxPoolSenderKey(val.Sender, val.Id), idxKey)
+	store.Set(types.GetOutgoingTxPoolReceiverKey(*val.DestAddress, val.Id), idxKey)
+	store.Set(types.GetOutgoingTxPoolHeightKey(val.CreatedHeight, val.Id), idxKey)
 	return err
 }
 
-// removeUnbatchedTXIndex removes the tx from the pool
+// removeUnbatchedTXIndex removes the tx from the pool and the sender, receiver and height indexes
 // WARNING: Do not make this function public
-func (k Keeper) removeUnbatchedTX(ctx sdk.Context, fee types.InternalERC20Token, txID uint64) error {
-	store := ctx.KVStore(k.storeKey)
-	idxKey := types.GetOutgoingTxPoolKey(fee, txID)
-	if !store.Has(idxKey) {
-		return sdkerrors.Wrap(types.ErrUnknown, "pool transaction")
+func (k Keeper) removeUnbatchedTX(ctx sdk.Context, fee types.InternalERC20Token, priority uint32, txID uint64) error {
+	tx, err := k.GetUnbatchedTxByFeeAndId(ctx, fee, priority, txID)
+	if err != nil {
+		return err
 	}
-	store.Delete(idxKey)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOutgoingTxPoolKey(fee, priority, txID))
+	store.Delete(types.GetOutgoingTxPoolSenderKey(tx.Sender, txID))
+	store.Delete(types.GetOutgoingTxPoolReceiverKey(*tx.DestAddress, txID))
+	store.Delete(types.GetOutgoingTxPoolHeightKey(tx.CreatedHeight, txID))
 	return nil
 }
 
-// GetUnbatchedTxByFeeAndId grabs a tx from the pool given its fee and txID
-func (k Keeper) GetUnbatchedTxByFeeAndId(ctx sdk.Context, fee types.InternalERC20Token, txID uint64) (*types.InternalOutgoingTransferTx, error) {
+// GetUnbatchedTxByFeeAndId grabs a tx from the pool given its fee, priority and txID
+func (k Keeper) GetUnbatchedTxByFeeAndId(ctx sdk.Context, fee types.InternalERC20Token, priority uint32, txID uint64) (*types.InternalOutgoingTransferTx, error) {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetOutgoingTxPoolKey(fee, txID))
+	bz := store.Get(types.GetOutgoingTxPoolKey(fee, priority, txID))
 	if bz == nil {
 		return nil, sdkerrors.Wrap(types.ErrUnknown, "pool transaction")
 	}
@@ -230,7 +624,7 @@ func (k Keeper) GetUnbatchedTxByFeeAndId(ctx sdk.Context, fee types.InternalERC2
 // note that due to the way unbatched txs are indexed, the GetUnbatchedTxByFeeAndId method is much faster
 func (k Keeper) GetUnbatchedTxById(ctx sdk.Context, txID uint64) (*types.InternalOutgoingTransferTx, error) {
 	var r *types.InternalOutgoingTransferTx = nil
-	k.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+	k.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, PoolIterationOptions{}, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
 		if tx.Id == txID {
 			r = tx
 			return true
@@ -245,20 +639,137 @@ func (k Keeper) GetUnbatchedTxById(ctx sdk.Context, txID uint64) (*types.Interna
 	return r, nil
 }
 
+// checkPendingTxLimit errors if sender already has MaxPendingTxsPerSender transactions in the pool, they are counted
+// through the sender index and only up to the limit
+func (k Keeper) checkPendingTxLimit(ctx sdk.Context, sender sdk.AccAddress) error {
+	limit := k.GetParams(ctx).MaxPendingTxsPerSender
+	if limit == 0 {
+		return nil
+	}
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetOutgoingTxPoolSenderPrefix(sender)).Iterator(nil, nil)
+	defer iter.Close()
+	var pending uint64
+	for ; iter.Valid() && pending < limit; iter.Next() {
+		pending++
+	}
+	if pending >= limit {
+		return sdkerrors.Wrapf(types.ErrTooManyPendingTxs, "%s already has %d transfers in the pool", sender, pending)
+	}
+	return nil
+}
+
+// GetUnbatchedTxsBySender grabs the transactions of sender from the pool in tx id order, through the sender
+// index rather than iterating the whole pool
+func (k Keeper) GetUnbatchedTxsBySender(ctx sdk.Context, sender sdk.AccAddress) []*types.InternalOutgoingTransferTx {
+	txs, _, err := k.GetUnbatchedTxsBySenderPaged(ctx, sender, nil)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "unbatched txs of %s", sender))
+	}
+	return txs
+}
+
+// GetUnbatchedTxsBySenderPaged returns a page of the transactions of sender in the pool in tx id order
+func (k Keeper) GetUnbatchedTxsBySenderPaged(ctx sdk.Context, sender sdk.AccAddress, pageReq *query.PageRequest) ([]*types.InternalOutgoingTransferTx, *query.PageResponse, error) {
+	return k.getUnbatchedTxsByIndex(ctx, types.GetOutgoingTxPoolSenderPrefix(sender), pageReq)
+}
+
+// GetUnbatchedTxsByReceiver returns the transactions in the pool to receiver in tx id order
+func (k Keeper) GetUnbatchedTxsByReceiver(ctx sdk.Context, receiver types.EthAddress) []*types.InternalOutgoingTransferTx {
+	txs, _, err := k.GetUnbatchedTxsByReceiverPaged(ctx, receiver, nil)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "unbatched txs to %s", receiver.GetAddress()))
+	}
+	return txs
+}
+
+// GetUnbatchedTxsByReceiverPaged returns a page of the transactions in the pool to receiver in tx id order
+func (k Keeper) GetUnbatchedTxsByReceiverPaged(ctx sdk.Context, receiver types.EthAddress, pageReq *query.PageRequest) ([]*types.InternalOutgoingTransferTx, *query.PageResponse, error) {
+	return k.getUnbatchedTxsByIndex(ctx, types.GetOutgoingTxPoolReceiverPrefix(receiver), pageReq)
+}
+
+// getUnbatchedTxsByIndex returns a page of the transactions in the pool listed under prefixKey in one of the
+// secondary indexes, whose values are keys in the pool
+func (k Keeper) getUnbatchedTxsByIndex(ctx sdk.Context, prefixKey []byte, pageReq *query.PageRequest) ([]*types.InternalOutgoingTransferTx, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	var txs []*types.InternalOutgoingTransferTx
+	pageRes, err := query.Paginate(prefix.NewStore(store, prefixKey), pageReq, func(_ []byte, idxKey []byte) error {
+		var tx types.OutgoingTransferTx
+		if err := k.cdc.UnmarshalBinaryBare(store.Get(idxKey), &tx); err != nil {
+			return err
+		}
+		intTx, err := tx.ToInternal()
+		if err != nil {
+			return err
+		}
+		txs = append(txs, intTx)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return txs, pageRes, nil
+}
+
 // GetUnbatchedTransactionsByContract, grabs all unbatched transactions from the tx pool for the given contract
-// unbatched transactions are sorted by fee amount in DESC order
+// unbatched transactions are sorted by priority and then by fee amount in DESC order
 func (k Keeper) GetUnbatchedTransactionsByContract(ctx sdk.Context, contractAddress types.EthAddress) []*types.InternalOutgoingTransferTx {
-	return k.collectUnbatchedTransactions(ctx, types.GetOutgoingTxPoolContractPrefix(contractAddress))
+	return k.collectUnbatchedTransactions(ctx, types.GetOutgoingTxPoolContractPrefix(contractAddress), PoolIterationOptions{})
 }
 
 // GetPoolTransactions, grabs all transactions from the tx pool, useful for queries or genesis save/load
 func (k Keeper) GetUnbatchedTransactions(ctx sdk.Context) []*types.InternalOutgoingTransferTx {
-	return k.collectUnbatchedTransactions(ctx, types.OutgoingTXPoolKey)
+	return k.collectUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, PoolIterationOptions{})
+}
+
+// GetUnbatchedTransactionsPaged returns a page of the transactions in the pool, only those of tokenContract if it
+// is not nil. The transactions come grouped by token contract, each token in DESC priority and fee order. The next
+// key of a page is the key of its last transaction, pages are read with IterateUnbatchedTransactions rather than by
+// collecting the pool so a query only ever loads what it returns
+func (k Keeper) GetUnbatchedTransactionsPaged(ctx sdk.Context, tokenContract *types.EthAddress, pageReq *query.PageRequest) ([]*types.InternalOutgoingTransferTx, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && len(pageReq.Key) != 0 {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	prefixKey := types.OutgoingTXPoolKey
+	if tokenContract != nil {
+		prefixKey = types.GetOutgoingTxPoolContractPrefix(*tokenContract)
+	}
+
+	var (
+		txs     []*types.InternalOutgoingTransferTx
+		lastKey []byte
+		visited uint64
+	)
+	pageRes := &query.PageResponse{NextKey: nil, Total: 0}
+	opts := PoolIterationOptions{Limit: IterationLimit{StartAfter: pageReq.Key}}
+	k.IterateUnbatchedTransactions(ctx, prefixKey, opts, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
+		visited++
+		switch {
+		case visited <= pageReq.Offset:
+		case uint64(len(txs)) < limit:
+			txs = append(txs, tx)
+			lastKey = append([]byte{}, key...)
+		case pageRes.NextKey == nil:
+			pageRes.NextKey = lastKey
+		}
+		// the total is only counted for the first page
+		return pageRes.NextKey != nil && !(pageReq.CountTotal && len(pageReq.Key) == 0)
+	})
+	if pageReq.CountTotal && len(pageReq.Key) == 0 {
+		pageRes.Total = visited
+	}
+	return txs, pageRes, nil
 }
 
-// Aggregates all unbatched transactions in the store with a given prefix
-func (k Keeper) collectUnbatchedTransactions(ctx sdk.Context, prefixKey []byte) (out []*types.InternalOutgoingTransferTx) {
-	k.IterateUnbatchedTransactions(ctx, prefixKey, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+// Aggregates all unbatched transactions in the store with a given prefix that match opts
+func (k Keeper) collectUnbatchedTransactions(ctx sdk.Context, prefixKey []byte, opts PoolIterationOptions) (out []*types.InternalOutgoingTransferTx) {
+	k.IterateUnbatchedTransactions(ctx, prefixKey, opts, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
 		out = append(out, tx)
 		return false
 	})
@@ -266,23 +777,135 @@ func (k Keeper) collectUnbatchedTransactions(ctx sdk.Context, prefixKey []byte)
 }
 
 // IterateUnbatchedTransactionsByContract, iterates through unbatched transactions from the tx pool for the given contract
-// unbatched transactions are sorted by fee amount in DESC order
+// in the order batches pick them in, by priority and then by fee amount in DESC order, the oldest transaction first
+// among equal fees. With PoolAgingBlocks set the priority of a transaction is raised by its time in the pool, see
+// effectivePriority, and the transactions are sorted rather than read in the order of their keys
 func (k Keeper) IterateUnbatchedTransactionsByContract(ctx sdk.Context, contractAddress types.EthAddress, cb func(key []byte, tx *types.InternalOutgoingTransferTx) bool) {
-	k.IterateUnbatchedTransactions(ctx, types.GetOutgoingTxPoolContractPrefix(contractAddress), cb)
+	prefixKey := types.GetOutgoingTxPoolContractPrefix(contractAddress)
+	agingBlocks := k.GetParams(ctx).PoolAgingBlocks
+	if agingBlocks == 0 {
+		k.IterateUnbatchedTransactions(ctx, prefixKey, PoolIterationOptions{}, cb)
+		return
+	}
+
+	var entries []poolEntry
+	k.IterateUnbatchedTransactions(ctx, prefixKey, PoolIterationOptions{}, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
+		entries = append(entries, poolEntry{key: append([]byte{}, key...), tx: tx})
+		return false
+	})
+	sortPoolEntries(entries, uint64(ctx.BlockHeight()), agingBlocks)
+	for _, entry := range entries {
+		if cb(entry.key, entry.tx) {
+			return
+		}
+	}
+}
+
+// poolEntry is a transaction of the pool with its key
+type poolEntry struct {
+	key []byte
+	tx  *types.InternalOutgoingTransferTx
+}
+
+// effectivePriority returns the priority class tx is batched with at height, every agingBlocks blocks it has waited
+// in the pool raise its priority by one class. An agingBlocks of zero disables aging
+func effectivePriority(tx *types.InternalOutgoingTransferTx, height uint64, agingBlocks uint64) uint64 {
+	if agingBlocks == 0 || height <= tx.CreatedHeight {
+		return uint64(tx.Priority)
+	}
+	return uint64(tx.Priority) + (height-tx.CreatedHeight)/agingBlocks
+}
+
+// sortPoolEntries sorts entries in the order batches pick them in at height, by effective priority and then by fee
+// amount in DESC order, the oldest transaction first among equal fees
+func sortPoolEntries(entries []poolEntry, height uint64, agingBlocks uint64) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i].tx, entries[j].tx
+		if pa, pb := effectivePriority(a, height, agingBlocks), effectivePriority(b, height, agingBlocks); pa != pb {
+			return pa > pb
+		}
+		if !a.Erc20Fee.Amount.Equal(b.Erc20Fee.Amount) {
+			return a.Erc20Fee.Amount.GT(b.Erc20Fee.Amount)
+		}
+		return a.Id < b.Id
+	})
+}
+
+// PoolIterationOptions narrows down an iteration over the unbatched pool, the zero value visits every transaction
+// in DESC priority and fee order
+type PoolIterationOptions struct {
+	// Ascending visits the transactions in ASC priority and fee order instead
+	Ascending bool
+	// MinFee and MaxFee, when set, skip transactions with a fee below or above them, both bounds are inclusive
+	MinFee *sdk.Int
+	MaxFee *sdk.Int
+	// Limit pages through the pool, transactions skipped for their fee do not count towards MaxResults
+	Limit IterationLimit
+}
+
+// poolPriorityPrefixLen is the length of the keys GetOutgoingTxPoolPriorityPrefix returns
+var poolPriorityPrefixLen = len(types.OutgoingTXPoolKey) + types.ETHContractAddressLen + 4
+
+// feeRange returns the store range of the transactions under prefixKey with a fee within the bounds. Only a
+// prefix of a single priority class of a fee contract orders its keys by fee, for any shorter prefix the whole
+// prefix is returned and the bounds have to be checked on every transaction
+func (o PoolIterationOptions) feeRange(prefixKey []byte) ([]byte, []byte) {
+	start, end := prefixRange(prefixKey)
+	if len(prefixKey) < poolPriorityPrefixLen {
+		return start, end
+	}
+	feeKey := func(fee sdk.Int) []byte {
+		// fees are non negative sdk.Ints of at most 256 bits, the same 32 bytes the pool keys use
+		return append(append([]byte{}, prefixKey...), fee.BigInt().FillBytes(make([]byte, 32))...)
+	}
+	if o.MinFee != nil && o.MinFee.IsPositive() {
+		start = feeKey(*o.MinFee)
+	}
+	if o.MaxFee != nil {
+		_, end = prefixRange(feeKey(*o.MaxFee))
+	}
+	return start, end
+}
+
+// matches returns true if the fee of tx is within the bounds
+func (o PoolIterationOptions) matches(tx *types.InternalOutgoingTransferTx) bool {
+	if o.MinFee != nil && tx.Erc20Fee.Amount.LT(*o.MinFee) {
+		return false
+	}
+	if o.MaxFee != nil && tx.Erc20Fee.Amount.GT(*o.MaxFee) {
+		return false
+	}
+	return true
 }
 
-// IterateUnbatchedTransactions iterates through all unbatched transactions whose keys begin with prefixKey in DESC order
-func (k Keeper) IterateUnbatchedTransactions(ctx sdk.Context, prefixKey []byte, cb func(key []byte, tx *types.InternalOutgoingTransferTx) bool) {
-	prefixStore := ctx.KVStore(k.storeKey)
-	iter := prefixStore.ReverseIterator(prefixRange(prefixKey))
+// IterateUnbatchedTransactions iterates through the unbatched transactions whose keys begin with prefixKey and
+// whose fee is within the bounds of opts, in DESC order unless opts asks for ASC
+func (k Keeper) IterateUnbatchedTransactions(ctx sdk.Context, prefixKey []byte, opts PoolIterationOptions, cb func(key []byte, tx *types.InternalOutgoingTransferTx) bool) {
+	// a negative upper bound matches nothing, and could not be encoded into a key
+	if opts.MaxFee != nil && opts.MaxFee.IsNegative() {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	var iter sdk.Iterator
+	start, end := opts.feeRange(prefixKey)
+	if start, end = opts.Limit.bounds(start, end, !opts.Ascending); opts.Ascending {
+		iter = store.Iterator(start, end)
+	} else {
+		iter = store.ReverseIterator(start, end)
+	}
 	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
+	var visited uint64
+	for ; iter.Valid() && !opts.Limit.reached(visited); iter.Next() {
 		var transact types.OutgoingTransferTx
 		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &transact)
 		intTx, err := transact.ToInternal()
 		if err != nil {
 			panic(sdkerrors.Wrapf(err, "invalid unbatched transaction in store: %v", transact))
 		}
+		if !opts.matches(intTx) {
+			continue
+		}
+		visited++
 		// cb returns true to stop early
 		if cb(iter.Key(), intTx) {
 			break
@@ -290,24 +913,57 @@ func (k Keeper) IterateUnbatchedTransactions(ctx sdk.Context, prefixKey []byte,
 	}
 }
 
-// GetBatchFeeByTokenType gets the fee the next batch of a given token type would
-// have if created right now. This info is both presented to relayers for the purpose of determining
+// GetBatchFeeByTokenType gets the fee, number of transactions and fee statistics the next batch of a given token
+// type would have if created right now. This info is both presented to relayers for the purpose of determining
 // when to request batches and also used by the batch creation process to decide not to create
-// a new batch (fees must be increasing)
+// a new batch that is not profitable to relay
 func (k Keeper) GetBatchFeeByTokenType(ctx sdk.Context, tokenContractAddr types.EthAddress, maxElements uint) *types.BatchFees {
-	batchFee := types.BatchFees{Token: tokenContractAddr.GetAddress(), TotalFees: sdk.NewInt(0)}
-	txCount := 0
+	var fees []sdk.Int
+	height := uint64(ctx.BlockHeight())
 
-	k.IterateUnbatchedTransactions(ctx, types.GetOutgoingTxPoolContractPrefix(tokenContractAddr), func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+	k.IterateUnbatchedTransactionsByContract(ctx, tokenContractAddr, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
 		fee := tx.Erc20Fee
 		if fee.Contract.GetAddress() != tokenContractAddr.GetAddress() {
 			panic(fmt.Errorf("unexpected fee contract %s when getting batch fees for contract %s", fee.Contract, tokenContractAddr))
 		}
-		batchFee.TotalFees = batchFee.TotalFees.Add(fee.Amount)
-		txCount += 1
-		return txCount == int(maxElements)
+		if tx.IsHeld(height) {
+			return false
+		}
+		fees = append(fees, fee.Amount)
+		return len(fees) == int(maxElements)
 	})
-	return &batchFee
+	return newBatchFees(tokenContractAddr.GetAddress(), fees)
+}
+
+// PreviewNextBatch tells whether a batch of the token of tx built right now would pick tx. It returns the
+// number of transactions a batch would pick before tx, counted in the order batches pick them in, and
+// the lowest fee of the first maxElements of them, at most the MaxBatchSize of the token. A held transaction is never
+// picked, its position is where it would be once released. A batch is only built if its fees cover the cost of
+// relaying it
+func (k Keeper) PreviewNextBatch(ctx sdk.Context, tx *types.InternalOutgoingTransferTx, maxElements uint) (position uint64, inNextBatch bool, minFee sdk.Int) {
+	if maxSize := k.GetMaxBatchSize(ctx, tx.Erc20Fee.Contract); maxElements > maxSize {
+		maxElements = maxSize
+	}
+	minFee = sdk.ZeroInt()
+	var count uint64
+	found := false
+	height := uint64(ctx.BlockHeight())
+	k.IterateUnbatchedTransactionsByContract(ctx, tx.Erc20Fee.Contract, func(_ []byte, poolTx *types.InternalOutgoingTransferTx) bool {
+		if poolTx.Id == tx.Id {
+			position, found = count, true
+		}
+		if poolTx.IsHeld(height) {
+			return false
+		}
+		// a transfer of a higher priority may pay a lower fee than the ones after it
+		if count < uint64(maxElements) && (count == 0 || poolTx.Erc20Fee.Amount.LT(minFee)) {
+			minFee = poolTx.Erc20Fee.Amount
+		}
+		count++
+		return found && count >= uint64(maxElements)
+	})
+	inNextBatch = found && !tx.IsHeld(height) && position < uint64(maxElements)
+	return position, inNextBatch, minFee
 }
 
 // GetAllBatchFees creates a fee entry for every batch type currently in the store
@@ -330,34 +986,87 @@ func (k Keeper) GetAllBatchFees(ctx sdk.Context, maxElements uint) (batchFees []
 
 // createBatchFees iterates over the unbatched transaction pool and creates batch token fee map
 // Implicitly creates batches with the highest potential fee because the transaction keys enforce an order which goes
-// fee contract address -> fee amount -> transaction nonce
+// fee contract address -> priority -> fee amount -> transaction nonce. With PoolAgingBlocks set the pool is sorted
+// the way batches pick from it instead. Every token counts at most maxElements transactions or its MaxBatchSize,
+// whichever is lower
 func (k Keeper) createBatchFees(ctx sdk.Context, maxElements uint) map[string]*types.BatchFees {
-	batchFeesMap := make(map[string]*types.BatchFees)
-	txCountMap := make(map[string]int)
+	feesMap := make(map[string][]sdk.Int)
+	sizeMap := make(map[string]int)
+	height := uint64(ctx.BlockHeight())
 
-	k.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
-		if txCountMap[tx.Erc20Fee.Contract.GetAddress()] < int(maxElements) {
-			addFeeToMap(tx.Erc20Fee, batchFeesMap, txCountMap)
+	count := func(tx *types.InternalOutgoingTransferTx) {
+		contract := tx.Erc20Fee.Contract.GetAddress()
+		if _, ok := sizeMap[contract]; !ok {
+			sizeMap[contract] = int(maxElements)
+			if maxSize := k.GetMaxBatchSize(ctx, tx.Erc20Fee.Contract); maxElements > maxSize {
+				sizeMap[contract] = int(maxSize)
+			}
+		}
+		if !tx.IsHeld(height) && len(feesMap[contract]) < sizeMap[contract] {
+			feesMap[contract] = append(feesMap[contract], tx.Erc20Fee.Amount)
+		}
+	}
+	batchFees := func() map[string]*types.BatchFees {
+		batchFeesMap := make(map[string]*types.BatchFees, len(feesMap))
+		for contract, fees := range feesMap {
+			batchFeesMap[contract] = newBatchFees(contract, fees)
 		}
+		return batchFeesMap
+	}
+
+	agingBlocks := k.GetParams(ctx).PoolAgingBlocks
+	if agingBlocks == 0 {
+		k.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, PoolIterationOptions{}, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+			count(tx)
+			return false
+		})
+		return batchFees()
+	}
+
+	// the tokens are counted separately, sorting them all together keeps the order within every token
+	var entries []poolEntry
+	k.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, PoolIterationOptions{}, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+		entries = append(entries, poolEntry{key: nil, tx: tx})
 		return false
 	})
-
-	return batchFeesMap
+	sortPoolEntries(entries, height, agingBlocks)
+	for _, entry := range entries {
+		count(entry.tx)
+	}
+	return batchFees()
 }
 
-// Helper method for creating batch fees
-func addFeeToMap(fee *types.InternalERC20Token, batchFeesMap map[string]*types.BatchFees, txCountMap map[string]int) {
-	feeAddrStr := fee.Contract.GetAddress()
-	txCountMap[feeAddrStr] = txCountMap[feeAddrStr] + 1
-
-	// add fee amount
-	if _, ok := batchFeesMap[feeAddrStr]; ok {
-		batchFeesMap[feeAddrStr].TotalFees = batchFeesMap[feeAddrStr].TotalFees.Add(fee.Amount)
+// Helper method for creating batch fees, sums up the fees of the transactions a batch of token would pick and
+// works out their min, max and median. The median of an even number of fees is the mean of the middle two, rounded down
+func newBatchFees(token string, fees []sdk.Int) *types.BatchFees {
+	batchFee := types.BatchFees{
+		Token:     token,
+		TotalFees: sdk.ZeroInt(),
+		TxCount:   uint64(len(fees)),
+		MinFee:    sdk.ZeroInt(),
+		MaxFee:    sdk.ZeroInt(),
+		MedianFee: sdk.ZeroInt(),
+	}
+	if len(fees) == 0 {
+		return &batchFee
+	}
+	sorted := make([]sdk.Int, len(fees))
+	copy(sorted, fees)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LT(sorted[j])
+	})
+	for _, fee := range sorted {
+		batchFee.TotalFees = batchFee.TotalFees.Add(fee)
+	}
+	batchFee.MinFee = sorted[0]
+	batchFee.MaxFee = sorted[len(sorted)-1]
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		batchFee.MedianFee = sorted[mid]
 	} else {
-		batchFeesMap[feeAddrStr] = &types.BatchFees{
-			Token:     feeAddrStr,
-			TotalFees: fee.Amount}
+		batchFee.MedianFee = sorted[mid-1].Add(sorted[mid]).QuoRaw(2)
 	}
+	return &batchFee
 }
 
 func (k Keeper) autoIncrementID(ctx sdk.Context, idKey []byte) uint64 {
@@ -1,11 +1,17 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -69,32 +75,36 @@ func TestAddToOutgoingPool(t *testing.T) {
 	oneHundredThreeTok, _ := types.NewInternalERC20Token(sdk.NewInt(103), myTokenContractAddr)
 	exp := []*types.InternalOutgoingTransferTx{
 		{
-			Id:          2,
-			Erc20Fee:    threeTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  oneHundredOneTok,
+			Id:            2,
+			Erc20Fee:      threeTok,
+			Sender:        mySender,
+			DestAddress:   receiverAddr,
+			Erc20Token:    oneHundredOneTok,
+			CreatedHeight: uint64(ctx.BlockHeight()),
 		},
 		{
-			Id:          3,
-			Erc20Fee:    twoTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  oneHundredTwoTok,
+			Id:            1,
+			Erc20Fee:      twoTok,
+			Sender:        mySender,
+			DestAddress:   receiverAddr,
+			Erc20Token:    oneHundredTok,
+			CreatedHeight: uint64(ctx.BlockHeight()),
 		},
 		{
-			Id:          1,
-			Erc20Fee:    twoTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  oneHundredTok,
+			Id:            3,
+			Erc20Fee:      twoTok,
+			Sender:        mySender,
+			DestAddress:   receiverAddr,
+			Erc20Token:    oneHundredTwoTok,
+			CreatedHeight: uint64(ctx.BlockHeight()),
 		},
 		{
-			Id:          4,
-			Erc20Fee:    oneTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  oneHundredThreeTok,
+			Id:            4,
+			Erc20Fee:      oneTok,
+			Sender:        mySender,
+			DestAddress:   receiverAddr,
+			Erc20Token:    oneHundredThreeTok,
+			CreatedHeight: uint64(ctx.BlockHeight()),
 		},
 	}
 	assert.Equal(t, exp, got)
@@ -256,6 +266,22 @@ func TestTotalBatchFeeInPool(t *testing.T) {
 		**/
 	assert.Equal(t, batchFees[0].TotalFees.BigInt(), big.NewInt(int64(8)))
 	assert.Equal(t, batchFees[1].TotalFees.BigInt(), big.NewInt(int64(500)))
+	assert.Equal(t, uint64(OutgoingTxBatchSize), batchFees[1].TxCount)
+
+	// the fees 1, 2, 2 and 3 of token1 and the 100 fees of 5 of token2
+	assert.Equal(t, uint64(4), batchFees[0].TxCount)
+	assert.Equal(t, sdk.NewInt(1), batchFees[0].MinFee)
+	assert.Equal(t, sdk.NewInt(3), batchFees[0].MaxFee)
+	assert.Equal(t, sdk.NewInt(2), batchFees[0].MedianFee)
+	assert.Equal(t, sdk.NewInt(5), batchFees[1].MinFee)
+	assert.Equal(t, sdk.NewInt(5), batchFees[1].MaxFee)
+	assert.Equal(t, sdk.NewInt(5), batchFees[1].MedianFee)
+
+	// a token without transactions in the pool has no fees at all
+	empty := input.GravityKeeper.GetBatchFeeByTokenType(ctx, *types.ZeroAddress(), OutgoingTxBatchSize)
+	assert.Equal(t, uint64(0), empty.TxCount)
+	assert.Equal(t, sdk.ZeroInt(), empty.MinFee)
+	assert.Equal(t, sdk.ZeroInt(), empty.MedianFee)
 
 }
 
@@ -434,6 +460,356 @@ func TestRemoveFromOutgoingPoolAndRefund(t *testing.T) {
 	require.Empty(t, input.GravityKeeper.GetUnbatchedTransactions(ctx))
 }
 
+// Tests that a sender can cancel all their transactions in the pool at once, leaving batched ones and those of
+// other senders alone
+func TestCancelAllSendToEth(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		otherSender, _      = sdk.AccAddressFromBech32("cosmos1mgamdcs9dah0vn0gqupl05up7pedg2mvupe6hh")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(myTokenDenom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers.Add(allVouchers...)))
+	for _, sender := range []sdk.AccAddress{mySender, otherSender} {
+		input.AccountKeeper.NewAccountWithAddress(ctx, sender)
+		require.NoError(t, input.BankKeeper.SetBalances(ctx, sender, allVouchers))
+	}
+
+	var ids []uint64
+	for i, v := range []int64{5, 4, 2, 1} {
+		id, err := k.AddToOutgoingPool(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, int64(i+100)),
+			sdk.NewInt64Coin(myTokenDenom, v))
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	otherID, err := k.AddToOutgoingPool(ctx, otherSender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100),
+		sdk.NewInt64Coin(myTokenDenom, 3))
+	require.NoError(t, err)
+	// the transactions with the fees 5 and 4 are batched
+	_, err = k.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	require.NoError(t, err)
+
+	msgServer := NewMsgServerImpl(k)
+	res, err := msgServer.CancelAllSendToEth(sdk.WrapSDKContext(ctx), types.NewMsgCancelAllSendToEth(mySender))
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{ids[2], ids[3]}, res.TransactionIds)
+	// amounts 100 and 101 with the fees 5 and 4 are still batched
+	assert.Equal(t, int64(99999-210), input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom).Amount.Int64())
+	assert.Empty(t, k.GetUnbatchedTxsBySender(ctx, mySender))
+	pool := k.GetUnbatchedTransactions(ctx)
+	require.Len(t, pool, 1)
+	assert.Equal(t, otherID, pool[0].Id)
+	require.Len(t, k.GetOutgoingTxBatches(ctx), 1)
+
+	// there is nothing left to cancel
+	_, err = msgServer.CancelAllSendToEth(sdk.WrapSDKContext(ctx), types.NewMsgCancelAllSendToEth(mySender))
+	require.Error(t, err)
+}
+
+// Tests that a canceled transaction can be refunded to another address chosen by its sender
+func TestCancelSendToEthRefundAddress(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		settlement, _       = sdk.AccAddressFromBech32("cosmos1mgamdcs9dah0vn0gqupl05up7pedg2mvupe6hh")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(myTokenDenom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	var ids []uint64
+	for _, v := range []int64{2, 3} {
+		id, err := k.AddToOutgoingPool(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, v))
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	msgServer := NewMsgServerImpl(k)
+	// only the sender may redirect the refund
+	msg := types.NewMsgCancelSendToEthWithRefundAddress(settlement, ids[0], settlement)
+	require.NoError(t, msg.ValidateBasic())
+	_, err = msgServer.CancelSendToEth(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+
+	msg = types.NewMsgCancelSendToEthWithRefundAddress(mySender, ids[0], settlement)
+	require.NoError(t, msg.ValidateBasic())
+	_, err = msgServer.CancelSendToEth(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	assert.Equal(t, int64(102), input.BankKeeper.GetBalance(ctx, settlement, myTokenDenom).Amount.Int64())
+	assert.Equal(t, int64(99999-205), input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom).Amount.Int64())
+
+	// without a refund address the sender is refunded
+	_, err = msgServer.CancelSendToEth(sdk.WrapSDKContext(ctx), types.NewMsgCancelSendToEth(mySender, ids[1]))
+	require.NoError(t, err)
+	assert.Equal(t, int64(99999-102), input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom).Amount.Int64())
+
+	receipts, _, err := k.GetRefundReceipts(ctx, mySender, nil)
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	assert.Equal(t, settlement.String(), receipts[0].RefundAddress)
+	assert.Empty(t, receipts[1].RefundAddress)
+
+	msg = &types.MsgCancelSendToEth{Sender: mySender.String(), TransactionId: 1, RefundAddress: "not an address"}
+	require.Error(t, msg.ValidateBasic())
+}
+
+// Tests that evacuating the pool cancels the unexecuted batches and refunds every transaction
+func TestEvacuatePoolProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	originalBal := uint64(99999)
+	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(originalBal), myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	for i, v := range []int64{2, 3, 2, 1} {
+		amount := sdk.NewInt64Coin(myTokenDenom, int64(i+100))
+		fee := sdk.NewInt64Coin(myTokenDenom, v)
+		_, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amount, fee)
+		require.NoError(t, err)
+	}
+	// two of the transactions are batched, the other two stay in the pool
+	_, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	require.NoError(t, err)
+	require.Len(t, input.GravityKeeper.GetOutgoingTxBatches(ctx), 1)
+	require.Len(t, input.GravityKeeper.GetUnbatchedTransactions(ctx), 2)
+
+	proposal := types.NewEvacuatePoolProposal("evacuate", "the contract is compromised")
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, input.GravityKeeper.HandleEvacuatePoolProposal(ctx, proposal))
+
+	assert.Empty(t, input.GravityKeeper.GetOutgoingTxBatches(ctx))
+	assert.Empty(t, input.GravityKeeper.GetUnbatchedTransactions(ctx))
+	assert.Equal(t, originalBal, input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom).Amount.Uint64())
+}
+
+// Tests that canceling a batch by governance returns its transactions to the pool or refunds them
+func TestCancelOutgoingBatchProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(myTokenDenom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	for i, v := range []int64{2, 3, 2, 1} {
+		_, err := k.AddToOutgoingPool(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, int64(i+100)), sdk.NewInt64Coin(myTokenDenom, v))
+		require.NoError(t, err)
+	}
+	balance := input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom).Amount
+
+	// an unknown batch can not be canceled
+	proposal := types.NewCancelOutgoingBatchProposal("cancel", "the token is exploited", *tokenContract, 1, false)
+	require.NoError(t, proposal.ValidateBasic())
+	require.Error(t, k.HandleCancelOutgoingBatchProposal(ctx, proposal))
+
+	// without a refund the transactions go back into the pool
+	batch, err := k.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	require.NoError(t, err)
+	proposal.BatchNonce = batch.BatchNonce
+	require.NoError(t, k.HandleCancelOutgoingBatchProposal(ctx, proposal))
+	assert.Empty(t, k.GetOutgoingTxBatches(ctx))
+	assert.Len(t, k.GetUnbatchedTransactions(ctx), 4)
+	assert.Equal(t, balance, input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom).Amount)
+
+	// with a refund they are paid back to their sender
+	batch, err = k.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	require.NoError(t, err)
+	refunded := sdk.ZeroInt()
+	for _, tx := range batch.Transactions {
+		refunded = refunded.Add(tx.Erc20Token.Amount).Add(tx.Erc20Fee.Amount)
+	}
+	proposal = types.NewCancelOutgoingBatchProposal("cancel", "the token is exploited", *tokenContract, batch.BatchNonce, true)
+	require.NoError(t, k.HandleCancelOutgoingBatchProposal(ctx, proposal))
+	assert.Empty(t, k.GetOutgoingTxBatches(ctx))
+	assert.Len(t, k.GetUnbatchedTransactions(ctx), 2)
+	assert.Equal(t, balance.Add(refunded), input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom).Amount)
+	receipts, _, err := k.GetRefundReceipts(ctx, mySender, nil)
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	for _, receipt := range receipts {
+		assert.Equal(t, types.REFUND_REASON_BATCH_CANCELED, receipt.Reason)
+	}
+}
+
+// Tests that simulating a proposal reports what it would change without changing anything
+func TestSimulateProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(myTokenDenom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	for i, v := range []int64{2, 3, 2, 1} {
+		_, err := k.AddToOutgoingPool(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, int64(i+100)),
+			sdk.NewInt64Coin(myTokenDenom, v))
+		require.NoError(t, err)
+	}
+	batch, err := k.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	require.NoError(t, err)
+	balance := input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom)
+
+	proposal, err := codectypes.NewAnyWithValue(types.NewEvacuatePoolProposal("evacuate", "the contract is compromised"))
+	require.NoError(t, err)
+	res, err := k.SimulateProposal(sdk.WrapSDKContext(ctx), &types.QuerySimulateProposalRequest{Proposal: proposal})
+	require.NoError(t, err)
+	simulation := res.Simulation
+	assert.Equal(t, types.ProposalTypeEvacuatePool, simulation.ProposalType)
+	assert.Empty(t, simulation.Error)
+
+	// nothing is persisted
+	assert.Len(t, k.GetOutgoingTxBatches(ctx), 1)
+	assert.Len(t, k.GetUnbatchedTransactions(ctx), 2)
+	assert.Equal(t, balance, input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom))
+
+	changes := make(map[string]string)
+	for _, change := range simulation.StateChanges {
+		changes[change.Key] = change.Operation
+	}
+	assert.Equal(t, "deleted", changes[hex.EncodeToString(types.GetOutgoingTxBatchKey(*tokenContract, batch.BatchNonce))])
+	for key, operation := range changes {
+		if strings.HasPrefix(key, hex.EncodeToString(types.AuditLogKey)) {
+			assert.Equal(t, "created", operation)
+		}
+	}
+	refunded := sdk.Coins{}
+	for _, movement := range simulation.FundsMoved {
+		if movement.To == mySender.String() {
+			refunded = refunded.Add(movement.Amount...)
+		}
+	}
+	// amounts 100 to 103 and fees 2, 3, 2 and 1
+	assert.Equal(t, sdk.NewInt(414), refunded.AmountOf(myTokenDenom))
+
+	// a proposal that would fail reports the error and changes nothing
+	migration := types.NewBridgeMigrationProposal("migrate", "to the same contract", *k.GetBridgeContractAddress(ctx))
+	simulation = k.DryRunProposal(ctx, migration)
+	assert.NotEmpty(t, simulation.Error)
+	assert.Empty(t, simulation.StateChanges)
+	assert.Empty(t, simulation.FundsMoved)
+}
+
+// Tests that refunds leave receipts that can be paged through by sender and are pruned after the retention window
+func TestRefundReceipts(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(10)
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(myTokenDenom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	var ids []uint64
+	for i, v := range []int64{2, 3, 2} {
+		id, err := k.AddToOutgoingPool(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, int64(i+100)), sdk.NewInt64Coin(myTokenDenom, v))
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, ids[1], mySender))
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, k.HandleEvacuatePoolProposal(ctx, types.NewEvacuatePoolProposal("evacuate", "compromised")))
+
+	receipts, _, err := k.GetRefundReceipts(ctx, mySender, nil)
+	require.NoError(t, err)
+	require.Len(t, receipts, 3)
+	assert.Equal(t, ids[0], receipts[0].TxId)
+	assert.Equal(t, types.REFUND_REASON_CANCELED, receipts[1].Reason)
+	assert.Equal(t, uint64(10), receipts[1].RefundHeight)
+	assert.Equal(t, sdk.NewInt64Coin(myTokenDenom, 101), receipts[1].Amount)
+	assert.Equal(t, sdk.NewInt64Coin(myTokenDenom, 3), receipts[1].Fee)
+	assert.Equal(t, types.REFUND_REASON_EVACUATED, receipts[2].Reason)
+	assert.Equal(t, myReceiver, receipts[2].DestAddress)
+
+	// receipts are paged in tx id order
+	res, err := k.RefundReceipts(sdk.WrapSDKContext(ctx), &types.QueryRefundReceiptsRequest{
+		Sender:     mySender.String(),
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Receipts, 2)
+	require.NotEmpty(t, res.Pagination.NextKey)
+	res, err = k.RefundReceipts(sdk.WrapSDKContext(ctx), &types.QueryRefundReceiptsRequest{
+		Sender:     mySender.String(),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Receipts, 1)
+	assert.Equal(t, ids[2], res.Receipts[0].TxId)
+
+	// only the receipt refunded more than the retention window ago is pruned
+	params := k.GetParams(ctx)
+	params.RefundReceiptRetention = 15
+	k.SetParams(ctx, params)
+	k.PruneRefundReceipts(ctx.WithBlockHeight(30))
+	receipts, _, err = k.GetRefundReceipts(ctx, mySender, nil)
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	for _, receipt := range receipts {
+		assert.Equal(t, types.REFUND_REASON_EVACUATED, receipt.Reason)
+	}
+}
+
 // Helper method to:
 // 1. Remove the transaction specified by `id`, `myTokenContractAddr` and `fee`
 // 2. Update the feesAndAmounts tracker by subtracting the refunded `fee` and `amount`
@@ -447,7 +823,7 @@ func checkRemovedTx(t *testing.T, input TestInput, ctx sdk.Context, id uint64, f
 	currentBal := input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom).Amount.Uint64()
 	require.Equal(t, currentBal, originalBal-*feesAndAmounts)
 	expectedKey := myTokenContractAddr + fmt.Sprint(fee) + fmt.Sprint(id)
-	input.GravityKeeper.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
+	input.GravityKeeper.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, PoolIterationOptions{}, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
 		require.NotEqual(t, []byte(expectedKey), key)
 		found := id == tx.Id &&
 			fee == tx.Erc20Fee.Amount.Uint64() &&
@@ -511,6 +887,69 @@ func TestRefundNonexistentTx(t *testing.T) {
 	require.Equal(t, origBalances, newBalances)
 }
 
+// Tests that the pool is refunded oldest first and within the per block budget once the bridge has stalled
+func TestRefundStalledPool(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(myTokenDenom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	params := k.GetParams(ctx)
+	params.StallRefundThreshold = 10
+	params.StallRefundBudget = 2
+	k.SetParams(ctx, params)
+
+	// the highest fee is the newest tx, the refunds must still go by age
+	var ids []uint64
+	for _, fee := range []int64{1, 2, 3} {
+		id, err := k.AddToOutgoingPool(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, fee))
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	// a bridge no orchestrator sent a heartbeat for is not stalled
+	assert.False(t, k.IsBridgeStalled(ctx.WithBlockHeight(ctx.BlockHeight()+100)))
+
+	heartbeat := func(ctx sdk.Context, vals ...sdk.ValAddress) {
+		for _, val := range vals {
+			k.SetOrchestratorHeartbeat(ctx, val, types.OrchestratorHeartbeat{
+				Validator:         val.String(),
+				CosmosBlockHeight: uint64(ctx.BlockHeight()),
+			})
+		}
+	}
+	heartbeat(ctx, ValAddrs...)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	assert.Equal(t, uint64(0), k.RefundStalledPool(ctx))
+
+	// three of the five validators are live, they can not confirm anything on their own
+	heartbeat(ctx, ValAddrs[:3]...)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	assert.Equal(t, uint64(2), k.RefundStalledPool(ctx))
+	remaining := k.GetUnbatchedTransactions(ctx)
+	require.Len(t, remaining, 1)
+	assert.Equal(t, ids[2], remaining[0].Id)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	assert.Equal(t, uint64(1), k.RefundStalledPool(ctx))
+	assert.Empty(t, k.GetUnbatchedTransactions(ctx))
+	assert.Equal(t, int64(99999), input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom).Amount.Int64())
+
+	// a fourth live validator is enough for the bridge to make progress again
+	heartbeat(ctx, ValAddrs[3])
+	assert.False(t, k.IsBridgeStalled(ctx))
+}
+
 func TestRefundTwice(t *testing.T) {
 	input := CreateTestEnv(t)
 	ctx := input.Context
@@ -563,6 +1002,112 @@ func TestRefundTwice(t *testing.T) {
 }
 
 // Check the various getter methods for the pool
+// Tests that a transfer whose fee dominates its amount is held out of batches until the sender releases it
+func TestFeeDominatedTransferNeedsConfirmation(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		notSender           = AccAddrs[1]
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	params := input.GravityKeeper.GetParams(ctx)
+	params.FeeConfirmationMultiple = 10
+	input.GravityKeeper.SetParams(ctx, params)
+
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
+	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
+	require.NoError(t, err)
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	err = input.BankKeeper.SetBalances(ctx, mySender, allVouchers)
+	require.NoError(t, err)
+
+	coin := func(amount int64) sdk.Coin {
+		tok, err := types.NewInternalERC20Token(sdk.NewInt(amount), myTokenContractAddr)
+		require.NoError(t, err)
+		return tok.GravityCoin()
+	}
+	// a fee of exactly 10x the amount is allowed, anything above is held
+	normalId, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, coin(100), coin(1000))
+	require.NoError(t, err)
+	heldId, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, coin(100), coin(5000))
+	require.NoError(t, err)
+
+	held, err := input.GravityKeeper.GetUnbatchedTxById(ctx, heldId)
+	require.NoError(t, err)
+	assert.True(t, held.NeedsConfirmation)
+	normal, err := input.GravityKeeper.GetUnbatchedTxById(ctx, normalId)
+	require.NoError(t, err)
+	assert.False(t, normal.NeedsConfirmation)
+
+	// the held tx does not count towards the fees of the next batch and is not batched
+	assert.Equal(t, sdk.NewInt(1000), input.GravityKeeper.GetBatchFeeByTokenType(ctx, *tokenContract, OutgoingTxBatchSize).TotalFees)
+	batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *tokenContract, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 1)
+	assert.Equal(t, normalId, batch.Transactions[0].Id)
+
+	// only the sender can release it, and only once
+	require.Error(t, input.GravityKeeper.ReleaseFromOutgoingPool(ctx, heldId, notSender))
+	require.NoError(t, input.GravityKeeper.ReleaseFromOutgoingPool(ctx, heldId, mySender))
+	require.Error(t, input.GravityKeeper.ReleaseFromOutgoingPool(ctx, heldId, mySender))
+
+	assert.Equal(t, sdk.NewInt(5000), input.GravityKeeper.GetBatchFeeByTokenType(ctx, *tokenContract, OutgoingTxBatchSize).TotalFees)
+	batch, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, *tokenContract, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 1)
+	assert.Equal(t, heldId, batch.Transactions[0].Id)
+}
+
+func TestMinSendToEthFee(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		otherTokenAddr      = "0x7580bfe88dd3d07947908fae12d95872a260f2d8"
+	)
+	// the contract is set in a different casing than the vouchers carry
+	params := input.GravityKeeper.GetParams(ctx)
+	params.MinSendToEthFees = []types.ERC20Token{
+		{Contract: strings.ToLower(myTokenContractAddr), Amount: sdk.NewInt(10)},
+	}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	coin := func(amount int64, contract string) sdk.Coin {
+		tok, err := types.NewInternalERC20Token(sdk.NewInt(amount), contract)
+		require.NoError(t, err)
+		return tok.GravityCoin()
+	}
+	allVouchers := sdk.NewCoins(coin(99999, myTokenContractAddr), coin(99999, otherTokenAddr))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	// below the minimum fails and takes nothing from the sender
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, coin(100, myTokenContractAddr), coin(9, myTokenContractAddr))
+	require.Error(t, err)
+	assert.Equal(t, sdk.NewInt(99999), input.BankKeeper.GetBalance(ctx, mySender, coin(0, myTokenContractAddr).Denom).Amount)
+
+	// the minimum itself is enough
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, coin(100, myTokenContractAddr), coin(10, myTokenContractAddr))
+	require.NoError(t, err)
+
+	// tokens without a minimum can still be sent without a fee
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, coin(100, otherTokenAddr), coin(0, otherTokenAddr))
+	require.NoError(t, err)
+}
+
 func TestGetUnbatchedTransactions(t *testing.T) {
 	input := CreateTestEnv(t)
 	ctx := input.Context
@@ -650,10 +1195,11 @@ func TestGetUnbatchedTransactions(t *testing.T) {
 	token1Amount, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(amounts[0]), myTokenContractAddr1)
 	require.NoError(t, err)
 	token1Id := ids1[0]
-	tx1, err1 := input.GravityKeeper.GetUnbatchedTxByFeeAndId(ctx, *token1Fee, token1Id)
+	tx1, err1 := input.GravityKeeper.GetUnbatchedTxByFeeAndId(ctx, *token1Fee, 0, token1Id)
 	require.NoError(t, err1)
 	expTx1, err1 := types.NewInternalOutgoingTransferTx(token1Id, mySender1.String(), myReceiver, *token1Amount.ToExternal(), *token1Fee.ToExternal())
 	require.NoError(t, err1)
+	expTx1.CreatedHeight = uint64(ctx.BlockHeight())
 	require.Equal(t, *expTx1, *tx1)
 
 	token2Fee, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(fees[3]), myTokenContractAddr2)
@@ -662,10 +1208,11 @@ func TestGetUnbatchedTransactions(t *testing.T) {
 	require.NoError(t, err)
 
 	token2Id := ids2[3]
-	tx2, err2 := input.GravityKeeper.GetUnbatchedTxByFeeAndId(ctx, *token2Fee, token2Id)
+	tx2, err2 := input.GravityKeeper.GetUnbatchedTxByFeeAndId(ctx, *token2Fee, 0, token2Id)
 	require.NoError(t, err2)
 	expTx2, err2 := types.NewInternalOutgoingTransferTx(token2Id, mySender2.String(), myReceiver, *token2Amount.ToExternal(), *token2Fee.ToExternal())
 	require.NoError(t, err2)
+	expTx2.CreatedHeight = uint64(ctx.BlockHeight())
 	require.Equal(t, *expTx2, *tx2)
 
 	// GetUnbatchedTxById
@@ -814,7 +1361,7 @@ func TestIterateUnbatchedTransactions(t *testing.T) {
 	}
 	// IterateUnbatchedTransactions
 	anotherFoundMap := make(map[uint64]bool)
-	input.GravityKeeper.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
+	input.GravityKeeper.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, PoolIterationOptions{}, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
 		require.NotNil(t, tx)
 		fTx := idToTxMap[tx.Id]
 		require.NotNil(t, fTx)
@@ -827,6 +1374,34 @@ func TestIterateUnbatchedTransactions(t *testing.T) {
 	for i := 1; i <= 8; i++ {
 		require.True(t, anotherFoundMap[uint64(i)])
 	}
+
+	// ASC order with a lower fee bound, served from a range of the contract prefix
+	two, three := sdk.NewInt(2), sdk.NewInt(3)
+	var ascFees []uint64
+	input.GravityKeeper.IterateUnbatchedTransactions(ctx, types.GetOutgoingTxPoolContractPrefix(*tokenContract1), PoolIterationOptions{Ascending: true, MinFee: &two, MaxFee: nil}, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
+		ascFees = append(ascFees, tx.Erc20Fee.Amount.Uint64())
+		return false
+	})
+	require.Equal(t, []uint64{2, 2, 3}, ascFees)
+
+	// an upper bound on the contract prefix includes every tx with exactly that fee
+	ids := make(map[uint64]bool)
+	for _, tx := range input.GravityKeeper.collectUnbatchedTransactions(ctx, types.GetOutgoingTxPoolContractPrefix(*tokenContract2), PoolIterationOptions{Ascending: false, MinFee: nil, MaxFee: &two}) {
+		ids[tx.Id] = true
+	}
+	require.Equal(t, map[uint64]bool{ids2[0]: true, ids2[2]: true, ids2[3]: true}, ids)
+
+	// bounds on the whole pool are checked against every tx
+	var count int
+	input.GravityKeeper.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, PoolIterationOptions{Ascending: false, MinFee: &three, MaxFee: &three}, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
+		require.Equal(t, uint64(3), tx.Erc20Fee.Amount.Uint64())
+		count++
+		return false
+	})
+	require.Equal(t, 2, count)
+
+	neg := sdk.NewInt(-1)
+	require.Empty(t, input.GravityKeeper.collectUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, PoolIterationOptions{Ascending: false, MinFee: nil, MaxFee: &neg}))
 }
 
 // Ensures that any unbatched tx will make its way into the exported data from ExportGenesis
@@ -869,11 +1444,12 @@ func TestAddToOutgoingPoolExportGenesis(t *testing.T) {
 		require.NoError(t, err)
 
 		unbatchedTxMap[r] = types.OutgoingTransferTx{
-			Id:          r,
-			Sender:      mySender.String(),
-			DestAddress: myReceiver,
-			Erc20Token:  amountToken.ToExternal(),
-			Erc20Fee:    feeToken.ToExternal(),
+			Id:            r,
+			Sender:        mySender.String(),
+			DestAddress:   myReceiver,
+			Erc20Token:    amountToken.ToExternal(),
+			Erc20Fee:      feeToken.ToExternal(),
+			CreatedHeight: uint64(ctx.BlockHeight()),
 		}
 		foundTxsMap[r] = false
 
@@ -893,3 +1469,947 @@ func TestAddToOutgoingPoolExportGenesis(t *testing.T) {
 		require.True(t, v)
 	}
 }
+
+type fixedPriceFeed map[string]sdk.Dec
+
+func (f fixedPriceFeed) GetUSDPrice(_ sdk.Context, denom string) (sdk.Dec, bool) {
+	price, ok := f[denom]
+	return price, ok
+}
+
+// Tests that the USD value of transfers to Ethereum is capped across all tokens within the outflow window
+func TestOutflowUsdLimit(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	sender := AccAddrs[0]
+	receiver, err := types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	require.NoError(t, err)
+
+	var coins []sdk.Coin
+	for _, addr := range TokenContractAddrs[:3] {
+		token, err := types.NewInternalERC20Token(sdk.NewInt(1000), addr)
+		require.NoError(t, err)
+		coins = append(coins, token.GravityCoin())
+	}
+	vouchers := sdk.NewCoins(coins...)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, vouchers))
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.OutflowUsdLimit = sdk.NewDec(100)
+	params.OutflowLimitWindow = 10
+	input.GravityKeeper.SetParams(ctx, params)
+
+	coin := func(i int, amount int64) sdk.Coin {
+		return sdk.NewInt64Coin(coins[i].Denom, amount)
+	}
+
+	// no price feed, the limit can not be set and nothing can leave while it is
+	require.Error(t, input.GravityKeeper.ValidateOutflowLimit(params.OutflowUsdLimit))
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, sender, *receiver, coin(0, 1), coin(0, 1))
+	require.Error(t, err)
+
+	input.GravityKeeper.PriceFeed = fixedPriceFeed{
+		coins[0].Denom: sdk.NewDec(2),
+		coins[1].Denom: sdk.NewDecWithPrec(5, 1),
+	}
+	k := input.GravityKeeper
+	require.NoError(t, k.ValidateOutflowLimit(params.OutflowUsdLimit))
+
+	// 2 * 30 + 0.5 * 60 = 90 USD
+	_, err = k.AddToOutgoingPool(ctx, sender, *receiver, coin(0, 25), coin(0, 5))
+	require.NoError(t, err)
+	_, err = k.AddToOutgoingPool(ctx, sender, *receiver, coin(1, 50), coin(1, 10))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(90), k.GetOutflowTotal(ctx))
+
+	// 12 USD more does not fit, 10 does, unpriced tokens are refused
+	_, err = k.AddToOutgoingPool(ctx, sender, *receiver, coin(0, 5), coin(0, 1))
+	require.True(t, types.ErrOutflowLimitExceeded.Is(err))
+	txID, err := k.AddToOutgoingPool(ctx, sender, *receiver, coin(0, 4), coin(0, 1))
+	require.NoError(t, err)
+	_, err = k.AddToOutgoingPool(ctx, sender, *receiver, coin(2, 1), coin(2, 1))
+	require.Error(t, err)
+
+	// a canceled transfer gives its value back, in a later block as well
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, txID, sender))
+	assert.Equal(t, sdk.NewDec(90), k.GetOutflowTotal(ctx))
+	txID, err = k.AddToOutgoingPool(ctx, sender, *receiver, coin(0, 4), coin(0, 1))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(100), k.GetOutflowTotal(ctx))
+
+	// once the window has passed the full limit is available again, a refund of a transfer whose block was
+	// pruned gives nothing back
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	_, err = k.AddToOutgoingPool(ctx, sender, *receiver, coin(0, 45), coin(0, 5))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(100), k.GetOutflowTotal(ctx))
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, txID, sender))
+	assert.Equal(t, sdk.NewDec(100), k.GetOutflowTotal(ctx))
+}
+
+func TestSendToEthFromModule(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(10)
+	k := input.GravityKeeper
+	var (
+		myModule            = "distribution"
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(myTokenDenom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, authtypes.NewModuleAddress(myModule), allVouchers))
+
+	// no grant, no sending
+	_, err = k.SendToEthFromModule(ctx, myModule, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, 1))
+	require.ErrorIs(t, err, types.ErrModuleSendGrantExceeded)
+
+	cap := sdk.NewCoins(sdk.NewInt64Coin(myTokenDenom, 250))
+	require.NoError(t, k.HandleModuleSendGrantProposal(ctx, types.NewModuleSendGrantProposal("grant", "pol", myModule, cap, 100)))
+
+	_, err = k.SendToEthFromModule(ctx, myModule, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, 1))
+	require.NoError(t, err)
+	_, err = k.SendToEthFromModule(ctx.WithBlockHeight(50), myModule, *receiver, sdk.NewInt64Coin(myTokenDenom, 149), sdk.NewInt64Coin(myTokenDenom, 1))
+	require.ErrorIs(t, err, types.ErrModuleSendGrantExceeded)
+	_, err = k.SendToEthFromModule(ctx.WithBlockHeight(50), myModule, *receiver, sdk.NewInt64Coin(myTokenDenom, 148), sdk.NewInt64Coin(myTokenDenom, 1))
+	require.NoError(t, err)
+	grant, found := k.GetModuleSendGrant(ctx, myModule)
+	require.True(t, found)
+	assert.Equal(t, cap, grant.Spent)
+	assert.Len(t, k.GetUnbatchedTransactions(ctx), 2)
+
+	// the budget is available again in the next epoch
+	_, err = k.SendToEthFromModule(ctx.WithBlockHeight(215), myModule, *receiver, sdk.NewInt64Coin(myTokenDenom, 200), sdk.NewInt64Coin(myTokenDenom, 1))
+	require.NoError(t, err)
+	grant, _ = k.GetModuleSendGrant(ctx, myModule)
+	assert.Equal(t, uint64(210), grant.EpochStart)
+
+	// an empty cap revokes the grant
+	require.NoError(t, k.HandleModuleSendGrantProposal(ctx, types.NewModuleSendGrantProposal("revoke", "done", myModule, nil, 0)))
+	assert.Empty(t, k.GetModuleSendGrants(ctx))
+}
+
+func TestPreviewNextBatch(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(myTokenDenom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	var ids []uint64
+	for _, v := range []int64{5, 3, 1, 2} {
+		id, err := k.AddToOutgoingPool(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, v))
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	tx, err := k.GetUnbatchedTxById(ctx, ids[3])
+	require.NoError(t, err)
+
+	position, inNextBatch, minFee := k.PreviewNextBatch(ctx, tx, 2)
+	assert.Equal(t, uint64(2), position)
+	assert.False(t, inNextBatch)
+	assert.Equal(t, sdk.NewInt(3), minFee)
+
+	position, inNextBatch, minFee = k.PreviewNextBatch(ctx, tx, 3)
+	assert.Equal(t, uint64(2), position)
+	assert.True(t, inNextBatch)
+	assert.Equal(t, sdk.NewInt(2), minFee)
+
+	// the preview is only returned when the message is simulated
+	msgServer := NewMsgServerImpl(k)
+	msg := types.NewMsgSendToEth(mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, 4))
+	res, err := msgServer.SendToEth(sdk.WrapSDKContext(ctx.WithIsCheckTx(true)), msg)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), res.BatchPosition)
+	assert.True(t, res.InNextBatch)
+	res, err = msgServer.SendToEth(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	assert.False(t, res.InNextBatch)
+}
+
+func TestGetUnbatchedTransactionsPaged(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _     = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver, _   = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContract = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		otherContract   = "0x7580bfe88dd3d07947908fae12d95872a260f2d8"
+	)
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	for _, contract := range []string{myTokenContract, otherContract} {
+		vouchers := sdk.Coins{sdk.NewInt64Coin("gravity"+contract, 99999)}
+		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, vouchers))
+	}
+	for _, fee := range []int64{3, 5, 1, 4, 2} {
+		_, err := k.AddToOutgoingPool(ctx, mySender, *myReceiver,
+			sdk.NewInt64Coin("gravity"+myTokenContract, 100), sdk.NewInt64Coin("gravity"+myTokenContract, fee))
+		require.NoError(t, err)
+	}
+	for _, fee := range []int64{7, 8} {
+		_, err := k.AddToOutgoingPool(ctx, mySender, *myReceiver,
+			sdk.NewInt64Coin("gravity"+otherContract, 100), sdk.NewInt64Coin("gravity"+otherContract, fee))
+		require.NoError(t, err)
+	}
+	tokenContract, err := types.NewEthAddress(myTokenContract)
+	require.NoError(t, err)
+
+	// pages of a token follow the DESC fee order batches pick transactions in
+	var fees []int64
+	pageReq := &query.PageRequest{Limit: 2, CountTotal: true}
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 3)
+		txs, pageRes, err := k.GetUnbatchedTransactionsPaged(ctx, tokenContract, pageReq)
+		require.NoError(t, err)
+		if pages == 0 {
+			assert.Equal(t, uint64(5), pageRes.Total)
+		}
+		for _, tx := range txs {
+			fees = append(fees, tx.Erc20Fee.Amount.Int64())
+		}
+		if pageRes.NextKey == nil {
+			break
+		}
+		pageReq = &query.PageRequest{Key: pageRes.NextKey, Limit: 2}
+	}
+	assert.Equal(t, []int64{5, 4, 3, 2, 1}, fees)
+
+	// without a token the whole pool is paged through
+	txs, pageRes, err := k.GetUnbatchedTransactionsPaged(ctx, nil, &query.PageRequest{Offset: 1, CountTotal: true})
+	require.NoError(t, err)
+	assert.Len(t, txs, 6)
+	assert.Equal(t, uint64(7), pageRes.Total)
+	assert.Nil(t, pageRes.NextKey)
+
+	_, _, err = k.GetUnbatchedTransactionsPaged(ctx, nil, &query.PageRequest{Offset: 1, Key: []byte{1}})
+	require.Error(t, err)
+}
+
+func TestGetUnbatchedTxsBySender(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		otherSender         = AccAddrs[0]
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(myTokenDenom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers.Add(allVouchers...)))
+	for _, sender := range []sdk.AccAddress{mySender, otherSender} {
+		input.AccountKeeper.NewAccountWithAddress(ctx, sender)
+		require.NoError(t, input.BankKeeper.SetBalances(ctx, sender, allVouchers))
+	}
+
+	var myIds []uint64
+	for i, v := range []int64{2, 5, 3, 1} {
+		sender := mySender
+		if i == 2 {
+			sender = otherSender
+		}
+		id, err := k.AddToOutgoingPool(ctx, sender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, v))
+		require.NoError(t, err)
+		if sender.Equals(mySender) {
+			myIds = append(myIds, id)
+		}
+	}
+
+	// the index returns the transfers of the sender only, in tx id order
+	got := k.GetUnbatchedTxsBySender(ctx, mySender)
+	require.Len(t, got, 3)
+	for i, tx := range got {
+		assert.Equal(t, myIds[i], tx.Id)
+		assert.Equal(t, mySender, tx.Sender)
+	}
+
+	// transfers leave the index when they are batched or canceled
+	_, err = k.BuildOutgoingTXBatch(ctx, *tokenContract, 1)
+	require.NoError(t, err)
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, myIds[0], mySender))
+	got = k.GetUnbatchedTxsBySender(ctx, mySender)
+	require.Len(t, got, 1)
+	assert.Equal(t, myIds[2], got[0].Id)
+
+	res, err := k.UnbatchedTxsBySender(sdk.WrapSDKContext(ctx), &types.QueryUnbatchedTxsBySenderRequest{Sender: otherSender.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Transfers, 1)
+	assert.Equal(t, sdk.NewInt(3), res.Transfers[0].Erc20Fee.Amount)
+}
+
+func TestFirstSendDelay(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(100)
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(myTokenDenom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+	amount, fee := sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, 2)
+
+	k.SetFirstSendDelay(ctx, mySender, 10)
+	require.Equal(t, uint64(10), k.GetFirstSendDelay(ctx, mySender))
+
+	// the first send to the receiver is held for the delay
+	id, err := k.AddToOutgoingPool(ctx, mySender, *receiver, amount, fee)
+	require.NoError(t, err)
+	tx, err := k.GetUnbatchedTxById(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(110), tx.HeldUntil)
+	batch, err := k.BuildOutgoingTXBatch(ctx.WithBlockHeight(109), *tokenContract, 10)
+	require.NoError(t, err)
+	assert.Nil(t, batch)
+
+	// once the delay passed it is batched and the receiver is known
+	batch, err = k.BuildOutgoingTXBatch(ctx.WithBlockHeight(110), *tokenContract, 10)
+	require.NoError(t, err)
+	require.NotNil(t, batch)
+	require.Len(t, batch.Transactions, 1)
+	dests, _, err := k.GetKnownEthDestinations(ctx, mySender, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{receiver.GetAddress()}, dests)
+
+	// later sends to the known receiver are not held
+	id, err = k.AddToOutgoingPool(ctx, mySender, *receiver, amount, fee)
+	require.NoError(t, err)
+	tx, err = k.GetUnbatchedTxById(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), tx.HeldUntil)
+
+	// opting out forgets the known destinations
+	k.SetFirstSendDelay(ctx, mySender, 0)
+	assert.Equal(t, uint64(0), k.GetFirstSendDelay(ctx, mySender))
+	assert.Empty(t, k.GetAllFirstSendDelays(ctx))
+	dests, _, err = k.GetKnownEthDestinations(ctx, mySender, nil)
+	require.NoError(t, err)
+	assert.Empty(t, dests)
+}
+
+func TestGetUnbatchedTxsByReceiver(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	require.NoError(t, err)
+	otherReceiver, err := types.NewEthAddress("0x2d9480eBA3A001033a0B8c3Df26039FD3433D55d")
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(myTokenDenom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	var ids []uint64
+	for i, v := range []int64{2, 5, 3, 1} {
+		dest := receiver
+		if i == 2 {
+			dest = otherReceiver
+		}
+		id, err := k.AddToOutgoingPool(ctx, mySender, *dest, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, v))
+		require.NoError(t, err)
+		if dest == receiver {
+			ids = append(ids, id)
+		}
+	}
+
+	// the index returns the transfers to the receiver only, in tx id order
+	got := k.GetUnbatchedTxsByReceiver(ctx, *receiver)
+	require.Len(t, got, 3)
+	for i, tx := range got {
+		assert.Equal(t, ids[i], tx.Id)
+		assert.Equal(t, receiver.GetAddress(), tx.DestAddress.GetAddress())
+	}
+
+	// a batched transfer leaves the index and is reported in batches by the query
+	_, err = k.BuildOutgoingTXBatch(ctx, *tokenContract, 1)
+	require.NoError(t, err)
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, ids[0], mySender))
+	res, err := k.PendingSendToEthByReceiver(sdk.WrapSDKContext(ctx), &types.QueryPendingSendToEthByReceiverRequest{Receiver: receiver.GetAddress()})
+	require.NoError(t, err)
+	require.Len(t, res.TransfersInBatches, 1)
+	assert.Equal(t, ids[1], res.TransfersInBatches[0].Id)
+	require.Len(t, res.UnbatchedTransfers, 1)
+	assert.Equal(t, ids[2], res.UnbatchedTransfers[0].Id)
+
+	_, err = k.PendingSendToEthByReceiver(sdk.WrapSDKContext(ctx), &types.QueryPendingSendToEthByReceiverRequest{Receiver: "not an address"})
+	require.Error(t, err)
+}
+
+func TestBumpOutgoingPoolFee(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(myTokenDenom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	lowID, err := k.AddToOutgoingPool(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, 2))
+	require.NoError(t, err)
+	highID, err := k.AddToOutgoingPool(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, 5))
+	require.NoError(t, err)
+	balance := input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom)
+
+	// only the sender can bump the fee and only in the denom of the transfer
+	err = k.BumpOutgoingPoolFee(ctx, lowID, AccAddrs[0], sdk.NewInt64Coin(myTokenDenom, 10))
+	require.Error(t, err)
+	err = k.BumpOutgoingPoolFee(ctx, lowID, mySender, sdk.NewInt64Coin("stake", 10))
+	require.Error(t, err)
+
+	require.NoError(t, k.BumpOutgoingPoolFee(ctx, lowID, mySender, sdk.NewInt64Coin(myTokenDenom, 10)))
+	assert.Equal(t, balance.Amount.SubRaw(10), input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom).Amount)
+	txs := k.GetUnbatchedTxsBySender(ctx, mySender)
+	require.Len(t, txs, 2)
+	assert.Equal(t, lowID, txs[0].Id)
+	assert.Equal(t, sdk.NewInt(12), txs[0].Erc20Fee.Amount)
+
+	// the bumped tx keeps its id and is now picked first
+	batch, err := k.BuildOutgoingTXBatch(ctx, *tokenContract, 1)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 1)
+	assert.Equal(t, lowID, batch.Transactions[0].Id)
+
+	// a batched tx can no longer be bumped
+	err = k.BumpOutgoingPoolFee(ctx, lowID, mySender, sdk.NewInt64Coin(myTokenDenom, 1))
+	require.Error(t, err)
+	require.NoError(t, k.BumpOutgoingPoolFee(ctx, highID, mySender, sdk.NewInt64Coin(myTokenDenom, 1)))
+}
+
+func TestRefundExpiredPool(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(myTokenDenom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	// one tx now and one 5 blocks later
+	ctx = ctx.WithBlockHeight(100)
+	oldID, err := k.AddToOutgoingPool(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, 1))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(105)
+	newID, err := k.AddToOutgoingPool(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, 2))
+	require.NoError(t, err)
+
+	// a timeout of zero never expires anything
+	assert.Equal(t, uint64(0), k.RefundExpiredPool(ctx.WithBlockHeight(10000)))
+
+	params := k.GetParams(ctx)
+	params.PoolTxTimeout = 10
+	k.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(109)
+	assert.Equal(t, uint64(0), k.RefundExpiredPool(ctx))
+
+	// the old tx has waited 10 blocks, the new one only 5
+	ctx = ctx.WithBlockHeight(110)
+	assert.Equal(t, uint64(1), k.RefundExpiredPool(ctx))
+	remaining := k.GetUnbatchedTransactions(ctx)
+	require.Len(t, remaining, 1)
+	assert.Equal(t, newID, remaining[0].Id)
+	assert.Equal(t, uint64(105), remaining[0].CreatedHeight)
+
+	receipts, _, err := k.GetRefundReceipts(ctx, mySender, nil)
+	require.NoError(t, err)
+	require.Len(t, receipts, 1)
+	assert.Equal(t, oldID, receipts[0].TxId)
+	assert.Equal(t, types.REFUND_REASON_EXPIRED, receipts[0].Reason)
+
+	// the refunded tx is gone from the height index, running again refunds nothing
+	assert.Equal(t, uint64(0), k.RefundExpiredPool(ctx))
+
+	ctx = ctx.WithBlockHeight(115)
+	assert.Equal(t, uint64(1), k.RefundExpiredPool(ctx))
+	assert.Empty(t, k.GetUnbatchedTransactions(ctx))
+	assert.Equal(t, int64(99999), input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom).Amount.Int64())
+}
+
+func TestSendToEthWithCallback(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTarget            = "0x17c1736CcF692F653c433d7aa2aB45148C016F68"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	target, err := types.NewEthAddress(myTarget)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	allCoins := sdk.NewCoins(sdk.NewInt64Coin(myTokenDenom, 99999), sdk.NewInt64Coin("stake", 1000))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allCoins))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allCoins))
+
+	params := k.GetParams(ctx)
+	params.MaxCallbackDataSize = 4
+	params.CallbackDataByteFee = sdk.NewInt64Coin("stake", 2)
+	k.SetParams(ctx, params)
+
+	amount, fee := sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, 5)
+	data := []byte{1, 2, 3}
+
+	// the data may not exceed the maximum size and the hook may not be the token itself
+	_, err = k.SendToEthWithCallback(ctx, mySender, *receiver, *target, []byte{1, 2, 3, 4, 5}, amount, fee)
+	require.Error(t, err)
+	_, err = k.SendToEthWithCallback(ctx, mySender, *receiver, *tokenContract, data, amount, fee)
+	require.Error(t, err)
+
+	id, err := k.SendToEthWithCallback(ctx, mySender, *receiver, *target, data, amount, fee)
+	require.NoError(t, err)
+	assert.Empty(t, k.GetUnbatchedTransactions(ctx))
+	assert.Equal(t, int64(99999-105), input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom).Amount.Int64())
+	assert.Equal(t, int64(1000-6), input.BankKeeper.GetBalance(ctx, mySender, "stake").Amount.Int64())
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	assert.Equal(t, int64(6), input.BankKeeper.GetBalance(ctx, feeCollector, "stake").Amount.Int64())
+
+	calls := k.GetOutgoingLogicCalls(ctx)
+	require.Len(t, calls, 1)
+	call := calls[0]
+	assert.Equal(t, types.GetCallbackInvalidationID(id), call.InvalidationId)
+	assert.Equal(t, uint64(1), call.InvalidationNonce)
+	assert.Equal(t, myTarget, call.LogicContractAddress)
+	assert.Equal(t, types.EncodeCallbackPayload(*receiver, amount.Amount, data), call.Payload)
+	require.Len(t, call.Transfers, 1)
+	assert.Equal(t, amount.Amount, call.Transfers[0].Amount)
+	require.Len(t, call.Fees, 1)
+	assert.Equal(t, fee.Amount, call.Fees[0].Amount)
+	require.NotNil(t, k.GetCallbackTransfer(ctx, call.InvalidationId))
+
+	// a logic call that times out pays the transfer back, the data fee is kept
+	require.NoError(t, k.CancelOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce))
+	assert.Nil(t, k.GetCallbackTransfer(ctx, call.InvalidationId))
+	assert.Equal(t, int64(99999), input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom).Amount.Int64())
+	receipts, _, err := k.GetRefundReceipts(ctx, mySender, nil)
+	require.NoError(t, err)
+	require.Len(t, receipts, 1)
+	assert.Equal(t, id, receipts[0].TxId)
+	assert.Equal(t, types.REFUND_REASON_CALLBACK_TIMED_OUT, receipts[0].Reason)
+
+	// an executed logic call is removed without a refund
+	id, err = k.SendToEthWithCallback(ctx, mySender, *receiver, *target, data, amount, fee)
+	require.NoError(t, err)
+	invalidationID := types.GetCallbackInvalidationID(id)
+	k.OutgoingLogicCallExecuted(ctx, invalidationID, 1)
+	assert.Empty(t, k.GetOutgoingLogicCalls(ctx))
+	assert.Nil(t, k.GetCallbackTransfer(ctx, invalidationID))
+	assert.Equal(t, int64(99999-105), input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom).Amount.Int64())
+
+	// callbacks can be disabled
+	params.MaxCallbackDataSize = 0
+	k.SetParams(ctx, params)
+	_, err = k.SendToEthWithCallback(ctx, mySender, *receiver, *target, nil, amount, fee)
+	require.Error(t, err)
+}
+
+func TestOutgoingPoolPriority(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	allCoins := sdk.NewCoins(sdk.NewInt64Coin(myTokenDenom, 99999), sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allCoins))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allCoins))
+
+	params := k.GetParams(ctx)
+	params.SendToEthPriorityFees = []sdk.Coin{sdk.NewInt64Coin("stake", 40)}
+	k.SetParams(ctx, params)
+	feeCollector := input.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	collected := input.BankKeeper.GetBalance(ctx, feeCollector, "stake")
+
+	normalID, err := k.AddToOutgoingPool(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, 20))
+	require.NoError(t, err)
+	fastID, err := k.AddToOutgoingPoolWithPriority(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, 1), 1)
+	require.NoError(t, err)
+	// there is no fee for a second priority class
+	_, err = k.AddToOutgoingPoolWithPriority(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, 1), 2)
+	require.True(t, types.ErrInvalid.Is(err))
+
+	// the priority fee went to the fee collector
+	assert.Equal(t, sdk.NewInt(60), input.BankKeeper.GetBalance(ctx, mySender, "stake").Amount)
+	assert.Equal(t, collected.Amount.AddRaw(40), input.BankKeeper.GetBalance(ctx, feeCollector, "stake").Amount)
+
+	// the higher priority comes first despite its lower fee, and keeps its priority when its fee is bumped
+	txs := k.GetUnbatchedTransactionsByContract(ctx, *tokenContract)
+	require.Len(t, txs, 2)
+	assert.Equal(t, fastID, txs[0].Id)
+	assert.Equal(t, uint32(1), txs[0].Priority)
+	require.NoError(t, k.BumpOutgoingPoolFee(ctx, fastID, mySender, sdk.NewInt64Coin(myTokenDenom, 1)))
+	tx, err := k.GetUnbatchedTxByFeeAndId(ctx, types.InternalERC20Token{Amount: sdk.NewInt(2), Contract: *tokenContract}, 1, fastID)
+	require.NoError(t, err)
+	assert.Equal(t, fastID, tx.Id)
+
+	// batches exhaust the higher priority first
+	batch, err := k.BuildOutgoingTXBatch(ctx, *tokenContract, 1)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 1)
+	assert.Equal(t, fastID, batch.Transactions[0].Id)
+	batch, err = k.BuildOutgoingTXBatch(ctx, *tokenContract, 1)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 1)
+	assert.Equal(t, normalID, batch.Transactions[0].Id)
+}
+
+func TestFeeExemptSenders(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	allCoins := sdk.NewCoins(sdk.NewInt64Coin(myTokenDenom, 99999), sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allCoins))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allCoins))
+
+	params := k.GetParams(ctx)
+	params.MinSendToEthFees = []types.ERC20Token{{Contract: myTokenContractAddr, Amount: sdk.NewInt(10)}}
+	params.SendToEthPriorityFees = []sdk.Coin{sdk.NewInt64Coin("stake", 40)}
+	k.SetParams(ctx, params)
+
+	_, err = k.AddToOutgoingPool(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, 0))
+	require.Error(t, err)
+
+	params.FeeExemptSenders = []string{mySender.String()}
+	k.SetParams(ctx, params)
+	assert.True(t, k.IsFeeExemptSender(ctx, mySender))
+	res, err := k.FeeExemptSenders(sdk.WrapSDKContext(ctx), &types.QueryFeeExemptSendersRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{mySender.String()}, res.Senders)
+
+	// an exempt sender may pay no fee and is not charged for its priority
+	_, err = k.AddToOutgoingPool(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, 0))
+	require.NoError(t, err)
+	_, err = k.AddToOutgoingPoolWithPriority(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, 0), 1)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(100), input.BankKeeper.GetBalance(ctx, mySender, "stake").Amount)
+	// a priority without an entry is still refused
+	_, err = k.AddToOutgoingPoolWithPriority(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, 0), 2)
+	require.True(t, types.ErrInvalid.Is(err))
+}
+
+func TestOutgoingPoolAging(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(myTokenDenom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+	addTx := func(height int64, fee int64) uint64 {
+		id, err := k.AddToOutgoingPool(ctx.WithBlockHeight(height), mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, fee))
+		require.NoError(t, err)
+		return id
+	}
+
+	// without aging a low fee tx waits behind any higher fee
+	oldID := addTx(100, 1)
+	newID := addTx(125, 5)
+	ctx = ctx.WithBlockHeight(125)
+	txs := k.GetUnbatchedTransactionsByContract(ctx, *tokenContract)
+	require.Len(t, txs, 2)
+	assert.Equal(t, newID, txs[0].Id)
+
+	// after 25 blocks with an aging of 10 blocks the old tx gained two priority classes
+	params := k.GetParams(ctx)
+	params.PoolAgingBlocks = 10
+	k.SetParams(ctx, params)
+	assert.Equal(t, sdk.NewInt(1), k.GetBatchFeeByTokenType(ctx, *tokenContract, 1).TotalFees)
+	batch, err := k.BuildOutgoingTXBatch(ctx, *tokenContract, 1)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 1)
+	assert.Equal(t, oldID, batch.Transactions[0].Id)
+
+	// equal fees go out oldest first
+	firstID := addTx(125, 3)
+	addTx(125, 3)
+	batch, err = k.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 2)
+	assert.Equal(t, newID, batch.Transactions[0].Id)
+	assert.Equal(t, firstID, batch.Transactions[1].Id)
+}
+
+// Tests that the transactions of a pool stored before priority classes and inverted ids are re-keyed, with their
+// indexes
+func TestMigratePoolKeys(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(myTokenDenom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+	var ids []uint64
+	for _, fee := range []int64{2, 3, 2} {
+		id, err := k.AddToOutgoingPool(ctx, mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, fee))
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	// store the transactions by contract, fee and id without any index, as they were before priority classes and
+	// inverted ids
+	store := ctx.KVStore(k.storeKey)
+	for _, tx := range k.GetUnbatchedTransactions(ctx) {
+		require.NoError(t, k.removeUnbatchedTX(ctx, *tx.Erc20Fee, tx.Priority, tx.Id))
+		tx.CreatedHeight = 0
+		amount := tx.Erc20Fee.Amount.BigInt().FillBytes(make([]byte, 32))
+		oldKey := append(append([]byte{}, types.OutgoingTXPoolKey...), []byte(tx.Erc20Fee.Contract.GetAddress())...)
+		oldKey = append(append(oldKey, amount...), types.UInt64Bytes(tx.Id)...)
+		store.Set(oldKey, k.cdc.MustMarshalBinaryBare(tx.ToExternal()))
+	}
+	require.Empty(t, k.GetUnbatchedTxsBySender(ctx, mySender))
+
+	require.NoError(t, k.MigratePoolKeys(ctx))
+	for _, id := range ids {
+		tx, err := k.GetUnbatchedTxById(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(ctx.BlockHeight()), tx.CreatedHeight)
+		assert.True(t, store.Has(types.GetOutgoingTxPoolKey(*tx.Erc20Fee, tx.Priority, id)))
+	}
+	assert.Len(t, k.GetUnbatchedTxsBySender(ctx, mySender), 3)
+	assert.Len(t, k.GetUnbatchedTxsByReceiver(ctx, *receiver), 3)
+	// the highest fee first and the oldest of the equal fees after it
+	txs := k.GetUnbatchedTransactionsByContract(ctx, *tokenContract)
+	require.Len(t, txs, 3)
+	assert.Equal(t, []uint64{ids[1], ids[0], ids[2]}, []uint64{txs[0].Id, txs[1].Id, txs[2].Id})
+
+	// a pool already under the current layout is left as it is
+	require.NoError(t, k.MigratePoolKeys(ctx))
+	assert.Len(t, k.GetUnbatchedTransactions(ctx), 3)
+	assert.Len(t, k.GetUnbatchedTxsBySender(ctx, mySender), 3)
+}
+
+// Tests that the parameters missing from the store after an upgrade are set to their defaults
+func TestMigrateMissingParams(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	params := k.GetParams(ctx)
+	params.SendToEthPriorityFees = []sdk.Coin{sdk.NewInt64Coin("stake", 5)}
+	params.SignedValsetsWindow = 42
+	k.SetParams(ctx, params)
+
+	// drop a parameter from the store, as on a chain started before it was added
+	store := prefix.NewStore(ctx.KVStore(input.ParamsKey), []byte(types.DefaultParamspace+"/"))
+	store.Delete(types.ParamStoreSendToEthPriorityFees)
+	require.Panics(t, func() { k.GetParams(ctx) })
+
+	k.MigrateMissingParams(ctx)
+	migrated := k.GetParams(ctx)
+	assert.Empty(t, migrated.SendToEthPriorityFees)
+	assert.Equal(t, uint64(42), migrated.SignedValsetsWindow)
+}
+
+func TestSendToEthWithPayload(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(myTokenDenom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+	msgServer := NewMsgServerImpl(k)
+	send := func(fee int64, payload []byte) (*types.MsgSendToEthResponse, error) {
+		msg := types.NewMsgSendToEth(mySender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, fee))
+		msg.Payload = payload
+		return msgServer.SendToEth(sdk.WrapSDKContext(ctx), msg)
+	}
+
+	// payloads are disabled by default
+	_, err = send(1, []byte{0xca, 0xfe})
+	require.True(t, types.ErrInvalid.Is(err))
+
+	params := k.GetParams(ctx)
+	params.MaxSendToEthPayloadSize = 2
+	params.MergeBatchTransfers = true
+	k.SetParams(ctx, params)
+	_, err = send(1, []byte{0xca, 0xfe, 0x00})
+	require.True(t, types.ErrInvalid.Is(err))
+	_, err = send(3, []byte{0xca, 0xfe})
+	require.NoError(t, err)
+	_, err = send(2, nil)
+	require.NoError(t, err)
+	_, err = send(1, nil)
+	require.NoError(t, err)
+
+	txs := k.GetUnbatchedTransactionsByContract(ctx, *tokenContract)
+	require.Len(t, txs, 3)
+	assert.Equal(t, []byte{0xca, 0xfe}, txs[0].Payload)
+	assert.Empty(t, txs[1].Payload)
+
+	// the transfers without a payload are merged, the one with a payload is kept apart and signed with it
+	batch, err := k.BuildOutgoingTXBatch(ctx, *tokenContract, 10)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 2)
+	assert.Equal(t, []byte{0xca, 0xfe}, batch.Transactions[0].Payload)
+	assert.Equal(t, sdk.NewInt(200), batch.Transactions[1].Erc20Token.Amount)
+	assert.True(t, batch.HasPayloads())
+	stored := k.GetOutgoingTXBatch(ctx, *tokenContract, batch.BatchNonce)
+	require.NotNil(t, stored)
+	assert.Equal(t, []byte{0xca, 0xfe}, stored.Transactions[0].Payload)
+	assert.Equal(t, batch.GetCheckpoint(k.GetGravityID(ctx)), stored.GetCheckpoint(k.GetGravityID(ctx)))
+}
+
+func TestPendingTxLimit(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		otherSender, _      = sdk.AccAddressFromBech32("cosmos1mgamdcs9dah0vn0gqupl05up7pedg2mvupe6hh")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		myTokenDenom        = "gravity" + myTokenContractAddr
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(myTokenDenom, 99999)}
+	for _, sender := range []sdk.AccAddress{mySender, otherSender} {
+		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+		input.AccountKeeper.NewAccountWithAddress(ctx, sender)
+		require.NoError(t, input.BankKeeper.SetBalances(ctx, sender, allVouchers))
+	}
+	add := func(sender sdk.AccAddress) (uint64, error) {
+		return k.AddToOutgoingPool(ctx, sender, *receiver, sdk.NewInt64Coin(myTokenDenom, 100), sdk.NewInt64Coin(myTokenDenom, 1))
+	}
+
+	params := k.GetParams(ctx)
+	params.MaxPendingTxsPerSender = 2
+	k.SetParams(ctx, params)
+
+	first, err := add(mySender)
+	require.NoError(t, err)
+	_, err = add(mySender)
+	require.NoError(t, err)
+	balance := input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom)
+	_, err = add(mySender)
+	require.True(t, types.ErrTooManyPendingTxs.Is(err))
+	assert.Equal(t, balance, input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom))
+
+	// the limit is per sender
+	_, err = add(otherSender)
+	require.NoError(t, err)
+
+	// canceled and batched transfers free up room
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, first, mySender))
+	_, err = add(mySender)
+	require.NoError(t, err)
+	_, err = k.BuildOutgoingTXBatch(ctx, *tokenContract, 10)
+	require.NoError(t, err)
+	_, err = add(mySender)
+	require.NoError(t, err)
+	_, err = add(mySender)
+	require.NoError(t, err)
+	_, err = add(mySender)
+	require.True(t, types.ErrTooManyPendingTxs.Is(err))
+}
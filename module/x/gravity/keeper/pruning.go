@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// MaxPrunedPerBlock is the most attestations or valsets pruned in a single block, whatever is left
+// over is pruned in the following blocks starting from the stored cursor
+const MaxPrunedPerBlock = 500
+
+/////////////////////////////
+//         PRUNING         //
+/////////////////////////////
+
+// getPruneCursor returns the nonce pruning stored under key should resume from
+func (k Keeper) getPruneCursor(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if len(bz) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bz)
+}
+
+// setPruneCursor stores the nonce pruning should resume from in the next block
+func (k Keeper) setPruneCursor(ctx sdk.Context, key []byte, nonce uint64) {
+	ctx.KVStore(k.storeKey).Set(key, types.UInt64Bytes(nonce))
+}
+
+// AttestationPruneCutoff returns the event nonce below which and the block height before which observed
+// attestations are pruned under the AttestationRetention param, ok is false while nothing is to be pruned.
+// Events claim slashing has not checked yet are kept, otherwise validators that missed them would go unpunished
+func (k Keeper) AttestationPruneCutoff(ctx sdk.Context, params types.Params) (cutoff uint64, earliestToKeep uint64, ok bool) {
+	currentBlock := uint64(ctx.BlockHeight())
+	if params.AttestationRetention == 0 || currentBlock <= params.AttestationRetention {
+		return 0, 0, false
+	}
+	cutoff = k.GetLastObservedEventNonce(ctx) + 1
+	if params.SignedClaimsWindow > 0 {
+		if unchecked := k.GetLastSlashedClaimEventNonce(ctx) + 1; unchecked < cutoff {
+			cutoff = unchecked
+		}
+	}
+	return cutoff, currentBlock - params.AttestationRetention, true
+}
+
+// isAttestationPrunable returns true if the attestation is neither pending execution nor observed at or after
+// earliestToKeep, attestations that were never observed go together with the observed one at their nonce
+func isAttestationPrunable(att types.Attestation, earliestToKeep uint64) bool {
+	return !att.PendingExecution && !(att.Observed && att.ObservedHeight >= earliestToKeep)
+}
+
+// allAttestationsPrunable returns true if every attestation at an event nonce can be pruned
+func allAttestationsPrunable(atts []types.Attestation, earliestToKeep uint64) bool {
+	for _, att := range atts {
+		if !isAttestationPrunable(att, earliestToKeep) {
+			return false
+		}
+	}
+	return true
+}
+
+// PruneAttestations deletes the attestations of event nonces below cutoff whose event was observed before
+// the block height earliestToKeep, walking up from where the previous block stopped. The attestations of a
+// nonce are pruned together, along with the conflicting claim records of the validators that voted on them,
+// and at most MaxPrunedPerBlock attestations are deleted per block. Pruning halts at the first nonce observed
+// too recently or still pending execution, observation and the execution queue both run in nonce order so
+// every later nonce is held back as well. Each nonce is deleted in its own budgeted unit that moves the cursor
+// past it. Returns the number of attestations deleted
+func (k Keeper) PruneAttestations(ctx sdk.Context, cutoff uint64, earliestToKeep uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	cursor := k.getPruneCursor(ctx, types.AttestationPruneCursorKey)
+	if cursor >= cutoff {
+		return 0
+	}
+
+	// collect the keys first, the store may not be written to while it is being iterated
+	type nonceGroup struct {
+		nonce         uint64
+		keys, records [][]byte
+	}
+	var (
+		groups       []nonceGroup
+		pruned       int
+		nonceKeys    [][]byte
+		nonceRecords [][]byte
+		nonce        = cursor
+		prunable     = true
+		lastNonce    = cursor
+	)
+	// flush adds the attestations of the current nonce to the ones deleted, it returns false once pruning has
+	// to stop. A nonce with more than MaxPrunedPerBlock attestations is pruned on its own so it can not stall
+	flush := func() bool {
+		if !prunable || (pruned > 0 && pruned+len(nonceKeys) > MaxPrunedPerBlock) {
+			return false
+		}
+		groups = append(groups, nonceGroup{nonce: nonce, keys: nonceKeys, records: nonceRecords})
+		pruned += len(nonceKeys)
+		nonceKeys, nonceRecords = nil, nil
+		lastNonce = nonce + 1
+		return true
+	}
+	prefixLen := len(types.OracleAttestationKey)
+	done := true
+	iter := store.Iterator(types.GetAttestationKey(cursor, nil), types.GetAttestationKey(cutoff, nil))
+	for ; iter.Valid(); iter.Next() {
+		keyNonce := types.UInt64FromBytes(iter.Key()[prefixLen : prefixLen+len(types.UInt64Bytes(0))])
+		if keyNonce != nonce {
+			if len(nonceKeys) > 0 && !flush() {
+				done = false
+				break
+			}
+			nonce, prunable = keyNonce, true
+		}
+		var att types.Attestation
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &att)
+		prunable = prunable && isAttestationPrunable(att, earliestToKeep)
+		nonceKeys = append(nonceKeys, append([]byte{}, iter.Key()...))
+		for _, vote := range att.Votes {
+			val, err := sdk.ValAddressFromBech32(vote)
+			if err != nil {
+				panic(err)
+			}
+			nonceRecords = append(nonceRecords, types.GetConflictingClaimSlashedKey(val, nonce))
+		}
+	}
+	iter.Close()
+	// once the range is exhausted the last nonce is complete and can be pruned as well
+	if done && (len(nonceKeys) == 0 || flush()) {
+		lastNonce = cutoff
+	}
+
+	for _, group := range groups {
+		group := group
+		k.RunBudgetedUnit(ctx, func(ctx sdk.Context) {
+			store := ctx.KVStore(k.storeKey)
+			for _, key := range group.keys {
+				store.Delete(key)
+			}
+			for _, key := range group.records {
+				store.Delete(key)
+			}
+			k.setPruneCursor(ctx, types.AttestationPruneCursorKey, group.nonce+1)
+		})
+	}
+	// the cursor also moves past the nonces without attestations at the end of the range
+	if lastNonce > cursor {
+		k.RunBudgetedUnit(ctx, func(ctx sdk.Context) {
+			k.setPruneCursor(ctx, types.AttestationPruneCursorKey, lastNonce)
+		})
+	}
+	return uint64(pruned)
+}
+
+// PruneValsets deletes up to MaxPrunedPerBlock valsets with a nonce below beforeNonce that were created
+// before the block height earliestToPrune, walking up from where the previous block stopped. Valset
+// heights grow with their nonces, so pruning stops at the first valset that is too recent. The confirms
+// of pruned valsets go with them. Each valset is deleted in its own budgeted unit that moves the cursor past
+// it. Returns the number of valsets deleted
+func (k Keeper) PruneValsets(ctx sdk.Context, beforeNonce uint64, earliestToPrune uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	cursor := k.getPruneCursor(ctx, types.ValsetPruneCursorKey)
+	var nonces []uint64
+	if cursor < beforeNonce {
+		iter := store.Iterator(types.GetValsetKey(cursor), types.GetValsetKey(beforeNonce))
+		for ; iter.Valid() && len(nonces) < MaxPrunedPerBlock; iter.Next() {
+			var valset types.Valset
+			k.cdc.MustUnmarshalBinaryBare(iter.Value(), &valset)
+			if valset.Height >= earliestToPrune {
+				break
+			}
+			nonces = append(nonces, valset.Nonce)
+		}
+		iter.Close()
+	}
+
+	for _, nonce := range nonces {
+		nonce := nonce
+		k.RunBudgetedUnit(ctx, func(ctx sdk.Context) {
+			k.DeleteValset(ctx, nonce)
+			k.setPruneCursor(ctx, types.ValsetPruneCursorKey, nonce+1)
+		})
+	}
+	if len(nonces) > 0 {
+		cursor = nonces[len(nonces)-1] + 1
+	}
+	k.pruneValsetConfirms(ctx, cursor)
+	return uint64(len(nonces))
+}
+
+// pruneValsetConfirms deletes up to MaxPrunedPerBlock confirms of valsets with a nonce below beforeNonce, the
+// ones left over are deleted in the following blocks. Valsets used to be pruned without their confirms, this
+// also clears the confirms of those
+func (k Keeper) pruneValsetConfirms(ctx sdk.Context, beforeNonce uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	iter := store.Iterator(types.ValsetConfirmKey, types.GetValsetConfirmKey(beforeNonce, nil))
+	for ; iter.Valid() && len(keys) < MaxPrunedPerBlock; iter.Next() {
+		keys = append(keys, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+
+	k.deletePrunedKeys(ctx, keys)
+	return uint64(len(keys))
+}
+
+// deletePrunedKeys deletes keys one budgeted unit at a time. Pruning that walks its prefix from the start every
+// block needs no cursor, the keys already deleted are simply no longer found
+func (k Keeper) deletePrunedKeys(ctx sdk.Context, keys [][]byte) {
+	for _, key := range keys {
+		key := key
+		k.RunBudgetedUnit(ctx, func(ctx sdk.Context) {
+			ctx.KVStore(k.storeKey).Delete(key)
+		})
+	}
+}
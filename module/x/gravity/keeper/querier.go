@@ -94,6 +94,7 @@ const (
 // NewQuerier is the module level router for state queries
 func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err error) {
+		ctx = keeper.WithStoredBlockHeader(ctx)
 		switch path[0] {
 
 		// Valsets
@@ -224,12 +225,10 @@ const maxValsetRequestsReturned = 5
 
 // lastValsetRequests returns up to maxValsetRequestsReturned valsets from the store
 func lastValsetRequests(ctx sdk.Context, keeper Keeper) ([]byte, error) {
-	var counter int
 	var valReq []*types.Valset
-	keeper.IterateValsets(ctx, func(_ []byte, val *types.Valset) bool {
+	keeper.IterateValsets(ctx, IterationLimit{StartAfter: nil, MaxResults: maxValsetRequestsReturned}, func(_ []byte, val *types.Valset) bool {
 		valReq = append(valReq, val)
-		counter++
-		return counter >= maxValsetRequestsReturned
+		return false
 	})
 	if len(valReq) == 0 {
 		return nil, nil
@@ -250,7 +249,7 @@ func lastPendingValsetRequest(ctx sdk.Context, operatorAddr string, keeper Keepe
 	}
 
 	var pendingValsetReq []*types.Valset
-	keeper.IterateValsets(ctx, func(_ []byte, val *types.Valset) bool {
+	keeper.IterateValsets(ctx, IterationLimit{}, func(_ []byte, val *types.Valset) bool {
 		// foundConfirm is true if the operatorAddr has signed the valset we are currently looking at
 		foundConfirm := keeper.GetValsetConfirm(ctx, val.Nonce, addr) != nil
 		// if this valset has NOT been signed by operatorAddr, store it in pendingValsetReq
@@ -324,7 +323,7 @@ func lastPendingBatchRequest(ctx sdk.Context, operatorAddr string, keeper Keeper
 	}
 
 	var pendingBatchReq *types.OutgoingTxBatch
-	keeper.IterateOutgoingTXBatches(ctx, func(_ []byte, batch *types.InternalOutgoingTxBatch) bool {
+	keeper.IterateOutgoingTXBatches(ctx, IterationLimit{}, func(_ []byte, batch *types.InternalOutgoingTxBatch) bool {
 		foundConfirm := keeper.GetBatchConfirm(ctx, batch.BatchNonce, batch.TokenContract, addr) != nil
 		if !foundConfirm {
 			pendingBatchReq = batch.ToExternal()
@@ -347,9 +346,9 @@ const MaxResults = 100 // todo: impl pagination
 // Gets MaxResults batches from store. Does not select by token type or anything
 func lastBatchesRequest(ctx sdk.Context, keeper Keeper) ([]byte, error) {
 	var batches []*types.OutgoingTxBatch
-	keeper.IterateOutgoingTXBatches(ctx, func(_ []byte, batch *types.InternalOutgoingTxBatch) bool {
+	keeper.IterateOutgoingTXBatches(ctx, IterationLimit{StartAfter: nil, MaxResults: MaxResults}, func(_ []byte, batch *types.InternalOutgoingTxBatch) bool {
 		batches = append(batches, batch.ToExternal())
-		return len(batches) == MaxResults
+		return false
 	})
 	if len(batches) == 0 {
 		return nil, nil
@@ -362,7 +361,7 @@ func lastBatchesRequest(ctx sdk.Context, keeper Keeper) ([]byte, error) {
 }
 
 func queryBatchFees(ctx sdk.Context, keeper Keeper) ([]byte, error) {
-	val := types.QueryBatchFeeResponse{BatchFees: keeper.GetAllBatchFees(ctx, OutgoingTxBatchSize)}
+	val := types.QueryBatchFeeResponse{BatchFees: keeper.GetAllBatchFees(ctx, keeper.getLargestMaxBatchSize(ctx))}
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, val)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
@@ -373,9 +372,9 @@ func queryBatchFees(ctx sdk.Context, keeper Keeper) ([]byte, error) {
 // Gets MaxResults logic calls from store.
 func lastLogicCallRequests(ctx sdk.Context, keeper Keeper) ([]byte, error) {
 	var calls []*types.OutgoingLogicCall
-	keeper.IterateOutgoingLogicCalls(ctx, func(_ []byte, call *types.OutgoingLogicCall) bool {
+	keeper.IterateOutgoingLogicCalls(ctx, IterationLimit{StartAfter: nil, MaxResults: MaxResults}, func(_ []byte, call *types.OutgoingLogicCall) bool {
 		calls = append(calls, call)
-		return len(calls) == MaxResults
+		return false
 	})
 	if len(calls) == 0 {
 		return nil, nil
@@ -416,7 +415,7 @@ func lastPendingLogicCallRequest(ctx sdk.Context, operatorAddr string, keeper Ke
 	}
 
 	var pendingLogicCalls *types.OutgoingLogicCall
-	keeper.IterateOutgoingLogicCalls(ctx, func(_ []byte, call *types.OutgoingLogicCall) bool {
+	keeper.IterateOutgoingLogicCalls(ctx, IterationLimit{}, func(_ []byte, call *types.OutgoingLogicCall) bool {
 		foundConfirm := keeper.GetLogicCallConfirm(ctx, call.InvalidationId, call.InvalidationNonce, addr) != nil
 		if !foundConfirm {
 			pendingLogicCalls = call
@@ -524,23 +523,25 @@ func queryERC20ToDenom(ctx sdk.Context, ERC20 string, keeper Keeper) ([]byte, er
 }
 
 func queryPendingSendToEth(ctx sdk.Context, senderAddr string, k Keeper) ([]byte, error) {
+	sender, err := sdk.AccAddressFromBech32(senderAddr)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, senderAddr)
+	}
 	batches := k.GetOutgoingTxBatches(ctx)
-	unbatched_tx := k.GetUnbatchedTransactions(ctx)
+	unbatched_tx := k.GetUnbatchedTxsBySender(ctx, sender)
 	res := types.QueryPendingSendToEthResponse{
 		TransfersInBatches: []*types.OutgoingTransferTx{},
 		UnbatchedTransfers: []*types.OutgoingTransferTx{},
 	}
 	for _, batch := range batches {
-		for _, tx := range batch.Transactions {
+		for _, tx := range k.GetBatchTransfers(ctx, *batch) {
 			if tx.Sender.String() == senderAddr {
 				res.TransfersInBatches = append(res.TransfersInBatches, tx.ToExternal())
 			}
 		}
 	}
 	for _, tx := range unbatched_tx {
-		if tx.Sender.String() == senderAddr {
-			res.UnbatchedTransfers = append(res.UnbatchedTransfers, tx.ToExternal())
-		}
+		res.UnbatchedTransfers = append(res.UnbatchedTransfers, tx.ToExternal())
 	}
 	bytes, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
 	if err != nil {
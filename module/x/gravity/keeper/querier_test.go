@@ -512,20 +512,22 @@ func TestLastPendingBatchRequest(t *testing.T) {
 		"erc20_fee": {
 			"amount": "3",
 			"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
-		}
+		},
+		"created_height": "1234567"
 		},
 		{
-		"id": "3",
+		"id": "1",
 		"sender": "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
 		"dest_address": "0x320915BD0F1bad11cBf06e85D5199DBcAC4E9934",
 		"erc20_token": {
-			"amount": "102",
+			"amount": "100",
 			"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
 		},
 		"erc20_fee": {
 			"amount": "2",
 			"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
-		}
+		},
+		"created_height": "1234567"
 		}
 	],
 	"token_contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
@@ -590,8 +592,8 @@ func createTestBatch(t *testing.T, input TestInput) {
 	// tx batch size is 2, so that some of them stay behind
 	_, err = input.GravityKeeper.BuildOutgoingTXBatch(input.Context, *tokenContract, 2)
 	require.NoError(t, err)
-	// Should have 2 and 3 from above
-	// 1 and 4 should be unbatched
+	// Should have 2 and 1 from above
+	// 3 and 4 should be unbatched
 }
 
 //nolint: exhaustivestruct
@@ -765,6 +767,7 @@ func TestQueryBatch(t *testing.T) {
 				"amount": "3",
 				"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
 			  },
+			  "created_height": "1234567",
 			  "dest_address": "0x320915BD0F1bad11cBf06e85D5199DBcAC4E9934",
 			  "erc20_token": {
 				"amount": "101",
@@ -778,13 +781,14 @@ func TestQueryBatch(t *testing.T) {
 				"amount": "2",
 				"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
 			  },
+			  "created_height": "1234567",
 			  "dest_address": "0x320915BD0F1bad11cBf06e85D5199DBcAC4E9934",
 			  "erc20_token": {
-				"amount": "102",
+				"amount": "100",
 				"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
 			  },
 			  "sender": "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
-			  "id": "3"
+			  "id": "1"
 			}
 		  ],
 		  "batch_nonce": "1",
@@ -817,6 +821,7 @@ func TestLastBatchesRequest(t *testing.T) {
 				"amount": "3",
 				"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
 			  },
+			  "created_height": "1234567",
 			  "dest_address": "0x320915BD0F1bad11cBf06e85D5199DBcAC4E9934",
 			  "erc20_token": {
 				"amount": "101",
@@ -830,13 +835,14 @@ func TestLastBatchesRequest(t *testing.T) {
 				"amount": "2",
 				"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
 			  },
+			  "created_height": "1234567",
 			  "dest_address": "0x320915BD0F1bad11cBf06e85D5199DBcAC4E9934",
 			  "erc20_token": {
 				"amount": "102",
 				"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
 			  },
 			  "sender": "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
-			  "id": "7"
+			  "id": "3"
 			}
 		  ],
 		  "batch_nonce": "2",
@@ -850,6 +856,7 @@ func TestLastBatchesRequest(t *testing.T) {
 				"amount": "3",
 				"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
 			  },
+			  "created_height": "1234567",
 			  "dest_address": "0x320915BD0F1bad11cBf06e85D5199DBcAC4E9934",
 			  "erc20_token": {
 				"amount": "101",
@@ -863,13 +870,14 @@ func TestLastBatchesRequest(t *testing.T) {
 				"amount": "2",
 				"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
 			  },
+			  "created_height": "1234567",
 			  "dest_address": "0x320915BD0F1bad11cBf06e85D5199DBcAC4E9934",
 			  "erc20_token": {
-				"amount": "102",
+				"amount": "100",
 				"contract": "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
 			  },
 			  "sender": "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
-			  "id": "3"
+			  "id": "1"
 			}
 		  ],
 		  "batch_nonce": "1",
@@ -1002,11 +1010,11 @@ func TestQueryPendingSendToEth(t *testing.T) {
 	ctx = ctx.WithBlockTime(now)
 
 	// tx batch size is 2, so that some of them stay behind
-	// Should contain 2 and 3 from above
+	// Should contain 2 and 1 from above
 	_, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
 	require.NoError(t, err)
 
-	// Should receive 1 and 4 unbatched, 2 and 3 batched in response
+	// Should receive 3 and 4 unbatched, 2 and 1 batched in response
 	response, err := queryPendingSendToEth(ctx, mySender.String(), input.GravityKeeper)
 	require.NoError(t, err)
 	expectedJSON := []byte(`{
@@ -1022,35 +1030,38 @@ func TestQueryPendingSendToEth(t *testing.T) {
       "erc20_fee": {
         "contract": "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
         "amount": "3"
-      }
+      },
+      "created_height": "1234567"
     },
     {
-      "id": "3",
+      "id": "1",
       "sender": "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
       "dest_address": "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
       "erc20_token": {
         "contract": "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
-        "amount": "102"
+        "amount": "100"
       },
       "erc20_fee": {
         "contract": "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
         "amount": "2"
-      }
+      },
+      "created_height": "1234567"
     }
   ],
   "unbatched_transfers": [
     {
-      "id": "1",
+      "id": "3",
       "sender": "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
       "dest_address": "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
       "erc20_token": {
         "contract": "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
-        "amount": "100"
+        "amount": "102"
       },
       "erc20_fee": {
         "contract": "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
         "amount": "2"
-      }
+      },
+      "created_height": "1234567"
     },
     {
       "id": "4",
@@ -1063,7 +1074,8 @@ func TestQueryPendingSendToEth(t *testing.T) {
       "erc20_fee": {
         "contract": "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
         "amount": "1"
-      }
+      },
+      "created_height": "1234567"
     }
   ]}
 	  `)
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//      QUERY CONTEXT      //
+/////////////////////////////
+
+// SetLastBlockHeader records the height and time of the current block in the store, so that a query served
+// from this version of the state can tell which block it is looking at
+func (k Keeper) SetLastBlockHeader(ctx sdk.Context) {
+	bz := append(types.UInt64Bytes(uint64(ctx.BlockHeight())), types.UInt64Bytes(uint64(ctx.BlockTime().UnixNano()))...)
+	ctx.KVStore(k.storeKey).Set(types.LastBlockHeaderKey, bz)
+}
+
+// WithStoredBlockHeader returns the context with its block height and time replaced by the ones recorded in its
+// store. A query context always carries the header of the latest block, even when its store is loaded at an
+// earlier height, so anything computed relative to the current block (heartbeat ages, stats windows, projected
+// Ethereum heights, the current valset) would otherwise describe the latest block and not the queried one. The
+// context is returned unchanged if no header was recorded yet
+func (k Keeper) WithStoredBlockHeader(ctx sdk.Context) sdk.Context {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastBlockHeaderKey)
+	if len(bz) != 16 {
+		return ctx
+	}
+	height := int64(types.UInt64FromBytes(bz[:8]))
+	blockTime := time.Unix(0, int64(types.UInt64FromBytes(bz[8:])))
+	return ctx.WithBlockHeight(height).WithBlockTime(blockTime)
+}
+
+// queryContext unwraps the sdk context of a gRPC query, see WithStoredBlockHeader
+func (k Keeper) queryContext(c context.Context) sdk.Context {
+	return k.WithStoredBlockHeader(sdk.UnwrapSDKContext(c))
+}
@@ -186,22 +186,69 @@ var (
 
 	// TestingGravityParams is a set of gravity params for testing
 	TestingGravityParams = types.Params{
-		GravityId:                    "testgravityid",
-		ContractSourceHash:           "62328f7bc12efb28f86111d08c29b39285680a906ea0e524e0209d6f6657b713",
-		BridgeEthereumAddress:        "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
-		BridgeChainId:                11,
-		SignedValsetsWindow:          10,
-		SignedBatchesWindow:          10,
-		SignedLogicCallsWindow:       10,
-		TargetBatchTimeout:           60001,
-		AverageBlockTime:             5000,
-		AverageEthereumBlockTime:     15000,
-		SlashFractionValset:          sdk.NewDecWithPrec(1, 2),
-		SlashFractionBatch:           sdk.NewDecWithPrec(1, 2),
-		SlashFractionLogicCall:       sdk.Dec{},
-		UnbondSlashingValsetsWindow:  15,
-		SlashFractionBadEthSignature: sdk.NewDecWithPrec(1, 2),
-		ValsetReward:                 sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		GravityId:                          "testgravityid",
+		ContractSourceHash:                 "62328f7bc12efb28f86111d08c29b39285680a906ea0e524e0209d6f6657b713",
+		BridgeEthereumAddress:              "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
+		BridgeChainId:                      11,
+		SignedValsetsWindow:                10,
+		SignedBatchesWindow:                10,
+		SignedLogicCallsWindow:             10,
+		TargetBatchTimeout:                 60001,
+		AverageBlockTime:                   5000,
+		AverageEthereumBlockTime:           15000,
+		SlashFractionValset:                sdk.NewDecWithPrec(1, 2),
+		SlashFractionBatch:                 sdk.NewDecWithPrec(1, 2),
+		SlashFractionLogicCall:             sdk.Dec{},
+		UnbondSlashingValsetsWindow:        15,
+		SlashFractionBadEthSignature:       sdk.NewDecWithPrec(1, 2),
+		ValsetReward:                       sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		EthereumPowerThreshold:             0,
+		EthereumTimeoutMargin:              4,
+		EthereumBlockTimeCalibrationPeriod: 100,
+		AttestationFastPathThreshold:       0,
+		AttestationExecutionBudget:         100,
+		ValsetCreationGasBudget:            0,
+		SlashingGasBudget:                  0,
+		PruningGasBudget:                   0,
+		AttestationGasBudget:               0,
+		RelayerLotteryReward:               sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		RelayerLotteryWindow:               100,
+		FeeConfirmationMultiple:            0,
+		OutflowUsdLimit:                    sdk.ZeroDec(),
+		OutflowLimitWindow:                 100,
+		StallRefundThreshold:               0,
+		StallRefundBudget:                  100,
+		RefundReceiptRetention:             432000,
+		PoolTxTimeout:                      0,
+		MaxCallbackDataSize:                1024,
+		CallbackDataByteFee:                sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		BatchGasPerTx:                      0,
+		BatchGasOverhead:                   0,
+		DepositReceiptRetention:            432000,
+		MinSendToEthFees:                   []types.ERC20Token{},
+		MaxBatchSize:                       100,
+		TokenMaxBatchSizes:                 []types.TokenBatchSize{},
+		MaxClaimAge:                        0,
+		RebuildTimedOutBatches:             false,
+		SendToEthPriorityFees:              []sdk.Coin{},
+		MergeBatchTransfers:                false,
+		PoolAgingBlocks:                    0,
+		MaxSendToEthPayloadSize:            0,
+		MaxPendingTxsPerSender:             0,
+		SignedClaimsWindow:                 0,
+		SlashFractionClaim:                 sdk.NewDecWithPrec(1, 2),
+		SlashFractionConflictingClaim:      sdk.NewDecWithPrec(1, 2),
+		AttestationRetention:               17280,
+		EthBlocksToObserve:                 6,
+		QuarantinedEthSenders:              []string{},
+		EthereumHeightDriftThreshold:       100,
+		ValsetPowerChangeThreshold:         sdk.NewDecWithPrec(5, 2),
+		MaxValsetInterval:                  0,
+		ValsetRetention:                    0,
+		TokenSignedBatchesWindows:          []types.TokenSignedBatchesWindow{},
+		EventNonceStallThreshold:           0,
+		MinimalEvents:                      false,
+		FeeExemptSenders:                   []string{},
 	}
 )
 
@@ -217,6 +264,7 @@ type TestInput struct {
 	Context        sdk.Context
 	Marshaler      codec.Marshaler
 	LegacyAmino    *codec.LegacyAmino
+	ParamsKey      sdk.StoreKey
 }
 
 // SetupFiveValChain does all the initialization for a 5 Validator chain using the keys here
@@ -463,6 +511,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 		Context:        ctx,
 		Marshaler:      marshaler,
 		LegacyAmino:    cdc,
+		ParamsKey:      keyParams,
 	}
 }
 
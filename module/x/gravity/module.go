@@ -118,8 +118,7 @@ func (AppModule) Name() string {
 
 // RegisterInvariants implements app module
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
-	// TODO: make some invariants in the gravity module to ensure that
-	// coins aren't being fraudlently minted etc...
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // Route implements app module
@@ -0,0 +1,21 @@
+package gravity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
+)
+
+// NewGravityProposalHandler returns a handler for the governance proposals of the gravity module
+func NewGravityProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return k.HandleProposal
+}
+
+// NewParamChangeProposalHandler wraps the params change proposal handler of the params module, so that a change
+// of the gravity params is checked against the state of the module before it is kept
+func NewParamChangeProposalHandler(k keeper.Keeper, paramsHandler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		return k.HandleParamChangeProposal(ctx, content, paramsHandler)
+	}
+}
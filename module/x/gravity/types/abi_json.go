@@ -34,6 +34,28 @@ const (
 		]
 	}]`
 
+	// OutgoingBatchTxWithPayloadsCheckpointABIJSON checks the ETH ABI for compatability of the OutgoingBatchTx message
+	// for batches whose transactions carry payloads
+	OutgoingBatchTxWithPayloadsCheckpointABIJSON = `[{
+		"name": "submitBatch",
+		"stateMutability": "pure",
+		"type": "function",
+		"inputs": [
+			{ "internalType": "bytes32",   "name": "_gravityId",     "type": "bytes32" },
+			{ "internalType": "bytes32",   "name": "_methodName",    "type": "bytes32" },
+			{ "internalType": "uint256[]", "name": "_amounts",       "type": "uint256[]" },
+			{ "internalType": "address[]", "name": "_destinations",  "type": "address[]" },
+			{ "internalType": "uint256[]", "name": "_fees",          "type": "uint256[]" },
+			{ "internalType": "bytes[]",   "name": "_payloads",      "type": "bytes[]" },
+			{ "internalType": "uint256",   "name": "_batchNonce",    "type": "uint256" },
+			{ "internalType": "address",   "name": "_tokenContract", "type": "address" },
+			{ "internalType": "uint256",   "name": "_batchTimeout",  "type": "uint256" }
+		],
+		"outputs": [
+			{ "internalType": "bytes32", "name": "", "type": "bytes32" }
+		]
+	}]`
+
 	// ValsetCheckpointABIJSON checks the ETH ABI for compatability of the Valset update message
 	ValsetCheckpointABIJSON = `[{
 		"name": "checkpoint",
@@ -53,6 +75,27 @@ const (
 		]
 	}]`
 
+	// ValsetCheckpointWithThresholdABIJSON checks the ETH ABI for compatability of the Valset update message
+	// for contracts that take the power threshold as part of the validator set
+	ValsetCheckpointWithThresholdABIJSON = `[{
+		"name": "checkpoint",
+		"stateMutability": "pure",
+		"type": "function",
+		"inputs": [
+			{ "internalType": "bytes32",   "name": "_gravityId",      "type": "bytes32"   },
+			{ "internalType": "bytes32",   "name": "_checkpoint",     "type": "bytes32"   },
+			{ "internalType": "uint256",   "name": "_valsetNonce",    "type": "uint256"   },
+			{ "internalType": "address[]", "name": "_validators",     "type": "address[]" },
+			{ "internalType": "uint256[]", "name": "_powers",         "type": "uint256[]" },
+			{ "internalType": "uint256",   "name": "_powerThreshold", "type": "uint256"   },
+			{ "internalType": "uint256",   "name": "_rewardAmount",   "type": "uint256"   },
+			{ "internalType": "address",   "name": "_rewardToken",    "type": "address"   }
+		],
+		"outputs": [
+			{ "internalType": "bytes32", "name": "", "type": "bytes32" }
+		]
+	}]`
+
 	// OutgoingLogicCallABIJSON checks the ETH ABI for compatability of the logic call message
 	OutgoingLogicCallABIJSON = `[{
 	  "name": "checkpoint",
@@ -73,4 +116,31 @@ const (
 			{ "internalType": "uint256",   "name": "_invalidationNonce",      "type": "uint256"   }
       ]
     }]`
+
+	// GravityConstructorABIJSON is the constructor of the Gravity contract, packing its arguments gives the
+	// constructor arguments a deployment appends to the bytecode
+	GravityConstructorABIJSON = `[{
+      "stateMutability": "nonpayable",
+      "type": "constructor",
+      "inputs": [
+			{ "internalType": "bytes32",   "name": "_gravityId",      "type": "bytes32"   },
+			{ "internalType": "uint256",   "name": "_powerThreshold", "type": "uint256"   },
+			{ "internalType": "address[]", "name": "_validators",     "type": "address[]" },
+			{ "internalType": "uint256[]", "name": "_powers",         "type": "uint256[]" }
+      ]
+    }]`
+
+	// CallbackReceiverABIJSON is the ERC677 style receiver hook called on the target of a transfer with a callback.
+	// Unlike the checkpoints above this is a real function call, the selector is kept
+	CallbackReceiverABIJSON = `[{
+	  "name": "onTokenTransfer",
+      "outputs": [],
+      "stateMutability": "nonpayable",
+      "type": "function",
+      "inputs": [
+			{ "internalType": "address", "name": "_sender", "type": "address" },
+			{ "internalType": "uint256", "name": "_value",  "type": "uint256" },
+			{ "internalType": "bytes",   "name": "_data",   "type": "bytes"   }
+      ]
+    }]`
 )
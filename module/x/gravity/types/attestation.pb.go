@@ -34,6 +34,7 @@ const (
 	CLAIM_TYPE_ERC20_DEPLOYED      ClaimType = 3
 	CLAIM_TYPE_LOGIC_CALL_EXECUTED ClaimType = 4
 	CLAIM_TYPE_VALSET_UPDATED      ClaimType = 5
+	CLAIM_TYPE_MIGRATION_COMPLETED ClaimType = 6
 )
 
 var ClaimType_name = map[int32]string{
@@ -43,6 +44,7 @@ var ClaimType_name = map[int32]string{
 	3: "CLAIM_TYPE_ERC20_DEPLOYED",
 	4: "CLAIM_TYPE_LOGIC_CALL_EXECUTED",
 	5: "CLAIM_TYPE_VALSET_UPDATED",
+	6: "CLAIM_TYPE_MIGRATION_COMPLETED",
 }
 
 var ClaimType_value = map[string]int32{
@@ -52,6 +54,7 @@ var ClaimType_value = map[string]int32{
 	"CLAIM_TYPE_ERC20_DEPLOYED":      3,
 	"CLAIM_TYPE_LOGIC_CALL_EXECUTED": 4,
 	"CLAIM_TYPE_VALSET_UPDATED":      5,
+	"CLAIM_TYPE_MIGRATION_COMPLETED": 6,
 }
 
 func (x ClaimType) String() string {
@@ -77,11 +80,28 @@ func (ClaimType) EnumDescriptor() ([]byte, []int) {
 // the key in which the attestation is stored is keyed on the exact details of the claim
 // but there is no reason to store those exact details becuause the next message sender
 // will kindly provide you with them.
+//
+// eth_block_timestamp is the unix timestamp of the Ethereum block the claimed
+// event occurred in, zero for claims that do not carry one. observed_time is
+// the unix time of the Cosmos block the attestation was observed in, zero
+// while it is not observed. vetoed attestations were blocked by governance,
+// they are never observed and accept no further votes. pending_execution is
+// set on observed attestations that are queued to be applied to the state.
+// claim_hash_version is the claim hash version the attestation is keyed
+// under, attestations stored before claim hashes were versioned are version 0.
+// observed_height is the Cosmos block height the attestation was observed at,
+// zero while it is not observed
 type Attestation struct {
-	Observed bool       `protobuf:"varint,1,opt,name=observed,proto3" json:"observed,omitempty"`
-	Votes    []string   `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
-	Height   uint64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	Claim    *types.Any `protobuf:"bytes,4,opt,name=claim,proto3" json:"claim,omitempty"`
+	Observed          bool       `protobuf:"varint,1,opt,name=observed,proto3" json:"observed,omitempty"`
+	Votes             []string   `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
+	Height            uint64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Claim             *types.Any `protobuf:"bytes,4,opt,name=claim,proto3" json:"claim,omitempty"`
+	EthBlockTimestamp uint64     `protobuf:"varint,5,opt,name=eth_block_timestamp,json=ethBlockTimestamp,proto3" json:"eth_block_timestamp,omitempty"`
+	ObservedTime      uint64     `protobuf:"varint,6,opt,name=observed_time,json=observedTime,proto3" json:"observed_time,omitempty"`
+	Vetoed            bool       `protobuf:"varint,7,opt,name=vetoed,proto3" json:"vetoed,omitempty"`
+	PendingExecution  bool       `protobuf:"varint,8,opt,name=pending_execution,json=pendingExecution,proto3" json:"pending_execution,omitempty"`
+	ClaimHashVersion  uint32     `protobuf:"varint,9,opt,name=claim_hash_version,json=claimHashVersion,proto3" json:"claim_hash_version,omitempty"`
+	ObservedHeight    uint64     `protobuf:"varint,10,opt,name=observed_height,json=observedHeight,proto3" json:"observed_height,omitempty"`
 }
 
 func (m *Attestation) Reset()         { *m = Attestation{} }
@@ -145,6 +165,215 @@ func (m *Attestation) GetClaim() *types.Any {
 	return nil
 }
 
+func (m *Attestation) GetEthBlockTimestamp() uint64 {
+	if m != nil {
+		return m.EthBlockTimestamp
+	}
+	return 0
+}
+
+func (m *Attestation) GetObservedTime() uint64 {
+	if m != nil {
+		return m.ObservedTime
+	}
+	return 0
+}
+
+func (m *Attestation) GetVetoed() bool {
+	if m != nil {
+		return m.Vetoed
+	}
+	return false
+}
+
+func (m *Attestation) GetPendingExecution() bool {
+	if m != nil {
+		return m.PendingExecution
+	}
+	return false
+}
+
+func (m *Attestation) GetClaimHashVersion() uint32 {
+	if m != nil {
+		return m.ClaimHashVersion
+	}
+	return 0
+}
+
+func (m *Attestation) GetObservedHeight() uint64 {
+	if m != nil {
+		return m.ObservedHeight
+	}
+	return 0
+}
+
+// AttestationVote is the vote of a single validator on an attestation along
+// with its current power
+type AttestationVote struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Power     uint64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *AttestationVote) Reset()         { *m = AttestationVote{} }
+func (m *AttestationVote) String() string { return proto.CompactTextString(m) }
+func (*AttestationVote) ProtoMessage()    {}
+func (*AttestationVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{1}
+}
+func (m *AttestationVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationVote.Merge(m, src)
+}
+func (m *AttestationVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationVote proto.InternalMessageInfo
+
+func (m *AttestationVote) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *AttestationVote) GetPower() uint64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// AttestationVoteBreakdown explains how close an attestation is to being
+// observed, all powers are current consensus powers. remaining_power is the
+// power that still has to vote before the attestation is observed.
+// missing_votes are the validators of the current set that have not voted on
+// the attestation, the most powerful first
+type AttestationVoteBreakdown struct {
+	EventNonce     uint64             `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	ClaimHash      string             `protobuf:"bytes,2,opt,name=claim_hash,json=claimHash,proto3" json:"claim_hash,omitempty"`
+	Observed       bool               `protobuf:"varint,3,opt,name=observed,proto3" json:"observed,omitempty"`
+	Votes          []*AttestationVote `protobuf:"bytes,4,rep,name=votes,proto3" json:"votes,omitempty"`
+	VotedPower     uint64             `protobuf:"varint,5,opt,name=voted_power,json=votedPower,proto3" json:"voted_power,omitempty"`
+	RequiredPower  uint64             `protobuf:"varint,6,opt,name=required_power,json=requiredPower,proto3" json:"required_power,omitempty"`
+	RemainingPower uint64             `protobuf:"varint,7,opt,name=remaining_power,json=remainingPower,proto3" json:"remaining_power,omitempty"`
+	TotalPower     uint64             `protobuf:"varint,8,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	MissingVotes   []*AttestationVote `protobuf:"bytes,9,rep,name=missing_votes,json=missingVotes,proto3" json:"missing_votes,omitempty"`
+}
+
+func (m *AttestationVoteBreakdown) Reset()         { *m = AttestationVoteBreakdown{} }
+func (m *AttestationVoteBreakdown) String() string { return proto.CompactTextString(m) }
+func (*AttestationVoteBreakdown) ProtoMessage()    {}
+func (*AttestationVoteBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{2}
+}
+func (m *AttestationVoteBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationVoteBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationVoteBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationVoteBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationVoteBreakdown.Merge(m, src)
+}
+func (m *AttestationVoteBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationVoteBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationVoteBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationVoteBreakdown proto.InternalMessageInfo
+
+func (m *AttestationVoteBreakdown) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *AttestationVoteBreakdown) GetClaimHash() string {
+	if m != nil {
+		return m.ClaimHash
+	}
+	return ""
+}
+
+func (m *AttestationVoteBreakdown) GetObserved() bool {
+	if m != nil {
+		return m.Observed
+	}
+	return false
+}
+
+func (m *AttestationVoteBreakdown) GetVotes() []*AttestationVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *AttestationVoteBreakdown) GetVotedPower() uint64 {
+	if m != nil {
+		return m.VotedPower
+	}
+	return 0
+}
+
+func (m *AttestationVoteBreakdown) GetRequiredPower() uint64 {
+	if m != nil {
+		return m.RequiredPower
+	}
+	return 0
+}
+
+func (m *AttestationVoteBreakdown) GetRemainingPower() uint64 {
+	if m != nil {
+		return m.RemainingPower
+	}
+	return 0
+}
+
+func (m *AttestationVoteBreakdown) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
+func (m *AttestationVoteBreakdown) GetMissingVotes() []*AttestationVote {
+	if m != nil {
+		return m.MissingVotes
+	}
+	return nil
+}
+
 // ERC20Token unique identifier for an Ethereum ERC20 token.
 // CONTRACT:
 // The contract address on ETH of the token, this could be a Cosmos
@@ -159,7 +388,7 @@ func (m *ERC20Token) Reset()         { *m = ERC20Token{} }
 func (m *ERC20Token) String() string { return proto.CompactTextString(m) }
 func (*ERC20Token) ProtoMessage()    {}
 func (*ERC20Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3205613bbab7525, []int{1}
+	return fileDescriptor_e3205613bbab7525, []int{3}
 }
 func (m *ERC20Token) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -198,43 +427,66 @@ func (m *ERC20Token) GetContract() string {
 func init() {
 	proto.RegisterEnum("gravity.v1.ClaimType", ClaimType_name, ClaimType_value)
 	proto.RegisterType((*Attestation)(nil), "gravity.v1.Attestation")
+	proto.RegisterType((*AttestationVote)(nil), "gravity.v1.AttestationVote")
+	proto.RegisterType((*AttestationVoteBreakdown)(nil), "gravity.v1.AttestationVoteBreakdown")
 	proto.RegisterType((*ERC20Token)(nil), "gravity.v1.ERC20Token")
 }
 
 func init() { proto.RegisterFile("gravity/v1/attestation.proto", fileDescriptor_e3205613bbab7525) }
 
 var fileDescriptor_e3205613bbab7525 = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x4f, 0x6f, 0x9b, 0x30,
-	0x18, 0xc6, 0x71, 0xfe, 0xa9, 0x71, 0x2f, 0x91, 0x15, 0x55, 0x69, 0xd4, 0xd1, 0x28, 0x87, 0x29,
-	0xaa, 0x14, 0xbc, 0x76, 0x9f, 0x80, 0x80, 0xbb, 0x46, 0xa2, 0x25, 0x02, 0x32, 0xad, 0xd3, 0x24,
-	0x04, 0xc4, 0x23, 0xa8, 0x01, 0x47, 0xe0, 0xa0, 0x71, 0xde, 0x65, 0xc7, 0x7d, 0x87, 0x7d, 0x99,
-	0x1e, 0x73, 0x9c, 0x76, 0xa8, 0xa6, 0xe4, 0x8b, 0x4c, 0x01, 0x92, 0x45, 0x3d, 0xc1, 0xe3, 0xdf,
-	0xeb, 0xd7, 0x8f, 0x1f, 0xbf, 0xf0, 0xc2, 0x8f, 0x9d, 0x34, 0xe0, 0x19, 0x4e, 0xaf, 0xb1, 0xc3,
-	0x39, 0x4d, 0xb8, 0xc3, 0x03, 0x16, 0x49, 0xcb, 0x98, 0x71, 0x86, 0x60, 0x49, 0xa5, 0xf4, 0xba,
-	0xdb, 0xf6, 0x99, 0xcf, 0xf2, 0x65, 0xbc, 0xfb, 0x2b, 0x2a, 0xba, 0xe7, 0x3e, 0x63, 0xfe, 0x82,
-	0xe2, 0x5c, 0xb9, 0xab, 0xaf, 0xd8, 0x89, 0xb2, 0x02, 0xf5, 0xbf, 0x03, 0x78, 0x2a, 0xff, 0x6f,
-	0x89, 0xba, 0xf0, 0x84, 0xb9, 0x09, 0x8d, 0x53, 0x3a, 0xeb, 0x80, 0x1e, 0x18, 0x9c, 0x18, 0x07,
-	0x8d, 0xda, 0xb0, 0x9e, 0x32, 0x4e, 0x93, 0x4e, 0xa5, 0x57, 0x1d, 0x34, 0x8d, 0x42, 0xa0, 0x33,
-	0xd8, 0x98, 0xd3, 0xc0, 0x9f, 0xf3, 0x4e, 0xb5, 0x07, 0x06, 0x35, 0xa3, 0x54, 0xe8, 0x0a, 0xd6,
-	0xbd, 0x85, 0x13, 0x84, 0x9d, 0x5a, 0x0f, 0x0c, 0x4e, 0x6f, 0xda, 0x52, 0x61, 0x42, 0xda, 0x9b,
-	0x90, 0xe4, 0x28, 0x33, 0x8a, 0x92, 0xfe, 0x12, 0x42, 0x62, 0x28, 0x37, 0xef, 0x2c, 0xf6, 0x44,
-	0x73, 0x0f, 0x1e, 0x8b, 0x78, 0xec, 0x78, 0x3c, 0xf7, 0xd0, 0x34, 0x0e, 0x1a, 0xdd, 0xc2, 0x86,
-	0x13, 0xb2, 0x55, 0xc4, 0x3b, 0x95, 0x1d, 0x19, 0x49, 0xcf, 0x2f, 0x97, 0xc2, 0x9f, 0x97, 0xcb,
-	0xb7, 0x7e, 0xc0, 0xe7, 0x2b, 0x57, 0xf2, 0x58, 0x88, 0x3d, 0x96, 0x84, 0x2c, 0x29, 0x3f, 0xc3,
-	0x64, 0xf6, 0x84, 0x79, 0xb6, 0xa4, 0x89, 0x34, 0x8e, 0xb8, 0x51, 0xee, 0xbe, 0x5a, 0x03, 0xd8,
-	0x54, 0x76, 0x67, 0x5b, 0xd9, 0x92, 0xa2, 0x2e, 0x3c, 0x53, 0x34, 0x79, 0x7c, 0x6f, 0x5b, 0x8f,
-	0x13, 0x62, 0x4f, 0x1f, 0xcc, 0x09, 0x51, 0xc6, 0xb7, 0x63, 0xa2, 0xb6, 0x04, 0xf4, 0x06, 0x9e,
-	0x1f, 0x31, 0x93, 0x3c, 0xa8, 0xb6, 0xa5, 0xdb, 0x8a, 0x6e, 0xde, 0xeb, 0x66, 0x0b, 0xa0, 0x1e,
-	0xbc, 0x38, 0xc2, 0x23, 0xd9, 0x52, 0xee, 0x0e, 0x45, 0xc4, 0xba, 0x6b, 0x55, 0x5e, 0x35, 0xc8,
-	0xef, 0x69, 0xab, 0x64, 0xa2, 0xe9, 0x8f, 0x44, 0x6d, 0x55, 0x51, 0x1f, 0x8a, 0x47, 0x58, 0xd3,
-	0x3f, 0x8c, 0x15, 0x5b, 0x91, 0x35, 0xcd, 0x26, 0x9f, 0x88, 0x32, 0xb5, 0x88, 0xda, 0xaa, 0xbd,
-	0x6a, 0xf1, 0x51, 0xd6, 0x4c, 0x62, 0xd9, 0xd3, 0x89, 0x2a, 0xef, 0x70, 0xbd, 0x5b, 0xfb, 0xf1,
-	0x4b, 0x14, 0x46, 0x5f, 0x9e, 0x37, 0x22, 0x58, 0x6f, 0x44, 0xf0, 0x77, 0x23, 0x82, 0x9f, 0x5b,
-	0x51, 0x58, 0x6f, 0x45, 0xe1, 0xf7, 0x56, 0x14, 0x3e, 0x8f, 0x8e, 0xc2, 0x71, 0x16, 0x7c, 0x4e,
-	0x9d, 0x61, 0x44, 0xf9, 0x3e, 0xa0, 0x72, 0x7c, 0x86, 0x6e, 0x1c, 0xcc, 0x7c, 0x8a, 0x43, 0x36,
-	0x5b, 0x2d, 0x28, 0xfe, 0x86, 0xf7, 0x43, 0x97, 0x87, 0xe7, 0x36, 0xf2, 0x77, 0x7b, 0xff, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0x0f, 0x0b, 0x25, 0x26, 0x8c, 0x02, 0x00, 0x00,
+	// 804 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x51, 0x6f, 0xda, 0x56,
+	0x14, 0xc6, 0x40, 0x68, 0x38, 0x34, 0x0d, 0xbd, 0x8b, 0x2a, 0x97, 0xa5, 0x80, 0x98, 0xb6, 0xa2,
+	0x6e, 0xb1, 0x97, 0xec, 0x0f, 0x0c, 0x8c, 0xdb, 0x20, 0x91, 0x80, 0x8c, 0x13, 0xad, 0xd3, 0x24,
+	0xeb, 0x82, 0xef, 0x6c, 0x2b, 0xd8, 0x97, 0xd9, 0x17, 0xb7, 0xfc, 0x83, 0x3d, 0xee, 0x3f, 0xec,
+	0xcf, 0xf4, 0xb1, 0x8f, 0xd3, 0x1e, 0xaa, 0x29, 0xf9, 0x0b, 0x79, 0x9d, 0x34, 0xdd, 0x7b, 0x6d,
+	0xc2, 0x78, 0xe9, 0x53, 0x7c, 0xbe, 0xef, 0xf3, 0x97, 0x73, 0xbe, 0x73, 0x30, 0x1c, 0x7b, 0x31,
+	0x4e, 0x03, 0xb6, 0xd6, 0xd3, 0x53, 0x1d, 0x33, 0x46, 0x12, 0x86, 0x59, 0x40, 0x23, 0x6d, 0x19,
+	0x53, 0x46, 0x11, 0x64, 0xac, 0x96, 0x9e, 0x36, 0x8e, 0x3c, 0xea, 0x51, 0x01, 0xeb, 0xfc, 0x49,
+	0x2a, 0x1a, 0xcf, 0x3d, 0x4a, 0xbd, 0x05, 0xd1, 0x45, 0x35, 0x5b, 0xfd, 0xaa, 0xe3, 0x68, 0x2d,
+	0xa9, 0xce, 0x7d, 0x11, 0x6a, 0xbd, 0x07, 0x4b, 0xd4, 0x80, 0x7d, 0x3a, 0x4b, 0x48, 0x9c, 0x12,
+	0x57, 0x55, 0xda, 0x4a, 0x77, 0xdf, 0xda, 0xd4, 0xe8, 0x08, 0xf6, 0x52, 0xca, 0x48, 0xa2, 0x16,
+	0xdb, 0xa5, 0x6e, 0xd5, 0x92, 0x05, 0x7a, 0x06, 0x15, 0x9f, 0x04, 0x9e, 0xcf, 0xd4, 0x52, 0x5b,
+	0xe9, 0x96, 0xad, 0xac, 0x42, 0xaf, 0x60, 0x6f, 0xbe, 0xc0, 0x41, 0xa8, 0x96, 0xdb, 0x4a, 0xb7,
+	0x76, 0x76, 0xa4, 0xc9, 0x26, 0xb4, 0xbc, 0x09, 0xad, 0x17, 0xad, 0x2d, 0x29, 0x41, 0x1a, 0x7c,
+	0x41, 0x98, 0xef, 0xcc, 0x16, 0x74, 0x7e, 0xe3, 0xb0, 0x20, 0xe4, 0xed, 0x84, 0x4b, 0x75, 0x4f,
+	0x18, 0x3e, 0x25, 0xcc, 0xef, 0x73, 0xc6, 0xce, 0x09, 0xf4, 0x15, 0x1c, 0xe4, 0x5d, 0x09, 0xb9,
+	0x5a, 0x11, 0xca, 0xc7, 0x39, 0xc8, 0x95, 0xbc, 0xb1, 0x94, 0x30, 0x4a, 0x5c, 0xf5, 0x91, 0x18,
+	0x24, 0xab, 0xd0, 0xb7, 0xf0, 0x74, 0x49, 0x22, 0x37, 0x88, 0x3c, 0x87, 0xbc, 0x27, 0xf3, 0x15,
+	0x9f, 0x5b, 0xdd, 0x17, 0x92, 0x7a, 0x46, 0x98, 0x39, 0x8e, 0xbe, 0x03, 0x24, 0x5a, 0x74, 0x7c,
+	0x9c, 0xf8, 0x4e, 0x4a, 0xe2, 0x84, 0xab, 0xab, 0x6d, 0xa5, 0x7b, 0x60, 0xd5, 0x05, 0x73, 0x8e,
+	0x13, 0xff, 0x5a, 0xe2, 0xe8, 0x25, 0x1c, 0x6e, 0xfa, 0xca, 0x42, 0x01, 0xd1, 0xd9, 0x93, 0x1c,
+	0x3e, 0x17, 0x68, 0xc7, 0x84, 0xc3, 0xad, 0xd4, 0xaf, 0x29, 0x23, 0xe8, 0x18, 0xaa, 0x29, 0x5e,
+	0x04, 0x2e, 0x66, 0x34, 0x16, 0xd1, 0x57, 0xad, 0x07, 0x80, 0x67, 0xbf, 0xa4, 0xef, 0x48, 0xac,
+	0x16, 0x85, 0x9f, 0x2c, 0x3a, 0xff, 0x16, 0x41, 0xdd, 0xf1, 0xe9, 0xc7, 0x04, 0xdf, 0xb8, 0xf4,
+	0x5d, 0x84, 0x5a, 0x50, 0x23, 0x29, 0x89, 0x98, 0x13, 0xd1, 0x68, 0x4e, 0x84, 0x65, 0xd9, 0x02,
+	0x01, 0x5d, 0x72, 0x04, 0xbd, 0x00, 0x78, 0x98, 0x4d, 0x18, 0x57, 0xad, 0xea, 0x66, 0xa6, 0xff,
+	0x9d, 0x42, 0x69, 0xe7, 0x14, 0x4e, 0xf3, 0x53, 0x28, 0xb7, 0x4b, 0xdd, 0xda, 0xd9, 0x97, 0xda,
+	0xc3, 0x0d, 0x6a, 0x3b, 0x0d, 0xe5, 0x77, 0xd2, 0x82, 0x1a, 0x7f, 0x70, 0x1d, 0x39, 0x87, 0xdc,
+	0x2d, 0x08, 0x68, 0xc2, 0x11, 0xf4, 0x35, 0x3c, 0x89, 0xc9, 0x6f, 0xab, 0x20, 0xde, 0x68, 0xe4,
+	0x56, 0x0f, 0x72, 0x54, 0xca, 0x5e, 0xc2, 0x61, 0x4c, 0x42, 0x1c, 0x44, 0x7c, 0x81, 0x52, 0xf7,
+	0x48, 0x66, 0xbc, 0x81, 0xa5, 0xb0, 0x05, 0x35, 0x46, 0x19, 0x5e, 0x64, 0xa2, 0x7d, 0xf9, 0x0f,
+	0x05, 0x24, 0x05, 0x3f, 0xc2, 0x41, 0x18, 0x24, 0x09, 0xf7, 0x91, 0xc3, 0x54, 0x3f, 0x3f, 0xcc,
+	0xe3, 0xec, 0x0d, 0x5e, 0x24, 0x9d, 0x25, 0x80, 0x69, 0x19, 0x67, 0xdf, 0xdb, 0xf4, 0x86, 0x88,
+	0xdf, 0xce, 0x9c, 0x46, 0x2c, 0xc6, 0x73, 0x96, 0x2d, 0x70, 0x53, 0xa3, 0xd7, 0x50, 0xc1, 0x21,
+	0x5d, 0x45, 0x4c, 0xe6, 0xdc, 0xd7, 0x3e, 0x7c, 0x6a, 0x15, 0xfe, 0xfe, 0xd4, 0xfa, 0xc6, 0x0b,
+	0x98, 0xbf, 0x9a, 0x69, 0x73, 0x1a, 0xea, 0x73, 0x9a, 0x84, 0x34, 0xc9, 0xfe, 0x9c, 0x24, 0xee,
+	0x8d, 0xce, 0xd6, 0x4b, 0x92, 0x68, 0xc3, 0x88, 0x59, 0xd9, 0xdb, 0xaf, 0xee, 0x15, 0xa8, 0x1a,
+	0x7c, 0x45, 0xf6, 0x7a, 0x49, 0x50, 0x03, 0x9e, 0x19, 0xa3, 0xde, 0xf0, 0xc2, 0xb1, 0xdf, 0x4e,
+	0x4c, 0xe7, 0xea, 0x72, 0x3a, 0x31, 0x8d, 0xe1, 0xeb, 0xa1, 0x39, 0xa8, 0x17, 0xd0, 0x0b, 0x78,
+	0xbe, 0xc5, 0x4d, 0xcd, 0xcb, 0x81, 0x63, 0x8f, 0x1d, 0x63, 0x3c, 0xbd, 0x18, 0x4f, 0xeb, 0x0a,
+	0x6a, 0xc3, 0xf1, 0x16, 0xdd, 0xef, 0xd9, 0xc6, 0xf9, 0x46, 0x64, 0xda, 0xe7, 0xf5, 0xe2, 0x8e,
+	0x81, 0x98, 0xd3, 0x19, 0x98, 0x93, 0xd1, 0xf8, 0xad, 0x39, 0xa8, 0x97, 0x50, 0x07, 0x9a, 0x5b,
+	0xf4, 0x68, 0xfc, 0x66, 0x68, 0x38, 0x46, 0x6f, 0x34, 0x72, 0xcc, 0x9f, 0x4c, 0xe3, 0xca, 0x36,
+	0x07, 0xf5, 0xf2, 0x8e, 0xc5, 0x75, 0x6f, 0x34, 0x35, 0x6d, 0xe7, 0x6a, 0x32, 0xe8, 0x71, 0x7a,
+	0x6f, 0xc7, 0xe2, 0x62, 0xf8, 0xc6, 0xea, 0xd9, 0xc3, 0xf1, 0xa5, 0x63, 0x8c, 0x2f, 0x26, 0x23,
+	0x93, 0x6b, 0x2a, 0x8d, 0xf2, 0xef, 0x7f, 0x36, 0x0b, 0xfd, 0x5f, 0x3e, 0xdc, 0x36, 0x95, 0x8f,
+	0xb7, 0x4d, 0xe5, 0x9f, 0xdb, 0xa6, 0xf2, 0xc7, 0x5d, 0xb3, 0xf0, 0xf1, 0xae, 0x59, 0xf8, 0xeb,
+	0xae, 0x59, 0xf8, 0xb9, 0xbf, 0x15, 0x20, 0x5e, 0x30, 0x9f, 0xe0, 0x93, 0x88, 0xb0, 0x3c, 0xc4,
+	0x6c, 0x93, 0x27, 0xb3, 0x38, 0x70, 0x3d, 0xa2, 0x87, 0xd4, 0x5d, 0x2d, 0x88, 0xfe, 0x5e, 0xcf,
+	0x3f, 0xa8, 0x22, 0xe0, 0x59, 0x45, 0x7c, 0x93, 0x7e, 0xf8, 0x6f, 0x00, 0x89, 0x23, 0x4d, 0x7f,
+	0x68, 0x05, 0x00, 0x00,
 }
 
 func (m *Attestation) Marshal() (dAtA []byte, err error) {
@@ -257,6 +509,46 @@ func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ObservedHeight != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.ObservedHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ClaimHashVersion != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.ClaimHashVersion))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PendingExecution {
+		i--
+		if m.PendingExecution {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Vetoed {
+		i--
+		if m.Vetoed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ObservedTime != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.ObservedTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EthBlockTimestamp != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.EthBlockTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Claim != nil {
 		{
 			size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
@@ -296,6 +588,134 @@ func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AttestationVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationVoteBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationVoteBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationVoteBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissingVotes) > 0 {
+		for iNdEx := len(m.MissingVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissingVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAttestation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RemainingPower != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.RemainingPower))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RequiredPower != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.RequiredPower))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.VotedPower != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.VotedPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAttestation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Observed {
+		i--
+		if m.Observed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClaimHash) > 0 {
+		i -= len(m.ClaimHash)
+		copy(dAtA[i:], m.ClaimHash)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.ClaimHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ERC20Token) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -369,33 +789,110 @@ func (m *Attestation) Size() (n int) {
 		l = m.Claim.Size()
 		n += 1 + l + sovAttestation(uint64(l))
 	}
+	if m.EthBlockTimestamp != 0 {
+		n += 1 + sovAttestation(uint64(m.EthBlockTimestamp))
+	}
+	if m.ObservedTime != 0 {
+		n += 1 + sovAttestation(uint64(m.ObservedTime))
+	}
+	if m.Vetoed {
+		n += 2
+	}
+	if m.PendingExecution {
+		n += 2
+	}
+	if m.ClaimHashVersion != 0 {
+		n += 1 + sovAttestation(uint64(m.ClaimHashVersion))
+	}
+	if m.ObservedHeight != 0 {
+		n += 1 + sovAttestation(uint64(m.ObservedHeight))
+	}
 	return n
 }
 
-func (m *ERC20Token) Size() (n int) {
+func (m *AttestationVote) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Contract)
+	l = len(m.Validator)
 	if l > 0 {
 		n += 1 + l + sovAttestation(uint64(l))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovAttestation(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovAttestation(uint64(m.Power))
+	}
 	return n
 }
 
-func sovAttestation(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAttestation(x uint64) (n int) {
-	return sovAttestation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Attestation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+func (m *AttestationVoteBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovAttestation(uint64(m.EventNonce))
+	}
+	l = len(m.ClaimHash)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.Observed {
+		n += 2
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovAttestation(uint64(l))
+		}
+	}
+	if m.VotedPower != 0 {
+		n += 1 + sovAttestation(uint64(m.VotedPower))
+	}
+	if m.RequiredPower != 0 {
+		n += 1 + sovAttestation(uint64(m.RequiredPower))
+	}
+	if m.RemainingPower != 0 {
+		n += 1 + sovAttestation(uint64(m.RemainingPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovAttestation(uint64(m.TotalPower))
+	}
+	if len(m.MissingVotes) > 0 {
+		for _, e := range m.MissingVotes {
+			l = e.Size()
+			n += 1 + l + sovAttestation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ERC20Token) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAttestation(uint64(l))
+	return n
+}
+
+func sovAttestation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttestation(x uint64) (n int) {
+	return sovAttestation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Attestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -529,6 +1026,494 @@ func (m *Attestation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockTimestamp", wireType)
+			}
+			m.EthBlockTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthBlockTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedTime", wireType)
+			}
+			m.ObservedTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Vetoed = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingExecution", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PendingExecution = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHashVersion", wireType)
+			}
+			m.ClaimHashVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimHashVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedHeight", wireType)
+			}
+			m.ObservedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationVoteBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationVoteBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationVoteBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Observed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Observed = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &AttestationVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedPower", wireType)
+			}
+			m.VotedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotedPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredPower", wireType)
+			}
+			m.RequiredPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingPower", wireType)
+			}
+			m.RemainingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingVotes = append(m.MissingVotes, &AttestationVote{})
+			if err := m.MissingVotes[len(m.MissingVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttestation(dAtA[iNdEx:])
@@ -13,7 +13,16 @@ import (
 )
 
 func (o OutgoingTransferTx) ToInternal() (*InternalOutgoingTransferTx, error) {
-	return NewInternalOutgoingTransferTx(o.Id, o.Sender, o.DestAddress, *o.Erc20Token, *o.Erc20Fee)
+	tx, err := NewInternalOutgoingTransferTx(o.Id, o.Sender, o.DestAddress, *o.Erc20Token, *o.Erc20Fee)
+	if err != nil {
+		return nil, err
+	}
+	tx.NeedsConfirmation = o.NeedsConfirmation
+	tx.HeldUntil = o.HeldUntil
+	tx.CreatedHeight = o.CreatedHeight
+	tx.Priority = o.Priority
+	tx.Payload = o.Payload
+	return tx, nil
 }
 
 // InternalOutgoingTransferTx is an internal duplicate of OutgoingTransferTx with validation
@@ -23,6 +32,16 @@ type InternalOutgoingTransferTx struct {
 	DestAddress *EthAddress
 	Erc20Token  *InternalERC20Token
 	Erc20Fee    *InternalERC20Token
+	// NeedsConfirmation holds the tx out of batches until the sender releases it
+	NeedsConfirmation bool
+	// HeldUntil holds the tx out of batches before this block height, see MsgSetFirstSendDelay
+	HeldUntil uint64
+	// CreatedHeight is the block height the tx entered the pool at, see the PoolTxTimeout param
+	CreatedHeight uint64
+	// Priority is the priority class of the tx, it is part of the pool key, see the SendToEthPriorityFees param
+	Priority uint32
+	// Payload is handed to the destination with the transfer, see the MaxSendToEthPayloadSize param
+	Payload []byte
 }
 
 func NewInternalOutgoingTransferTx(
@@ -60,14 +79,25 @@ func NewInternalOutgoingTransferTx(
 
 func (i InternalOutgoingTransferTx) ToExternal() *OutgoingTransferTx {
 	return &OutgoingTransferTx{
-		Id:          i.Id,
-		Sender:      i.Sender.String(),
-		DestAddress: i.DestAddress.GetAddress(),
-		Erc20Token:  i.Erc20Token.ToExternal(),
-		Erc20Fee:    i.Erc20Fee.ToExternal(),
+		Id:                i.Id,
+		Sender:            i.Sender.String(),
+		DestAddress:       i.DestAddress.GetAddress(),
+		Erc20Token:        i.Erc20Token.ToExternal(),
+		Erc20Fee:          i.Erc20Fee.ToExternal(),
+		NeedsConfirmation: i.NeedsConfirmation,
+		HeldUntil:         i.HeldUntil,
+		CreatedHeight:     i.CreatedHeight,
+		Priority:          i.Priority,
+		Payload:           i.Payload,
 	}
 }
 
+// IsHeld tells whether the tx is kept out of batches at height, either until its sender confirms it or
+// until its first send delay has passed
+func (i InternalOutgoingTransferTx) IsHeld(height uint64) bool {
+	return i.NeedsConfirmation || height < i.HeldUntil
+}
+
 func (i InternalOutgoingTransferTx) ValidateBasic() error {
 	//TODO: Validate id?
 	//TODO: Validate cosmos sender?
@@ -178,10 +208,26 @@ func (o OutgoingTxBatch) GetCheckpoint(gravityIDstring string) []byte {
 	return i.GetCheckpoint(gravityIDstring)
 }
 
-// GetCheckpoint gets the checkpoint signature from the given outgoing tx batch
+// HasPayloads tells whether any transaction of the batch carries a payload
+func (i InternalOutgoingTxBatch) HasPayloads() bool {
+	for _, tx := range i.Transactions {
+		if len(tx.Payload) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GetCheckpoint gets the checkpoint signature from the given outgoing tx batch, if any of its transactions
+// carries a payload the payloads are encoded after the fees, otherwise the legacy encoding is used
 func (i InternalOutgoingTxBatch) GetCheckpoint(gravityIDstring string) []byte {
 
-	abi, err := abi.JSON(strings.NewReader(OutgoingBatchTxCheckpointABIJSON))
+	withPayloads := i.HasPayloads()
+	abiJSON := OutgoingBatchTxCheckpointABIJSON
+	if withPayloads {
+		abiJSON = OutgoingBatchTxWithPayloadsCheckpointABIJSON
+	}
+	abi, err := abi.JSON(strings.NewReader(abiJSON))
 	if err != nil {
 		panic("Bad ABI constant!")
 	}
@@ -204,25 +250,45 @@ func (i InternalOutgoingTxBatch) GetCheckpoint(gravityIDstring string) []byte {
 	txAmounts := make([]*big.Int, len(i.Transactions))
 	txDestinations := make([]gethcommon.Address, len(i.Transactions))
 	txFees := make([]*big.Int, len(i.Transactions))
+	txPayloads := make([][]byte, len(i.Transactions))
 	for j, tx := range i.Transactions {
 		txAmounts[j] = tx.Erc20Token.Amount.BigInt()
 		txDestinations[j] = gethcommon.HexToAddress(tx.DestAddress.GetAddress())
 		txFees[j] = tx.Erc20Fee.Amount.BigInt()
+		txPayloads[j] = tx.Payload
+		if txPayloads[j] == nil {
+			txPayloads[j] = []byte{}
+		}
 	}
 
 	// the methodName needs to be the same as the 'name' above in the checkpointAbiJson
 	// but other than that it's a constant that has no impact on the output. This is because
 	// it gets encoded as a function name which we must then discard.
-	abiEncodedBatch, err := abi.Pack("submitBatch",
-		gravityID,
-		batchMethodName,
-		txAmounts,
-		txDestinations,
-		txFees,
-		big.NewInt(int64(i.BatchNonce)),
-		gethcommon.HexToAddress(i.TokenContract.GetAddress()),
-		big.NewInt(int64(i.BatchTimeout)),
-	)
+	var abiEncodedBatch []byte
+	if withPayloads {
+		abiEncodedBatch, err = abi.Pack("submitBatch",
+			gravityID,
+			batchMethodName,
+			txAmounts,
+			txDestinations,
+			txFees,
+			txPayloads,
+			big.NewInt(int64(i.BatchNonce)),
+			gethcommon.HexToAddress(i.TokenContract.GetAddress()),
+			big.NewInt(int64(i.BatchTimeout)),
+		)
+	} else {
+		abiEncodedBatch, err = abi.Pack("submitBatch",
+			gravityID,
+			batchMethodName,
+			txAmounts,
+			txDestinations,
+			txFees,
+			big.NewInt(int64(i.BatchNonce)),
+			gethcommon.HexToAddress(i.TokenContract.GetAddress()),
+			big.NewInt(int64(i.BatchTimeout)),
+		)
+	}
 
 	// this should never happen outside of test since any case that could crash on encoding
 	// should be filtered above.
@@ -301,3 +367,24 @@ func (c OutgoingLogicCall) GetCheckpoint(gravityIDstring string) []byte {
 
 	return crypto.Keccak256Hash(abiEncodedCall[4:]).Bytes()
 }
+
+// GetCallbackInvalidationID returns the invalidation id of the logic call delivering the transfer with a receiver
+// hook with the given id. Every such transfer gets an invalidation id of its own, executing one must not invalidate
+// the logic calls of any other
+func GetCallbackInvalidationID(txID uint64) []byte {
+	return crypto.Keccak256([]byte("sendToEthCallback"), UInt64Bytes(txID))
+}
+
+// EncodeCallbackPayload returns the calldata of onTokenTransfer(from, amount, data), the receiver hook the logic
+// call of a transfer with a callback invokes on its target after delivering the tokens to it
+func EncodeCallbackPayload(from EthAddress, amount sdk.Int, data []byte) []byte {
+	abi, err := abi.JSON(strings.NewReader(CallbackReceiverABIJSON))
+	if err != nil {
+		panic("Bad ABI constant!")
+	}
+	payload, err := abi.Pack("onTokenTransfer", gethcommon.HexToAddress(from.GetAddress()), amount.BigInt(), data)
+	if err != nil {
+		panic(fmt.Sprintf("Error packing callback payload! %s", err))
+	}
+	return payload
+}
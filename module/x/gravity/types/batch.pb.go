@@ -106,6 +106,20 @@ type OutgoingTransferTx struct {
 	DestAddress string      `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
 	Erc20Token  *ERC20Token `protobuf:"bytes,4,opt,name=erc20_token,json=erc20Token,proto3" json:"erc20_token,omitempty"`
 	Erc20Fee    *ERC20Token `protobuf:"bytes,5,opt,name=erc20_fee,json=erc20Fee,proto3" json:"erc20_fee,omitempty"`
+	// set when the fee dominates the transferred amount, the transfer is not
+	// batched until the sender releases it
+	NeedsConfirmation bool `protobuf:"varint,6,opt,name=needs_confirmation,json=needsConfirmation,proto3" json:"needs_confirmation,omitempty"`
+	// set when the sender requires a delay before sending to a new destination,
+	// the transfer is not batched before this block height
+	HeldUntil uint64 `protobuf:"varint,7,opt,name=held_until,json=heldUntil,proto3" json:"held_until,omitempty"`
+	// the block height the transfer entered the pool at, it is refunded once it
+	// waited for longer than the pool_tx_timeout param
+	CreatedHeight uint64 `protobuf:"varint,8,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// the priority class the sender paid for, batches take the transfers of a
+	// higher priority before those of a lower one regardless of their fees
+	Priority uint32 `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
+	// the data the sender handed to the destination, see MsgSendToEth
+	Payload []byte `protobuf:"bytes,10,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
 func (m *OutgoingTransferTx) Reset()         { *m = OutgoingTransferTx{} }
@@ -176,6 +190,104 @@ func (m *OutgoingTransferTx) GetErc20Fee() *ERC20Token {
 	return nil
 }
 
+func (m *OutgoingTransferTx) GetNeedsConfirmation() bool {
+	if m != nil {
+		return m.NeedsConfirmation
+	}
+	return false
+}
+
+func (m *OutgoingTransferTx) GetHeldUntil() uint64 {
+	if m != nil {
+		return m.HeldUntil
+	}
+	return 0
+}
+
+func (m *OutgoingTransferTx) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *OutgoingTransferTx) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *OutgoingTransferTx) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// MergedTransfer records the pool transactions to the same destination a
+// batch carries as the single transfer id, the id of the first of them. They
+// go back into the pool one by one if the batch does not execute
+type MergedTransfer struct {
+	BatchNonce uint64                `protobuf:"varint,1,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	Id         uint64                `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Children   []*OutgoingTransferTx `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`
+}
+
+func (m *MergedTransfer) Reset()         { *m = MergedTransfer{} }
+func (m *MergedTransfer) String() string { return proto.CompactTextString(m) }
+func (*MergedTransfer) ProtoMessage()    {}
+func (*MergedTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4453b445b0660cab, []int{2}
+}
+func (m *MergedTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergedTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergedTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergedTransfer.Merge(m, src)
+}
+func (m *MergedTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergedTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergedTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergedTransfer proto.InternalMessageInfo
+
+func (m *MergedTransfer) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *MergedTransfer) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MergedTransfer) GetChildren() []*OutgoingTransferTx {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
 // OutgoingLogicCall represents an individual logic call from gravity to ETH
 type OutgoingLogicCall struct {
 	Transfers            []*ERC20Token `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
@@ -192,7 +304,7 @@ func (m *OutgoingLogicCall) Reset()         { *m = OutgoingLogicCall{} }
 func (m *OutgoingLogicCall) String() string { return proto.CompactTextString(m) }
 func (*OutgoingLogicCall) ProtoMessage()    {}
 func (*OutgoingLogicCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4453b445b0660cab, []int{2}
+	return fileDescriptor_4453b445b0660cab, []int{3}
 }
 func (m *OutgoingLogicCall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,46 +392,55 @@ func (m *OutgoingLogicCall) GetBlock() uint64 {
 func init() {
 	proto.RegisterType((*OutgoingTxBatch)(nil), "gravity.v1.OutgoingTxBatch")
 	proto.RegisterType((*OutgoingTransferTx)(nil), "gravity.v1.OutgoingTransferTx")
+	proto.RegisterType((*MergedTransfer)(nil), "gravity.v1.MergedTransfer")
 	proto.RegisterType((*OutgoingLogicCall)(nil), "gravity.v1.OutgoingLogicCall")
 }
 
 func init() { proto.RegisterFile("gravity/v1/batch.proto", fileDescriptor_4453b445b0660cab) }
 
 var fileDescriptor_4453b445b0660cab = []byte{
-	// 522 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x51, 0x6b, 0x1a, 0x41,
-	0x10, 0xf6, 0x8c, 0x31, 0x71, 0x34, 0x86, 0x2c, 0x41, 0x8e, 0x52, 0xae, 0xd6, 0x52, 0x2a, 0x05,
-	0xbd, 0xc4, 0x04, 0xfa, 0x5c, 0xa5, 0x85, 0x42, 0x69, 0xe1, 0xf0, 0xa9, 0x14, 0x64, 0xbd, 0x1d,
-	0xcf, 0x25, 0xe7, 0xad, 0xdc, 0xae, 0xa2, 0xff, 0xa2, 0x3f, 0xab, 0x2f, 0x81, 0x3c, 0xe6, 0xb1,
-	0xe8, 0x1f, 0x29, 0x37, 0x77, 0x67, 0x4c, 0x4b, 0xf2, 0x76, 0xf3, 0xcd, 0x37, 0x33, 0xdf, 0xcc,
-	0x7e, 0x07, 0x8d, 0x20, 0xe6, 0x4b, 0x69, 0xd6, 0xee, 0xf2, 0xd2, 0x1d, 0x73, 0xe3, 0x4f, 0xbb,
-	0xf3, 0x58, 0x19, 0xc5, 0x20, 0xc3, 0xbb, 0xcb, 0xcb, 0x17, 0x2f, 0xf7, 0x38, 0xdc, 0x18, 0xd4,
-	0x86, 0x1b, 0xa9, 0xa2, 0x94, 0xd9, 0xba, 0xb7, 0xe0, 0xf4, 0xfb, 0xc2, 0x04, 0x4a, 0x46, 0xc1,
-	0x70, 0xd5, 0x4f, 0x7a, 0xb0, 0x57, 0x50, 0xa5, 0x66, 0xa3, 0x48, 0x45, 0x3e, 0xda, 0x56, 0xd3,
-	0x6a, 0x97, 0x3c, 0x20, 0xe8, 0x5b, 0x82, 0xb0, 0x37, 0x70, 0x92, 0x12, 0x8c, 0x9c, 0xa1, 0x5a,
-	0x18, 0xbb, 0x48, 0x94, 0x1a, 0x81, 0xc3, 0x14, 0x63, 0x7d, 0xa8, 0x99, 0x98, 0x47, 0x9a, 0xfb,
-	0xc9, 0x38, 0x6d, 0x1f, 0x34, 0x0f, 0xda, 0xd5, 0x9e, 0xd3, 0x7d, 0x90, 0xd6, 0xdd, 0x0d, 0x4e,
-	0x78, 0x13, 0x8c, 0x87, 0x2b, 0xef, 0x51, 0x0d, 0x7b, 0x0b, 0x75, 0xa3, 0x6e, 0x30, 0x1a, 0xf9,
-	0x2a, 0x32, 0x31, 0xf7, 0x8d, 0x5d, 0x6a, 0x5a, 0xed, 0x8a, 0x77, 0x42, 0xe8, 0x20, 0x03, 0xd9,
-	0x39, 0x1c, 0x8e, 0x43, 0xe5, 0xdf, 0xd8, 0x87, 0xa4, 0x23, 0x0d, 0x5a, 0xb7, 0x16, 0xb0, 0xff,
-	0x27, 0xb0, 0x3a, 0x14, 0xa5, 0xc8, 0x96, 0x2a, 0x4a, 0xc1, 0x1a, 0x50, 0xd6, 0x18, 0x09, 0x8c,
-	0x69, 0x8b, 0x8a, 0x97, 0x45, 0xec, 0x35, 0xd4, 0x04, 0x6a, 0x33, 0xe2, 0x42, 0xc4, 0xa8, 0x13,
-	0xfd, 0x49, 0xb6, 0x9a, 0x60, 0x1f, 0x53, 0x88, 0x7d, 0x80, 0x2a, 0xc6, 0x7e, 0xef, 0x62, 0x44,
-	0x72, 0x48, 0x5b, 0xb5, 0xd7, 0xd8, 0xdf, 0xf0, 0x93, 0x37, 0xe8, 0x5d, 0x0c, 0x93, 0xac, 0x07,
-	0x44, 0xa5, 0x6f, 0x76, 0x05, 0x95, 0xb4, 0x70, 0x82, 0x48, 0xa2, 0x9f, 0x2e, 0x3b, 0x26, 0xe2,
-	0x67, 0xc4, 0xd6, 0x6d, 0x11, 0xce, 0xf2, 0x7d, 0xbe, 0xaa, 0x40, 0xfa, 0x03, 0x1e, 0x86, 0xec,
-	0x1a, 0x2a, 0x26, 0x5b, 0x4e, 0xdb, 0x16, 0xdd, 0xf8, 0xa9, 0x56, 0x0f, 0x44, 0xf6, 0x1e, 0x4a,
-	0x13, 0x44, 0x6d, 0x17, 0x9f, 0x2d, 0x20, 0x0e, 0xbb, 0x86, 0x46, 0x98, 0x8c, 0xdb, 0x3d, 0xc2,
-	0x3f, 0x27, 0x39, 0xa7, 0x6c, 0xfe, 0x18, 0xf9, 0x6d, 0x6c, 0x38, 0x9a, 0xf3, 0x75, 0xa8, 0xb8,
-	0xa0, 0xbb, 0xd4, 0xbc, 0x3c, 0x4c, 0x32, 0xb9, 0x6f, 0xd2, 0xf7, 0xca, 0x43, 0xf6, 0x0e, 0x4e,
-	0x65, 0xb4, 0xe4, 0xa1, 0x14, 0x64, 0xd1, 0x91, 0x14, 0x76, 0x99, 0x6a, 0xeb, 0xfb, 0xf0, 0x17,
-	0xc1, 0x3a, 0xc0, 0x1e, 0x11, 0x53, 0xa3, 0x1e, 0x51, 0xb7, 0xb3, 0xfd, 0x4c, 0xea, 0xd7, 0x9d,
-	0x3f, 0x8e, 0xf7, 0xfc, 0xd1, 0xff, 0xf9, 0x7b, 0xe3, 0x58, 0x77, 0x1b, 0xc7, 0xfa, 0xb3, 0x71,
-	0xac, 0x5f, 0x5b, 0xa7, 0x70, 0xb7, 0x75, 0x0a, 0xf7, 0x5b, 0xa7, 0xf0, 0xa3, 0x1f, 0x48, 0x33,
-	0x5d, 0x8c, 0xbb, 0xbe, 0x9a, 0xb9, 0x3c, 0x34, 0x53, 0xe4, 0x9d, 0x08, 0x8d, 0xeb, 0x2b, 0x3d,
-	0x53, 0xba, 0x93, 0xdd, 0xaa, 0x33, 0x8e, 0xa5, 0x08, 0xd0, 0x9d, 0x29, 0xb1, 0x08, 0xd1, 0x5d,
-	0xb9, 0xf9, 0x7f, 0x66, 0xd6, 0x73, 0xd4, 0xe3, 0x32, 0xfd, 0x5f, 0x57, 0x7f, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0xb6, 0x22, 0x58, 0x81, 0xa3, 0x03, 0x00, 0x00,
+	// 643 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x6b, 0xdb, 0x4e,
+	0x10, 0x8d, 0x1c, 0x27, 0xb1, 0xc7, 0x7f, 0x42, 0x96, 0x60, 0x96, 0xf0, 0xfb, 0xb9, 0xae, 0x4b,
+	0xa9, 0x29, 0xd8, 0x4e, 0x9c, 0x40, 0xa1, 0xb7, 0xda, 0xb4, 0xb4, 0xd0, 0x3f, 0x20, 0xdc, 0x4b,
+	0x29, 0x98, 0xb5, 0x76, 0x2c, 0x2d, 0x91, 0xb5, 0x66, 0xb5, 0x36, 0xf1, 0xa1, 0xdf, 0xa1, 0x1f,
+	0xab, 0x97, 0x42, 0x8e, 0x39, 0x96, 0xe4, 0x4b, 0xf4, 0x58, 0x76, 0x25, 0x39, 0x4a, 0x0b, 0x69,
+	0x6f, 0x9a, 0x37, 0x6f, 0x35, 0x33, 0x6f, 0xde, 0x2e, 0x34, 0x7c, 0xc5, 0x56, 0x42, 0xaf, 0xfb,
+	0xab, 0x93, 0xfe, 0x94, 0x69, 0x2f, 0xe8, 0x2d, 0x94, 0xd4, 0x92, 0x40, 0x8a, 0xf7, 0x56, 0x27,
+	0x47, 0xff, 0xe5, 0x38, 0x4c, 0x6b, 0x8c, 0x35, 0xd3, 0x42, 0x46, 0x09, 0xb3, 0x7d, 0xe5, 0xc0,
+	0xfe, 0x87, 0xa5, 0xf6, 0xa5, 0x88, 0xfc, 0xf1, 0xc5, 0xd0, 0xfc, 0x83, 0x3c, 0x80, 0x8a, 0xfd,
+	0xd9, 0x24, 0x92, 0x91, 0x87, 0xd4, 0x69, 0x39, 0x9d, 0xa2, 0x0b, 0x16, 0x7a, 0x6f, 0x10, 0xf2,
+	0x08, 0x6a, 0x09, 0x41, 0x8b, 0x39, 0xca, 0xa5, 0xa6, 0x05, 0x4b, 0xa9, 0x5a, 0x70, 0x9c, 0x60,
+	0x64, 0x08, 0x55, 0xad, 0x58, 0x14, 0x33, 0xcf, 0x94, 0x8b, 0xe9, 0x76, 0x6b, 0xbb, 0x53, 0x19,
+	0x34, 0x7b, 0xb7, 0xad, 0xf5, 0x36, 0x85, 0x0d, 0x6f, 0x86, 0x6a, 0x7c, 0xe1, 0xde, 0x39, 0x43,
+	0x1e, 0x43, 0x5d, 0xcb, 0x73, 0x8c, 0x26, 0x9e, 0x8c, 0xb4, 0x62, 0x9e, 0xa6, 0xc5, 0x96, 0xd3,
+	0x29, 0xbb, 0x35, 0x8b, 0x8e, 0x52, 0x90, 0x1c, 0xc2, 0xce, 0x34, 0x94, 0xde, 0x39, 0xdd, 0xb1,
+	0x7d, 0x24, 0x41, 0xfb, 0x67, 0x01, 0xc8, 0x9f, 0x15, 0x48, 0x1d, 0x0a, 0x82, 0xa7, 0x43, 0x15,
+	0x04, 0x27, 0x0d, 0xd8, 0x8d, 0x31, 0xe2, 0xa8, 0xec, 0x14, 0x65, 0x37, 0x8d, 0xc8, 0x43, 0xa8,
+	0x72, 0x8c, 0xf5, 0x84, 0x71, 0xae, 0x30, 0x36, 0xfd, 0x9b, 0x6c, 0xc5, 0x60, 0x2f, 0x12, 0x88,
+	0x3c, 0x83, 0x0a, 0x2a, 0x6f, 0x70, 0x3c, 0xb1, 0xed, 0xd8, 0xde, 0x2a, 0x83, 0x46, 0x7e, 0xc2,
+	0x97, 0xee, 0x68, 0x70, 0x3c, 0x36, 0x59, 0x17, 0x2c, 0xd5, 0x7e, 0x93, 0x53, 0x28, 0x27, 0x07,
+	0x67, 0x88, 0x74, 0xe7, 0xde, 0x63, 0x25, 0x4b, 0x7c, 0x85, 0x48, 0xba, 0x40, 0x22, 0x44, 0x1e,
+	0x1b, 0x31, 0x66, 0x42, 0xcd, 0xed, 0x1a, 0xe9, 0x6e, 0xcb, 0xe9, 0x94, 0xdc, 0x03, 0x9b, 0x19,
+	0xe5, 0x12, 0xe4, 0x7f, 0x80, 0x00, 0x43, 0x3e, 0x59, 0x46, 0x5a, 0x84, 0x74, 0xcf, 0xce, 0x5b,
+	0x36, 0xc8, 0x47, 0x03, 0x18, 0x69, 0x3d, 0x85, 0x4c, 0x23, 0x9f, 0x04, 0x28, 0xfc, 0x40, 0xd3,
+	0x92, 0xa5, 0xd4, 0x52, 0xf4, 0xb5, 0x05, 0xc9, 0x11, 0x94, 0x16, 0x4a, 0x48, 0x25, 0xf4, 0x9a,
+	0x96, 0x5b, 0x4e, 0xa7, 0xe6, 0x6e, 0x62, 0x42, 0x61, 0x6f, 0xc1, 0xd6, 0xa1, 0x64, 0x9c, 0x42,
+	0xcb, 0xe9, 0x54, 0xdd, 0x2c, 0x6c, 0x7f, 0x81, 0xfa, 0x3b, 0x54, 0x3e, 0xf2, 0x4c, 0xf7, 0xbf,
+	0x7b, 0x2a, 0x59, 0x4b, 0x61, 0xb3, 0x96, 0xe7, 0x50, 0xf2, 0x02, 0x11, 0x72, 0x85, 0xd1, 0x3f,
+	0x5a, 0x67, 0xc3, 0x6f, 0x7f, 0x2f, 0xc0, 0x41, 0x46, 0x78, 0x2b, 0x7d, 0xe1, 0x8d, 0x58, 0x18,
+	0x92, 0x33, 0x28, 0xeb, 0x94, 0x1d, 0x53, 0xa7, 0xb5, 0x7d, 0x8f, 0xe8, 0xb7, 0x44, 0xf2, 0x14,
+	0x8a, 0x33, 0xc4, 0x98, 0x16, 0xee, 0x3d, 0x60, 0x39, 0xe4, 0x0c, 0x1a, 0xa1, 0x29, 0xb7, 0xb1,
+	0xeb, 0x6f, 0xe6, 0x39, 0xb4, 0xd9, 0xcc, 0xb6, 0x99, 0x8b, 0x72, 0x32, 0x16, 0xef, 0xc8, 0x68,
+	0x32, 0xd9, 0x0d, 0x4b, 0x9c, 0x9d, 0x85, 0xe4, 0x09, 0xec, 0x8b, 0x68, 0xc5, 0x42, 0xc1, 0xed,
+	0xb2, 0x27, 0x82, 0x5b, 0x23, 0x54, 0xdd, 0x7a, 0x1e, 0x7e, 0xc3, 0x8d, 0x69, 0xee, 0x10, 0x13,
+	0xf9, 0x13, 0x37, 0x1c, 0xe4, 0x33, 0xc9, 0x16, 0x36, 0x37, 0xa9, 0x94, 0xbb, 0x49, 0xc3, 0xcf,
+	0xdf, 0xae, 0x9b, 0xce, 0xe5, 0x75, 0xd3, 0xf9, 0x71, 0xdd, 0x74, 0xbe, 0xde, 0x34, 0xb7, 0x2e,
+	0x6f, 0x9a, 0x5b, 0x57, 0x37, 0xcd, 0xad, 0x4f, 0x43, 0x5f, 0xe8, 0x60, 0x39, 0xed, 0x79, 0x72,
+	0xde, 0x67, 0xa1, 0x0e, 0x90, 0x75, 0x23, 0xd4, 0x7d, 0x4f, 0xc6, 0x73, 0x19, 0x77, 0x53, 0xad,
+	0xba, 0x53, 0x25, 0xb8, 0x8f, 0xfd, 0xb9, 0xe4, 0xcb, 0x10, 0xfb, 0x17, 0xfd, 0xec, 0x45, 0xd2,
+	0xeb, 0x05, 0xc6, 0xd3, 0x5d, 0xfb, 0x12, 0x9d, 0xfe, 0x1a, 0x00, 0x75, 0xec, 0x93, 0xe4, 0xcd,
+	0x04, 0x00, 0x00,
 }
 
 func (m *OutgoingTxBatch) Marshal() (dAtA []byte, err error) {
@@ -401,6 +522,38 @@ func (m *OutgoingTransferTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Priority != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HeldUntil != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.HeldUntil))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NeedsConfirmation {
+		i--
+		if m.NeedsConfirmation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Erc20Fee != nil {
 		{
 			size, err := m.Erc20Fee.MarshalToSizedBuffer(dAtA[:i])
@@ -447,6 +600,53 @@ func (m *OutgoingTransferTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MergedTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergedTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergedTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Children) > 0 {
+		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Children[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBatch(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OutgoingLogicCall) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -598,6 +798,43 @@ func (m *OutgoingTransferTx) Size() (n int) {
 		l = m.Erc20Fee.Size()
 		n += 1 + l + sovBatch(uint64(l))
 	}
+	if m.NeedsConfirmation {
+		n += 2
+	}
+	if m.HeldUntil != 0 {
+		n += 1 + sovBatch(uint64(m.HeldUntil))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovBatch(uint64(m.CreatedHeight))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovBatch(uint64(m.Priority))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+
+func (m *MergedTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovBatch(uint64(m.BatchNonce))
+	}
+	if m.Id != 0 {
+		n += 1 + sovBatch(uint64(m.Id))
+	}
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.Size()
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1009,6 +1246,242 @@ func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NeedsConfirmation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NeedsConfirmation = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldUntil", wireType)
+			}
+			m.HeldUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeldUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergedTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergedTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, &OutgoingTransferTx{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBatch(dAtA[iNdEx:])
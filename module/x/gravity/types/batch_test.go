@@ -2,9 +2,13 @@ package types
 
 import (
 	"encoding/hex"
+	"math/big"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -53,6 +57,67 @@ func TestOutgoingTxBatchCheckpointGold1(t *testing.T) {
 	assert.Equal(t, goldHash, hex.EncodeToString(ourHash))
 }
 
+// Tests that payloads are only encoded into the checkpoint of a batch that carries any, and then as a bytes[]
+// after the fees
+//nolint: exhaustivestruct
+func TestOutgoingTxBatchCheckpointWithPayloads(t *testing.T) {
+	senderAddr, err := sdk.AccAddressFromHex("527FBEE652609AB150F0AEE9D61A2F76CFC4A73E")
+	require.NoError(t, err)
+	erc20Address, err := NewEthAddress("0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4")
+	require.NoError(t, err)
+	destAddress, err := NewEthAddress("0x9FC9C2DfBA3b6cF204C37a5F690619772b926e39")
+	require.NoError(t, err)
+	tx := func(id uint64, payload []byte) *OutgoingTransferTx {
+		return &OutgoingTransferTx{
+			Id:          id,
+			Sender:      senderAddr.String(),
+			DestAddress: destAddress.GetAddress(),
+			Erc20Token:  &ERC20Token{Amount: sdk.NewInt(0x1), Contract: erc20Address.GetAddress()},
+			Erc20Fee:    &ERC20Token{Amount: sdk.NewInt(0x1), Contract: erc20Address.GetAddress()},
+			Payload:     payload,
+		}
+	}
+	src := OutgoingTxBatch{
+		BatchNonce:    1,
+		BatchTimeout:  2111,
+		Transactions:  []*OutgoingTransferTx{tx(1, []byte{})},
+		TokenContract: erc20Address.GetAddress(),
+	}
+
+	// an empty payload keeps the legacy checkpoint of TestOutgoingTxBatchCheckpointGold1
+	goldHash := "a3a7ee0a363b8ad2514e7ee8f110d7449c0d88f3b0913c28c1751e6e0079a9b2"
+	assert.Equal(t, goldHash, hex.EncodeToString(src.GetCheckpoint("foo")))
+
+	// the same as abi.encode(gravityId, "transactionBatch", amounts, destinations, fees, payloads, nonce, token, timeout)
+	src.Transactions = append(src.Transactions, tx(2, []byte{0xca, 0xfe}))
+	newType := func(name string) abi.Type {
+		typ, err := abi.NewType(name, "", nil)
+		if err != nil {
+			panic(err)
+		}
+		return typ
+	}
+	args := abi.Arguments{}
+	for _, typ := range []string{"bytes32", "bytes32", "uint256[]", "address[]", "uint256[]", "bytes[]", "uint256", "address", "uint256"} {
+		args = append(args, abi.Argument{Type: newType(typ)})
+	}
+	var gravityID, methodName [32]byte
+	copy(gravityID[:], "foo")
+	copy(methodName[:], "transactionBatch")
+	dest := gethcommon.HexToAddress(destAddress.GetAddress())
+	encoded, err := args.Pack(gravityID, methodName,
+		[]*big.Int{big.NewInt(1), big.NewInt(1)},
+		[]gethcommon.Address{dest, dest},
+		[]*big.Int{big.NewInt(1), big.NewInt(1)},
+		[][]byte{{}, {0xca, 0xfe}},
+		big.NewInt(1),
+		gethcommon.HexToAddress(erc20Address.GetAddress()),
+		big.NewInt(2111),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.Keccak256(encoded), src.GetCheckpoint("foo"))
+}
+
 //nolint: exhaustivestruct
 func TestOutgoingLogicCallCheckpointGold1(t *testing.T) {
 	payload, err := hex.DecodeString("0x74657374696e675061796c6f6164000000000000000000000000000000000000"[2:])
@@ -83,3 +148,23 @@ func TestOutgoingLogicCallCheckpointGold1(t *testing.T) {
 	// a different hash.
 	assert.Equal(t, goldHash, hex.EncodeToString(ourHash))
 }
+
+func TestEncodeCallbackPayload(t *testing.T) {
+	from, err := NewEthAddress("0x17c1736CcF692F653c433d7aa2aB45148C016F68")
+	require.NoError(t, err)
+
+	payload := EncodeCallbackPayload(*from, sdk.NewInt(1), []byte{0xab})
+
+	// the ERC677 onTokenTransfer(address,uint256,bytes) selector followed by the abi encoded arguments
+	expected := "a4c0ed36" +
+		"00000000000000000000000017c1736ccf692f653c433d7aa2ab45148c016f68" +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"0000000000000000000000000000000000000000000000000000000000000060" +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"ab00000000000000000000000000000000000000000000000000000000000000"
+	assert.Equal(t, expected, hex.EncodeToString(payload))
+
+	// every transfer gets an invalidation id of its own
+	assert.Len(t, GetCallbackInvalidationID(1), 32)
+	assert.NotEqual(t, GetCallbackInvalidationID(1), GetCallbackInvalidationID(2))
+}
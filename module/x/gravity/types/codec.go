@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // ModuleCdc is the codec for the module
@@ -30,7 +31,18 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgLogicCallExecutedClaim{},
 		&MsgValsetUpdatedClaim{},
 		&MsgCancelSendToEth{},
+		&MsgCancelAllSendToEth{},
+		&MsgReleaseSendToEth{},
+		&MsgBumpSendToEthFee{},
 		&MsgSubmitBadSignatureEvidence{},
+		&MsgOrchestratorHeartbeat{},
+		&MsgSetEthDestinationLabel{},
+		&MsgSetFirstSendDelay{},
+		&MsgMigrationCompletedClaim{},
+		&MsgSubmitConfirms{},
+		&MsgSubmitConflictingClaimEvidence{},
+		&MsgSubmitEthereumEvents{},
+		&MsgFundValsetReward{},
 	)
 
 	registry.RegisterInterface(
@@ -41,8 +53,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgERC20DeployedClaim{},
 		&MsgLogicCallExecutedClaim{},
 		&MsgValsetUpdatedClaim{},
+		&MsgMigrationCompletedClaim{},
 	)
 
+	registry.RegisterImplementations((*govtypes.Content)(nil), &BridgeMigrationProposal{}, &AttestationVetoProposal{}, &EvacuatePoolProposal{}, &CancelOutgoingBatchProposal{}, &ModuleSendGrantProposal{}, &BridgeInstanceResetProposal{}, &ResolveEventNonceProposal{}, &ReleaseQuarantinedDepositProposal{})
+
 	registry.RegisterInterface("gravity.v1beta1.EthereumSigned", (*EthereumSigned)(nil), &Valset{}, &OutgoingTxBatch{}, &OutgoingLogicCall{})
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -66,9 +81,28 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgValsetUpdatedClaim{}, "gravity/MsgValsetUpdatedClaim", nil)
 	cdc.RegisterConcrete(&OutgoingTxBatch{}, "gravity/OutgoingTxBatch", nil)
 	cdc.RegisterConcrete(&MsgCancelSendToEth{}, "gravity/MsgCancelSendToEth", nil)
+	cdc.RegisterConcrete(&MsgCancelAllSendToEth{}, "gravity/MsgCancelAllSendToEth", nil)
+	cdc.RegisterConcrete(&MsgReleaseSendToEth{}, "gravity/MsgReleaseSendToEth", nil)
+	cdc.RegisterConcrete(&MsgBumpSendToEthFee{}, "gravity/MsgBumpSendToEthFee", nil)
 	cdc.RegisterConcrete(&OutgoingTransferTx{}, "gravity/OutgoingTransferTx", nil)
 	cdc.RegisterConcrete(&ERC20Token{}, "gravity/ERC20Token", nil)
 	cdc.RegisterConcrete(&IDSet{}, "gravity/IDSet", nil)
 	cdc.RegisterConcrete(&Attestation{}, "gravity/Attestation", nil)
 	cdc.RegisterConcrete(&MsgSubmitBadSignatureEvidence{}, "gravity/MsgSubmitBadSignatureEvidence", nil)
+	cdc.RegisterConcrete(&MsgOrchestratorHeartbeat{}, "gravity/MsgOrchestratorHeartbeat", nil)
+	cdc.RegisterConcrete(&MsgSetEthDestinationLabel{}, "gravity/MsgSetEthDestinationLabel", nil)
+	cdc.RegisterConcrete(&MsgSetFirstSendDelay{}, "gravity/MsgSetFirstSendDelay", nil)
+	cdc.RegisterConcrete(&MsgMigrationCompletedClaim{}, "gravity/MsgMigrationCompletedClaim", nil)
+	cdc.RegisterConcrete(&MsgSubmitConfirms{}, "gravity/MsgSubmitConfirms", nil)
+	cdc.RegisterConcrete(&MsgSubmitConflictingClaimEvidence{}, "gravity/MsgSubmitConflictingClaimEvidence", nil)
+	cdc.RegisterConcrete(&MsgSubmitEthereumEvents{}, "gravity/MsgSubmitEthereumEvents", nil)
+	cdc.RegisterConcrete(&MsgFundValsetReward{}, "gravity/MsgFundValsetReward", nil)
+	cdc.RegisterConcrete(&BridgeMigrationProposal{}, "gravity/BridgeMigrationProposal", nil)
+	cdc.RegisterConcrete(&AttestationVetoProposal{}, "gravity/AttestationVetoProposal", nil)
+	cdc.RegisterConcrete(&EvacuatePoolProposal{}, "gravity/EvacuatePoolProposal", nil)
+	cdc.RegisterConcrete(&CancelOutgoingBatchProposal{}, "gravity/CancelOutgoingBatchProposal", nil)
+	cdc.RegisterConcrete(&ModuleSendGrantProposal{}, "gravity/ModuleSendGrantProposal", nil)
+	cdc.RegisterConcrete(&BridgeInstanceResetProposal{}, "gravity/BridgeInstanceResetProposal", nil)
+	cdc.RegisterConcrete(&ResolveEventNonceProposal{}, "gravity/ResolveEventNonceProposal", nil)
+	cdc.RegisterConcrete(&ReleaseQuarantinedDepositProposal{}, "gravity/ReleaseQuarantinedDepositProposal", nil)
 }
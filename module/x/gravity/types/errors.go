@@ -16,4 +16,13 @@ var (
 	ErrNonContiguousEventNonce = sdkerrors.Register(ModuleName, 9, "non contiguous event nonce")
 	ErrResetDelegateKeys       = sdkerrors.Register(ModuleName, 10, "can not set orchestrator addresses more than once")
 	ErrMismatched              = sdkerrors.Register(ModuleName, 11, "mismatched")
+	ErrBridgeMigrating         = sdkerrors.Register(ModuleName, 12, "bridge contract migration in progress")
+	ErrAttestationVetoed       = sdkerrors.Register(ModuleName, 13, "attestation vetoed by governance")
+	ErrOutflowLimitExceeded    = sdkerrors.Register(ModuleName, 14, "outflow limit exceeded")
+	ErrModuleSendGrantExceeded = sdkerrors.Register(ModuleName, 15, "module send grant exceeded")
+	ErrBridgeInstanceReplay    = sdkerrors.Register(ModuleName, 16, "produced for a previous bridge instance")
+	ErrBatchNotProfitable      = sdkerrors.Register(ModuleName, 17, "batch not profitable")
+	ErrClaimTooOld             = sdkerrors.Register(ModuleName, 18, "claim too far behind the last observed event")
+	ErrAlreadySubmitted        = sdkerrors.Register(ModuleName, 19, "already submitted")
+	ErrTooManyPendingTxs       = sdkerrors.Register(ModuleName, 20, "too many pending transfers")
 )
@@ -76,6 +76,14 @@ func ValidateEthAddress(address string) error {
 	return nil
 }
 
+// ValidateEthTxHash checks that hash is a 0x prefixed 32 byte hex Ethereum transaction hash
+func ValidateEthTxHash(hash string) error {
+	if !regexp.MustCompile("^0x[0-9a-fA-F]{64}$").MatchString(hash) {
+		return fmt.Errorf("tx hash(%s) doesn't pass regex", hash)
+	}
+	return nil
+}
+
 // Performs validation on the wrapped string
 func (ea EthAddress) ValidateBasic() error {
 	return ValidateEthAddress(ea.address)
@@ -53,7 +53,7 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/ethereum_signer.proto", fileDescriptor_005a3d0c6f36c26c) }
 
 var fileDescriptor_005a3d0c6f36c26c = []byte{
-	// 280 bytes of a gzipped FileDescriptorProto
+	// 277 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x2f, 0x4a, 0x2c,
 	0xcb, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0xc9, 0x48, 0x2d, 0x4a, 0x2d, 0xcd, 0x8d,
 	0x2f, 0xce, 0x4c, 0xcf, 0x4b, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x82, 0xaa,
@@ -70,6 +70,6 @@ var fileDescriptor_005a3d0c6f36c26c = []byte{
 	0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x62, 0x4e, 0x49, 0x46, 0x6a, 0xa2, 0x6e,
 	0x5e, 0x6a, 0x89, 0x7e, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0xb1, 0x2e, 0xd4, 0xc3, 0xba, 0x49, 0x45,
 	0x99, 0x29, 0xe9, 0xa9, 0xfa, 0xb9, 0xf9, 0x29, 0xa5, 0x39, 0xa9, 0xfa, 0x15, 0xfa, 0xb0, 0xa0,
-	0x2a, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xde, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0x5a, 0x4c, 0xd8, 0xbf, 0x42, 0x01, 0x00, 0x00,
+	0x2a, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xde, 0x18, 0x30, 0x00, 0x5a, 0x4c, 0xd8,
+	0xbf, 0x42, 0x01, 0x00, 0x00,
 }
@@ -1,5 +1,12 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// EventVersion is the version of the typed events in events.proto, every transaction and EndBlock emits it in
+// the event_version attribute of a gravity event. It is bumped whenever a typed event changes in a way a
+// subscriber has to handle, fields that are only added do not bump it
+const EventVersion = "1"
+
 const (
 	EventTypeObservation               = "observation"
 	EventTypeOutgoingBatch             = "outgoing_batch"
@@ -9,22 +16,79 @@ const (
 	EventTypeBridgeWithdrawalReceived  = "withdrawal_received"
 	EventTypeBridgeDepositReceived     = "deposit_received"
 	EventTypeBridgeWithdrawCanceled    = "withdraw_canceled"
+	EventTypeBridgeMigrationStarted    = "bridge_migration_started"
+	EventTypeBridgeMigrationValset     = "bridge_migration_valset"
+	EventTypeBridgeMigrationCompleted  = "bridge_migration_completed"
+	EventTypeAttestationVetoed         = "attestation_vetoed"
+	EventTypeRelayerLotteryWon         = "relayer_lottery_won"
+	EventTypeBridgeWithdrawalHeld      = "withdrawal_needs_confirmation"
+	EventTypeBridgeWithdrawalReleased  = "withdrawal_released"
+	EventTypeBridgePoolEvacuated       = "pool_evacuated"
+	EventTypeBatchTimeoutTxRequeued    = "batch_timeout_tx_requeued"
+	EventTypeModuleSendGrantUpdated    = "module_send_grant_updated"
+	EventTypeBridgeInstanceReset       = "bridge_instance_reset"
+	EventTypeBridgeWithdrawalDelayed   = "withdrawal_delayed_new_destination"
+	EventTypeBridgeWithdrawalFeeBumped = "withdrawal_fee_bumped"
+	EventTypeBridgeWithdrawalExpired   = "withdrawal_expired"
+	EventTypeBridgeWithdrawalCallback  = "withdrawal_callback"
+	EventTypeBatchTransfersMerged      = "batch_transfers_merged"
+	EventTypeEventNonceResolved        = "event_nonce_resolved"
+	EventTypeBridgeDepositQuarantined  = "deposit_quarantined"
+	EventTypeBridgeDepositReleased     = "deposit_released"
+	EventTypeValsetRewardFunded        = "valset_reward_funded"
+	EventTypeValsetRewardShortfall     = "valset_reward_shortfall"
 
-	AttributeKeyAttestationID          = "attestation_id"
-	AttributeKeyBatchConfirmKey        = "batch_confirm_key"
-	AttributeKeyValsetConfirmKey       = "valset_confirm_key"
-	AttributeKeyMultisigID             = "multisig_id"
-	AttributeKeyOutgoingBatchID        = "batch_id"
-	AttributeKeyOutgoingTXID           = "outgoing_tx_id"
-	AttributeKeyAttestationType        = "attestation_type"
-	AttributeKeyContract               = "bridge_contract"
-	AttributeKeyNonce                  = "nonce"
-	AttributeKeyValsetNonce            = "valset_nonce"
-	AttributeKeyBatchNonce             = "batch_nonce"
-	AttributeKeyBridgeChainID          = "bridge_chain_id"
-	AttributeKeySetOperatorAddr        = "set_operator_address"
-	AttributeKeyInvalidationID         = "logic_call_invalidation_id"
-	AttributeKeyInvalidationNonce      = "logic_call_invalidation_nonce"
-	AttributeKeyBadEthSignature        = "bad_eth_signature"
-	AttributeKeyBadEthSignatureSubject = "bad_eth_signature_subject"
+	AttributeKeyAttestationID           = "attestation_id"
+	AttributeKeyBatchConfirmKey         = "batch_confirm_key"
+	AttributeKeyValsetConfirmKey        = "valset_confirm_key"
+	AttributeKeyMultisigID              = "multisig_id"
+	AttributeKeyOutgoingBatchID         = "batch_id"
+	AttributeKeyOutgoingTXID            = "outgoing_tx_id"
+	AttributeKeyAttestationType         = "attestation_type"
+	AttributeKeyContract                = "bridge_contract"
+	AttributeKeyNonce                   = "nonce"
+	AttributeKeyValsetNonce             = "valset_nonce"
+	AttributeKeyBatchNonce              = "batch_nonce"
+	AttributeKeyBridgeChainID           = "bridge_chain_id"
+	AttributeKeySetOperatorAddr         = "set_operator_address"
+	AttributeKeyInvalidationID          = "logic_call_invalidation_id"
+	AttributeKeyInvalidationNonce       = "logic_call_invalidation_nonce"
+	AttributeKeyBadEthSignature         = "bad_eth_signature"
+	AttributeKeyBadEthSignatureSubject  = "bad_eth_signature_subject"
+	AttributeKeyHeartbeatEthHeight      = "heartbeat_eth_block_height"
+	AttributeKeyHeartbeatVersion        = "heartbeat_version"
+	AttributeKeyHeartbeatEthGasPrice    = "heartbeat_eth_gas_price"
+	AttributeKeyHeartbeatEthBaseFee     = "heartbeat_eth_base_fee"
+	AttributeKeyHeartbeatEthPriorityFee = "heartbeat_eth_priority_fee"
+	AttributeKeyNewContract             = "new_bridge_contract"
+	AttributeKeyEthBlockTimestamp       = "eth_block_timestamp"
+	AttributeKeyRelayer                 = "relayer"
+	AttributeKeyRewardReceiver          = "reward_receiver"
+	AttributeKeyRewardAmount            = "reward_amount"
+	AttributeKeyCanceledBatches         = "canceled_batches"
+	AttributeKeyRefundedTxs             = "refunded_txs"
+	AttributeKeyBatchTimeout            = "batch_timeout"
+	AttributeKeyGrantModule             = "grant_module"
+	AttributeKeyGrantCap                = "grant_cap"
+	AttributeKeyGrantEpochBlocks        = "grant_epoch_blocks"
+	AttributeKeyBridgeInstanceID        = "bridge_instance_id"
+	AttributeKeyEthDestinationLabel     = "eth_destination_label"
+	AttributeKeyEthDestination          = "eth_destination"
+	AttributeKeyFirstSendDelay          = "first_send_delay"
+	AttributeKeyHeldUntil               = "held_until"
+	AttributeKeyBridgeFee               = "bridge_fee"
+	AttributeKeyCreatedHeight           = "created_height"
+	AttributeKeyCallbackTarget          = "callback_target"
+	AttributeKeyLogicCallTimeout        = "logic_call_timeout"
+	AttributeKeyAcceptedConfirms        = "accepted_confirms"
+	AttributeKeyMergedTxIDs             = "merged_tx_ids"
+	AttributeKeyEthereumSender          = "ethereum_sender"
+	AttributeKeyDepositReceiver         = "deposit_receiver"
+	AttributeKeyRewardShortfall         = "reward_shortfall"
+	AttributeKeyEventVersion            = "event_version"
 )
+
+// NewEventVersionEvent returns the gravity event announcing the EventVersion of the typed events emitted with it
+func NewEventVersionEvent() sdk.Event {
+	return sdk.NewEvent(ModuleName, sdk.NewAttribute(AttributeKeyEventVersion, EventVersion))
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -34,8 +35,27 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	GetDenomMetaData(ctx sdk.Context, denom string) bank.Metadata
+	GetSupply(ctx sdk.Context) bankexported.SupplyI
 }
 
 type SlashingKeeper interface {
 	GetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) (info slashingtypes.ValidatorSigningInfo, found bool)
 }
+
+// WeiPriceDenom is the denom a PriceFeed is asked for the USD price of a single wei of ETH, the relay cost of
+// batches is valued with it
+const WeiPriceDenom = "wei"
+
+// PriceFeed provides the USD prices the global outflow limit and the relay cost of batches are computed with
+type PriceFeed interface {
+	// GetUSDPrice returns the USD price of a single base unit of denom, ok is false if there is no price
+	GetUSDPrice(ctx sdk.Context, denom string) (price sdk.Dec, ok bool)
+}
+
+// BatchProfitability decides whether a batch is worth building, replacing the check against the estimated
+// relay gas the module does by default
+type BatchProfitability interface {
+	// CheckBatchProfitable returns an error if a batch of tokenContract paying fees to its relayer should not be
+	// built yet
+	CheckBatchProfitable(ctx sdk.Context, tokenContract EthAddress, fees BatchFees) error
+}
@@ -2,12 +2,15 @@ package types
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
+	"math"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
 // DefaultParamspace defines the default auth module parameter subspace
@@ -20,6 +23,15 @@ var (
 	// AttestationVotesPowerThreshold threshold of votes power to succeed
 	AttestationVotesPowerThreshold = sdk.NewInt(66)
 
+	// MinEthereumPowerThreshold is 2/3 of the normalized (u32 max) bridge power, the
+	// Ethereum power threshold param may never be set below this value
+	MinEthereumPowerThreshold uint64 = math.MaxUint32 / 3 * 2
+
+	// LegacyEthereumPowerThreshold is the threshold the Gravity contract has historically
+	// hardcoded, it is equal to MinEthereumPowerThreshold. Valsets without a power threshold
+	// are checkpointed with the legacy encoding and checked against this threshold
+	LegacyEthereumPowerThreshold uint64 = 2863311530
+
 	// ParamsStoreKeyGravityID stores the gravity id
 	ParamsStoreKeyGravityID = []byte("GravityID")
 
@@ -66,6 +78,147 @@ var (
 	// to a relayer when they relay a valset
 	ParamStoreValsetRewardAmount = []byte("ValsetReward")
 
+	// ParamStoreEthereumPowerThreshold stores the power threshold encoded into valset checkpoints
+	ParamStoreEthereumPowerThreshold = []byte("EthereumPowerThreshold")
+
+	// ParamStoreEthereumTimeoutMargin stores the timeout margin in Ethereum blocks
+	ParamStoreEthereumTimeoutMargin = []byte("EthereumTimeoutMargin")
+
+	// ParamStoreEthereumBlockTimeCalibrationPeriod stores the number of blocks between Ethereum block time recalibrations
+	ParamStoreEthereumBlockTimeCalibrationPeriod = []byte("EthereumBlockTimeCalibrationPeriod")
+
+	// ParamStoreAttestationFastPathThreshold stores the vote percentage that observes attestations on submission
+	ParamStoreAttestationFastPathThreshold = []byte("AttestationFastPathThreshold")
+
+	// ParamStoreAttestationExecutionBudget stores the number of observed attestations applied per block
+	ParamStoreAttestationExecutionBudget = []byte("AttestationExecutionBudget")
+
+	// ParamStoreValsetCreationGasBudget stores the gas budget of valset creation in the EndBlocker
+	ParamStoreValsetCreationGasBudget = []byte("ValsetCreationGasBudget")
+
+	// ParamStoreSlashingGasBudget stores the gas budget of the slashing scans in the EndBlocker
+	ParamStoreSlashingGasBudget = []byte("SlashingGasBudget")
+
+	// ParamStorePruningGasBudget stores the gas budget of pruning in the EndBlocker
+	ParamStorePruningGasBudget = []byte("PruningGasBudget")
+
+	// ParamStoreAttestationGasBudget stores the gas budget of attestation handling in the EndBlocker
+	ParamStoreAttestationGasBudget = []byte("AttestationGasBudget")
+
+	// ParamStoreRelayerLotteryReward stores the reward of the relayer lottery
+	ParamStoreRelayerLotteryReward = []byte("RelayerLotteryReward")
+
+	// ParamStoreRelayerLotteryWindow stores the number of blocks of relay activity the relayer lottery counts
+	ParamStoreRelayerLotteryWindow = []byte("RelayerLotteryWindow")
+
+	// ParamStoreFeeConfirmationMultiple stores the fee to amount ratio above which a transfer needs confirmation
+	ParamStoreFeeConfirmationMultiple = []byte("FeeConfirmationMultiple")
+
+	// ParamStoreOutflowUsdLimit stores the USD value that may leave the bridge per outflow limit window
+	ParamStoreOutflowUsdLimit = []byte("OutflowUsdLimit")
+
+	// ParamStoreOutflowLimitWindow stores the number of blocks the outflow usd limit applies to
+	ParamStoreOutflowLimitWindow = []byte("OutflowLimitWindow")
+
+	// ParamStoreStallRefundThreshold stores the number of blocks without orchestrator heartbeats after which the pool is refunded
+	ParamStoreStallRefundThreshold = []byte("StallRefundThreshold")
+
+	// ParamStoreStallRefundBudget stores the number of pool transfers refunded per block while the bridge is stalled
+	ParamStoreStallRefundBudget = []byte("StallRefundBudget")
+
+	// ParamStoreRefundReceiptRetention stores the number of blocks refund receipts are kept for
+	ParamStoreRefundReceiptRetention = []byte("RefundReceiptRetention")
+
+	// ParamStorePoolTxTimeout stores the number of blocks a transfer may wait in the pool before it is refunded
+	ParamStorePoolTxTimeout = []byte("PoolTxTimeout")
+
+	// ParamStoreMaxCallbackDataSize stores the maximum size of the callback data of a transfer with a receiver hook
+	ParamStoreMaxCallbackDataSize = []byte("MaxCallbackDataSize")
+
+	// ParamStoreCallbackDataByteFee stores the fee charged per byte of callback data
+	ParamStoreCallbackDataByteFee = []byte("CallbackDataByteFee")
+
+	// ParamStoreBatchGasPerTx stores the Ethereum gas estimated for every transfer in a batch
+	ParamStoreBatchGasPerTx = []byte("BatchGasPerTx")
+
+	// ParamStoreBatchGasOverhead stores the Ethereum gas estimated for relaying a batch on top of its transfers
+	ParamStoreBatchGasOverhead = []byte("BatchGasOverhead")
+
+	// ParamStoreDepositReceiptRetention stores the number of blocks deposit receipts are kept for
+	ParamStoreDepositReceiptRetention = []byte("DepositReceiptRetention")
+
+	// ParamStoreMinSendToEthFees stores the minimum fee of transfers to Ethereum per token contract
+	ParamStoreMinSendToEthFees = []byte("MinSendToEthFees")
+
+	// ParamStoreMaxBatchSize stores the most transactions a batch may carry
+	ParamStoreMaxBatchSize = []byte("MaxBatchSize")
+
+	// ParamStoreTokenMaxBatchSizes stores the per token overrides of MaxBatchSize
+	ParamStoreTokenMaxBatchSizes = []byte("TokenMaxBatchSizes")
+
+	// ParamStoreMaxClaimAge stores the most event nonces a claim may be behind the last observed event nonce
+	ParamStoreMaxClaimAge = []byte("MaxClaimAge")
+
+	// ParamStoreRebuildTimedOutBatches stores whether a batch is rebuilt for a token when one of its batches times out
+	ParamStoreRebuildTimedOutBatches = []byte("RebuildTimedOutBatches")
+
+	// ParamStoreSendToEthPriorityFees stores the fees of the priority classes of transfers to Ethereum
+	ParamStoreSendToEthPriorityFees = []byte("SendToEthPriorityFees")
+
+	// ParamStoreMergeBatchTransfers stores whether the transactions of a batch to the same destination are merged
+	ParamStoreMergeBatchTransfers = []byte("MergeBatchTransfers")
+
+	// ParamStorePoolAgingBlocks stores the number of blocks in the pool that raise the priority of a transfer by one
+	ParamStorePoolAgingBlocks = []byte("PoolAgingBlocks")
+
+	// ParamStoreMaxSendToEthPayloadSize stores the maximum size of the payload of a transfer to Ethereum
+	ParamStoreMaxSendToEthPayloadSize = []byte("MaxSendToEthPayloadSize")
+
+	// ParamStoreMaxPendingTxsPerSender stores the number of transfers a sender may have waiting in the pool at once
+	ParamStoreMaxPendingTxsPerSender = []byte("MaxPendingTxsPerSender")
+
+	// ParamsStoreKeySignedClaimsWindow stores the blocks a validator has to submit a claim for an observed event
+	ParamsStoreKeySignedClaimsWindow = []byte("SignedClaimsWindow")
+
+	// ParamsStoreSlashFractionClaim stores the slash fraction for not submitting a claim for an observed event
+	ParamsStoreSlashFractionClaim = []byte("SlashFractionClaim")
+
+	// ParamsStoreSlashFractionConflictingClaim stores the slash fraction for signing two claims for one event nonce
+	ParamsStoreSlashFractionConflictingClaim = []byte("SlashFractionConflictingClaim")
+
+	// ParamStoreAttestationRetention stores the number of blocks attestations are kept for after observation
+	ParamStoreAttestationRetention = []byte("AttestationRetention")
+
+	// ParamStoreEthBlocksToObserve stores the Ethereum confirmation depth orchestrators wait for before claiming
+	ParamStoreEthBlocksToObserve = []byte("EthBlocksToObserve")
+
+	// ParamStoreQuarantinedEthSenders stores the Ethereum senders whose deposits are held in escrow
+	ParamStoreQuarantinedEthSenders = []byte("QuarantinedEthSenders")
+
+	// ParamStoreEthereumHeightDriftThreshold stores how far behind the median Ethereum height a validator may report
+	ParamStoreEthereumHeightDriftThreshold = []byte("EthereumHeightDriftThreshold")
+
+	// ParamStoreValsetPowerChangeThreshold stores the power change that requests a new valset
+	ParamStoreValsetPowerChangeThreshold = []byte("ValsetPowerChangeThreshold")
+
+	// ParamStoreMaxValsetInterval stores the number of blocks after which a new valset is requested regardless
+	ParamStoreMaxValsetInterval = []byte("MaxValsetInterval")
+
+	// ParamStoreValsetRetention stores the number of valsets kept below the last observed one
+	ParamStoreValsetRetention = []byte("ValsetRetention")
+
+	// ParamStoreTokenSignedBatchesWindows stores the per token overrides of SignedBatchesWindow
+	ParamStoreTokenSignedBatchesWindows = []byte("TokenSignedBatchesWindows")
+
+	// ParamStoreEventNonceStallThreshold stores the number of blocks the next event nonce may stay unobserved for
+	ParamStoreEventNonceStallThreshold = []byte("EventNonceStallThreshold")
+
+	// ParamStoreMinimalEvents stores whether the untyped events duplicating a typed event are left out
+	ParamStoreMinimalEvents = []byte("MinimalEvents")
+
+	// ParamStoreFeeExemptSenders stores the Cosmos addresses exempt from the fees charged on transfers to Ethereum
+	ParamStoreFeeExemptSenders = []byte("FeeExemptSenders")
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{
 		GravityId:                    "",
@@ -87,6 +240,53 @@ var (
 			Denom:  "",
 			Amount: sdk.Int{},
 		},
+		EthereumPowerThreshold:             0,
+		EthereumTimeoutMargin:              0,
+		EthereumBlockTimeCalibrationPeriod: 0,
+		AttestationFastPathThreshold:       0,
+		AttestationExecutionBudget:         0,
+		ValsetCreationGasBudget:            0,
+		SlashingGasBudget:                  0,
+		PruningGasBudget:                   0,
+		AttestationGasBudget:               0,
+		RelayerLotteryReward:               sdk.Coin{Denom: "", Amount: sdk.Int{}},
+		RelayerLotteryWindow:               0,
+		FeeConfirmationMultiple:            0,
+		OutflowUsdLimit:                    sdk.Dec{},
+		OutflowLimitWindow:                 0,
+		StallRefundThreshold:               0,
+		StallRefundBudget:                  0,
+		RefundReceiptRetention:             0,
+		PoolTxTimeout:                      0,
+		MaxCallbackDataSize:                0,
+		CallbackDataByteFee:                sdk.Coin{Denom: "", Amount: sdk.Int{}},
+		BatchGasPerTx:                      0,
+		BatchGasOverhead:                   0,
+		DepositReceiptRetention:            0,
+		MinSendToEthFees:                   []ERC20Token{},
+		MaxBatchSize:                       0,
+		TokenMaxBatchSizes:                 []TokenBatchSize{},
+		MaxClaimAge:                        0,
+		RebuildTimedOutBatches:             false,
+		SendToEthPriorityFees:              []sdk.Coin{},
+		MergeBatchTransfers:                false,
+		PoolAgingBlocks:                    0,
+		MaxSendToEthPayloadSize:            0,
+		MaxPendingTxsPerSender:             0,
+		SignedClaimsWindow:                 0,
+		SlashFractionClaim:                 sdk.Dec{},
+		SlashFractionConflictingClaim:      sdk.Dec{},
+		AttestationRetention:               0,
+		EthBlocksToObserve:                 0,
+		QuarantinedEthSenders:              []string{},
+		EthereumHeightDriftThreshold:       0,
+		ValsetPowerChangeThreshold:         sdk.Dec{},
+		MaxValsetInterval:                  0,
+		ValsetRetention:                    0,
+		TokenSignedBatchesWindows:          []TokenSignedBatchesWindow{},
+		EventNonceStallThreshold:           0,
+		MinimalEvents:                      false,
+		FeeExemptSenders:                   []string{},
 	}
 )
 
@@ -96,6 +296,90 @@ func (s GenesisState) ValidateBasic() error {
 	if err := s.Params.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "params")
 	}
+	for _, grant := range s.ModuleSendGrants {
+		if grant.Module == "" || grant.Module == ModuleName {
+			return sdkerrors.Wrapf(ErrInvalid, "module send grant module %q", grant.Module)
+		}
+		if !grant.Cap.IsValid() || grant.Cap.Empty() || grant.EpochBlocks == 0 {
+			return sdkerrors.Wrapf(ErrInvalid, "module send grant of %s", grant.Module)
+		}
+	}
+	for _, entry := range s.EthDestinationLabels {
+		if _, err := sdk.AccAddressFromBech32(entry.Owner); err != nil {
+			return sdkerrors.Wrapf(ErrInvalid, "eth destination label owner %q", entry.Owner)
+		}
+		if err := ValidateEthDestinationLabel(entry.Label); err != nil {
+			return err
+		}
+		if err := ValidateEthAddress(entry.EthAddress); err != nil {
+			return sdkerrors.Wrapf(err, "eth destination label %s of %s", entry.Label, entry.Owner)
+		}
+	}
+	for _, entry := range s.FirstSendDelays {
+		if _, err := sdk.AccAddressFromBech32(entry.Account); err != nil {
+			return sdkerrors.Wrapf(ErrInvalid, "first send delay account %q", entry.Account)
+		}
+		if entry.DelayBlocks == 0 || entry.DelayBlocks > MaxFirstSendDelay {
+			return sdkerrors.Wrapf(ErrInvalid, "first send delay of %d blocks for %s", entry.DelayBlocks, entry.Account)
+		}
+		for _, dest := range entry.KnownEthDestinations {
+			if err := ValidateEthAddress(dest); err != nil {
+				return sdkerrors.Wrapf(err, "known eth destination of %s", entry.Account)
+			}
+		}
+	}
+	auditLogIDs := make(map[uint64]bool, len(s.AuditLog))
+	for _, entry := range s.AuditLog {
+		if entry.Id == 0 || auditLogIDs[entry.Id] {
+			return sdkerrors.Wrapf(ErrInvalid, "audit log entry id %d", entry.Id)
+		}
+		auditLogIDs[entry.Id] = true
+	}
+	for _, tx := range s.CallbackTransfers {
+		if _, err := tx.ToInternal(); err != nil {
+			return sdkerrors.Wrapf(err, "callback transfer %d", tx.Id)
+		}
+	}
+	for _, merged := range s.MergedTransfers {
+		if len(merged.Children) < 2 {
+			return sdkerrors.Wrapf(ErrInvalid, "merged transfer %d of batch %d has %d children", merged.Id, merged.BatchNonce, len(merged.Children))
+		}
+		for _, tx := range merged.Children {
+			if _, err := tx.ToInternal(); err != nil {
+				return sdkerrors.Wrapf(err, "child %d of merged transfer %d", tx.Id, merged.Id)
+			}
+		}
+	}
+	quarantinedNonces := make(map[uint64]bool, len(s.QuarantinedDeposits))
+	for _, deposit := range s.QuarantinedDeposits {
+		if deposit.EventNonce == 0 || quarantinedNonces[deposit.EventNonce] {
+			return sdkerrors.Wrapf(ErrInvalid, "quarantined deposit event nonce %d", deposit.EventNonce)
+		}
+		quarantinedNonces[deposit.EventNonce] = true
+		if _, err := sdk.AccAddressFromBech32(deposit.CosmosReceiver); err != nil {
+			return sdkerrors.Wrapf(err, "receiver of quarantined deposit %d", deposit.EventNonce)
+		}
+		if !deposit.Amount.IsValid() {
+			return sdkerrors.Wrapf(ErrInvalid, "amount of quarantined deposit %d", deposit.EventNonce)
+		}
+	}
+	if err := s.ValsetRewardReserve.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "valset reward reserve")
+	}
+	for _, reserve := range s.ValsetRewardReserve {
+		if _, err := GravityDenomToERC20(reserve.Denom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalid, "valset reward reserve of %s is not an Ethereum originated voucher", reserve.Denom)
+		}
+	}
+	if s.BridgeInstance != nil {
+		if id, err := hex.DecodeString(s.BridgeInstance.Id); err != nil || len(id) != tmhash.Size {
+			return sdkerrors.Wrapf(ErrInvalid, "bridge instance id %q", s.BridgeInstance.Id)
+		}
+		if s.BridgeInstance.StartEventNonce > s.LastObservedNonce {
+			return sdkerrors.Wrapf(ErrInvalid, "bridge instance started after event nonce %d, last observed is %d",
+				s.BridgeInstance.StartEventNonce, s.LastObservedNonce)
+		}
+	}
 	return nil
 }
 
@@ -103,40 +387,96 @@ func (s GenesisState) ValidateBasic() error {
 // TODO: set some better defaults here
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:             DefaultParams(),
-		LastObservedNonce:  0,
-		Valsets:            []*Valset{},
-		ValsetConfirms:     []*MsgValsetConfirm{},
-		Batches:            []*OutgoingTxBatch{},
-		BatchConfirms:      []MsgConfirmBatch{},
-		LogicCalls:         []*OutgoingLogicCall{},
-		LogicCallConfirms:  []MsgConfirmLogicCall{},
-		Attestations:       []Attestation{},
-		DelegateKeys:       []*MsgSetOrchestratorAddress{},
-		Erc20ToDenoms:      []*ERC20ToDenom{},
-		UnbatchedTransfers: []*OutgoingTransferTx{},
+		Params:               DefaultParams(),
+		LastObservedNonce:    0,
+		Valsets:              []*Valset{},
+		ValsetConfirms:       []*MsgValsetConfirm{},
+		Batches:              []*OutgoingTxBatch{},
+		BatchConfirms:        []MsgConfirmBatch{},
+		LogicCalls:           []*OutgoingLogicCall{},
+		LogicCallConfirms:    []MsgConfirmLogicCall{},
+		Attestations:         []Attestation{},
+		DelegateKeys:         []*MsgSetOrchestratorAddress{},
+		Erc20ToDenoms:        []*ERC20ToDenom{},
+		UnbatchedTransfers:   []*OutgoingTransferTx{},
+		ModuleSendGrants:     []ModuleSendGrant{},
+		BridgeInstance:       nil,
+		EthDestinationLabels: []EthDestinationLabel{},
+		FirstSendDelays:      []FirstSendDelay{},
+		AuditLog:             []AuditLogEntry{},
+		CallbackTransfers:    []*OutgoingTransferTx{},
+		MergedTransfers:      []MergedTransfer{},
+		QuarantinedDeposits:  []DepositReceipt{},
+		ValsetRewardReserve:  sdk.Coins{},
 	}
 }
 
 // DefaultParams returns a copy of the default params
 func DefaultParams() *Params {
 	return &Params{
-		GravityId:                    "defaultgravityid",
-		ContractSourceHash:           "",
-		BridgeEthereumAddress:        "0x0000000000000000000000000000000000000000",
-		BridgeChainId:                0,
-		SignedValsetsWindow:          10000,
-		SignedBatchesWindow:          10000,
-		SignedLogicCallsWindow:       10000,
-		TargetBatchTimeout:           43200000,
-		AverageBlockTime:             5000,
-		AverageEthereumBlockTime:     15000,
-		SlashFractionValset:          sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionBatch:           sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionLogicCall:       sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		UnbondSlashingValsetsWindow:  10000,
-		SlashFractionBadEthSignature: sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		ValsetReward:                 sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		GravityId:                          "defaultgravityid",
+		ContractSourceHash:                 "",
+		BridgeEthereumAddress:              "0x0000000000000000000000000000000000000000",
+		BridgeChainId:                      0,
+		SignedValsetsWindow:                10000,
+		SignedBatchesWindow:                10000,
+		SignedLogicCallsWindow:             10000,
+		TargetBatchTimeout:                 43200000,
+		AverageBlockTime:                   5000,
+		AverageEthereumBlockTime:           15000,
+		SlashFractionValset:                sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionBatch:                 sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionLogicCall:             sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		UnbondSlashingValsetsWindow:        10000,
+		SlashFractionBadEthSignature:       sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		ValsetReward:                       sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		EthereumPowerThreshold:             0,
+		EthereumTimeoutMargin:              2880,
+		EthereumBlockTimeCalibrationPeriod: 17280,
+		AttestationFastPathThreshold:       0,
+		AttestationExecutionBudget:         100,
+		ValsetCreationGasBudget:            10000000,
+		SlashingGasBudget:                  50000000,
+		PruningGasBudget:                   20000000,
+		AttestationGasBudget:               50000000,
+		RelayerLotteryReward:               sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		RelayerLotteryWindow:               17280,
+		FeeConfirmationMultiple:            0,
+		OutflowUsdLimit:                    sdk.ZeroDec(),
+		OutflowLimitWindow:                 17280,
+		StallRefundThreshold:               0,
+		StallRefundBudget:                  100,
+		RefundReceiptRetention:             432000,
+		PoolTxTimeout:                      120960,
+		MaxCallbackDataSize:                1024,
+		CallbackDataByteFee:                sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		BatchGasPerTx:                      60000,
+		BatchGasOverhead:                   300000,
+		DepositReceiptRetention:            432000,
+		MinSendToEthFees:                   []ERC20Token{},
+		MaxBatchSize:                       100,
+		TokenMaxBatchSizes:                 []TokenBatchSize{},
+		MaxClaimAge:                        1000,
+		RebuildTimedOutBatches:             true,
+		SendToEthPriorityFees:              []sdk.Coin{},
+		MergeBatchTransfers:                false,
+		PoolAgingBlocks:                    0,
+		MaxSendToEthPayloadSize:            0,
+		MaxPendingTxsPerSender:             0,
+		SignedClaimsWindow:                 0,
+		SlashFractionClaim:                 sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionConflictingClaim:      sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		AttestationRetention:               17280,
+		EthBlocksToObserve:                 6,
+		QuarantinedEthSenders:              []string{},
+		EthereumHeightDriftThreshold:       100,
+		ValsetPowerChangeThreshold:         sdk.NewDecWithPrec(5, 2),
+		MaxValsetInterval:                  120960,
+		ValsetRetention:                    1000,
+		TokenSignedBatchesWindows:          []TokenSignedBatchesWindow{},
+		EventNonceStallThreshold:           600,
+		MinimalEvents:                      false,
+		FeeExemptSenders:                   []string{},
 	}
 }
 
@@ -190,6 +530,147 @@ func (p Params) ValidateBasic() error {
 	if err := validateValsetRewardAmount(p.ValsetReward); err != nil {
 		return sdkerrors.Wrap(err, "ValsetReward amount")
 	}
+	if err := validateEthereumPowerThreshold(p.EthereumPowerThreshold); err != nil {
+		return sdkerrors.Wrap(err, "ethereum power threshold")
+	}
+	if err := validateEthereumTimeoutMargin(p.EthereumTimeoutMargin); err != nil {
+		return sdkerrors.Wrap(err, "ethereum timeout margin")
+	}
+	if err := validateEthereumBlockTimeCalibrationPeriod(p.EthereumBlockTimeCalibrationPeriod); err != nil {
+		return sdkerrors.Wrap(err, "ethereum block time calibration period")
+	}
+	if err := validateAttestationFastPathThreshold(p.AttestationFastPathThreshold); err != nil {
+		return sdkerrors.Wrap(err, "attestation fast path threshold")
+	}
+	if err := validateAttestationExecutionBudget(p.AttestationExecutionBudget); err != nil {
+		return sdkerrors.Wrap(err, "attestation execution budget")
+	}
+	if err := validateValsetCreationGasBudget(p.ValsetCreationGasBudget); err != nil {
+		return sdkerrors.Wrap(err, "valset creation gas budget")
+	}
+	if err := validateSlashingGasBudget(p.SlashingGasBudget); err != nil {
+		return sdkerrors.Wrap(err, "slashing gas budget")
+	}
+	if err := validatePruningGasBudget(p.PruningGasBudget); err != nil {
+		return sdkerrors.Wrap(err, "pruning gas budget")
+	}
+	if err := validateAttestationGasBudget(p.AttestationGasBudget); err != nil {
+		return sdkerrors.Wrap(err, "attestation gas budget")
+	}
+	if err := validateRelayerLotteryReward(p.RelayerLotteryReward); err != nil {
+		return sdkerrors.Wrap(err, "relayer lottery reward")
+	}
+	if err := validateRelayerLotteryWindow(p.RelayerLotteryWindow); err != nil {
+		return sdkerrors.Wrap(err, "relayer lottery window")
+	}
+	if err := validateFeeConfirmationMultiple(p.FeeConfirmationMultiple); err != nil {
+		return sdkerrors.Wrap(err, "fee confirmation multiple")
+	}
+	if err := validateOutflowUsdLimit(p.OutflowUsdLimit); err != nil {
+		return sdkerrors.Wrap(err, "outflow usd limit")
+	}
+	if err := validateOutflowLimitWindow(p.OutflowLimitWindow); err != nil {
+		return sdkerrors.Wrap(err, "outflow limit window")
+	}
+	if err := validateStallRefundThreshold(p.StallRefundThreshold); err != nil {
+		return sdkerrors.Wrap(err, "stall refund threshold")
+	}
+	if err := validateStallRefundBudget(p.StallRefundBudget); err != nil {
+		return sdkerrors.Wrap(err, "stall refund budget")
+	}
+	if err := validateRefundReceiptRetention(p.RefundReceiptRetention); err != nil {
+		return sdkerrors.Wrap(err, "refund receipt retention")
+	}
+	if err := validatePoolTxTimeout(p.PoolTxTimeout); err != nil {
+		return sdkerrors.Wrap(err, "pool tx timeout")
+	}
+	if err := validateMaxCallbackDataSize(p.MaxCallbackDataSize); err != nil {
+		return sdkerrors.Wrap(err, "max callback data size")
+	}
+	if err := validateCallbackDataByteFee(p.CallbackDataByteFee); err != nil {
+		return sdkerrors.Wrap(err, "callback data byte fee")
+	}
+	if err := validateBatchGasPerTx(p.BatchGasPerTx); err != nil {
+		return sdkerrors.Wrap(err, "batch gas per tx")
+	}
+	if err := validateBatchGasOverhead(p.BatchGasOverhead); err != nil {
+		return sdkerrors.Wrap(err, "batch gas overhead")
+	}
+	if err := validateDepositReceiptRetention(p.DepositReceiptRetention); err != nil {
+		return sdkerrors.Wrap(err, "deposit receipt retention")
+	}
+	if err := validateMinSendToEthFees(p.MinSendToEthFees); err != nil {
+		return sdkerrors.Wrap(err, "min send to eth fees")
+	}
+	if err := validateMaxBatchSize(p.MaxBatchSize); err != nil {
+		return sdkerrors.Wrap(err, "max batch size")
+	}
+	if err := validateTokenMaxBatchSizes(p.TokenMaxBatchSizes); err != nil {
+		return sdkerrors.Wrap(err, "token max batch sizes")
+	}
+	if err := validateMaxClaimAge(p.MaxClaimAge); err != nil {
+		return sdkerrors.Wrap(err, "max claim age")
+	}
+	if err := validateRebuildTimedOutBatches(p.RebuildTimedOutBatches); err != nil {
+		return sdkerrors.Wrap(err, "rebuild timed out batches")
+	}
+	if err := validateSendToEthPriorityFees(p.SendToEthPriorityFees); err != nil {
+		return sdkerrors.Wrap(err, "send to eth priority fees")
+	}
+	if err := validateMergeBatchTransfers(p.MergeBatchTransfers); err != nil {
+		return sdkerrors.Wrap(err, "merge batch transfers")
+	}
+	if err := validatePoolAgingBlocks(p.PoolAgingBlocks); err != nil {
+		return sdkerrors.Wrap(err, "pool aging blocks")
+	}
+	if err := validateMaxSendToEthPayloadSize(p.MaxSendToEthPayloadSize); err != nil {
+		return sdkerrors.Wrap(err, "max send to eth payload size")
+	}
+	if err := validateMaxPendingTxsPerSender(p.MaxPendingTxsPerSender); err != nil {
+		return sdkerrors.Wrap(err, "max pending txs per sender")
+	}
+	if err := validateSignedClaimsWindow(p.SignedClaimsWindow); err != nil {
+		return sdkerrors.Wrap(err, "signed blocks window claims")
+	}
+	if err := validateSlashFractionClaim(p.SlashFractionClaim); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction claim")
+	}
+	if err := validateSlashFractionConflictingClaim(p.SlashFractionConflictingClaim); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction conflicting claim")
+	}
+	if err := validateAttestationRetention(p.AttestationRetention); err != nil {
+		return sdkerrors.Wrap(err, "attestation retention")
+	}
+	if err := validateEthBlocksToObserve(p.EthBlocksToObserve); err != nil {
+		return sdkerrors.Wrap(err, "eth blocks to observe")
+	}
+	if err := validateQuarantinedEthSenders(p.QuarantinedEthSenders); err != nil {
+		return sdkerrors.Wrap(err, "quarantined eth senders")
+	}
+	if err := validateEthereumHeightDriftThreshold(p.EthereumHeightDriftThreshold); err != nil {
+		return sdkerrors.Wrap(err, "ethereum height drift threshold")
+	}
+	if err := validateValsetPowerChangeThreshold(p.ValsetPowerChangeThreshold); err != nil {
+		return sdkerrors.Wrap(err, "valset power change threshold")
+	}
+	if err := validateMaxValsetInterval(p.MaxValsetInterval); err != nil {
+		return sdkerrors.Wrap(err, "max valset interval")
+	}
+	if err := validateValsetRetention(p.ValsetRetention); err != nil {
+		return sdkerrors.Wrap(err, "valset retention")
+	}
+	if err := validateTokenSignedBatchesWindows(p.TokenSignedBatchesWindows); err != nil {
+		return sdkerrors.Wrap(err, "token signed batches windows")
+	}
+	if err := validateEventNonceStallThreshold(p.EventNonceStallThreshold); err != nil {
+		return sdkerrors.Wrap(err, "event nonce stall threshold")
+	}
+	if err := validateMinimalEvents(p.MinimalEvents); err != nil {
+		return sdkerrors.Wrap(err, "minimal events")
+	}
+	if err := validateFeeExemptSenders(p.FeeExemptSenders); err != nil {
+		return sdkerrors.Wrap(err, "fee exempt senders")
+	}
 
 	return nil
 }
@@ -216,6 +697,53 @@ func ParamKeyTable() paramtypes.KeyTable {
 			Denom:  "",
 			Amount: sdk.Int{},
 		},
+		EthereumPowerThreshold:             0,
+		EthereumTimeoutMargin:              0,
+		EthereumBlockTimeCalibrationPeriod: 0,
+		AttestationFastPathThreshold:       0,
+		AttestationExecutionBudget:         0,
+		ValsetCreationGasBudget:            0,
+		SlashingGasBudget:                  0,
+		PruningGasBudget:                   0,
+		AttestationGasBudget:               0,
+		RelayerLotteryReward:               sdk.Coin{Denom: "", Amount: sdk.Int{}},
+		RelayerLotteryWindow:               0,
+		FeeConfirmationMultiple:            0,
+		OutflowUsdLimit:                    sdk.Dec{},
+		OutflowLimitWindow:                 0,
+		StallRefundThreshold:               0,
+		StallRefundBudget:                  0,
+		RefundReceiptRetention:             0,
+		PoolTxTimeout:                      0,
+		MaxCallbackDataSize:                0,
+		CallbackDataByteFee:                sdk.Coin{Denom: "", Amount: sdk.Int{}},
+		BatchGasPerTx:                      0,
+		BatchGasOverhead:                   0,
+		DepositReceiptRetention:            0,
+		MinSendToEthFees:                   []ERC20Token{},
+		MaxBatchSize:                       0,
+		TokenMaxBatchSizes:                 []TokenBatchSize{},
+		MaxClaimAge:                        0,
+		RebuildTimedOutBatches:             false,
+		SendToEthPriorityFees:              []sdk.Coin{},
+		MergeBatchTransfers:                false,
+		PoolAgingBlocks:                    0,
+		MaxSendToEthPayloadSize:            0,
+		MaxPendingTxsPerSender:             0,
+		SignedClaimsWindow:                 0,
+		SlashFractionClaim:                 sdk.Dec{},
+		SlashFractionConflictingClaim:      sdk.Dec{},
+		AttestationRetention:               0,
+		EthBlocksToObserve:                 0,
+		QuarantinedEthSenders:              []string{},
+		EthereumHeightDriftThreshold:       0,
+		ValsetPowerChangeThreshold:         sdk.Dec{},
+		MaxValsetInterval:                  0,
+		ValsetRetention:                    0,
+		TokenSignedBatchesWindows:          []TokenSignedBatchesWindow{},
+		EventNonceStallThreshold:           0,
+		MinimalEvents:                      false,
+		FeeExemptSenders:                   []string{},
 	})
 }
 
@@ -238,6 +766,53 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreUnbondSlashingValsetsWindow, &p.UnbondSlashingValsetsWindow, validateUnbondSlashingValsetsWindow),
 		paramtypes.NewParamSetPair(ParamStoreSlashFractionBadEthSignature, &p.SlashFractionBadEthSignature, validateSlashFractionBadEthSignature),
 		paramtypes.NewParamSetPair(ParamStoreValsetRewardAmount, &p.ValsetReward, validateValsetRewardAmount),
+		paramtypes.NewParamSetPair(ParamStoreEthereumPowerThreshold, &p.EthereumPowerThreshold, validateEthereumPowerThreshold),
+		paramtypes.NewParamSetPair(ParamStoreEthereumTimeoutMargin, &p.EthereumTimeoutMargin, validateEthereumTimeoutMargin),
+		paramtypes.NewParamSetPair(ParamStoreEthereumBlockTimeCalibrationPeriod, &p.EthereumBlockTimeCalibrationPeriod, validateEthereumBlockTimeCalibrationPeriod),
+		paramtypes.NewParamSetPair(ParamStoreAttestationFastPathThreshold, &p.AttestationFastPathThreshold, validateAttestationFastPathThreshold),
+		paramtypes.NewParamSetPair(ParamStoreAttestationExecutionBudget, &p.AttestationExecutionBudget, validateAttestationExecutionBudget),
+		paramtypes.NewParamSetPair(ParamStoreValsetCreationGasBudget, &p.ValsetCreationGasBudget, validateValsetCreationGasBudget),
+		paramtypes.NewParamSetPair(ParamStoreSlashingGasBudget, &p.SlashingGasBudget, validateSlashingGasBudget),
+		paramtypes.NewParamSetPair(ParamStorePruningGasBudget, &p.PruningGasBudget, validatePruningGasBudget),
+		paramtypes.NewParamSetPair(ParamStoreAttestationGasBudget, &p.AttestationGasBudget, validateAttestationGasBudget),
+		paramtypes.NewParamSetPair(ParamStoreRelayerLotteryReward, &p.RelayerLotteryReward, validateRelayerLotteryReward),
+		paramtypes.NewParamSetPair(ParamStoreRelayerLotteryWindow, &p.RelayerLotteryWindow, validateRelayerLotteryWindow),
+		paramtypes.NewParamSetPair(ParamStoreFeeConfirmationMultiple, &p.FeeConfirmationMultiple, validateFeeConfirmationMultiple),
+		paramtypes.NewParamSetPair(ParamStoreOutflowUsdLimit, &p.OutflowUsdLimit, validateOutflowUsdLimit),
+		paramtypes.NewParamSetPair(ParamStoreOutflowLimitWindow, &p.OutflowLimitWindow, validateOutflowLimitWindow),
+		paramtypes.NewParamSetPair(ParamStoreStallRefundThreshold, &p.StallRefundThreshold, validateStallRefundThreshold),
+		paramtypes.NewParamSetPair(ParamStoreStallRefundBudget, &p.StallRefundBudget, validateStallRefundBudget),
+		paramtypes.NewParamSetPair(ParamStoreRefundReceiptRetention, &p.RefundReceiptRetention, validateRefundReceiptRetention),
+		paramtypes.NewParamSetPair(ParamStorePoolTxTimeout, &p.PoolTxTimeout, validatePoolTxTimeout),
+		paramtypes.NewParamSetPair(ParamStoreMaxCallbackDataSize, &p.MaxCallbackDataSize, validateMaxCallbackDataSize),
+		paramtypes.NewParamSetPair(ParamStoreCallbackDataByteFee, &p.CallbackDataByteFee, validateCallbackDataByteFee),
+		paramtypes.NewParamSetPair(ParamStoreBatchGasPerTx, &p.BatchGasPerTx, validateBatchGasPerTx),
+		paramtypes.NewParamSetPair(ParamStoreBatchGasOverhead, &p.BatchGasOverhead, validateBatchGasOverhead),
+		paramtypes.NewParamSetPair(ParamStoreDepositReceiptRetention, &p.DepositReceiptRetention, validateDepositReceiptRetention),
+		paramtypes.NewParamSetPair(ParamStoreMinSendToEthFees, &p.MinSendToEthFees, validateMinSendToEthFees),
+		paramtypes.NewParamSetPair(ParamStoreMaxBatchSize, &p.MaxBatchSize, validateMaxBatchSize),
+		paramtypes.NewParamSetPair(ParamStoreTokenMaxBatchSizes, &p.TokenMaxBatchSizes, validateTokenMaxBatchSizes),
+		paramtypes.NewParamSetPair(ParamStoreMaxClaimAge, &p.MaxClaimAge, validateMaxClaimAge),
+		paramtypes.NewParamSetPair(ParamStoreRebuildTimedOutBatches, &p.RebuildTimedOutBatches, validateRebuildTimedOutBatches),
+		paramtypes.NewParamSetPair(ParamStoreSendToEthPriorityFees, &p.SendToEthPriorityFees, validateSendToEthPriorityFees),
+		paramtypes.NewParamSetPair(ParamStoreMergeBatchTransfers, &p.MergeBatchTransfers, validateMergeBatchTransfers),
+		paramtypes.NewParamSetPair(ParamStorePoolAgingBlocks, &p.PoolAgingBlocks, validatePoolAgingBlocks),
+		paramtypes.NewParamSetPair(ParamStoreMaxSendToEthPayloadSize, &p.MaxSendToEthPayloadSize, validateMaxSendToEthPayloadSize),
+		paramtypes.NewParamSetPair(ParamStoreMaxPendingTxsPerSender, &p.MaxPendingTxsPerSender, validateMaxPendingTxsPerSender),
+		paramtypes.NewParamSetPair(ParamsStoreKeySignedClaimsWindow, &p.SignedClaimsWindow, validateSignedClaimsWindow),
+		paramtypes.NewParamSetPair(ParamsStoreSlashFractionClaim, &p.SlashFractionClaim, validateSlashFractionClaim),
+		paramtypes.NewParamSetPair(ParamsStoreSlashFractionConflictingClaim, &p.SlashFractionConflictingClaim, validateSlashFractionConflictingClaim),
+		paramtypes.NewParamSetPair(ParamStoreAttestationRetention, &p.AttestationRetention, validateAttestationRetention),
+		paramtypes.NewParamSetPair(ParamStoreEthBlocksToObserve, &p.EthBlocksToObserve, validateEthBlocksToObserve),
+		paramtypes.NewParamSetPair(ParamStoreQuarantinedEthSenders, &p.QuarantinedEthSenders, validateQuarantinedEthSenders),
+		paramtypes.NewParamSetPair(ParamStoreEthereumHeightDriftThreshold, &p.EthereumHeightDriftThreshold, validateEthereumHeightDriftThreshold),
+		paramtypes.NewParamSetPair(ParamStoreValsetPowerChangeThreshold, &p.ValsetPowerChangeThreshold, validateValsetPowerChangeThreshold),
+		paramtypes.NewParamSetPair(ParamStoreMaxValsetInterval, &p.MaxValsetInterval, validateMaxValsetInterval),
+		paramtypes.NewParamSetPair(ParamStoreValsetRetention, &p.ValsetRetention, validateValsetRetention),
+		paramtypes.NewParamSetPair(ParamStoreTokenSignedBatchesWindows, &p.TokenSignedBatchesWindows, validateTokenSignedBatchesWindows),
+		paramtypes.NewParamSetPair(ParamStoreEventNonceStallThreshold, &p.EventNonceStallThreshold, validateEventNonceStallThreshold),
+		paramtypes.NewParamSetPair(ParamStoreMinimalEvents, &p.MinimalEvents, validateMinimalEvents),
+		paramtypes.NewParamSetPair(ParamStoreFeeExemptSenders, &p.FeeExemptSenders, validateFeeExemptSenders),
 	}
 }
 
@@ -390,6 +965,467 @@ func validateValsetRewardAmount(i interface{}) error {
 	return nil
 }
 
+func validateEthereumPowerThreshold(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		// valsets keep the legacy checkpoint encoding
+		return nil
+	} else if val < MinEthereumPowerThreshold {
+		return fmt.Errorf("invalid ethereum power threshold, less than 2/3 of the total power")
+	} else if val > math.MaxUint32 {
+		return fmt.Errorf("invalid ethereum power threshold, more than the total power")
+	}
+	return nil
+}
+
+func validateEthereumTimeoutMargin(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("invalid ethereum timeout margin, batches would time out immediately")
+	}
+	return nil
+}
+
+func validateEthereumBlockTimeCalibrationPeriod(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateAttestationFastPathThreshold(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return nil
+	} else if val <= AttestationVotesPowerThreshold.Uint64() {
+		return fmt.Errorf("invalid attestation fast path threshold, not above the attestation threshold")
+	} else if val > 100 {
+		return fmt.Errorf("invalid attestation fast path threshold, more than 100 percent")
+	}
+	return nil
+}
+
+func validateAttestationExecutionBudget(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("invalid attestation execution budget, observed attestations would never be applied")
+	}
+	return nil
+}
+
+func validateValsetCreationGasBudget(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSlashingGasBudget(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validatePruningGasBudget(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateAttestationGasBudget(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateRelayerLotteryReward(i interface{}) error {
+	val, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val.Amount.IsNil() || val.IsZero() {
+		return nil
+	}
+	if err := val.Validate(); err != nil {
+		return err
+	}
+	if _, err := GravityDenomToERC20(val.Denom); err == nil {
+		return fmt.Errorf("invalid relayer lottery reward, can not mint bridged token %s", val.Denom)
+	}
+	return nil
+}
+
+func validateRelayerLotteryWindow(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("invalid relayer lottery window, must be at least one block")
+	}
+	return nil
+}
+
+func validateFeeConfirmationMultiple(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateOutflowUsdLimit(i interface{}) error {
+	val, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val.IsNil() || val.IsNegative() {
+		return fmt.Errorf("invalid outflow usd limit, must not be negative")
+	}
+	return nil
+}
+
+func validateOutflowLimitWindow(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("invalid outflow limit window, must be at least one block")
+	}
+	return nil
+}
+
+func validateStallRefundThreshold(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateStallRefundBudget(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("invalid stall refund budget, the pool would never be refunded")
+	}
+	return nil
+}
+
+func validateRefundReceiptRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validatePoolTxTimeout(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxCallbackDataSize(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateCallbackDataByteFee(i interface{}) error {
+	val, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val.Amount.IsNil() || val.IsZero() {
+		return nil
+	}
+	return val.Validate()
+}
+
+func validateBatchGasPerTx(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateBatchGasOverhead(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateDepositReceiptRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMinSendToEthFees(i interface{}) error {
+	v, ok := i.([]ERC20Token)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool)
+	for _, fee := range v {
+		if err := fee.ValidateBasic(); err != nil {
+			return err
+		}
+		if fee.Amount.IsNil() || fee.Amount.IsNegative() {
+			return fmt.Errorf("invalid minimum fee %v for %s", fee.Amount, fee.Contract)
+		}
+		// the same contract may be written in different checksum casings
+		contract := strings.ToLower(fee.Contract)
+		if seen[contract] {
+			return fmt.Errorf("duplicate minimum fee for %s", fee.Contract)
+		}
+		seen[contract] = true
+	}
+	return nil
+}
+
+func validateMaxBatchSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max batch size must be positive")
+	}
+	return nil
+}
+
+func validateTokenMaxBatchSizes(i interface{}) error {
+	v, ok := i.([]TokenBatchSize)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool)
+	for _, size := range v {
+		if err := ValidateEthAddress(size.TokenContract); err != nil {
+			return sdkerrors.Wrap(err, "token contract")
+		}
+		if size.MaxBatchSize == 0 {
+			return fmt.Errorf("max batch size of %s must be positive", size.TokenContract)
+		}
+		contract := strings.ToLower(size.TokenContract)
+		if seen[contract] {
+			return fmt.Errorf("duplicate max batch size for %s", size.TokenContract)
+		}
+		seen[contract] = true
+	}
+	return nil
+}
+
+func validateMaxClaimAge(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateRebuildTimedOutBatches(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSendToEthPriorityFees(i interface{}) error {
+	v, ok := i.([]sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	for n, fee := range v {
+		if err := fee.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "fee of priority %d", n+1)
+		}
+	}
+	return nil
+}
+
+func validateMergeBatchTransfers(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validatePoolAgingBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxSendToEthPayloadSize(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxPendingTxsPerSender(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSignedClaimsWindow(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSlashFractionClaim(i interface{}) error {
+	if _, ok := i.(sdk.Dec); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSlashFractionConflictingClaim(i interface{}) error {
+	if _, ok := i.(sdk.Dec); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateAttestationRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateEthBlocksToObserve(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateQuarantinedEthSenders(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool)
+	for _, sender := range v {
+		if err := ValidateEthAddress(sender); err != nil {
+			return sdkerrors.Wrap(err, "quarantined sender")
+		}
+		address := strings.ToLower(sender)
+		if seen[address] {
+			return fmt.Errorf("duplicate quarantined sender %s", sender)
+		}
+		seen[address] = true
+	}
+	return nil
+}
+
+func validateEthereumHeightDriftThreshold(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateValsetPowerChangeThreshold(i interface{}) error {
+	val, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val.IsNil() || !val.IsPositive() || val.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid valset power change threshold, must be above 0 and at most 1")
+	}
+	return nil
+}
+
+func validateMaxValsetInterval(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateValsetRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateTokenSignedBatchesWindows(i interface{}) error {
+	v, ok := i.([]TokenSignedBatchesWindow)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool)
+	for _, window := range v {
+		if err := ValidateEthAddress(window.TokenContract); err != nil {
+			return sdkerrors.Wrap(err, "token contract")
+		}
+		if window.SignedBatchesWindow == 0 {
+			return fmt.Errorf("signed batches window of %s must be positive", window.TokenContract)
+		}
+		contract := strings.ToLower(window.TokenContract)
+		if seen[contract] {
+			return fmt.Errorf("duplicate signed batches window for %s", window.TokenContract)
+		}
+		seen[contract] = true
+	}
+	return nil
+}
+
+func validateEventNonceStallThreshold(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMinimalEvents(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateFeeExemptSenders(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool)
+	for _, sender := range v {
+		address, err := sdk.AccAddressFromBech32(sender)
+		if err != nil {
+			return sdkerrors.Wrap(err, "fee exempt sender")
+		}
+		if seen[address.String()] {
+			return fmt.Errorf("duplicate fee exempt sender %s", sender)
+		}
+		seen[address.String()] = true
+	}
+	return nil
+}
+
 func strToFixByteArray(s string) ([32]byte, error) {
 	var out [32]byte
 	if len([]byte(s)) > 32 {
@@ -35,13 +35,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // a validator needs to continue signing blocks. The goal of this paramater is that when a validator leaves
 // the set, if their leaving creates enough change in the validator set to justify an update they will sign
 // a validator set update for the Ethereum bridge that does not include themselves. Allowing us to remove them
-// from the Ethereum bridge and replace them with the new set gracefully.
+// from the Ethereum bridge and replace them with the new set gracefully. The same window applies to the
+// batches created after they started to unbond, past it they are exempt from signing both.
 //
 // valset_reward
 //
 // Valset rewards are the amount of tokens this chain issues to relayers of validator sets.
-// These can be any ERC20 token in the bridge, but it's strongly advised that chains use only
-// Cosmos originated tokens, which the bridge effectively mints on Ethereum. If you run out of
+// These can be any ERC20 token in the bridge, either a Cosmos originated denom with a deployed
+// ERC20, which the bridge effectively mints on Ethereum, or the voucher of an Ethereum originated
+// token. The contract pays Ethereum originated rewards out of the tokens backing the vouchers, so
+// the module burns vouchers from the valset reward reserve, funded with MsgFundValsetReward, for
+// every reward paid and only offers the reward while the reserve covers it. If you run out of
 // the token you are using for validator set rewards valset updates will fail and the bridge
 // will be vulnerable to highjacking. For these paramaters the zero values are special and indicate
 // not to attempt any reward. This is the default for bootstrapping.
@@ -62,6 +66,186 @@ type Params struct {
 	UnbondSlashingValsetsWindow  uint64                                 `protobuf:"varint,15,opt,name=unbond_slashing_valsets_window,json=unbondSlashingValsetsWindow,proto3" json:"unbond_slashing_valsets_window,omitempty"`
 	SlashFractionBadEthSignature github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_fraction_bad_eth_signature,json=slashFractionBadEthSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_bad_eth_signature"`
 	ValsetReward                 types.Coin                             `protobuf:"bytes,17,opt,name=valset_reward,json=valsetReward,proto3" json:"valset_reward"`
+	// the normalized power (out of u32 max) that must sign a valset update, batch
+	// or logic call before the Ethereum contract will execute it. This value is
+	// encoded into every valset checkpoint so that the contract and the module can
+	// be moved to a new threshold in lockstep. It may never be set below 2/3. Zero,
+	// the default, keeps the legacy checkpoint encoding the deployed contract and
+	// orchestrators expect, with the threshold hardcoded in the contract
+	EthereumPowerThreshold uint64 `protobuf:"varint,18,opt,name=ethereum_power_threshold,json=ethereumPowerThreshold,proto3" json:"ethereum_power_threshold,omitempty"`
+	// the number of Ethereum blocks past the observed Ethereum height at which
+	// newly created batches and logic calls time out
+	EthereumTimeoutMargin uint64 `protobuf:"varint,19,opt,name=ethereum_timeout_margin,json=ethereumTimeoutMargin,proto3" json:"ethereum_timeout_margin,omitempty"`
+	// the number of Cosmos blocks between recalibrations of the Ethereum block
+	// time from the Ethereum block heights and timestamps of observed claims. The
+	// calibrated block time is used in place of average_ethereum_block_time,
+	// which is left as governance set it. Zero disables the recalibration and
+	// uses average_ethereum_block_time again
+	EthereumBlockTimeCalibrationPeriod uint64 `protobuf:"varint,20,opt,name=ethereum_block_time_calibration_period,json=ethereumBlockTimeCalibrationPeriod,proto3" json:"ethereum_block_time_calibration_period,omitempty"`
+	// the percentage of the total validator power whose votes make an
+	// attestation observed as soon as the final claim is submitted, instead of in
+	// the EndBlocker. It must be above the regular attestation threshold, zero
+	// disables the fast path
+	AttestationFastPathThreshold uint64 `protobuf:"varint,21,opt,name=attestation_fast_path_threshold,json=attestationFastPathThreshold,proto3" json:"attestation_fast_path_threshold,omitempty"`
+	// the maximum number of observed attestations applied to the state in a
+	// single EndBlocker, attestations past the budget are applied in the
+	// following blocks in event nonce order
+	AttestationExecutionBudget uint64 `protobuf:"varint,22,opt,name=attestation_execution_budget,json=attestationExecutionBudget,proto3" json:"attestation_execution_budget,omitempty"`
+	// the gas available to the automatic valset creation in each EndBlocker. A
+	// step that runs out of its gas budget keeps the work it completed and
+	// resumes from there in the next block, a zero budget leaves the step
+	// unmetered
+	ValsetCreationGasBudget uint64 `protobuf:"varint,23,opt,name=valset_creation_gas_budget,json=valsetCreationGasBudget,proto3" json:"valset_creation_gas_budget,omitempty"`
+	// the gas available to the valset, batch and logic call slashing scans in each
+	// EndBlocker
+	SlashingGasBudget uint64 `protobuf:"varint,24,opt,name=slashing_gas_budget,json=slashingGasBudget,proto3" json:"slashing_gas_budget,omitempty"`
+	// the gas available to valset and attestation pruning in each EndBlocker
+	PruningGasBudget uint64 `protobuf:"varint,25,opt,name=pruning_gas_budget,json=pruningGasBudget,proto3" json:"pruning_gas_budget,omitempty"`
+	// the gas available to tallying attestation votes and, separately, to applying
+	// queued attestations in each EndBlocker
+	AttestationGasBudget uint64 `protobuf:"varint,26,opt,name=attestation_gas_budget,json=attestationGasBudget,proto3" json:"attestation_gas_budget,omitempty"`
+	// the coin minted for the winner of the relayer lottery held every time a
+	// batch is executed, the winner is drawn from the relayers of recent batches
+	// weighted by how many they relayed. A zero amount disables the lottery.
+	// Tokens minted by the bridge itself may not be used
+	RelayerLotteryReward types.Coin `protobuf:"bytes,27,opt,name=relayer_lottery_reward,json=relayerLotteryReward,proto3" json:"relayer_lottery_reward"`
+	// the number of Cosmos blocks of batch relays the relayer lottery weighs
+	// relayers by
+	RelayerLotteryWindow uint64 `protobuf:"varint,28,opt,name=relayer_lottery_window,json=relayerLotteryWindow,proto3" json:"relayer_lottery_window,omitempty"`
+	// transfers to Ethereum whose fee is more than this multiple of the
+	// transferred amount are held out of batches until the sender confirms
+	// them, 0 disables the check
+	FeeConfirmationMultiple uint64 `protobuf:"varint,29,opt,name=fee_confirmation_multiple,json=feeConfirmationMultiple,proto3" json:"fee_confirmation_multiple,omitempty"`
+	// the maximum USD value, as reported by the price feed, of all transfers
+	// to Ethereum requested across every token within outflow_limit_window
+	// blocks. Zero disables the limit, while it is set transfers of tokens
+	// without a price are rejected. It can only be set if the keeper has a
+	// price feed, refunded transfers do not count against it
+	OutflowUsdLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,30,opt,name=outflow_usd_limit,json=outflowUsdLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"outflow_usd_limit"`
+	// the number of blocks the outflow_usd_limit applies to
+	OutflowLimitWindow uint64 `protobuf:"varint,31,opt,name=outflow_limit_window,json=outflowLimitWindow,proto3" json:"outflow_limit_window,omitempty"`
+	// the number of blocks after which an orchestrator that sent no heartbeat
+	// no longer counts as live. Once the live validators hold too little power
+	// to confirm anything the transfers waiting in the pool are refunded to
+	// their senders, 0 disables the refunds
+	StallRefundThreshold uint64 `protobuf:"varint,32,opt,name=stall_refund_threshold,json=stallRefundThreshold,proto3" json:"stall_refund_threshold,omitempty"`
+	// the maximum number of pool transfers refunded per block while the bridge
+	// is stalled
+	StallRefundBudget uint64 `protobuf:"varint,33,opt,name=stall_refund_budget,json=stallRefundBudget,proto3" json:"stall_refund_budget,omitempty"`
+	// the number of blocks the receipts of refunded transfers to Ethereum are
+	// kept for, 0 keeps them forever
+	RefundReceiptRetention uint64 `protobuf:"varint,34,opt,name=refund_receipt_retention,json=refundReceiptRetention,proto3" json:"refund_receipt_retention,omitempty"`
+	// the number of blocks a transfer may wait in the pool before it is refunded
+	// to its sender, 0 lets transfers wait forever
+	PoolTxTimeout uint64 `protobuf:"varint,35,opt,name=pool_tx_timeout,json=poolTxTimeout,proto3" json:"pool_tx_timeout,omitempty"`
+	// the maximum size of the callback data of a transfer to Ethereum with a
+	// receiver hook, 0 disables such transfers
+	MaxCallbackDataSize uint64 `protobuf:"varint,36,opt,name=max_callback_data_size,json=maxCallbackDataSize,proto3" json:"max_callback_data_size,omitempty"`
+	// the fee taken from the sender for every byte of callback data, it is paid
+	// to the fee collector. An empty denom makes callback data free
+	CallbackDataByteFee types.Coin `protobuf:"bytes,37,opt,name=callback_data_byte_fee,json=callbackDataByteFee,proto3" json:"callback_data_byte_fee"`
+	// the Ethereum gas estimated for every transfer in a batch, a batch is only
+	// built if its fees are worth more than the estimated gas at the Ethereum
+	// gas price reported by the orchestrators. 0 for both this and
+	// batch_gas_overhead falls back to requiring more fees than the last batch
+	BatchGasPerTx uint64 `protobuf:"varint,38,opt,name=batch_gas_per_tx,json=batchGasPerTx,proto3" json:"batch_gas_per_tx,omitempty"`
+	// the Ethereum gas estimated for relaying a batch on top of its transfers,
+	// mostly spent checking the validator signatures
+	BatchGasOverhead uint64 `protobuf:"varint,39,opt,name=batch_gas_overhead,json=batchGasOverhead,proto3" json:"batch_gas_overhead,omitempty"`
+	// the number of blocks the receipts of deposits from Ethereum are kept for,
+	// 0 keeps them forever
+	DepositReceiptRetention uint64 `protobuf:"varint,40,opt,name=deposit_receipt_retention,json=depositReceiptRetention,proto3" json:"deposit_receipt_retention,omitempty"`
+	// the minimum fee a transfer to Ethereum of a token has to pay, keyed by the
+	// token contract, tokens without an entry can be sent without a fee
+	MinSendToEthFees []ERC20Token `protobuf:"bytes,41,rep,name=min_send_to_eth_fees,json=minSendToEthFees,proto3" json:"min_send_to_eth_fees"`
+	// the most transactions a batch may carry
+	MaxBatchSize uint64 `protobuf:"varint,42,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// per token overrides of max_batch_size, so that tokens that are expensive
+	// to transfer can use smaller batches
+	TokenMaxBatchSizes []TokenBatchSize `protobuf:"bytes,43,rep,name=token_max_batch_sizes,json=tokenMaxBatchSizes,proto3" json:"token_max_batch_sizes"`
+	// the most event nonces a claim may be behind the last observed event nonce,
+	// 0 accepts claims of any age
+	MaxClaimAge uint64 `protobuf:"varint,44,opt,name=max_claim_age,json=maxClaimAge,proto3" json:"max_claim_age,omitempty"`
+	// rebuild a batch for a token in the same block one of its batches times
+	// out, so that the returned transfers do not wait for a batch request
+	RebuildTimedOutBatches bool `protobuf:"varint,45,opt,name=rebuild_timed_out_batches,json=rebuildTimedOutBatches,proto3" json:"rebuild_timed_out_batches,omitempty"`
+	// the fee a transfer to Ethereum pays to the fee collector for each priority
+	// class above 0, the n-th entry is the price of priority n. Priorities past
+	// the end of the list are refused, an empty list disables priorities
+	SendToEthPriorityFees []types.Coin `protobuf:"bytes,46,rep,name=send_to_eth_priority_fees,json=sendToEthPriorityFees,proto3" json:"send_to_eth_priority_fees"`
+	// merge the transactions of a batch to the same destination into a single
+	// transfer, saving calldata and gas on Ethereum
+	MergeBatchTransfers bool `protobuf:"varint,47,opt,name=merge_batch_transfers,json=mergeBatchTransfers,proto3" json:"merge_batch_transfers,omitempty"`
+	// every pool_aging_blocks blocks a transfer waits in the pool it is batched
+	// as if its priority class were one higher, so that low fee transfers are
+	// not starved by a steady stream of higher fees. Zero disables aging
+	PoolAgingBlocks uint64 `protobuf:"varint,48,opt,name=pool_aging_blocks,json=poolAgingBlocks,proto3" json:"pool_aging_blocks,omitempty"`
+	// the maximum size of the payload a transfer to Ethereum may carry through
+	// its batch, 0 disables payloads. Only enable it once the Gravity contract
+	// verifies the batch checkpoint that includes them
+	MaxSendToEthPayloadSize uint64 `protobuf:"varint,49,opt,name=max_send_to_eth_payload_size,json=maxSendToEthPayloadSize,proto3" json:"max_send_to_eth_payload_size,omitempty"`
+	// the number of transfers a sender may have waiting in the pool at once,
+	// further transfers are refused until some are batched or canceled. Zero
+	// disables the limit
+	MaxPendingTxsPerSender uint64 `protobuf:"varint,50,opt,name=max_pending_txs_per_sender,json=maxPendingTxsPerSender,proto3" json:"max_pending_txs_per_sender,omitempty"`
+	// the number of blocks after an event is observed within which every bonded
+	// validator must have submitted a claim for it, a validator that did not is
+	// slashed by slash_fraction_claim and jailed. Zero disables claim slashing
+	SignedClaimsWindow uint64                                 `protobuf:"varint,51,opt,name=signed_claims_window,json=signedClaimsWindow,proto3" json:"signed_claims_window,omitempty"`
+	SlashFractionClaim github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,52,opt,name=slash_fraction_claim,json=slashFractionClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_claim"`
+	// the fraction a validator is slashed by when its orchestrator is proven to
+	// have signed two different claims for the same event nonce
+	SlashFractionConflictingClaim github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,53,opt,name=slash_fraction_conflicting_claim,json=slashFractionConflictingClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_conflicting_claim"`
+	// the number of blocks the attestations of an event are kept for after it
+	// is observed, 0 keeps them forever
+	AttestationRetention uint64 `protobuf:"varint,54,opt,name=attestation_retention,json=attestationRetention,proto3" json:"attestation_retention,omitempty"`
+	// the number of Ethereum blocks an event has to be buried under before
+	// orchestrators claim it, so that a reorg can not undo an observed event.
+	// The module can not see Ethereum blocks itself, orchestrators read the
+	// depth from here instead of hardcoding it
+	EthBlocksToObserve uint64 `protobuf:"varint,55,opt,name=eth_blocks_to_observe,json=ethBlocksToObserve,proto3" json:"eth_blocks_to_observe,omitempty"`
+	// Ethereum addresses whose deposits are not paid out. A deposit whose
+	// ethereum_sender or token_sender is listed is minted, or for Cosmos
+	// originated tokens left, in the module account and held there until a
+	// ReleaseQuarantinedDepositProposal releases it
+	QuarantinedEthSenders []string `protobuf:"bytes,56,rep,name=quarantined_eth_senders,json=quarantinedEthSenders,proto3" json:"quarantined_eth_senders,omitempty"`
+	// the number of Ethereum blocks the height reported by a validators
+	// orchestrator may fall behind the median of the bonded validators before an
+	// EventEthereumHeightDrift is emitted for it, 0 disables the alert
+	EthereumHeightDriftThreshold uint64 `protobuf:"varint,57,opt,name=ethereum_height_drift_threshold,json=ethereumHeightDriftThreshold,proto3" json:"ethereum_height_drift_threshold,omitempty"`
+	// a new valset is requested once the normalized power of the current
+	// validators differs from the latest valset by more than this fraction
+	ValsetPowerChangeThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,58,opt,name=valset_power_change_threshold,json=valsetPowerChangeThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valset_power_change_threshold"`
+	// the number of blocks after which a new valset is requested even if the
+	// power did not change enough, so that the valset on Ethereum does not go
+	// stale on a quiet chain. Zero disables it
+	MaxValsetInterval uint64 `protobuf:"varint,59,opt,name=max_valset_interval,json=maxValsetInterval,proto3" json:"max_valset_interval,omitempty"`
+	// the number of valsets below the last observed one that are kept, together
+	// with their confirms, for the HistoricalValsets query. Older ones are pruned
+	// once the signed valsets window has passed for them
+	ValsetRetention uint64 `protobuf:"varint,60,opt,name=valset_retention,json=valsetRetention,proto3" json:"valset_retention,omitempty"`
+	// per token overrides of signed_batches_window, so that validators can be
+	// given more time to sign the batches of low value tokens than those of the
+	// main assets of the chain
+	TokenSignedBatchesWindows []TokenSignedBatchesWindow `protobuf:"bytes,61,rep,name=token_signed_batches_windows,json=tokenSignedBatchesWindows,proto3" json:"token_signed_batches_windows"`
+	// the number of blocks the attestations for the event nonce after the last
+	// observed one may go without reaching consensus before an
+	// EventNonceStalled is emitted, an early warning that the bridge is about to
+	// halt. Zero disables it
+	EventNonceStallThreshold uint64 `protobuf:"varint,62,opt,name=event_nonce_stall_threshold,json=eventNonceStallThreshold,proto3" json:"event_nonce_stall_threshold,omitempty"`
+	// whether only the typed events are emitted for the state changes that have
+	// one, leaving out the deprecated untyped events they duplicate. The
+	// message events of the service messages and the event version are still
+	// emitted. Shrinks the block results of busy chains, but indexers that still
+	// read the untyped events must move to the typed ones first
+	MinimalEvents bool `protobuf:"varint,63,opt,name=minimal_events,json=minimalEvents,proto3" json:"minimal_events,omitempty"`
+	// Cosmos addresses exempt from the fees the chain charges on transfers to
+	// Ethereum, such as a community pool executor or the market makers of an
+	// incentive program. Their transfers skip the min_send_to_eth_fees floor and
+	// are not charged the send_to_eth_priority_fees or the
+	// callback_data_byte_fee, the bridge fee they pay relayers is still theirs
+	// to choose
+	FeeExemptSenders []string `protobuf:"bytes,64,rep,name=fee_exempt_senders,json=feeExemptSenders,proto3" json:"fee_exempt_senders,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -181,680 +365,3640 @@ func (m *Params) GetValsetReward() types.Coin {
 	return types.Coin{}
 }
 
-// GenesisState struct
-type GenesisState struct {
-	Params             *Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	LastObservedNonce  uint64                       `protobuf:"varint,2,opt,name=last_observed_nonce,json=lastObservedNonce,proto3" json:"last_observed_nonce,omitempty"`
-	Valsets            []*Valset                    `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets,omitempty"`
-	ValsetConfirms     []*MsgValsetConfirm          `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms,omitempty"`
-	Batches            []*OutgoingTxBatch           `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches,omitempty"`
-	BatchConfirms      []MsgConfirmBatch            `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
-	LogicCalls         []*OutgoingLogicCall         `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls,omitempty"`
-	LogicCallConfirms  []MsgConfirmLogicCall        `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
-	Attestations       []Attestation                `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
-	DelegateKeys       []*MsgSetOrchestratorAddress `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
-	Erc20ToDenoms      []*ERC20ToDenom              `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
-	UnbatchedTransfers []*OutgoingTransferTx        `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers,omitempty"`
+func (m *Params) GetEthereumPowerThreshold() uint64 {
+	if m != nil {
+		return m.EthereumPowerThreshold
+	}
+	return 0
 }
 
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{1}
-}
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *Params) GetEthereumTimeoutMargin() uint64 {
+	if m != nil {
+		return m.EthereumTimeoutMargin
+	}
+	return 0
 }
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Params) GetEthereumBlockTimeCalibrationPeriod() uint64 {
+	if m != nil {
+		return m.EthereumBlockTimeCalibrationPeriod
 	}
+	return 0
 }
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
+
+func (m *Params) GetAttestationFastPathThreshold() uint64 {
+	if m != nil {
+		return m.AttestationFastPathThreshold
+	}
+	return 0
 }
-func (m *GenesisState) XXX_Size() int {
-	return m.Size()
+
+func (m *Params) GetAttestationExecutionBudget() uint64 {
+	if m != nil {
+		return m.AttestationExecutionBudget
+	}
+	return 0
 }
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+
+func (m *Params) GetValsetCreationGasBudget() uint64 {
+	if m != nil {
+		return m.ValsetCreationGasBudget
+	}
+	return 0
 }
 
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+func (m *Params) GetSlashingGasBudget() uint64 {
+	if m != nil {
+		return m.SlashingGasBudget
+	}
+	return 0
+}
 
-func (m *GenesisState) GetParams() *Params {
+func (m *Params) GetPruningGasBudget() uint64 {
 	if m != nil {
-		return m.Params
+		return m.PruningGasBudget
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetLastObservedNonce() uint64 {
+func (m *Params) GetAttestationGasBudget() uint64 {
 	if m != nil {
-		return m.LastObservedNonce
+		return m.AttestationGasBudget
 	}
 	return 0
 }
 
-func (m *GenesisState) GetValsets() []*Valset {
+func (m *Params) GetRelayerLotteryReward() types.Coin {
 	if m != nil {
-		return m.Valsets
+		return m.RelayerLotteryReward
 	}
-	return nil
+	return types.Coin{}
 }
 
-func (m *GenesisState) GetValsetConfirms() []*MsgValsetConfirm {
+func (m *Params) GetRelayerLotteryWindow() uint64 {
 	if m != nil {
-		return m.ValsetConfirms
+		return m.RelayerLotteryWindow
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetBatches() []*OutgoingTxBatch {
+func (m *Params) GetFeeConfirmationMultiple() uint64 {
 	if m != nil {
-		return m.Batches
+		return m.FeeConfirmationMultiple
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetBatchConfirms() []MsgConfirmBatch {
+func (m *Params) GetOutflowLimitWindow() uint64 {
 	if m != nil {
-		return m.BatchConfirms
+		return m.OutflowLimitWindow
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetLogicCalls() []*OutgoingLogicCall {
+func (m *Params) GetStallRefundThreshold() uint64 {
 	if m != nil {
-		return m.LogicCalls
+		return m.StallRefundThreshold
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetLogicCallConfirms() []MsgConfirmLogicCall {
+func (m *Params) GetStallRefundBudget() uint64 {
 	if m != nil {
-		return m.LogicCallConfirms
+		return m.StallRefundBudget
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetAttestations() []Attestation {
+func (m *Params) GetRefundReceiptRetention() uint64 {
 	if m != nil {
-		return m.Attestations
+		return m.RefundReceiptRetention
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetDelegateKeys() []*MsgSetOrchestratorAddress {
+func (m *Params) GetPoolTxTimeout() uint64 {
 	if m != nil {
-		return m.DelegateKeys
+		return m.PoolTxTimeout
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetErc20ToDenoms() []*ERC20ToDenom {
+func (m *Params) GetMaxCallbackDataSize() uint64 {
 	if m != nil {
-		return m.Erc20ToDenoms
+		return m.MaxCallbackDataSize
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetUnbatchedTransfers() []*OutgoingTransferTx {
+func (m *Params) GetCallbackDataByteFee() types.Coin {
 	if m != nil {
-		return m.UnbatchedTransfers
+		return m.CallbackDataByteFee
 	}
-	return nil
+	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
-	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
+func (m *Params) GetBatchGasPerTx() uint64 {
+	if m != nil {
+		return m.BatchGasPerTx
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
+func (m *Params) GetBatchGasOverhead() uint64 {
+	if m != nil {
+		return m.BatchGasOverhead
+	}
+	return 0
+}
 
-var fileDescriptor_387b0aba880adb60 = []byte{
-	// 993 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xdf, 0x4e, 0x23, 0x37,
-	0x14, 0xc6, 0x93, 0x6e, 0x36, 0x80, 0x93, 0xc0, 0xe2, 0x00, 0x6b, 0xfe, 0x6c, 0x88, 0x56, 0xea,
-	0x0a, 0x55, 0xcb, 0x0c, 0xa4, 0x6a, 0xa5, 0x56, 0x6a, 0x55, 0x12, 0x68, 0x77, 0xdb, 0x6e, 0xa9,
-	0x26, 0xb4, 0x95, 0xaa, 0x4a, 0xae, 0x67, 0xc6, 0xcc, 0x8c, 0x98, 0xd8, 0xc8, 0x76, 0x02, 0xdc,
-	0xf5, 0x11, 0x7a, 0xdb, 0x07, 0xe9, 0x3b, 0xec, 0xe5, 0x5e, 0x56, 0x55, 0xb5, 0xaa, 0xe0, 0x45,
-	0x56, 0x63, 0x7b, 0x26, 0x43, 0x96, 0x2b, 0xae, 0x60, 0xfc, 0x7d, 0xbf, 0x73, 0x4e, 0x8e, 0xed,
-	0x63, 0x80, 0x22, 0x41, 0x26, 0x89, 0xba, 0x72, 0x27, 0xfb, 0x6e, 0x44, 0x19, 0x95, 0x89, 0x74,
-	0xce, 0x05, 0x57, 0x1c, 0x02, 0xab, 0x38, 0x93, 0xfd, 0x8d, 0x95, 0x88, 0x47, 0x5c, 0x2f, 0xbb,
-	0xd9, 0x7f, 0xc6, 0xb1, 0xb1, 0x56, 0x62, 0xd5, 0xd5, 0x39, 0xb5, 0xe4, 0xc6, 0x6a, 0x69, 0x7d,
-	0x24, 0x23, 0x79, 0x87, 0xdd, 0x27, 0x2a, 0x88, 0xed, 0xfa, 0x56, 0x69, 0x9d, 0x28, 0x45, 0xa5,
-	0x22, 0x2a, 0xe1, 0xcc, 0xaa, 0x9d, 0x80, 0xcb, 0x11, 0x97, 0xae, 0x4f, 0x24, 0x75, 0x27, 0xfb,
-	0x3e, 0x55, 0x64, 0xdf, 0x0d, 0x78, 0x62, 0xf5, 0xa7, 0x7f, 0xcf, 0x83, 0xfa, 0x8f, 0x44, 0x90,
-	0x91, 0x84, 0x4f, 0x40, 0x5e, 0x33, 0x4e, 0x42, 0x54, 0xed, 0x56, 0x77, 0x16, 0xbc, 0x05, 0xbb,
-	0xf2, 0x32, 0x84, 0x7b, 0x60, 0x25, 0xe0, 0x4c, 0x09, 0x12, 0x28, 0x2c, 0xf9, 0x58, 0x04, 0x14,
-	0xc7, 0x44, 0xc6, 0xe8, 0x03, 0x6d, 0x84, 0xb9, 0x36, 0xd4, 0xd2, 0x0b, 0x22, 0x63, 0xf8, 0x29,
-	0x78, 0xec, 0x8b, 0x24, 0x8c, 0x28, 0xa6, 0x2a, 0xa6, 0x82, 0x8e, 0x47, 0x98, 0x84, 0xa1, 0xa0,
-	0x52, 0xa2, 0x9a, 0x86, 0x56, 0x8d, 0x7c, 0x64, 0xd5, 0x03, 0x23, 0xc2, 0x67, 0x60, 0xc9, 0x72,
-	0x41, 0x4c, 0x12, 0x96, 0x55, 0xf3, 0xb0, 0x5b, 0xdd, 0xa9, 0x79, 0x2d, 0xb3, 0x3c, 0xc8, 0x56,
-	0x5f, 0x86, 0xb0, 0x07, 0x56, 0x65, 0x12, 0x31, 0x1a, 0xe2, 0x09, 0x49, 0x25, 0x55, 0x12, 0x5f,
-	0x24, 0x2c, 0xe4, 0x17, 0xa8, 0xae, 0xdd, 0x6d, 0x23, 0xfe, 0x6c, 0xb4, 0x5f, 0xb4, 0x54, 0x62,
-	0x74, 0x0f, 0x69, 0xc1, 0xcc, 0x95, 0x99, 0xbe, 0xd1, 0x2c, 0xf3, 0x19, 0x58, 0xb7, 0x4c, 0xca,
-	0xa3, 0x24, 0xc0, 0x01, 0x49, 0xd3, 0x82, 0x9b, 0xd7, 0xdc, 0x9a, 0x31, 0x7c, 0x9f, 0xe9, 0x83,
-	0x4c, 0xb6, 0xe8, 0x1e, 0x58, 0x51, 0x44, 0x44, 0x54, 0x99, 0x74, 0x58, 0x25, 0x23, 0xca, 0xc7,
-	0x0a, 0x2d, 0x68, 0x0a, 0x1a, 0x4d, 0x67, 0x3b, 0x31, 0x0a, 0x7c, 0x0e, 0x20, 0x99, 0x50, 0x41,
-	0x22, 0x8a, 0xfd, 0x94, 0x07, 0x67, 0x1a, 0x41, 0x40, 0xfb, 0x1f, 0x59, 0xa5, 0x9f, 0x09, 0x19,
-	0x00, 0xbf, 0x00, 0x9b, 0xb9, 0xbb, 0xe8, 0x71, 0x09, 0x6b, 0x68, 0x0c, 0x59, 0x4b, 0xde, 0xe7,
-	0x29, 0xee, 0x83, 0x55, 0x99, 0x12, 0x19, 0xe3, 0xd3, 0x6c, 0xeb, 0x12, 0xce, 0x6c, 0x27, 0x51,
-	0xb3, 0x5b, 0xdd, 0x69, 0xf6, 0x9d, 0xd7, 0x6f, 0xb7, 0x2b, 0xff, 0xbe, 0xdd, 0x7e, 0x16, 0x25,
-	0x2a, 0x1e, 0xfb, 0x4e, 0xc0, 0x47, 0xae, 0x3d, 0x4f, 0xe6, 0xcf, 0xae, 0x0c, 0xcf, 0xec, 0xd9,
-	0x3d, 0xa4, 0x81, 0xd7, 0xd6, 0xc1, 0xbe, 0xb6, 0xb1, 0x4c, 0xe3, 0xe1, 0xef, 0x60, 0x65, 0x26,
-	0x87, 0x6e, 0x05, 0x6a, 0xdd, 0x2b, 0x05, 0xbc, 0x95, 0x42, 0x77, 0x0e, 0x26, 0x60, 0x7d, 0x26,
-	0xc3, 0x74, 0x9f, 0xd0, 0xe2, 0xbd, 0xd2, 0xac, 0xdd, 0x4a, 0x53, 0x6c, 0x2b, 0x1c, 0x80, 0xce,
-	0x98, 0xf9, 0x9c, 0x85, 0x58, 0x1b, 0x12, 0x16, 0xcd, 0x9e, 0xbd, 0x25, 0xdd, 0xf2, 0x4d, 0xe3,
-	0x1a, 0x5a, 0xd3, 0xed, 0x33, 0x38, 0x01, 0xdd, 0xf7, 0x3a, 0x12, 0x66, 0xfb, 0x87, 0xb3, 0x53,
-	0x44, 0xd4, 0x58, 0x50, 0xf4, 0xe8, 0x5e, 0x65, 0x6f, 0xcd, 0x74, 0x27, 0x3c, 0x52, 0xf1, 0x30,
-	0x8f, 0x09, 0x0f, 0x41, 0xcb, 0x14, 0x8b, 0x05, 0xbd, 0x20, 0x22, 0x44, 0xcb, 0xdd, 0xea, 0x4e,
-	0xa3, 0xb7, 0xee, 0x98, 0x58, 0x4e, 0x36, 0x23, 0x1c, 0x3b, 0x23, 0x9c, 0x01, 0x4f, 0x58, 0xbf,
-	0x96, 0xe5, 0xf7, 0x9a, 0x86, 0xf2, 0x34, 0xf4, 0x79, 0xed, 0x8f, 0xff, 0xba, 0x95, 0xa7, 0x7f,
-	0xd5, 0x41, 0xf3, 0x1b, 0x33, 0xf0, 0x86, 0x8a, 0x28, 0x0a, 0x3f, 0x02, 0xf5, 0x73, 0x3d, 0x47,
-	0xf4, 0xe4, 0x68, 0xf4, 0xa0, 0x33, 0x1d, 0x80, 0x8e, 0x99, 0x30, 0x9e, 0x75, 0x40, 0x07, 0xb4,
-	0x53, 0x22, 0x15, 0xe6, 0xbe, 0xa4, 0x62, 0x42, 0x43, 0xcc, 0x38, 0x0b, 0xa8, 0x9e, 0x24, 0x35,
-	0x6f, 0x39, 0x93, 0x8e, 0xad, 0xf2, 0x43, 0x26, 0xc0, 0xe7, 0x60, 0xce, 0x76, 0x19, 0x3d, 0xe8,
-	0x3e, 0x98, 0x0d, 0x6e, 0x9a, 0xeb, 0xe5, 0x16, 0x78, 0x04, 0x96, 0xec, 0xcf, 0x0c, 0x38, 0x3b,
-	0x4d, 0xc4, 0x28, 0x1b, 0x37, 0x19, 0xb5, 0x55, 0xa6, 0x5e, 0x49, 0xbb, 0x2b, 0x03, 0x63, 0xf2,
-	0x16, 0x27, 0xe5, 0x4f, 0x09, 0x3f, 0x01, 0x73, 0x76, 0x44, 0xa0, 0x87, 0x1a, 0xdf, 0x2c, 0xe3,
-	0xc7, 0x63, 0x15, 0xf1, 0x84, 0x45, 0x27, 0x97, 0xfa, 0x0c, 0x7a, 0xb9, 0x17, 0xbe, 0x00, 0x8b,
-	0xe6, 0xaa, 0x17, 0xc9, 0xeb, 0xef, 0xd3, 0xaf, 0x64, 0x64, 0xf3, 0x68, 0xda, 0xf6, 0xb9, 0xa5,
-	0xc1, 0xa2, 0x80, 0x2f, 0x41, 0xa3, 0x34, 0x6f, 0xd0, 0x9c, 0x0e, 0xf3, 0xe4, 0xae, 0x22, 0x8a,
-	0xf3, 0xe9, 0x81, 0xb4, 0x98, 0x40, 0xf0, 0x27, 0xd0, 0x9e, 0xf2, 0xd3, 0x72, 0xe6, 0x75, 0x9c,
-	0xed, 0xbb, 0xcb, 0x29, 0x22, 0xd9, 0x92, 0x96, 0x8b, 0x78, 0x45, 0x59, 0x07, 0xa0, 0x59, 0x7a,
-	0x66, 0x24, 0x5a, 0xd0, 0xf1, 0x1e, 0x97, 0xe3, 0x1d, 0x4c, 0xf5, 0xfc, 0x08, 0x95, 0x11, 0xf8,
-	0x2d, 0x68, 0x85, 0x34, 0xa5, 0x11, 0x51, 0x14, 0x9f, 0xd1, 0x2b, 0x89, 0x80, 0x8e, 0xf1, 0xe1,
-	0x4c, 0x4d, 0x43, 0xaa, 0x8e, 0x45, 0xd6, 0x54, 0x25, 0x88, 0xe2, 0xc2, 0x3e, 0x0f, 0x5e, 0x33,
-	0x67, 0xbf, 0xa3, 0x57, 0x12, 0x7e, 0x05, 0x96, 0xa8, 0x08, 0x7a, 0x7b, 0x58, 0x71, 0x1c, 0x52,
-	0xc6, 0x47, 0x12, 0x35, 0x74, 0x34, 0x54, 0x8e, 0x76, 0xe4, 0x0d, 0x7a, 0x7b, 0x27, 0xfc, 0x30,
-	0x33, 0x78, 0x2d, 0x0d, 0xd8, 0x2f, 0x09, 0x8f, 0x41, 0x7b, 0xcc, 0xcc, 0xf6, 0x85, 0x58, 0x09,
-	0xc2, 0xe4, 0x29, 0x15, 0x12, 0x35, 0x75, 0x94, 0xce, 0x9d, 0x9b, 0x6e, 0x4d, 0x27, 0x97, 0x1e,
-	0x2c, 0xd0, 0x7c, 0x51, 0xf6, 0x7f, 0x7b, 0x7d, 0xdd, 0xa9, 0xbe, 0xb9, 0xee, 0x54, 0xff, 0xbf,
-	0xee, 0x54, 0xff, 0xbc, 0xe9, 0x54, 0xde, 0xdc, 0x74, 0x2a, 0xff, 0xdc, 0x74, 0x2a, 0xbf, 0xf6,
-	0x4b, 0xf7, 0x98, 0xa4, 0x2a, 0xa6, 0x64, 0x97, 0x51, 0x95, 0xdf, 0x65, 0x9b, 0x69, 0xd7, 0xbc,
-	0x72, 0xee, 0x88, 0x87, 0xe3, 0x94, 0xba, 0x97, 0x6e, 0xfe, 0xc0, 0xeb, 0x7b, 0xee, 0xd7, 0xf5,
-	0xc3, 0xfd, 0xf1, 0xbb, 0x00, 0x00, 0x00, 0xff, 0xff, 0x70, 0x7b, 0x92, 0x4a, 0x7b, 0x08, 0x00,
-	0x00,
+func (m *Params) GetDepositReceiptRetention() uint64 {
+	if m != nil {
+		return m.DepositReceiptRetention
+	}
+	return 0
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetMinSendToEthFees() []ERC20Token {
+	if m != nil {
+		return m.MinSendToEthFees
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetMaxBatchSize() uint64 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ValsetReward.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+func (m *Params) GetTokenMaxBatchSizes() []TokenBatchSize {
+	if m != nil {
+		return m.TokenMaxBatchSizes
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x8a
-	{
-		size := m.SlashFractionBadEthSignature.Size()
-		i -= size
-		if _, err := m.SlashFractionBadEthSignature.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *Params) GetMaxClaimAge() uint64 {
+	if m != nil {
+		return m.MaxClaimAge
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	if m.UnbondSlashingValsetsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingValsetsWindow))
-		i--
-		dAtA[i] = 0x78
+	return 0
+}
+
+func (m *Params) GetRebuildTimedOutBatches() bool {
+	if m != nil {
+		return m.RebuildTimedOutBatches
 	}
-	{
-		size := m.SlashFractionLogicCall.Size()
-		i -= size
-		if _, err := m.SlashFractionLogicCall.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return false
+}
+
+func (m *Params) GetSendToEthPriorityFees() []types.Coin {
+	if m != nil {
+		return m.SendToEthPriorityFees
 	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size := m.SlashFractionBatch.Size()
-		i -= size
-		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *Params) GetMergeBatchTransfers() bool {
+	if m != nil {
+		return m.MergeBatchTransfers
 	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size := m.SlashFractionValset.Size()
-		i -= size
-		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return false
+}
+
+func (m *Params) GetPoolAgingBlocks() uint64 {
+	if m != nil {
+		return m.PoolAgingBlocks
 	}
-	i--
-	dAtA[i] = 0x62
-	if m.AverageEthereumBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
-		i--
-		dAtA[i] = 0x58
+	return 0
+}
+
+func (m *Params) GetMaxSendToEthPayloadSize() uint64 {
+	if m != nil {
+		return m.MaxSendToEthPayloadSize
 	}
-	if m.AverageBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
-		i--
-		dAtA[i] = 0x50
+	return 0
+}
+
+func (m *Params) GetMaxPendingTxsPerSender() uint64 {
+	if m != nil {
+		return m.MaxPendingTxsPerSender
 	}
-	if m.TargetBatchTimeout != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBatchTimeout))
-		i--
-		dAtA[i] = 0x48
+	return 0
+}
+
+func (m *Params) GetSignedClaimsWindow() uint64 {
+	if m != nil {
+		return m.SignedClaimsWindow
 	}
-	if m.SignedLogicCallsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedLogicCallsWindow))
-		i--
-		dAtA[i] = 0x40
+	return 0
+}
+
+func (m *Params) GetAttestationRetention() uint64 {
+	if m != nil {
+		return m.AttestationRetention
 	}
-	if m.SignedBatchesWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
-		i--
-		dAtA[i] = 0x38
+	return 0
+}
+
+func (m *Params) GetEthBlocksToObserve() uint64 {
+	if m != nil {
+		return m.EthBlocksToObserve
 	}
-	if m.SignedValsetsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedValsetsWindow))
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *Params) GetQuarantinedEthSenders() []string {
+	if m != nil {
+		return m.QuarantinedEthSenders
 	}
-	if m.BridgeChainId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.BridgeEthereumAddress) > 0 {
-		i -= len(m.BridgeEthereumAddress)
-		copy(dAtA[i:], m.BridgeEthereumAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *Params) GetEthereumHeightDriftThreshold() uint64 {
+	if m != nil {
+		return m.EthereumHeightDriftThreshold
 	}
-	if len(m.ContractSourceHash) > 0 {
-		i -= len(m.ContractSourceHash)
-		copy(dAtA[i:], m.ContractSourceHash)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *Params) GetMaxValsetInterval() uint64 {
+	if m != nil {
+		return m.MaxValsetInterval
 	}
-	if len(m.GravityId) > 0 {
-		i -= len(m.GravityId)
-		copy(dAtA[i:], m.GravityId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GravityId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *Params) GetValsetRetention() uint64 {
+	if m != nil {
+		return m.ValsetRetention
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetTokenSignedBatchesWindows() []TokenSignedBatchesWindow {
+	if m != nil {
+		return m.TokenSignedBatchesWindows
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetEventNonceStallThreshold() uint64 {
+	if m != nil {
+		return m.EventNonceStallThreshold
+	}
+	return 0
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UnbatchedTransfers) > 0 {
-		for iNdEx := len(m.UnbatchedTransfers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnbatchedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
+func (m *Params) GetMinimalEvents() bool {
+	if m != nil {
+		return m.MinimalEvents
 	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
+	return false
+}
+
+func (m *Params) GetFeeExemptSenders() []string {
+	if m != nil {
+		return m.FeeExemptSenders
 	}
-	if len(m.DelegateKeys) > 0 {
-		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
+	return nil
+}
+
+// TokenBatchSize overrides the max_batch_size param for the batches of a token
+type TokenBatchSize struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	MaxBatchSize  uint64 `protobuf:"varint,2,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+}
+
+func (m *TokenBatchSize) Reset()         { *m = TokenBatchSize{} }
+func (m *TokenBatchSize) String() string { return proto.CompactTextString(m) }
+func (*TokenBatchSize) ProtoMessage()    {}
+func (*TokenBatchSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{1}
+}
+func (m *TokenBatchSize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenBatchSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenBatchSize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Attestations) > 0 {
-		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+}
+func (m *TokenBatchSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenBatchSize.Merge(m, src)
+}
+func (m *TokenBatchSize) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenBatchSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenBatchSize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenBatchSize proto.InternalMessageInfo
+
+func (m *TokenBatchSize) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if len(m.LogicCallConfirms) > 0 {
-		for iNdEx := len(m.LogicCallConfirms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogicCallConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
+	return ""
+}
+
+func (m *TokenBatchSize) GetMaxBatchSize() uint64 {
+	if m != nil {
+		return m.MaxBatchSize
 	}
-	if len(m.LogicCalls) > 0 {
-		for iNdEx := len(m.LogicCalls) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogicCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
+	return 0
+}
+
+// TokenSignedBatchesWindow overrides the signed_batches_window param for the
+// batches of a token
+type TokenSignedBatchesWindow struct {
+	TokenContract       string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	SignedBatchesWindow uint64 `protobuf:"varint,2,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
+}
+
+func (m *TokenSignedBatchesWindow) Reset()         { *m = TokenSignedBatchesWindow{} }
+func (m *TokenSignedBatchesWindow) String() string { return proto.CompactTextString(m) }
+func (*TokenSignedBatchesWindow) ProtoMessage()    {}
+func (*TokenSignedBatchesWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{2}
+}
+func (m *TokenSignedBatchesWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenSignedBatchesWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenSignedBatchesWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.BatchConfirms) > 0 {
-		for iNdEx := len(m.BatchConfirms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BatchConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+}
+func (m *TokenSignedBatchesWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenSignedBatchesWindow.Merge(m, src)
+}
+func (m *TokenSignedBatchesWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenSignedBatchesWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenSignedBatchesWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenSignedBatchesWindow proto.InternalMessageInfo
+
+func (m *TokenSignedBatchesWindow) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if len(m.Batches) > 0 {
-		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	return ""
+}
+
+func (m *TokenSignedBatchesWindow) GetSignedBatchesWindow() uint64 {
+	if m != nil {
+		return m.SignedBatchesWindow
 	}
-	if len(m.ValsetConfirms) > 0 {
-		for iNdEx := len(m.ValsetConfirms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValsetConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	return 0
+}
+
+// GenesisState struct
+type GenesisState struct {
+	Params               *Params                                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	LastObservedNonce    uint64                                   `protobuf:"varint,2,opt,name=last_observed_nonce,json=lastObservedNonce,proto3" json:"last_observed_nonce,omitempty"`
+	Valsets              []*Valset                                `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets,omitempty"`
+	ValsetConfirms       []*MsgValsetConfirm                      `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms,omitempty"`
+	Batches              []*OutgoingTxBatch                       `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches,omitempty"`
+	BatchConfirms        []MsgConfirmBatch                        `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
+	LogicCalls           []*OutgoingLogicCall                     `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls,omitempty"`
+	LogicCallConfirms    []MsgConfirmLogicCall                    `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
+	Attestations         []Attestation                            `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
+	DelegateKeys         []*MsgSetOrchestratorAddress             `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
+	Erc20ToDenoms        []*ERC20ToDenom                          `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
+	UnbatchedTransfers   []*OutgoingTransferTx                    `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers,omitempty"`
+	ModuleSendGrants     []ModuleSendGrant                        `protobuf:"bytes,13,rep,name=module_send_grants,json=moduleSendGrants,proto3" json:"module_send_grants"`
+	BridgeInstance       *BridgeInstance                          `protobuf:"bytes,14,opt,name=bridge_instance,json=bridgeInstance,proto3" json:"bridge_instance,omitempty"`
+	EthDestinationLabels []EthDestinationLabel                    `protobuf:"bytes,15,rep,name=eth_destination_labels,json=ethDestinationLabels,proto3" json:"eth_destination_labels"`
+	FirstSendDelays      []FirstSendDelay                         `protobuf:"bytes,16,rep,name=first_send_delays,json=firstSendDelays,proto3" json:"first_send_delays"`
+	AuditLog             []AuditLogEntry                          `protobuf:"bytes,17,rep,name=audit_log,json=auditLog,proto3" json:"audit_log"`
+	CallbackTransfers    []*OutgoingTransferTx                    `protobuf:"bytes,18,rep,name=callback_transfers,json=callbackTransfers,proto3" json:"callback_transfers,omitempty"`
+	MergedTransfers      []MergedTransfer                         `protobuf:"bytes,19,rep,name=merged_transfers,json=mergedTransfers,proto3" json:"merged_transfers"`
+	QuarantinedDeposits  []DepositReceipt                         `protobuf:"bytes,20,rep,name=quarantined_deposits,json=quarantinedDeposits,proto3" json:"quarantined_deposits"`
+	ValsetRewardReserve  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,21,rep,name=valset_reward_reserve,json=valsetRewardReserve,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"valset_reward_reserve"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{3}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Valsets) > 0 {
-		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() *Params {
+	if m != nil {
+		return m.Params
 	}
-	if m.LastObservedNonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedNonce))
-		i--
-		dAtA[i] = 0x10
+	return nil
+}
+
+func (m *GenesisState) GetLastObservedNonce() uint64 {
+	if m != nil {
+		return m.LastObservedNonce
 	}
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *GenesisState) GetValsets() []*Valset {
+	if m != nil {
+		return m.Valsets
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GenesisState) GetValsetConfirms() []*MsgValsetConfirm {
+	if m != nil {
+		return m.ValsetConfirms
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *GenesisState) GetBatches() []*OutgoingTxBatch {
+	if m != nil {
+		return m.Batches
 	}
-	var l int
-	_ = l
-	l = len(m.GravityId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+func (m *GenesisState) GetBatchConfirms() []MsgConfirmBatch {
+	if m != nil {
+		return m.BatchConfirms
 	}
-	l = len(m.ContractSourceHash)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+func (m *GenesisState) GetLogicCalls() []*OutgoingLogicCall {
+	if m != nil {
+		return m.LogicCalls
 	}
-	l = len(m.BridgeEthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+func (m *GenesisState) GetLogicCallConfirms() []MsgConfirmLogicCall {
+	if m != nil {
+		return m.LogicCallConfirms
 	}
-	if m.BridgeChainId != 0 {
-		n += 1 + sovGenesis(uint64(m.BridgeChainId))
+	return nil
+}
+
+func (m *GenesisState) GetAttestations() []Attestation {
+	if m != nil {
+		return m.Attestations
 	}
-	if m.SignedValsetsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedValsetsWindow))
+	return nil
+}
+
+func (m *GenesisState) GetDelegateKeys() []*MsgSetOrchestratorAddress {
+	if m != nil {
+		return m.DelegateKeys
 	}
-	if m.SignedBatchesWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
+	return nil
+}
+
+func (m *GenesisState) GetErc20ToDenoms() []*ERC20ToDenom {
+	if m != nil {
+		return m.Erc20ToDenoms
 	}
-	if m.SignedLogicCallsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedLogicCallsWindow))
+	return nil
+}
+
+func (m *GenesisState) GetUnbatchedTransfers() []*OutgoingTransferTx {
+	if m != nil {
+		return m.UnbatchedTransfers
 	}
-	if m.TargetBatchTimeout != 0 {
-		n += 1 + sovGenesis(uint64(m.TargetBatchTimeout))
+	return nil
+}
+
+func (m *GenesisState) GetModuleSendGrants() []ModuleSendGrant {
+	if m != nil {
+		return m.ModuleSendGrants
 	}
-	if m.AverageBlockTime != 0 {
-		n += 1 + sovGenesis(uint64(m.AverageBlockTime))
+	return nil
+}
+
+func (m *GenesisState) GetBridgeInstance() *BridgeInstance {
+	if m != nil {
+		return m.BridgeInstance
 	}
-	if m.AverageEthereumBlockTime != 0 {
-		n += 1 + sovGenesis(uint64(m.AverageEthereumBlockTime))
+	return nil
+}
+
+func (m *GenesisState) GetEthDestinationLabels() []EthDestinationLabel {
+	if m != nil {
+		return m.EthDestinationLabels
 	}
-	l = m.SlashFractionValset.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionBatch.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionLogicCall.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.UnbondSlashingValsetsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.UnbondSlashingValsetsWindow))
+	return nil
+}
+
+func (m *GenesisState) GetFirstSendDelays() []FirstSendDelay {
+	if m != nil {
+		return m.FirstSendDelays
 	}
-	l = m.SlashFractionBadEthSignature.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	l = m.ValsetReward.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	return n
+	return nil
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisState) GetAuditLog() []AuditLogEntry {
+	if m != nil {
+		return m.AuditLog
 	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+func (m *GenesisState) GetCallbackTransfers() []*OutgoingTransferTx {
+	if m != nil {
+		return m.CallbackTransfers
 	}
-	if m.LastObservedNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.LastObservedNonce))
+	return nil
+}
+
+func (m *GenesisState) GetMergedTransfers() []MergedTransfer {
+	if m != nil {
+		return m.MergedTransfers
 	}
-	if len(m.Valsets) > 0 {
-		for _, e := range m.Valsets {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return nil
+}
+
+func (m *GenesisState) GetQuarantinedDeposits() []DepositReceipt {
+	if m != nil {
+		return m.QuarantinedDeposits
 	}
-	if len(m.ValsetConfirms) > 0 {
-		for _, e := range m.ValsetConfirms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return nil
+}
+
+func (m *GenesisState) GetValsetRewardReserve() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ValsetRewardReserve
 	}
-	if len(m.Batches) > 0 {
-		for _, e := range m.Batches {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
+	proto.RegisterType((*TokenBatchSize)(nil), "gravity.v1.TokenBatchSize")
+	proto.RegisterType((*TokenSignedBatchesWindow)(nil), "gravity.v1.TokenSignedBatchesWindow")
+	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
+
+var fileDescriptor_387b0aba880adb60 = []byte{
+	// 2360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5b, 0x73, 0x1b, 0xb7,
+	0x15, 0xb6, 0x62, 0xc7, 0x17, 0xe8, 0x0e, 0x89, 0x32, 0x24, 0xcb, 0x12, 0xab, 0xc6, 0x8e, 0xe2,
+	0xda, 0xd4, 0xc5, 0x4e, 0xea, 0x38, 0x71, 0x6a, 0x8b, 0x92, 0x2f, 0x8d, 0x54, 0x69, 0x96, 0x72,
+	0x3b, 0x4d, 0xdb, 0xd9, 0x82, 0xbb, 0x87, 0xcb, 0x1d, 0xed, 0x85, 0x01, 0x40, 0x8a, 0xca, 0x4b,
+	0xfb, 0xdc, 0xa7, 0xfe, 0x8e, 0xbe, 0xf6, 0x4f, 0xe4, 0x31, 0x8f, 0x9d, 0x4e, 0x27, 0xed, 0xd8,
+	0x7f, 0xa4, 0x83, 0x03, 0xec, 0x85, 0xa2, 0x3c, 0xd5, 0x68, 0xfa, 0x24, 0x11, 0xdf, 0xf7, 0x9d,
+	0x03, 0x9c, 0x73, 0x00, 0x1c, 0x2c, 0x61, 0x81, 0xe0, 0xbd, 0x50, 0x9d, 0xac, 0xf5, 0x36, 0xd6,
+	0x02, 0x48, 0x40, 0x86, 0xb2, 0xd6, 0x11, 0xa9, 0x4a, 0x29, 0xb1, 0x48, 0xad, 0xb7, 0xb1, 0x30,
+	0x1b, 0xa4, 0x41, 0x8a, 0xc3, 0x6b, 0xfa, 0x3f, 0xc3, 0x58, 0x98, 0x2b, 0x69, 0xd5, 0x49, 0x07,
+	0xac, 0x72, 0xa1, 0x52, 0x1a, 0x8f, 0x65, 0x20, 0xcf, 0xa0, 0x37, 0xb9, 0xf2, 0xda, 0x76, 0x7c,
+	0xb1, 0x34, 0xce, 0x95, 0x02, 0xa9, 0xb8, 0x0a, 0xd3, 0xc4, 0xa2, 0x4b, 0x5e, 0x2a, 0xe3, 0x54,
+	0xae, 0x35, 0xb9, 0x84, 0xb5, 0xde, 0x46, 0x13, 0x14, 0xdf, 0x58, 0xf3, 0xd2, 0xd0, 0xe2, 0x2b,
+	0x7f, 0x5f, 0x26, 0x57, 0x0f, 0xb8, 0xe0, 0xb1, 0xa4, 0xb7, 0x49, 0x36, 0x67, 0x37, 0xf4, 0xd9,
+	0x48, 0x75, 0x64, 0xf5, 0x86, 0x73, 0xc3, 0x8e, 0xbc, 0xf6, 0xe9, 0x3a, 0x99, 0xf5, 0xd2, 0x44,
+	0x09, 0xee, 0x29, 0x57, 0xa6, 0x5d, 0xe1, 0x81, 0xdb, 0xe6, 0xb2, 0xcd, 0x3e, 0x40, 0x22, 0xcd,
+	0xb0, 0x06, 0x42, 0xaf, 0xb8, 0x6c, 0xd3, 0xcf, 0xc8, 0xcd, 0xa6, 0x08, 0xfd, 0x00, 0x5c, 0x50,
+	0x6d, 0x10, 0xd0, 0x8d, 0x5d, 0xee, 0xfb, 0x02, 0xa4, 0x64, 0x57, 0x50, 0x54, 0x31, 0xf0, 0x8e,
+	0x45, 0x9f, 0x1b, 0x90, 0xde, 0x25, 0x93, 0x56, 0xe7, 0xb5, 0x79, 0x98, 0xe8, 0xd9, 0x7c, 0x58,
+	0x1d, 0x59, 0xbd, 0xe2, 0x8c, 0x9b, 0xe1, 0xba, 0x1e, 0x7d, 0xed, 0xd3, 0x4d, 0x52, 0x91, 0x61,
+	0x90, 0x80, 0xef, 0xf6, 0x78, 0x24, 0x41, 0x49, 0xf7, 0x38, 0x4c, 0xfc, 0xf4, 0x98, 0x5d, 0x45,
+	0xf6, 0x8c, 0x01, 0x7f, 0x6d, 0xb0, 0xdf, 0x20, 0x54, 0xd2, 0x60, 0x0c, 0x21, 0xd7, 0x5c, 0x2b,
+	0x6b, 0xb6, 0x0c, 0x66, 0x35, 0x9f, 0x93, 0x79, 0xab, 0x89, 0xd2, 0x20, 0xf4, 0x5c, 0x8f, 0x47,
+	0x51, 0xae, 0xbb, 0x8e, 0xba, 0x39, 0x43, 0xd8, 0xd5, 0x78, 0x5d, 0xc3, 0x56, 0xba, 0x4e, 0x66,
+	0x15, 0x17, 0x01, 0x28, 0xe3, 0xce, 0x55, 0x61, 0x0c, 0x69, 0x57, 0xb1, 0x1b, 0xa8, 0xa2, 0x06,
+	0x43, 0x6f, 0x87, 0x06, 0xa1, 0xf7, 0x09, 0xe5, 0x3d, 0x10, 0x3c, 0x00, 0xb7, 0x19, 0xa5, 0xde,
+	0x11, 0x4a, 0x18, 0x41, 0xfe, 0x94, 0x45, 0xb6, 0x34, 0xa0, 0x05, 0xf4, 0x29, 0xb9, 0x95, 0xb1,
+	0xf3, 0x18, 0x97, 0x64, 0xa3, 0x28, 0x63, 0x96, 0x92, 0xc5, 0xb9, 0x90, 0x37, 0x49, 0x45, 0x46,
+	0x5c, 0xb6, 0xdd, 0x96, 0x4e, 0x5d, 0x98, 0x26, 0x36, 0x92, 0x6c, 0xac, 0x3a, 0xb2, 0x3a, 0xb6,
+	0x55, 0xfb, 0xfe, 0xc7, 0xe5, 0x4b, 0xff, 0xfc, 0x71, 0xf9, 0x6e, 0x10, 0xaa, 0x76, 0xb7, 0x59,
+	0xf3, 0xd2, 0x78, 0xcd, 0xd6, 0x93, 0xf9, 0xf3, 0x40, 0xfa, 0x47, 0xb6, 0x76, 0xb7, 0xc1, 0x73,
+	0x66, 0xd0, 0xd8, 0x0b, 0x6b, 0xcb, 0x04, 0x9e, 0xfe, 0x91, 0xcc, 0x9e, 0xf2, 0x81, 0xa1, 0x60,
+	0xe3, 0x17, 0x72, 0x41, 0x07, 0x5c, 0x60, 0xe4, 0x68, 0x48, 0xe6, 0x4f, 0x79, 0x28, 0xf2, 0xc4,
+	0x26, 0x2e, 0xe4, 0x66, 0x6e, 0xc0, 0x4d, 0x9e, 0x56, 0x5a, 0x27, 0x4b, 0xdd, 0xa4, 0x99, 0x26,
+	0xbe, 0x8b, 0x84, 0x30, 0x09, 0x4e, 0xd7, 0xde, 0x24, 0x86, 0xfc, 0x96, 0x61, 0x35, 0x2c, 0x69,
+	0xb0, 0x06, 0x7b, 0xa4, 0x3a, 0x14, 0x11, 0x5f, 0xe7, 0xcf, 0xd5, 0x55, 0xc4, 0x55, 0x57, 0x00,
+	0x9b, 0xba, 0xd0, 0xb4, 0x17, 0x4f, 0x45, 0xc7, 0xdf, 0x51, 0xed, 0x46, 0x66, 0x93, 0x6e, 0x93,
+	0x71, 0x33, 0x59, 0x57, 0xc0, 0x31, 0x17, 0x3e, 0x9b, 0xae, 0x8e, 0xac, 0x8e, 0x6e, 0xce, 0xd7,
+	0x8c, 0xad, 0x9a, 0x3e, 0x23, 0x6a, 0xf6, 0x8c, 0xa8, 0xd5, 0xd3, 0x30, 0xd9, 0xba, 0xa2, 0xfd,
+	0x3b, 0x63, 0x46, 0xe5, 0xa0, 0x88, 0x3e, 0x26, 0x2c, 0x2f, 0xb5, 0x4e, 0x7a, 0x0c, 0xc2, 0x55,
+	0x6d, 0x01, 0xb2, 0x9d, 0x46, 0x3e, 0xa3, 0x66, 0x33, 0x64, 0xf8, 0x81, 0x86, 0x0f, 0x33, 0x54,
+	0x9f, 0x07, 0xb9, 0xd2, 0x6e, 0x04, 0x37, 0xe6, 0x22, 0x08, 0x13, 0x36, 0x83, 0xc2, 0x4a, 0x06,
+	0xdb, 0xcd, 0xb0, 0x87, 0x20, 0x75, 0xc8, 0xdd, 0x33, 0x8a, 0x5b, 0xa7, 0x37, 0x6c, 0x0a, 0x3c,
+	0xec, 0xdc, 0x0e, 0x88, 0x30, 0xf5, 0xd9, 0x2c, 0x9a, 0x59, 0x81, 0xd3, 0x85, 0x5e, 0x2f, 0xa8,
+	0x07, 0xc8, 0xa4, 0x3b, 0x64, 0xb9, 0x74, 0x58, 0xba, 0x2d, 0x2e, 0x95, 0xdb, 0xe1, 0xaa, 0x5d,
+	0x5a, 0x4c, 0x05, 0x8d, 0x2d, 0x96, 0x68, 0x2f, 0xb8, 0x54, 0x07, 0x5c, 0xb5, 0x8b, 0x25, 0x3d,
+	0x23, 0x65, 0xdc, 0x85, 0x3e, 0x78, 0x5d, 0x93, 0xd1, 0xae, 0x1f, 0x80, 0x62, 0x73, 0x68, 0x63,
+	0xa1, 0xc4, 0xd9, 0xc9, 0x28, 0x5b, 0xc8, 0xa0, 0x5f, 0x90, 0x05, 0x9b, 0x14, 0x4f, 0x80, 0xb1,
+	0x12, 0x70, 0x99, 0xe9, 0x6f, 0xa2, 0xfe, 0xa6, 0x61, 0xd4, 0x2d, 0xe1, 0x25, 0x97, 0x56, 0x5c,
+	0x23, 0x33, 0x79, 0x1d, 0x96, 0x54, 0x0c, 0x55, 0xd3, 0x19, 0x54, 0xf0, 0xef, 0x13, 0xda, 0x11,
+	0xdd, 0xe4, 0x14, 0x7d, 0xde, 0x1c, 0x2e, 0x16, 0x29, 0xd8, 0x8f, 0xc8, 0x5c, 0x79, 0x71, 0x25,
+	0xc5, 0x02, 0x2a, 0x66, 0x4b, 0x68, 0xa1, 0x7a, 0x43, 0xe6, 0x04, 0x44, 0xfc, 0x04, 0x84, 0x1b,
+	0xa5, 0x4a, 0x81, 0x38, 0xc9, 0xca, 0xed, 0xd6, 0xf9, 0xca, 0x6d, 0xd6, 0xca, 0x77, 0x8d, 0xda,
+	0x96, 0xdd, 0xa3, 0x61, 0xb3, 0x76, 0xc7, 0x2d, 0x9a, 0xc9, 0x0c, 0xaa, 0xec, 0x56, 0x7b, 0x42,
+	0xe6, 0x5b, 0x00, 0xae, 0x97, 0x26, 0xad, 0x50, 0xc4, 0x66, 0x1d, 0x71, 0x37, 0x52, 0x61, 0x27,
+	0x02, 0x76, 0xdb, 0x04, 0xb7, 0x05, 0x50, 0x2f, 0xe1, 0x7b, 0x16, 0xa6, 0xdf, 0x90, 0xe9, 0xb4,
+	0xab, 0x5a, 0x51, 0x7a, 0xec, 0x76, 0xa5, 0xef, 0x46, 0x61, 0x1c, 0x2a, 0xb6, 0x74, 0xa1, 0x7d,
+	0x39, 0x69, 0x0d, 0xbd, 0x91, 0xfe, 0xae, 0x36, 0xa3, 0xef, 0x85, 0xcc, 0x36, 0xda, 0xcd, 0xd6,
+	0xb2, 0x6c, 0xee, 0x05, 0x8b, 0x21, 0xd7, 0xae, 0xe4, 0x11, 0x99, 0x93, 0x8a, 0x47, 0x91, 0x2b,
+	0xa0, 0xd5, 0x4d, 0xfc, 0x52, 0x9d, 0x56, 0xcd, 0xfa, 0x11, 0x75, 0x10, 0x2c, 0xea, 0x53, 0x17,
+	0x48, 0x59, 0x65, 0xf3, 0xf7, 0x13, 0x5b, 0x20, 0x85, 0xc4, 0x26, 0xef, 0x31, 0x61, 0x96, 0x29,
+	0xc0, 0x83, 0xb0, 0xa3, 0x8f, 0x0a, 0x05, 0x89, 0x8e, 0x0b, 0x5b, 0x31, 0x9b, 0xdb, 0xe0, 0x8e,
+	0x81, 0x9d, 0x0c, 0xd5, 0x97, 0x76, 0x27, 0x4d, 0x23, 0x57, 0xf5, 0xf3, 0x4b, 0xee, 0xa7, 0xe6,
+	0xd2, 0xd6, 0xc3, 0x87, 0xfd, 0xec, 0x7e, 0x7b, 0x48, 0xe6, 0x62, 0xde, 0xc7, 0xb3, 0xb9, 0xc9,
+	0xbd, 0x23, 0xd7, 0xe7, 0x8a, 0xbb, 0x32, 0xfc, 0x0e, 0xd8, 0x47, 0xe6, 0x06, 0x8e, 0x79, 0xbf,
+	0x6e, 0xc1, 0x6d, 0xae, 0x78, 0x23, 0xfc, 0x0e, 0xe8, 0x21, 0x99, 0x1b, 0x14, 0x34, 0x4f, 0x14,
+	0xb8, 0x2d, 0x00, 0x76, 0xe7, 0x7c, 0x35, 0x35, 0xe3, 0x95, 0x4c, 0x6e, 0x9d, 0x28, 0x78, 0x01,
+	0x40, 0x3f, 0x26, 0x53, 0xe6, 0x56, 0xd6, 0x95, 0xdd, 0xd1, 0x07, 0x59, 0x9f, 0xdd, 0xb5, 0x8d,
+	0x86, 0x1e, 0x7f, 0xc9, 0xe5, 0x01, 0x88, 0xc3, 0xbe, 0xde, 0x36, 0x05, 0x31, 0xed, 0x81, 0x68,
+	0x03, 0xf7, 0xd9, 0xc7, 0x66, 0xdb, 0x64, 0xd4, 0x7d, 0x3b, 0xae, 0x6b, 0xce, 0x87, 0x4e, 0x2a,
+	0x43, 0x75, 0x46, 0x10, 0x57, 0x4d, 0xcd, 0x59, 0xc2, 0x50, 0x14, 0x77, 0xc9, 0x6c, 0x1c, 0x26,
+	0xae, 0x04, 0x9d, 0xe1, 0x14, 0xef, 0x84, 0x16, 0x80, 0x64, 0x9f, 0x54, 0x2f, 0xaf, 0x8e, 0x6e,
+	0xce, 0xd5, 0x8a, 0xa6, 0xb2, 0xb6, 0xe3, 0xd4, 0x37, 0xd7, 0x0f, 0xd3, 0x23, 0xc8, 0xd6, 0x38,
+	0x15, 0x87, 0x49, 0x03, 0x12, 0xff, 0x30, 0xdd, 0x51, 0xed, 0x17, 0x00, 0x92, 0x7e, 0x44, 0x26,
+	0x74, 0xac, 0xcd, 0xdc, 0x31, 0xc6, 0xf7, 0xd0, 0xfd, 0x58, 0xcc, 0xfb, 0x78, 0x75, 0x62, 0x70,
+	0x1b, 0xa4, 0xa2, 0xb4, 0x19, 0x77, 0x90, 0x2b, 0xd9, 0xcf, 0xd0, 0xe9, 0x42, 0xd9, 0xa9, 0xf1,
+	0x97, 0x49, 0xad, 0x63, 0x8a, 0xf2, 0xbd, 0x92, 0x4d, 0x49, 0x57, 0xc8, 0x38, 0xa6, 0x39, 0xe2,
+	0x61, 0xec, 0xf2, 0x00, 0xd8, 0x7d, 0xf4, 0x3c, 0xaa, 0xb3, 0xab, 0xc7, 0x9e, 0x07, 0xa0, 0xfb,
+	0x2a, 0x01, 0xcd, 0x6e, 0x18, 0xf9, 0x58, 0x32, 0xbe, 0xab, 0x2f, 0x04, 0xdb, 0x96, 0xb1, 0x07,
+	0xd5, 0x91, 0xd5, 0xeb, 0xce, 0x9c, 0x25, 0xe8, 0xea, 0xf1, 0xf7, 0xbb, 0xca, 0x36, 0x66, 0xf4,
+	0xb7, 0x64, 0xbe, 0x1c, 0xa3, 0x8e, 0x08, 0x53, 0xa1, 0x1b, 0x57, 0x0c, 0x56, 0xad, 0x7a, 0xf9,
+	0x3c, 0x35, 0x51, 0x91, 0x59, 0xb0, 0x0e, 0xac, 0x1c, 0x83, 0xb6, 0x49, 0x2a, 0x31, 0x08, 0xdd,
+	0x7e, 0x99, 0x8e, 0x4d, 0xf0, 0x44, 0xb6, 0x40, 0x48, 0xb6, 0x86, 0x33, 0x9a, 0x41, 0xd0, 0xb4,
+	0x6c, 0x19, 0x44, 0xef, 0x91, 0x69, 0x2c, 0x7e, 0x1e, 0xe8, 0xa3, 0x15, 0xef, 0x28, 0xc9, 0xd6,
+	0x71, 0xc5, 0xb8, 0x2b, 0x9e, 0xeb, 0x71, 0xbc, 0x8d, 0x24, 0x7d, 0x4a, 0x16, 0x75, 0x64, 0x06,
+	0xa6, 0xcf, 0x4f, 0xa2, 0x94, 0xfb, 0x26, 0x45, 0x1b, 0xa6, 0x42, 0x62, 0xde, 0xcf, 0x93, 0x79,
+	0x60, 0x70, 0xcc, 0xd6, 0x13, 0xb2, 0xa0, 0xe5, 0x1d, 0x48, 0x7c, 0xed, 0x4b, 0xf5, 0x4d, 0xe9,
+	0x6a, 0x73, 0x20, 0xd8, 0xa6, 0xd9, 0xa3, 0x31, 0xef, 0x1f, 0x18, 0xc2, 0x61, 0x5f, 0xd7, 0x70,
+	0x03, 0x51, 0x7d, 0xea, 0xd8, 0x46, 0x16, 0xf3, 0x92, 0xf7, 0x2c, 0x0f, 0xcd, 0xa9, 0x63, 0x30,
+	0x4c, 0x4f, 0xd6, 0xaa, 0x0c, 0x37, 0x6f, 0xa8, 0x64, 0x8f, 0xfe, 0x0f, 0xcd, 0x1b, 0x3a, 0xa2,
+	0xc7, 0x43, 0xcd, 0x90, 0x3e, 0xac, 0xa3, 0xd0, 0x53, 0x7a, 0x79, 0xc6, 0xdb, 0xa7, 0x17, 0xf2,
+	0x76, 0x7b, 0xd0, 0x5b, 0x61, 0xd5, 0x38, 0x7e, 0x48, 0x2a, 0xe5, 0xdb, 0xad, 0xd8, 0xa2, 0x9f,
+	0x0d, 0x5d, 0x6e, 0xc5, 0xfe, 0xdc, 0x20, 0xba, 0x47, 0xb1, 0x19, 0xd6, 0xe9, 0x4b, 0x9b, 0x12,
+	0x44, 0x0f, 0xd8, 0xcf, 0x4d, 0x08, 0x41, 0xb5, 0x4d, 0x9a, 0x0f, 0xd3, 0x7d, 0x83, 0xe8, 0xae,
+	0xe7, 0xdb, 0x2e, 0x17, 0x3c, 0x51, 0xa1, 0x8e, 0xbc, 0x96, 0x9b, 0x64, 0x49, 0xf6, 0xb8, 0x7a,
+	0x59, 0xbf, 0x82, 0x4a, 0xb0, 0xee, 0xd7, 0x0c, 0xa8, 0x3b, 0x94, 0xbc, 0xeb, 0x69, 0x43, 0x18,
+	0xb4, 0x95, 0xeb, 0x8b, 0xb0, 0xa5, 0x4a, 0x27, 0xff, 0xe7, 0xa6, 0x43, 0xc9, 0x68, 0xaf, 0x90,
+	0xb5, 0xad, 0x49, 0xc5, 0x0d, 0xf0, 0x2d, 0xb9, 0x6d, 0xfb, 0x0b, 0xd3, 0xac, 0x79, 0x6d, 0x9e,
+	0x04, 0x50, 0x32, 0xf2, 0xe4, 0x42, 0xc1, 0xb5, 0x4d, 0x0b, 0x76, 0x78, 0x75, 0x34, 0x39, 0x70,
+	0xe9, 0xe8, 0x12, 0xb5, 0x6e, 0xc3, 0x44, 0x81, 0xe8, 0xf1, 0x88, 0x7d, 0x61, 0x2e, 0x9d, 0x98,
+	0xf7, 0x4d, 0x3b, 0xfc, 0xda, 0x02, 0xf4, 0x13, 0x32, 0x95, 0xf7, 0xa5, 0x59, 0x12, 0xbe, 0x34,
+	0x9b, 0x27, 0xeb, 0x3c, 0xb3, 0xf8, 0x1f, 0x91, 0x45, 0x73, 0x56, 0x9d, 0xf9, 0x88, 0x93, 0xec,
+	0x29, 0x6e, 0xfd, 0x8f, 0x86, 0x8e, 0xac, 0xc6, 0xf0, 0xb3, 0xce, 0x9e, 0x02, 0xf3, 0xea, 0x3d,
+	0xb8, 0xde, 0xa9, 0xb7, 0xa0, 0x07, 0x89, 0x72, 0x93, 0x34, 0xf1, 0xc0, 0x35, 0x17, 0x69, 0x11,
+	0xb8, 0xaf, 0xcc, 0xe3, 0x0a, 0x29, 0xbf, 0xd2, 0x8c, 0x86, 0x26, 0x14, 0x61, 0xb8, 0x43, 0x26,
+	0xe2, 0x30, 0x09, 0x63, 0x1e, 0xb9, 0xc8, 0x91, 0xec, 0x17, 0x78, 0x82, 0x8c, 0xdb, 0xd1, 0x1d,
+	0x1c, 0xd4, 0x97, 0x8b, 0x6e, 0x51, 0xa0, 0x0f, 0x71, 0x47, 0xe5, 0xa5, 0xf1, 0x0c, 0x4b, 0x63,
+	0xaa, 0x05, 0xb0, 0x83, 0x80, 0xad, 0x8a, 0x27, 0x57, 0xfe, 0xfc, 0xaf, 0xea, 0xa5, 0x95, 0x3f,
+	0x90, 0x89, 0xc1, 0x93, 0x58, 0x3b, 0x33, 0x81, 0xc9, 0xde, 0xe1, 0xf6, 0x01, 0x3f, 0x8e, 0xa3,
+	0x75, 0x3b, 0x78, 0xc6, 0x8d, 0xf0, 0xc1, 0xf0, 0x8d, 0xb0, 0xd2, 0x25, 0xec, 0x7d, 0x51, 0x3b,
+	0xaf, 0xa3, 0xf7, 0xbe, 0xb3, 0x3f, 0x78, 0xef, 0x3b, 0x7b, 0xe5, 0x2f, 0x63, 0x64, 0xec, 0xa5,
+	0xf9, 0x88, 0xd2, 0x50, 0x5c, 0x01, 0xbd, 0x47, 0xae, 0x76, 0xf0, 0xdb, 0x04, 0xfa, 0x18, 0xdd,
+	0xa4, 0xe5, 0xbc, 0x9a, 0xaf, 0x16, 0x8e, 0x65, 0xe8, 0xa2, 0x8b, 0xb8, 0x54, 0xd9, 0x86, 0xf4,
+	0x4d, 0xd2, 0xac, 0xbb, 0x69, 0x0d, 0xd9, 0x0d, 0xe9, 0x63, 0xae, 0xe8, 0x7d, 0x72, 0xcd, 0xbe,
+	0xdc, 0xd8, 0xe5, 0xea, 0xe5, 0xd3, 0xc6, 0x4d, 0x85, 0x3a, 0x19, 0x85, 0xee, 0x90, 0xc9, 0xac,
+	0x4b, 0x37, 0xad, 0xa2, 0xfe, 0x84, 0xa1, 0x55, 0x8b, 0x65, 0xd5, 0x9e, 0xb4, 0x2f, 0x3d, 0xdb,
+	0x4f, 0x3a, 0x13, 0xbd, 0xf2, 0x4f, 0x49, 0x3f, 0x25, 0xd7, 0xb2, 0xfb, 0xed, 0x43, 0x94, 0xdf,
+	0x2a, 0xcb, 0xf7, 0xbb, 0x2a, 0x48, 0xf1, 0xcc, 0xc6, 0xb8, 0x38, 0x19, 0x97, 0xbe, 0x22, 0x13,
+	0xf8, 0x6f, 0xe1, 0xfc, 0xea, 0xb0, 0x7a, 0x4f, 0x06, 0xd6, 0x0f, 0xaa, 0x6d, 0x79, 0x9b, 0x4e,
+	0x26, 0x9f, 0xc0, 0x57, 0x64, 0xb4, 0xf4, 0x0d, 0x83, 0x5d, 0x43, 0x33, 0xb7, 0xcf, 0x9a, 0x44,
+	0xfe, 0xe6, 0x75, 0x48, 0x94, 0xfd, 0x2b, 0xe9, 0x1b, 0x32, 0x53, 0xe8, 0x8b, 0xe9, 0x5c, 0x47,
+	0x3b, 0xcb, 0x67, 0x4f, 0x27, 0xb7, 0x64, 0xa7, 0x34, 0x9d, 0xdb, 0xcb, 0xa7, 0xf5, 0x9c, 0x8c,
+	0x95, 0x8e, 0x5b, 0xc9, 0x6e, 0xa0, 0xbd, 0x9b, 0x65, 0x7b, 0xcf, 0x0b, 0x3c, 0x7b, 0x96, 0x96,
+	0x25, 0xf4, 0x97, 0x64, 0xdc, 0x87, 0x08, 0x02, 0xae, 0xc0, 0x3d, 0x82, 0x13, 0xc9, 0x08, 0xda,
+	0xb8, 0x73, 0x6a, 0x4e, 0x0d, 0x50, 0xfb, 0x42, 0x07, 0x55, 0x09, 0xae, 0x52, 0x61, 0x3f, 0x39,
+	0x39, 0x63, 0x99, 0xf6, 0x6b, 0x38, 0x91, 0xf4, 0x19, 0x99, 0x04, 0xe1, 0x6d, 0xae, 0xeb, 0x03,
+	0xde, 0x87, 0x24, 0x8d, 0x25, 0x1b, 0x45, 0x6b, 0xec, 0x8c, 0x06, 0x6c, 0x5b, 0x13, 0x9c, 0x71,
+	0x14, 0xd8, 0x5f, 0x92, 0xee, 0x93, 0x99, 0x6e, 0x62, 0xd2, 0xe7, 0x97, 0x5a, 0x88, 0x31, 0xb4,
+	0xb2, 0x74, 0x66, 0xd2, 0x2d, 0xe9, 0xb0, 0xef, 0xd0, 0x5c, 0x5a, 0x74, 0x18, 0xfb, 0x84, 0xc6,
+	0xa9, 0xdf, 0x8d, 0xc0, 0x34, 0x0e, 0x81, 0xbe, 0x30, 0x24, 0x1b, 0x3f, 0xa3, 0x0c, 0x90, 0xa5,
+	0x8f, 0x8b, 0x97, 0x9a, 0x93, 0xf7, 0x86, 0x83, 0xc3, 0x92, 0xd6, 0xf3, 0x8f, 0x6c, 0x61, 0x22,
+	0x15, 0xd7, 0x7b, 0x65, 0xa2, 0x3a, 0x72, 0xba, 0xdf, 0xdb, 0x42, 0xca, 0x6b, 0xcb, 0x70, 0x26,
+	0x9a, 0x03, 0xbf, 0xe9, 0xef, 0x88, 0x7e, 0xeb, 0xbb, 0x3e, 0x48, 0x15, 0x26, 0xe6, 0x1e, 0x8d,
+	0x78, 0x13, 0x22, 0xc9, 0x26, 0x87, 0x2b, 0x62, 0x47, 0xb5, 0xb7, 0x0b, 0xe2, 0xae, 0xe6, 0x65,
+	0x2f, 0x3e, 0x18, 0x86, 0x24, 0xdd, 0x25, 0xd3, 0xad, 0x50, 0x48, 0x73, 0x26, 0xba, 0xbe, 0x7e,
+	0xde, 0x49, 0x36, 0x35, 0xdc, 0x93, 0xbe, 0xd0, 0x24, 0xbd, 0xb2, 0x6d, 0x4d, 0xb1, 0x26, 0x27,
+	0x5b, 0x03, 0xa3, 0x92, 0x7e, 0x49, 0x6e, 0xf0, 0xae, 0x1f, 0x2a, 0xfd, 0x6d, 0x88, 0x4d, 0xdb,
+	0x0e, 0xb1, 0x5c, 0x5f, 0x1a, 0xdc, 0x4d, 0x83, 0x9d, 0x44, 0x89, 0xcc, 0xc8, 0x75, 0x6e, 0x07,
+	0xe9, 0x1e, 0xa1, 0xf9, 0x03, 0xa4, 0x48, 0x27, 0x3d, 0x57, 0x3a, 0xa7, 0x33, 0x65, 0x91, 0xcd,
+	0xaf, 0xc9, 0x14, 0xb6, 0x91, 0xe5, 0xda, 0x98, 0x19, 0x5e, 0xd9, 0x1e, 0x72, 0x32, 0x59, 0xb6,
+	0xb2, 0x78, 0x60, 0x54, 0xd2, 0x06, 0x99, 0x2d, 0x37, 0x18, 0xf6, 0x69, 0x21, 0xd9, 0xec, 0xb0,
+	0xc1, 0xed, 0x81, 0x67, 0x47, 0xf6, 0x36, 0x2a, 0xa9, 0x2d, 0x41, 0xd2, 0x3f, 0x91, 0xca, 0xc0,
+	0xb7, 0x22, 0x57, 0x80, 0x69, 0x74, 0x2a, 0xff, 0xab, 0xb9, 0x5e, 0xd7, 0x46, 0xff, 0xf6, 0xef,
+	0xe5, 0xd5, 0x73, 0x74, 0x12, 0x5a, 0x20, 0x9d, 0x99, 0xf2, 0xf7, 0x25, 0xc7, 0xf8, 0xd9, 0xfa,
+	0xfd, 0xf7, 0x6f, 0x97, 0x46, 0x7e, 0x78, 0xbb, 0x34, 0xf2, 0x9f, 0xb7, 0x4b, 0x23, 0x7f, 0x7d,
+	0xb7, 0x74, 0xe9, 0x87, 0x77, 0x4b, 0x97, 0xfe, 0xf1, 0x6e, 0xe9, 0xd2, 0x37, 0x5b, 0x25, 0xc3,
+	0x3c, 0x52, 0x6d, 0xe0, 0x0f, 0x12, 0x50, 0x99, 0x71, 0xbb, 0xda, 0x07, 0xa6, 0x52, 0xd7, 0x4c,
+	0xdd, 0xaf, 0xf5, 0xd7, 0xec, 0xb8, 0x71, 0xdc, 0xbc, 0x8a, 0x5f, 0xbf, 0x1f, 0xfe, 0x77, 0x00,
+	0xbd, 0x17, 0x8f, 0x01, 0xc0, 0x17, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.BatchConfirms) > 0 {
-		for _, e := range m.BatchConfirms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeExemptSenders) > 0 {
+		for iNdEx := len(m.FeeExemptSenders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeExemptSenders[iNdEx])
+			copy(dAtA[i:], m.FeeExemptSenders[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FeeExemptSenders[iNdEx])))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x82
 		}
 	}
-	if len(m.LogicCalls) > 0 {
-		for _, e := range m.LogicCalls {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.MinimalEvents {
+		i--
+		if m.MinimalEvents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xf8
 	}
-	if len(m.LogicCallConfirms) > 0 {
-		for _, e := range m.LogicCallConfirms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.EventNonceStallThreshold != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EventNonceStallThreshold))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xf0
+	}
+	if len(m.TokenSignedBatchesWindows) > 0 {
+		for iNdEx := len(m.TokenSignedBatchesWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenSignedBatchesWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xea
 		}
 	}
-	if len(m.Attestations) > 0 {
-		for _, e := range m.Attestations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.ValsetRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ValsetRetention))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.MaxValsetInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxValsetInterval))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd8
+	}
+	{
+		size := m.ValsetPowerChangeThreshold.Size()
+		i -= size
+		if _, err := m.ValsetPowerChangeThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.DelegateKeys) > 0 {
-		for _, e := range m.DelegateKeys {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xd2
+	if m.EthereumHeightDriftThreshold != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumHeightDriftThreshold))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.QuarantinedEthSenders) > 0 {
+		for iNdEx := len(m.QuarantinedEthSenders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QuarantinedEthSenders[iNdEx])
+			copy(dAtA[i:], m.QuarantinedEthSenders[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.QuarantinedEthSenders[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for _, e := range m.Erc20ToDenoms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.EthBlocksToObserve != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EthBlocksToObserve))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.AttestationRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AttestationRetention))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb0
+	}
+	{
+		size := m.SlashFractionConflictingClaim.Size()
+		i -= size
+		if _, err := m.SlashFractionConflictingClaim.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.UnbatchedTransfers) > 0 {
-		for _, e := range m.UnbatchedTransfers {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.SlashFractionClaim.Size()
+		i -= size
+		if _, err := m.SlashFractionClaim.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xa2
+	if m.SignedClaimsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedClaimsWindow))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MaxPendingTxsPerSender != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPendingTxsPerSender))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxSendToEthPayloadSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxSendToEthPayloadSize))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.PoolAgingBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolAgingBlocks))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MergeBatchTransfers {
+		i--
+		if m.MergeBatchTransfers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf8
+	}
+	if len(m.SendToEthPriorityFees) > 0 {
+		for iNdEx := len(m.SendToEthPriorityFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendToEthPriorityFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if m.RebuildTimedOutBatches {
+		i--
+		if m.RebuildTimedOutBatches {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.MaxClaimAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxClaimAge))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.TokenMaxBatchSizes) > 0 {
+		for iNdEx := len(m.TokenMaxBatchSizes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenMaxBatchSizes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.MinSendToEthFees) > 0 {
+		for iNdEx := len(m.MinSendToEthFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinSendToEthFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.DepositReceiptRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DepositReceiptRetention))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.BatchGasOverhead != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchGasOverhead))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.BatchGasPerTx != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchGasPerTx))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
+	}
+	{
+		size, err := m.CallbackDataByteFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xaa
+	if m.MaxCallbackDataSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxCallbackDataSize))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.PoolTxTimeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolTxTimeout))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.RefundReceiptRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RefundReceiptRetention))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.StallRefundBudget != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StallRefundBudget))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.StallRefundThreshold != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StallRefundThreshold))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.OutflowLimitWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OutflowLimitWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	{
+		size := m.OutflowUsdLimit.Size()
+		i -= size
+		if _, err := m.OutflowUsdLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf2
+	if m.FeeConfirmationMultiple != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FeeConfirmationMultiple))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.RelayerLotteryWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RelayerLotteryWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	{
+		size, err := m.RelayerLotteryReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	if m.AttestationGasBudget != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AttestationGasBudget))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.PruningGasBudget != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PruningGasBudget))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.SlashingGasBudget != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SlashingGasBudget))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.ValsetCreationGasBudget != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ValsetCreationGasBudget))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.AttestationExecutionBudget != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AttestationExecutionBudget))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.AttestationFastPathThreshold != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AttestationFastPathThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.EthereumBlockTimeCalibrationPeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumBlockTimeCalibrationPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.EthereumTimeoutMargin != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumTimeoutMargin))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.EthereumPowerThreshold != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumPowerThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size, err := m.ValsetReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.SlashFractionBadEthSignature.Size()
+		i -= size
+		if _, err := m.SlashFractionBadEthSignature.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.UnbondSlashingValsetsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingValsetsWindow))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.SlashFractionLogicCall.Size()
+		i -= size
+		if _, err := m.SlashFractionLogicCall.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.SlashFractionBatch.Size()
+		i -= size
+		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.SlashFractionValset.Size()
+		i -= size
+		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.AverageEthereumBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.AverageBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TargetBatchTimeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBatchTimeout))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SignedLogicCallsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedLogicCallsWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SignedBatchesWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SignedValsetsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedValsetsWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BridgeChainId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractSourceHash) > 0 {
+		i -= len(m.ContractSourceHash)
+		copy(dAtA[i:], m.ContractSourceHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenBatchSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenBatchSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenBatchSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenSignedBatchesWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenSignedBatchesWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenSignedBatchesWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignedBatchesWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValsetRewardReserve) > 0 {
+		for iNdEx := len(m.ValsetRewardReserve) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValsetRewardReserve[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.QuarantinedDeposits) > 0 {
+		for iNdEx := len(m.QuarantinedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuarantinedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.MergedTransfers) > 0 {
+		for iNdEx := len(m.MergedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MergedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.CallbackTransfers) > 0 {
+		for iNdEx := len(m.CallbackTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CallbackTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.AuditLog) > 0 {
+		for iNdEx := len(m.AuditLog) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditLog[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.FirstSendDelays) > 0 {
+		for iNdEx := len(m.FirstSendDelays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FirstSendDelays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.EthDestinationLabels) > 0 {
+		for iNdEx := len(m.EthDestinationLabels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthDestinationLabels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.BridgeInstance != nil {
+		{
+			size, err := m.BridgeInstance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ModuleSendGrants) > 0 {
+		for iNdEx := len(m.ModuleSendGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleSendGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.UnbatchedTransfers) > 0 {
+		for iNdEx := len(m.UnbatchedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbatchedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LogicCallConfirms) > 0 {
+		for iNdEx := len(m.LogicCallConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCallConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LogicCalls) > 0 {
+		for iNdEx := len(m.LogicCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BatchConfirms) > 0 {
+		for iNdEx := len(m.BatchConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ValsetConfirms) > 0 {
+		for iNdEx := len(m.ValsetConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValsetConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Valsets) > 0 {
+		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LastObservedNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ContractSourceHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BridgeChainId != 0 {
+		n += 1 + sovGenesis(uint64(m.BridgeChainId))
+	}
+	if m.SignedValsetsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedValsetsWindow))
+	}
+	if m.SignedBatchesWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
+	}
+	if m.SignedLogicCallsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedLogicCallsWindow))
+	}
+	if m.TargetBatchTimeout != 0 {
+		n += 1 + sovGenesis(uint64(m.TargetBatchTimeout))
+	}
+	if m.AverageBlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.AverageBlockTime))
+	}
+	if m.AverageEthereumBlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.AverageEthereumBlockTime))
+	}
+	l = m.SlashFractionValset.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionBatch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionLogicCall.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.UnbondSlashingValsetsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.UnbondSlashingValsetsWindow))
+	}
+	l = m.SlashFractionBadEthSignature.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.ValsetReward.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.EthereumPowerThreshold != 0 {
+		n += 2 + sovGenesis(uint64(m.EthereumPowerThreshold))
+	}
+	if m.EthereumTimeoutMargin != 0 {
+		n += 2 + sovGenesis(uint64(m.EthereumTimeoutMargin))
+	}
+	if m.EthereumBlockTimeCalibrationPeriod != 0 {
+		n += 2 + sovGenesis(uint64(m.EthereumBlockTimeCalibrationPeriod))
+	}
+	if m.AttestationFastPathThreshold != 0 {
+		n += 2 + sovGenesis(uint64(m.AttestationFastPathThreshold))
+	}
+	if m.AttestationExecutionBudget != 0 {
+		n += 2 + sovGenesis(uint64(m.AttestationExecutionBudget))
+	}
+	if m.ValsetCreationGasBudget != 0 {
+		n += 2 + sovGenesis(uint64(m.ValsetCreationGasBudget))
+	}
+	if m.SlashingGasBudget != 0 {
+		n += 2 + sovGenesis(uint64(m.SlashingGasBudget))
+	}
+	if m.PruningGasBudget != 0 {
+		n += 2 + sovGenesis(uint64(m.PruningGasBudget))
+	}
+	if m.AttestationGasBudget != 0 {
+		n += 2 + sovGenesis(uint64(m.AttestationGasBudget))
+	}
+	l = m.RelayerLotteryReward.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.RelayerLotteryWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.RelayerLotteryWindow))
+	}
+	if m.FeeConfirmationMultiple != 0 {
+		n += 2 + sovGenesis(uint64(m.FeeConfirmationMultiple))
+	}
+	l = m.OutflowUsdLimit.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.OutflowLimitWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.OutflowLimitWindow))
+	}
+	if m.StallRefundThreshold != 0 {
+		n += 2 + sovGenesis(uint64(m.StallRefundThreshold))
+	}
+	if m.StallRefundBudget != 0 {
+		n += 2 + sovGenesis(uint64(m.StallRefundBudget))
+	}
+	if m.RefundReceiptRetention != 0 {
+		n += 2 + sovGenesis(uint64(m.RefundReceiptRetention))
+	}
+	if m.PoolTxTimeout != 0 {
+		n += 2 + sovGenesis(uint64(m.PoolTxTimeout))
+	}
+	if m.MaxCallbackDataSize != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxCallbackDataSize))
+	}
+	l = m.CallbackDataByteFee.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.BatchGasPerTx != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchGasPerTx))
+	}
+	if m.BatchGasOverhead != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchGasOverhead))
+	}
+	if m.DepositReceiptRetention != 0 {
+		n += 2 + sovGenesis(uint64(m.DepositReceiptRetention))
+	}
+	if len(m.MinSendToEthFees) > 0 {
+		for _, e := range m.MinSendToEthFees {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxBatchSize != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxBatchSize))
+	}
+	if len(m.TokenMaxBatchSizes) > 0 {
+		for _, e := range m.TokenMaxBatchSizes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxClaimAge != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxClaimAge))
+	}
+	if m.RebuildTimedOutBatches {
+		n += 3
+	}
+	if len(m.SendToEthPriorityFees) > 0 {
+		for _, e := range m.SendToEthPriorityFees {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MergeBatchTransfers {
+		n += 3
+	}
+	if m.PoolAgingBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.PoolAgingBlocks))
+	}
+	if m.MaxSendToEthPayloadSize != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxSendToEthPayloadSize))
+	}
+	if m.MaxPendingTxsPerSender != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxPendingTxsPerSender))
+	}
+	if m.SignedClaimsWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.SignedClaimsWindow))
+	}
+	l = m.SlashFractionClaim.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionConflictingClaim.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.AttestationRetention != 0 {
+		n += 2 + sovGenesis(uint64(m.AttestationRetention))
+	}
+	if m.EthBlocksToObserve != 0 {
+		n += 2 + sovGenesis(uint64(m.EthBlocksToObserve))
+	}
+	if len(m.QuarantinedEthSenders) > 0 {
+		for _, s := range m.QuarantinedEthSenders {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EthereumHeightDriftThreshold != 0 {
+		n += 2 + sovGenesis(uint64(m.EthereumHeightDriftThreshold))
+	}
+	l = m.ValsetPowerChangeThreshold.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.MaxValsetInterval != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxValsetInterval))
+	}
+	if m.ValsetRetention != 0 {
+		n += 2 + sovGenesis(uint64(m.ValsetRetention))
+	}
+	if len(m.TokenSignedBatchesWindows) > 0 {
+		for _, e := range m.TokenSignedBatchesWindows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EventNonceStallThreshold != 0 {
+		n += 2 + sovGenesis(uint64(m.EventNonceStallThreshold))
+	}
+	if m.MinimalEvents {
+		n += 3
+	}
+	if len(m.FeeExemptSenders) > 0 {
+		for _, s := range m.FeeExemptSenders {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenBatchSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxBatchSize))
+	}
+	return n
+}
+
+func (m *TokenSignedBatchesWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.SignedBatchesWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastObservedNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastObservedNonce))
+	}
+	if len(m.Valsets) > 0 {
+		for _, e := range m.Valsets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValsetConfirms) > 0 {
+		for _, e := range m.ValsetConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchConfirms) > 0 {
+		for _, e := range m.BatchConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LogicCalls) > 0 {
+		for _, e := range m.LogicCalls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LogicCallConfirms) > 0 {
+		for _, e := range m.LogicCallConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for _, e := range m.DelegateKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for _, e := range m.Erc20ToDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbatchedTransfers) > 0 {
+		for _, e := range m.UnbatchedTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ModuleSendGrants) > 0 {
+		for _, e := range m.ModuleSendGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BridgeInstance != nil {
+		l = m.BridgeInstance.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.EthDestinationLabels) > 0 {
+		for _, e := range m.EthDestinationLabels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FirstSendDelays) > 0 {
+		for _, e := range m.FirstSendDelays {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuditLog) > 0 {
+		for _, e := range m.AuditLog {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CallbackTransfers) > 0 {
+		for _, e := range m.CallbackTransfers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MergedTransfers) > 0 {
+		for _, e := range m.MergedTransfers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QuarantinedDeposits) > 0 {
+		for _, e := range m.QuarantinedDeposits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValsetRewardReserve) > 0 {
+		for _, e := range m.ValsetRewardReserve {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedValsetsWindow", wireType)
+			}
+			m.SignedValsetsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedValsetsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			}
+			m.SignedBatchesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedLogicCallsWindow", wireType)
+			}
+			m.SignedLogicCallsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedLogicCallsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBatchTimeout", wireType)
+			}
+			m.TargetBatchTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBatchTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			m.AverageBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			}
+			m.AverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLogicCall", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionLogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingValsetsWindow", wireType)
+			}
+			m.UnbondSlashingValsetsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondSlashingValsetsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBadEthSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBadEthSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValsetReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumPowerThreshold", wireType)
+			}
+			m.EthereumPowerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumPowerThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumTimeoutMargin", wireType)
+			}
+			m.EthereumTimeoutMargin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumTimeoutMargin |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockTimeCalibrationPeriod", wireType)
+			}
+			m.EthereumBlockTimeCalibrationPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumBlockTimeCalibrationPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationFastPathThreshold", wireType)
+			}
+			m.AttestationFastPathThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationFastPathThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationExecutionBudget", wireType)
+			}
+			m.AttestationExecutionBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationExecutionBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetCreationGasBudget", wireType)
+			}
+			m.ValsetCreationGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetCreationGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingGasBudget", wireType)
+			}
+			m.SlashingGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashingGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningGasBudget", wireType)
+			}
+			m.PruningGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruningGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationGasBudget", wireType)
+			}
+			m.AttestationGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerLotteryReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RelayerLotteryReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerLotteryWindow", wireType)
+			}
+			m.RelayerLotteryWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayerLotteryWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeConfirmationMultiple", wireType)
+			}
+			m.FeeConfirmationMultiple = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeConfirmationMultiple |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowUsdLimit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutflowUsdLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowLimitWindow", wireType)
+			}
+			m.OutflowLimitWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutflowLimitWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StallRefundThreshold", wireType)
+			}
+			m.StallRefundThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StallRefundThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StallRefundBudget", wireType)
+			}
+			m.StallRefundBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StallRefundBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundReceiptRetention", wireType)
+			}
+			m.RefundReceiptRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundReceiptRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTxTimeout", wireType)
+			}
+			m.PoolTxTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolTxTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallbackDataSize", wireType)
+			}
+			m.MaxCallbackDataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallbackDataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackDataByteFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CallbackDataByteFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchGasPerTx", wireType)
+			}
+			m.BatchGasPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchGasPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchGasOverhead", wireType)
+			}
+			m.BatchGasOverhead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchGasOverhead |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositReceiptRetention", wireType)
+			}
+			m.DepositReceiptRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositReceiptRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSendToEthFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinSendToEthFees = append(m.MinSendToEthFees, ERC20Token{})
+			if err := m.MinSendToEthFees[len(m.MinSendToEthFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMaxBatchSizes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenMaxBatchSizes = append(m.TokenMaxBatchSizes, TokenBatchSize{})
+			if err := m.TokenMaxBatchSizes[len(m.TokenMaxBatchSizes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClaimAge", wireType)
+			}
+			m.MaxClaimAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxClaimAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebuildTimedOutBatches", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RebuildTimedOutBatches = bool(v != 0)
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthPriorityFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendToEthPriorityFees = append(m.SendToEthPriorityFees, types.Coin{})
+			if err := m.SendToEthPriorityFees[len(m.SendToEthPriorityFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeBatchTransfers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MergeBatchTransfers = bool(v != 0)
+		case 48:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAgingBlocks", wireType)
+			}
+			m.PoolAgingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolAgingBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 49:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSendToEthPayloadSize", wireType)
+			}
+			m.MaxSendToEthPayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSendToEthPayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 50:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingTxsPerSender", wireType)
+			}
+			m.MaxPendingTxsPerSender = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPendingTxsPerSender |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedClaimsWindow", wireType)
+			}
+			m.SignedClaimsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedClaimsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionClaim", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingClaim", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionConflictingClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 54:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationRetention", wireType)
+			}
+			m.AttestationRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 55:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBlocksToObserve", wireType)
+			}
+			m.EthBlocksToObserve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthBlocksToObserve |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedEthSenders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuarantinedEthSenders = append(m.QuarantinedEthSenders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 57:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeightDriftThreshold", wireType)
+			}
+			m.EthereumHeightDriftThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeightDriftThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetPowerChangeThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValsetPowerChangeThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 59:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValsetInterval", wireType)
+			}
+			m.MaxValsetInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValsetInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 60:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetRetention", wireType)
+			}
+			m.ValsetRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 61:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenSignedBatchesWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenSignedBatchesWindows = append(m.TokenSignedBatchesWindows, TokenSignedBatchesWindow{})
+			if err := m.TokenSignedBatchesWindows[len(m.TokenSignedBatchesWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 62:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonceStallThreshold", wireType)
+			}
+			m.EventNonceStallThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonceStallThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 63:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimalEvents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinimalEvents = bool(v != 0)
+		case 64:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExemptSenders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeExemptSenders = append(m.FeeExemptSenders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *TokenBatchSize) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -877,15 +4021,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenBatchSize: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenBatchSize: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -913,13 +4057,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GravityId = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
 			}
-			var stringLen uint64
+			m.MaxBatchSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -929,27 +4073,67 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxBatchSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenSignedBatchesWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenSignedBatchesWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenSignedBatchesWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -977,13 +4161,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
 			}
-			m.BridgeChainId = 0
+			m.SignedBatchesWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -993,73 +4177,69 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeChainId |= uint64(b&0x7F) << shift
+				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedValsetsWindow", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.SignedValsetsWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SignedValsetsWindow |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.SignedBatchesWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedLogicCallsWindow", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			m.SignedLogicCallsWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SignedLogicCallsWindow |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBatchTimeout", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.TargetBatchTimeout = 0
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1069,35 +4249,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetBatchTimeout |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.AverageBlockTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AverageBlockTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 11:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedNonce", wireType)
 			}
-			m.AverageEthereumBlockTime = 0
+			m.LastObservedNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1107,16 +4285,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				m.LastObservedNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1126,30 +4304,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Valsets = append(m.Valsets, &Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1159,30 +4338,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValsetConfirms = append(m.ValsetConfirms, &MsgValsetConfirm{})
+			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLogicCall", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1192,30 +4372,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionLogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Batches = append(m.Batches, &OutgoingTxBatch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingValsetsWindow", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
 			}
-			m.UnbondSlashingValsetsWindow = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1225,16 +4406,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondSlashingValsetsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
+			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBadEthSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1244,28 +4440,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBadEthSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LogicCalls = append(m.LogicCalls, &OutgoingLogicCall{})
+			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1292,66 +4489,48 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValsetReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
+			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenesis
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1378,18 +4557,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegateKeys = append(m.DelegateKeys, &MsgSetOrchestratorAddress{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedNonce", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
 			}
-			m.LastObservedNonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1399,14 +4576,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastObservedNonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1433,14 +4625,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valsets = append(m.Valsets, &Valset{})
-			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnbatchedTransfers = append(m.UnbatchedTransfers, &OutgoingTransferTx{})
+			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleSendGrants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1467,14 +4659,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValsetConfirms = append(m.ValsetConfirms, &MsgValsetConfirm{})
-			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ModuleSendGrants = append(m.ModuleSendGrants, ModuleSendGrant{})
+			if err := m.ModuleSendGrants[len(m.ModuleSendGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeInstance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1501,14 +4693,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Batches = append(m.Batches, &OutgoingTxBatch{})
-			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BridgeInstance == nil {
+				m.BridgeInstance = &BridgeInstance{}
+			}
+			if err := m.BridgeInstance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthDestinationLabels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1535,14 +4729,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
-			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EthDestinationLabels = append(m.EthDestinationLabels, EthDestinationLabel{})
+			if err := m.EthDestinationLabels[len(m.EthDestinationLabels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstSendDelays", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1569,14 +4763,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicCalls = append(m.LogicCalls, &OutgoingLogicCall{})
-			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FirstSendDelays = append(m.FirstSendDelays, FirstSendDelay{})
+			if err := m.FirstSendDelays[len(m.FirstSendDelays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLog", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1603,14 +4797,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
-			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AuditLog = append(m.AuditLog, AuditLogEntry{})
+			if err := m.AuditLog[len(m.AuditLog)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackTransfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1637,14 +4831,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attestations = append(m.Attestations, Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CallbackTransfers = append(m.CallbackTransfers, &OutgoingTransferTx{})
+			if err := m.CallbackTransfers[len(m.CallbackTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergedTransfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1671,14 +4865,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateKeys = append(m.DelegateKeys, &MsgSetOrchestratorAddress{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MergedTransfers = append(m.MergedTransfers, MergedTransfer{})
+			if err := m.MergedTransfers[len(m.MergedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedDeposits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1705,14 +4899,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
-			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.QuarantinedDeposits = append(m.QuarantinedDeposits, DepositReceipt{})
+			if err := m.QuarantinedDeposits[len(m.QuarantinedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetRewardReserve", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1739,8 +4933,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbatchedTransfers = append(m.UnbatchedTransfers, &OutgoingTransferTx{})
-			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValsetRewardReserve = append(m.ValsetRewardReserve, types.Coin{})
+			if err := m.ValsetRewardReserve[len(m.ValsetRewardReserve)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
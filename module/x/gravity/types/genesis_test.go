@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math"
+	"strings"
 	"testing"
 
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -34,18 +36,74 @@ func TestGenesisStateValidate(t *testing.T) {
 					Denom:  "",
 					Amount: types.Int{},
 				},
+				EthereumPowerThreshold:             0,
+				EthereumTimeoutMargin:              0,
+				EthereumBlockTimeCalibrationPeriod: 0,
+				AttestationFastPathThreshold:       0,
+				AttestationExecutionBudget:         0,
+				ValsetCreationGasBudget:            0,
+				SlashingGasBudget:                  0,
+				PruningGasBudget:                   0,
+				AttestationGasBudget:               0,
+				RelayerLotteryReward:               types.Coin{Denom: "", Amount: types.Int{}},
+				RelayerLotteryWindow:               0,
+				FeeConfirmationMultiple:            0,
+				OutflowUsdLimit:                    types.Dec{},
+				OutflowLimitWindow:                 0,
+				StallRefundThreshold:               0,
+				StallRefundBudget:                  0,
+				RefundReceiptRetention:             0,
+				PoolTxTimeout:                      0,
+				MaxCallbackDataSize:                0,
+				CallbackDataByteFee:                types.Coin{Denom: "", Amount: types.Int{}},
+				BatchGasPerTx:                      0,
+				BatchGasOverhead:                   0,
+				DepositReceiptRetention:            0,
+				MinSendToEthFees:                   []ERC20Token{},
+				MaxBatchSize:                       0,
+				TokenMaxBatchSizes:                 []TokenBatchSize{},
+				MaxClaimAge:                        0,
+				RebuildTimedOutBatches:             false,
+				SendToEthPriorityFees:              []types.Coin{},
+				MergeBatchTransfers:                false,
+				PoolAgingBlocks:                    0,
+				MaxSendToEthPayloadSize:            0,
+				MaxPendingTxsPerSender:             0,
+				SignedClaimsWindow:                 0,
+				SlashFractionClaim:                 types.Dec{},
+				SlashFractionConflictingClaim:      types.Dec{},
+				AttestationRetention:               0,
+				EthBlocksToObserve:                 0,
+				QuarantinedEthSenders:              []string{},
+				EthereumHeightDriftThreshold:       0,
+				ValsetPowerChangeThreshold:         types.Dec{},
+				MaxValsetInterval:                  0,
+				ValsetRetention:                    0,
+				TokenSignedBatchesWindows:          []TokenSignedBatchesWindow{},
+				EventNonceStallThreshold:           0,
+				MinimalEvents:                      false,
+				FeeExemptSenders:                   []string{},
 			},
-			LastObservedNonce:  0,
-			Valsets:            []*Valset{},
-			ValsetConfirms:     []*MsgValsetConfirm{},
-			Batches:            []*OutgoingTxBatch{},
-			BatchConfirms:      []MsgConfirmBatch{},
-			LogicCalls:         []*OutgoingLogicCall{},
-			LogicCallConfirms:  []MsgConfirmLogicCall{},
-			Attestations:       []Attestation{},
-			DelegateKeys:       []*MsgSetOrchestratorAddress{},
-			Erc20ToDenoms:      []*ERC20ToDenom{},
-			UnbatchedTransfers: []*OutgoingTransferTx{},
+			LastObservedNonce:    0,
+			Valsets:              []*Valset{},
+			ValsetConfirms:       []*MsgValsetConfirm{},
+			Batches:              []*OutgoingTxBatch{},
+			BatchConfirms:        []MsgConfirmBatch{},
+			LogicCalls:           []*OutgoingLogicCall{},
+			LogicCallConfirms:    []MsgConfirmLogicCall{},
+			Attestations:         []Attestation{},
+			DelegateKeys:         []*MsgSetOrchestratorAddress{},
+			Erc20ToDenoms:        []*ERC20ToDenom{},
+			UnbatchedTransfers:   []*OutgoingTransferTx{},
+			ModuleSendGrants:     []ModuleSendGrant{},
+			BridgeInstance:       nil,
+			EthDestinationLabels: []EthDestinationLabel{},
+			FirstSendDelays:      []FirstSendDelay{},
+			AuditLog:             []AuditLogEntry{},
+			CallbackTransfers:    []*OutgoingTransferTx{},
+			MergedTransfers:      []MergedTransfer{},
+			QuarantinedDeposits:  []DepositReceipt{},
+			ValsetRewardReserve:  types.Coins{},
 		}, expErr: true},
 		"invalid params": {src: &GenesisState{
 			Params: &Params{
@@ -68,18 +126,74 @@ func TestGenesisStateValidate(t *testing.T) {
 					Denom:  "",
 					Amount: types.Int{},
 				},
+				EthereumPowerThreshold:             0,
+				EthereumTimeoutMargin:              0,
+				EthereumBlockTimeCalibrationPeriod: 0,
+				AttestationFastPathThreshold:       0,
+				AttestationExecutionBudget:         0,
+				ValsetCreationGasBudget:            0,
+				SlashingGasBudget:                  0,
+				PruningGasBudget:                   0,
+				AttestationGasBudget:               0,
+				RelayerLotteryReward:               types.Coin{Denom: "", Amount: types.Int{}},
+				RelayerLotteryWindow:               0,
+				FeeConfirmationMultiple:            0,
+				OutflowUsdLimit:                    types.Dec{},
+				OutflowLimitWindow:                 0,
+				StallRefundThreshold:               0,
+				StallRefundBudget:                  0,
+				RefundReceiptRetention:             0,
+				PoolTxTimeout:                      0,
+				MaxCallbackDataSize:                0,
+				CallbackDataByteFee:                types.Coin{Denom: "", Amount: types.Int{}},
+				BatchGasPerTx:                      0,
+				BatchGasOverhead:                   0,
+				DepositReceiptRetention:            0,
+				MinSendToEthFees:                   []ERC20Token{},
+				MaxBatchSize:                       0,
+				TokenMaxBatchSizes:                 []TokenBatchSize{},
+				MaxClaimAge:                        0,
+				RebuildTimedOutBatches:             false,
+				SendToEthPriorityFees:              []types.Coin{},
+				MergeBatchTransfers:                false,
+				PoolAgingBlocks:                    0,
+				MaxSendToEthPayloadSize:            0,
+				MaxPendingTxsPerSender:             0,
+				SignedClaimsWindow:                 0,
+				SlashFractionClaim:                 types.Dec{},
+				SlashFractionConflictingClaim:      types.Dec{},
+				AttestationRetention:               0,
+				EthBlocksToObserve:                 0,
+				QuarantinedEthSenders:              []string{},
+				EthereumHeightDriftThreshold:       0,
+				ValsetPowerChangeThreshold:         types.Dec{},
+				MaxValsetInterval:                  0,
+				ValsetRetention:                    0,
+				TokenSignedBatchesWindows:          []TokenSignedBatchesWindow{},
+				EventNonceStallThreshold:           0,
+				MinimalEvents:                      false,
+				FeeExemptSenders:                   []string{},
 			},
-			LastObservedNonce:  0,
-			Valsets:            []*Valset{},
-			ValsetConfirms:     []*MsgValsetConfirm{},
-			Batches:            []*OutgoingTxBatch{},
-			BatchConfirms:      []MsgConfirmBatch{},
-			LogicCalls:         []*OutgoingLogicCall{},
-			LogicCallConfirms:  []MsgConfirmLogicCall{},
-			Attestations:       []Attestation{},
-			DelegateKeys:       []*MsgSetOrchestratorAddress{},
-			Erc20ToDenoms:      []*ERC20ToDenom{},
-			UnbatchedTransfers: []*OutgoingTransferTx{},
+			LastObservedNonce:    0,
+			Valsets:              []*Valset{},
+			ValsetConfirms:       []*MsgValsetConfirm{},
+			Batches:              []*OutgoingTxBatch{},
+			BatchConfirms:        []MsgConfirmBatch{},
+			LogicCalls:           []*OutgoingLogicCall{},
+			LogicCallConfirms:    []MsgConfirmLogicCall{},
+			Attestations:         []Attestation{},
+			DelegateKeys:         []*MsgSetOrchestratorAddress{},
+			Erc20ToDenoms:        []*ERC20ToDenom{},
+			UnbatchedTransfers:   []*OutgoingTransferTx{},
+			ModuleSendGrants:     []ModuleSendGrant{},
+			BridgeInstance:       nil,
+			EthDestinationLabels: []EthDestinationLabel{},
+			FirstSendDelays:      []FirstSendDelay{},
+			AuditLog:             []AuditLogEntry{},
+			CallbackTransfers:    []*OutgoingTransferTx{},
+			MergedTransfers:      []MergedTransfer{},
+			QuarantinedDeposits:  []DepositReceipt{},
+			ValsetRewardReserve:  types.Coins{},
 		}, expErr: true},
 	}
 	for msg, spec := range specs {
@@ -94,6 +208,70 @@ func TestGenesisStateValidate(t *testing.T) {
 	}
 }
 
+func TestValidateEthereumPowerThreshold(t *testing.T) {
+	require.NoError(t, validateEthereumPowerThreshold(uint64(0)))
+	require.NoError(t, validateEthereumPowerThreshold(LegacyEthereumPowerThreshold))
+	require.NoError(t, validateEthereumPowerThreshold(uint64(math.MaxUint32)))
+	require.Error(t, validateEthereumPowerThreshold(MinEthereumPowerThreshold-1))
+	require.Error(t, validateEthereumPowerThreshold(uint64(math.MaxUint32)+1))
+	require.Error(t, validateEthereumPowerThreshold(int64(LegacyEthereumPowerThreshold)))
+}
+
+func TestValidateMinSendToEthFees(t *testing.T) {
+	token := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	require.NoError(t, validateMinSendToEthFees([]ERC20Token{}))
+	require.NoError(t, validateMinSendToEthFees([]ERC20Token{{Contract: token, Amount: types.NewInt(10)}}))
+	require.Error(t, validateMinSendToEthFees([]ERC20Token{{Contract: token, Amount: types.NewInt(-1)}}))
+	require.Error(t, validateMinSendToEthFees([]ERC20Token{{Contract: token, Amount: types.Int{}}}))
+	require.Error(t, validateMinSendToEthFees([]ERC20Token{{Contract: "0xdeadbeef", Amount: types.NewInt(10)}}))
+	// the same contract in another casing is a duplicate
+	require.Error(t, validateMinSendToEthFees([]ERC20Token{
+		{Contract: token, Amount: types.NewInt(10)},
+		{Contract: strings.ToLower(token), Amount: types.NewInt(20)},
+	}))
+	require.Error(t, validateMinSendToEthFees(map[string]types.Int{}))
+}
+
+func TestValidateMaxBatchSizes(t *testing.T) {
+	token := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	require.NoError(t, validateMaxBatchSize(uint64(100)))
+	require.Error(t, validateMaxBatchSize(uint64(0)))
+	require.NoError(t, validateTokenMaxBatchSizes([]TokenBatchSize{{TokenContract: token, MaxBatchSize: 10}}))
+	require.Error(t, validateTokenMaxBatchSizes([]TokenBatchSize{{TokenContract: token, MaxBatchSize: 0}}))
+	require.Error(t, validateTokenMaxBatchSizes([]TokenBatchSize{{TokenContract: "0xdeadbeef", MaxBatchSize: 10}}))
+	require.Error(t, validateTokenMaxBatchSizes([]TokenBatchSize{
+		{TokenContract: token, MaxBatchSize: 10},
+		{TokenContract: strings.ToLower(token), MaxBatchSize: 20},
+	}))
+}
+
+func TestValidateTokenSignedBatchesWindows(t *testing.T) {
+	token := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	require.NoError(t, validateTokenSignedBatchesWindows([]TokenSignedBatchesWindow{{TokenContract: token, SignedBatchesWindow: 10}}))
+	require.Error(t, validateTokenSignedBatchesWindows([]TokenSignedBatchesWindow{{TokenContract: token, SignedBatchesWindow: 0}}))
+	require.Error(t, validateTokenSignedBatchesWindows([]TokenSignedBatchesWindow{{TokenContract: "0xdeadbeef", SignedBatchesWindow: 10}}))
+	require.Error(t, validateTokenSignedBatchesWindows([]TokenSignedBatchesWindow{
+		{TokenContract: token, SignedBatchesWindow: 10},
+		{TokenContract: strings.ToLower(token), SignedBatchesWindow: 20},
+	}))
+}
+
+func TestValidateQuarantinedEthSenders(t *testing.T) {
+	sender := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	require.NoError(t, validateQuarantinedEthSenders([]string{}))
+	require.NoError(t, validateQuarantinedEthSenders([]string{sender}))
+	require.Error(t, validateQuarantinedEthSenders([]string{"0xdeadbeef"}))
+	require.Error(t, validateQuarantinedEthSenders([]string{sender, strings.ToLower(sender)}))
+}
+
+func TestValidateFeeExemptSenders(t *testing.T) {
+	sender := "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn"
+	require.NoError(t, validateFeeExemptSenders([]string{}))
+	require.NoError(t, validateFeeExemptSenders([]string{sender}))
+	require.Error(t, validateFeeExemptSenders([]string{"cosmos1deadbeef"}))
+	require.Error(t, validateFeeExemptSenders([]string{sender, strings.ToUpper(sender)}))
+}
+
 func TestStringToByteArray(t *testing.T) {
 	specs := map[string]struct {
 		testString string
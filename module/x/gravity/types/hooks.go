@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GravityHooks lets other modules, for example a rewards or an accounting module, react to the Ethereum events the
+// gravity module applies. The hooks are called in the context the event is applied in, so whatever they change is
+// committed or discarded together with the event. They can not fail the event, a hook that can not do its work has
+// to deal with that itself
+type GravityHooks interface {
+	// AfterSendToCosmos is called once a deposit from Ethereum was paid out, when its claim is observed or for a
+	// quarantined deposit when governance releases it
+	AfterSendToCosmos(ctx sdk.Context, deposit DepositReceipt)
+	// AfterBatchExecuted is called once a batch was observed executed on Ethereum and removed from the store
+	AfterBatchExecuted(ctx sdk.Context, batch OutgoingTxBatch)
+	// AfterValsetUpdated is called once a valset was observed as the current one of the Ethereum contract
+	AfterValsetUpdated(ctx sdk.Context, valset Valset)
+	// AfterContractCallExecuted is called once a logic call was observed executed on Ethereum
+	AfterContractCallExecuted(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64)
+}
+
+var _ GravityHooks = MultiGravityHooks{}
+
+// MultiGravityHooks combines multiple gravity hooks, they are called in the order they were given in
+type MultiGravityHooks []GravityHooks
+
+// NewMultiGravityHooks combines hooks into one GravityHooks
+func NewMultiGravityHooks(hooks ...GravityHooks) MultiGravityHooks {
+	return hooks
+}
+
+// AfterSendToCosmos calls AfterSendToCosmos of every hook
+func (h MultiGravityHooks) AfterSendToCosmos(ctx sdk.Context, deposit DepositReceipt) {
+	for _, hook := range h {
+		hook.AfterSendToCosmos(ctx, deposit)
+	}
+}
+
+// AfterBatchExecuted calls AfterBatchExecuted of every hook
+func (h MultiGravityHooks) AfterBatchExecuted(ctx sdk.Context, batch OutgoingTxBatch) {
+	for _, hook := range h {
+		hook.AfterBatchExecuted(ctx, batch)
+	}
+}
+
+// AfterValsetUpdated calls AfterValsetUpdated of every hook
+func (h MultiGravityHooks) AfterValsetUpdated(ctx sdk.Context, valset Valset) {
+	for _, hook := range h {
+		hook.AfterValsetUpdated(ctx, valset)
+	}
+}
+
+// AfterContractCallExecuted calls AfterContractCallExecuted of every hook
+func (h MultiGravityHooks) AfterContractCallExecuted(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) {
+	for _, hook := range h {
+		hook.AfterContractCallExecuted(ctx, invalidationID, invalidationNonce)
+	}
+}
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"encoding/binary"
+	"math"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -84,6 +88,9 @@ var (
 	// KeyLastOutgoingBatchID indexes the lastBatchID
 	KeyLastOutgoingBatchID = append(SequenceKeyPrefix, []byte("lastBatchId")...)
 
+	// KeyLastAuditLogID indexes the id of the next audit log entry
+	KeyLastAuditLogID = append(SequenceKeyPrefix, []byte("lastAuditLogId")...)
+
 	// KeyOrchestratorAddress indexes the validator keys for an orchestrator
 	KeyOrchestratorAddress = []byte{0x11}
 
@@ -125,6 +132,131 @@ var (
 
 	// PastEthSignatureCheckpointKey indexes eth signature checkpoints that have existed
 	PastEthSignatureCheckpointKey = []byte{0x1b}
+
+	// OrchestratorHeartbeatKey indexes the latest orchestrator heartbeat by validator
+	OrchestratorHeartbeatKey = []byte{0x1c}
+
+	// BridgeMigrationKey indexes the in progress bridge contract migration, if any
+	BridgeMigrationKey = []byte{0x1d}
+
+	// EthereumHeightVoteKey indexes the latest Ethereum height reported by each validator
+	EthereumHeightVoteKey = []byte{0x1e}
+
+	// EthereumBlockTimeCalibrationKey indexes the state of the Ethereum block time calibration
+	EthereumBlockTimeCalibrationKey = []byte{0x1f}
+
+	// AttestationExecutionQueueKey indexes observed attestations waiting to be applied to the state
+	AttestationExecutionQueueKey = []byte{0x1a}
+
+	// AttestationPruneCursorKey indexes the lowest event nonce that attestation pruning has not finished
+	AttestationPruneCursorKey = []byte{0x21}
+
+	// ValsetPruneCursorKey indexes the lowest valset nonce that valset pruning has not finished
+	ValsetPruneCursorKey = []byte{0x22}
+
+	// RelayActivityKey indexes the number of batches each relayer executed per block
+	RelayActivityKey = []byte{0x23}
+
+	// BridgeStatsKey indexes the deposit and withdrawal counters of each token per hour of block time
+	BridgeStatsKey = []byte{0x24}
+
+	// BridgeStatsSenderKey indexes the senders that used the bridge per hour of block time
+	BridgeStatsSenderKey = []byte{0x25}
+
+	// OutflowKey indexes the USD value of the transfers to Ethereum requested per block
+	OutflowKey = []byte{0x26}
+
+	// OutflowTotalKey indexes the USD value of the transfers to Ethereum requested within the outflow limit window
+	OutflowTotalKey = []byte{0x27}
+
+	// TimedOutBatchKey indexes the batches canceled because they timed out on Ethereum by batch nonce
+	TimedOutBatchKey = []byte{0x28}
+
+	// LastBlockHeaderKey indexes the height and time of the last block the EndBlocker ran in
+	LastBlockHeaderKey = []byte{0x29}
+
+	// BridgeStatsTokenSenderKey indexes the senders that moved each token over the bridge per hour of block time
+	BridgeStatsTokenSenderKey = []byte{0x2a}
+
+	// RefundReceiptKey indexes the receipts of refunded transfers to Ethereum by sender and tx id
+	RefundReceiptKey = []byte{0x2b}
+
+	// RefundReceiptHeightKey indexes the receipts of refunded transfers to Ethereum by refund height for pruning
+	RefundReceiptHeightKey = []byte{0x2c}
+
+	// ModuleSendGrantKey indexes the send to Ethereum budgets governance granted to other modules by module name
+	ModuleSendGrantKey = []byte{0x2d}
+
+	// BridgeInstanceKey indexes the bridge instance the chain is running, started at genesis
+	BridgeInstanceKey = []byte{0x2e}
+
+	// EthDestinationLabelKey indexes the labeled Ethereum destinations of each account by owner and label
+	EthDestinationLabelKey = []byte{0x2f}
+
+	// OutgoingTXPoolSenderKey indexes the transactions in the outgoing tx pool by sender and tx id
+	OutgoingTXPoolSenderKey = []byte{0x30}
+
+	// FirstSendDelayKey indexes the number of blocks sends to a new Ethereum destination are held by account
+	FirstSendDelayKey = []byte{0x31}
+
+	// KnownEthDestinationKey indexes the Ethereum destinations accounts with a first send delay have sent to
+	KnownEthDestinationKey = []byte{0x32}
+
+	// OutgoingTXPoolReceiverKey indexes the transactions in the outgoing tx pool by Ethereum receiver and tx id
+	OutgoingTXPoolReceiverKey = []byte{0x33}
+
+	// AuditLogKey indexes the log of the changes governance made to the bridge by entry id
+	AuditLogKey = []byte{0x34}
+
+	// OutgoingTXPoolHeightKey indexes the transactions in the outgoing tx pool by the height they entered it at
+	OutgoingTXPoolHeightKey = []byte{0x35}
+
+	// CallbackTransferKey indexes the transfers with a receiver hook by the invalidation id of their logic call
+	CallbackTransferKey = []byte{0x36}
+
+	// DepositReceiptKey indexes the receipts of deposits from Ethereum by receiver and event nonce
+	DepositReceiptKey = []byte{0x37}
+
+	// DepositReceiptHeightKey indexes the keys of deposit receipts by the height the deposit was paid out at
+	DepositReceiptHeightKey = []byte{0x38}
+
+	// MergedTransferKey indexes the pool transactions merged into a transfer of a batch by batch nonce and transfer id
+	MergedTransferKey = []byte{0x39}
+
+	// LastSlashedClaimEventNonce stores the last observed event nonce checked for validators that did not claim it
+	LastSlashedClaimEventNonce = []byte{0x3a}
+
+	// ConflictingClaimSlashedKey marks the validators slashed for a conflicting claim by validator and event nonce
+	ConflictingClaimSlashedKey = []byte{0x3b}
+
+	// QuarantinedDepositKey indexes the deposits held for quarantined Ethereum senders by event nonce
+	QuarantinedDepositKey = []byte{0x3c}
+
+	// EthereumHeightDriftKey marks the validators whose reported Ethereum height drifted behind the median
+	EthereumHeightDriftKey = []byte{0x3d}
+
+	// ValsetRewardReserveKey indexes the vouchers held to pay valset rewards in Ethereum originated tokens by denom
+	ValsetRewardReserveKey = []byte{0x3e}
+
+	// BatchSlashedKey marks the batches already slashed for while an earlier batch of a token with a longer signed
+	// batches window holds back LastSlashedBatchBlock
+	BatchSlashedKey = []byte{0x3f}
+
+	// BadSignatureSlashedKey marks the validators slashed for signing a checkpoint the chain never produced by
+	// validator and checkpoint
+	BadSignatureSlashedKey = []byte{0x40}
+
+	// DepositEthTxHashKey indexes the event nonce of deposits from Ethereum by the hash of their Ethereum transaction
+	DepositEthTxHashKey = []byte{0x41}
+
+	// FinishedBatchKey indexes the lifecycle of the batches that were executed, canceled or timed out by batch nonce
+	FinishedBatchKey = []byte{0x42}
+
+	// LastStalledEventNonceKey stores the last event nonce an EventNonceStalled was emitted for
+	LastStalledEventNonceKey = []byte{0x43}
+
+	// OutflowTxKey indexes the USD value each transfer to Ethereum added to the outflow by tx id and block height
+	OutflowTxKey = []byte{0x44}
 )
 
 // GetOrchestratorAddressKey returns the following key format
@@ -214,19 +346,64 @@ func GetOutgoingTxPoolContractPrefix(contractAddress EthAddress) []byte {
 	return append(OutgoingTXPoolKey, []byte(contractAddress.GetAddress())...)
 }
 
+// GetOutgoingTxPoolPriorityPrefix returns the following key format
+// prefix	feeContract		priority
+// [0x6][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 1]
+// This prefix is used for iterating over the unbatched transactions of one priority class of a contract
+func GetOutgoingTxPoolPriorityPrefix(contractAddress EthAddress, priority uint32) []byte {
+	p := make([]byte, 4)
+	binary.BigEndian.PutUint32(p, priority)
+	return append(GetOutgoingTxPoolContractPrefix(contractAddress), p...)
+}
+
 // GetOutgoingTxPoolKey returns the following key format
-// prefix	feeContract		feeAmount     id
-// [0x6][0xc783df8a850f42e7F7e57013759C285caa701eB6][1000000000][0 0 0 0 0 0 0 1]
-func GetOutgoingTxPoolKey(fee InternalERC20Token, id uint64) []byte {
+// prefix	feeContract		priority	feeAmount     inverted id
+// [0x6][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 1][1000000000][255 255 255 255 255 255 255 254]
+// The priority comes before the fee so that the transactions of a higher priority are batched first. The id is
+// inverted so that among equal fees the oldest transaction comes first in the DESC order batches pick them in
+func GetOutgoingTxPoolKey(fee InternalERC20Token, priority uint32, id uint64) []byte {
 	// sdkInts have a size limit of 255 bits or 32 bytes
 	// therefore this will never panic and is always safe
 	amount := make([]byte, 32)
 	amount = fee.Amount.BigInt().FillBytes(amount)
 
-	a := append(amount, UInt64Bytes(id)...)
-	b := append([]byte(fee.Contract.GetAddress()), a...)
-	r := append(OutgoingTXPoolKey, b...)
-	return r
+	a := append(amount, UInt64Bytes(math.MaxUint64-id)...)
+	return append(GetOutgoingTxPoolPriorityPrefix(fee.Contract, priority), a...)
+}
+
+// GetOutgoingTxPoolSenderKey returns the following key format
+// prefix     sender-length  sender                                        id
+// [0x30][20][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetOutgoingTxPoolSenderKey(sender sdk.AccAddress, id uint64) []byte {
+	return append(GetOutgoingTxPoolSenderPrefix(sender), UInt64Bytes(id)...)
+}
+
+// GetOutgoingTxPoolSenderPrefix returns the following key format
+// prefix     sender-length  sender
+// [0x30][20][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetOutgoingTxPoolSenderPrefix(sender sdk.AccAddress) []byte {
+	return append(append(append([]byte{}, OutgoingTXPoolSenderKey...), byte(len(sender))), sender.Bytes()...)
+}
+
+// GetOutgoingTxPoolReceiverKey returns the following key format
+// prefix     eth-receiver-address                        id
+// [0x33][0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7][0 0 0 0 0 0 0 1]
+func GetOutgoingTxPoolReceiverKey(receiver EthAddress, id uint64) []byte {
+	return append(GetOutgoingTxPoolReceiverPrefix(receiver), UInt64Bytes(id)...)
+}
+
+// GetOutgoingTxPoolReceiverPrefix returns the following key format
+// prefix     eth-receiver-address
+// [0x33][0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7]
+func GetOutgoingTxPoolReceiverPrefix(receiver EthAddress) []byte {
+	return append(append([]byte{}, OutgoingTXPoolReceiverKey...), []byte(receiver.GetAddress())...)
+}
+
+// GetOutgoingTxPoolHeightKey returns the following key format
+// prefix     height                    id
+// [0x35][0 0 0 0 0 0 0 1][0 0 0 0 0 0 0 1]
+func GetOutgoingTxPoolHeightKey(height uint64, id uint64) []byte {
+	return append(append(append([]byte{}, OutgoingTXPoolHeightKey...), UInt64Bytes(height)...), UInt64Bytes(id)...)
 }
 
 // GetOutgoingTxBatchKey returns the following key format
@@ -236,6 +413,14 @@ func GetOutgoingTxBatchKey(tokenContract EthAddress, nonce uint64) []byte {
 	return append(append(OutgoingTXBatchKey, []byte(tokenContract.GetAddress())...), UInt64Bytes(nonce)...)
 }
 
+// GetOutgoingTxBatchContractPrefix returns the following key format
+// prefix     eth-contract-address
+// [0xa][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+// This prefix is used for iterating over the outgoing batches of a given contract
+func GetOutgoingTxBatchContractPrefix(tokenContract EthAddress) []byte {
+	return append(append([]byte{}, OutgoingTXBatchKey...), []byte(tokenContract.GetAddress())...)
+}
+
 // GetOutgoingTxBatchBlockKey returns the following key format
 // prefix     blockheight
 // [0xb][0 0 0 0 2 1 4 3]
@@ -287,3 +472,281 @@ func GetLogicConfirmKey(invalidationId []byte, invalidationNonce uint64, validat
 func GetPastEthSignatureCheckpointKey(checkpoint []byte) []byte {
 	return append(PastEthSignatureCheckpointKey, checkpoint...)
 }
+
+// GetOrchestratorHeartbeatKey returns the following key format
+// prefix    cosmos-validator
+// [0x1c][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetOrchestratorHeartbeatKey(validator sdk.ValAddress) []byte {
+	return append(OrchestratorHeartbeatKey, validator.Bytes()...)
+}
+
+// GetEthereumHeightVoteKey returns the following key format
+// prefix    cosmos-validator
+// [0x1e][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetEthereumHeightVoteKey(validator sdk.ValAddress) []byte {
+	return append(EthereumHeightVoteKey, validator.Bytes()...)
+}
+
+// GetAttestationExecutionQueueKey returns the following key format
+// prefix     nonce                             claim-details-hash
+// [0x1a][0 0 0 0 0 0 0 1][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
+// The queue is ordered by event nonce, so attestations are applied in the order they were observed
+func GetAttestationExecutionQueueKey(eventNonce uint64, claimHash []byte) []byte {
+	key := make([]byte, len(AttestationExecutionQueueKey)+len(UInt64Bytes(0))+len(claimHash))
+	copy(key[0:], AttestationExecutionQueueKey)
+	copy(key[len(AttestationExecutionQueueKey):], UInt64Bytes(eventNonce))
+	copy(key[len(AttestationExecutionQueueKey)+len(UInt64Bytes(0)):], claimHash)
+	return key
+}
+
+// GetRelayActivityKey returns the following key format
+// prefix     block-height                      relayer
+// [0x23][0 0 0 0 0 0 0 1][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetRelayActivityKey(height uint64, relayer EthAddress) []byte {
+	return append(GetRelayActivityPrefix(height), []byte(relayer.GetAddress())...)
+}
+
+// GetRelayActivityPrefix returns the following key format
+// prefix     block-height
+// [0x23][0 0 0 0 0 0 0 1]
+// This prefix is used for iterating over the relay activity from a given block height onwards
+func GetRelayActivityPrefix(height uint64) []byte {
+	return append(append([]byte{}, RelayActivityKey...), UInt64Bytes(height)...)
+}
+
+// GetBridgeStatsKey returns the following key format
+// prefix     hour                token-contract
+// [0x24][0 0 0 0 0 0 0 1][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetBridgeStatsKey(hour uint64, tokenContract EthAddress) []byte {
+	return append(GetBridgeStatsPrefix(hour), []byte(tokenContract.GetAddress())...)
+}
+
+// GetBridgeStatsPrefix returns the following key format
+// prefix     hour
+// [0x24][0 0 0 0 0 0 0 1]
+func GetBridgeStatsPrefix(hour uint64) []byte {
+	return append(append([]byte{}, BridgeStatsKey...), UInt64Bytes(hour)...)
+}
+
+// GetBridgeStatsSenderKey returns the following key format
+// prefix     hour                sender
+// [0x25][0 0 0 0 0 0 0 1][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+// the sender is an Ethereum address for deposits and a bech32 Cosmos address for withdrawals
+func GetBridgeStatsSenderKey(hour uint64, sender string) []byte {
+	return append(GetBridgeStatsSenderPrefix(hour), []byte(sender)...)
+}
+
+// GetBridgeStatsSenderPrefix returns the following key format
+// prefix     hour
+// [0x25][0 0 0 0 0 0 0 1]
+func GetBridgeStatsSenderPrefix(hour uint64) []byte {
+	return append(append([]byte{}, BridgeStatsSenderKey...), UInt64Bytes(hour)...)
+}
+
+// GetBridgeStatsTokenSenderKey returns the following key format
+// prefix     hour                token-contract                              sender
+// [0x2a][0 0 0 0 0 0 0 1][0xc783df8a850f42e7F7e57013759C285caa701eB6][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetBridgeStatsTokenSenderKey(hour uint64, tokenContract EthAddress, sender string) []byte {
+	return append(append(GetBridgeStatsTokenSenderPrefix(hour), []byte(tokenContract.GetAddress())...), []byte(sender)...)
+}
+
+// GetBridgeStatsTokenSenderPrefix returns the following key format
+// prefix     hour
+// [0x2a][0 0 0 0 0 0 0 1]
+func GetBridgeStatsTokenSenderPrefix(hour uint64) []byte {
+	return append(append([]byte{}, BridgeStatsTokenSenderKey...), UInt64Bytes(hour)...)
+}
+
+// GetRefundReceiptKey returns the following key format
+// prefix     sender-length  sender                                        tx-id
+// [0x2b][20][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetRefundReceiptKey(sender sdk.AccAddress, txId uint64) []byte {
+	return append(GetRefundReceiptPrefix(sender), UInt64Bytes(txId)...)
+}
+
+// GetRefundReceiptPrefix returns the following key format
+// prefix     sender-length  sender
+// [0x2b][20][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+// the sender is length prefixed since module accounts have longer addresses, without it the receipts of one
+// sender could be iterated as part of those of another
+func GetRefundReceiptPrefix(sender sdk.AccAddress) []byte {
+	return append(append(append([]byte{}, RefundReceiptKey...), byte(len(sender))), sender.Bytes()...)
+}
+
+// GetRefundReceiptHeightKey returns the following key format
+// prefix     refund-height       tx-id
+// [0x2c][0 0 0 0 0 0 0 1][0 0 0 0 0 0 0 1]
+func GetRefundReceiptHeightKey(height uint64, txId uint64) []byte {
+	return append(append(append([]byte{}, RefundReceiptHeightKey...), UInt64Bytes(height)...), UInt64Bytes(txId)...)
+}
+
+// GetModuleSendGrantKey returns the following key format
+// prefix     module-name
+// [0x2d][distribution]
+func GetModuleSendGrantKey(module string) []byte {
+	return append(append([]byte{}, ModuleSendGrantKey...), []byte(module)...)
+}
+
+// GetEthDestinationLabelKey returns the following key format
+// prefix     owner-length  owner                                         label
+// [0x2f][20][0xc783df8a850f42e7F7e57013759C285caa701eB6][treasury]
+func GetEthDestinationLabelKey(owner sdk.AccAddress, label string) []byte {
+	return append(GetEthDestinationLabelPrefix(owner), []byte(label)...)
+}
+
+// GetEthDestinationLabelPrefix returns the following key format
+// prefix     owner-length  owner
+// [0x2f][20][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetEthDestinationLabelPrefix(owner sdk.AccAddress) []byte {
+	return append(append(append([]byte{}, EthDestinationLabelKey...), byte(len(owner))), owner.Bytes()...)
+}
+
+// GetFirstSendDelayKey returns the following key format
+// prefix     account-length  account
+// [0x31][20][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetFirstSendDelayKey(account sdk.AccAddress) []byte {
+	return append(append(append([]byte{}, FirstSendDelayKey...), byte(len(account))), account.Bytes()...)
+}
+
+// GetKnownEthDestinationKey returns the following key format
+// prefix     account-length  account                                       eth-address
+// [0x32][20][0xc783df8a850f42e7F7e57013759C285caa701eB6][0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7]
+func GetKnownEthDestinationKey(account sdk.AccAddress, dest EthAddress) []byte {
+	return append(GetKnownEthDestinationPrefix(account), []byte(dest.GetAddress())...)
+}
+
+// GetKnownEthDestinationPrefix returns the following key format
+// prefix     account-length  account
+// [0x32][20][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetKnownEthDestinationPrefix(account sdk.AccAddress) []byte {
+	return append(append(append([]byte{}, KnownEthDestinationKey...), byte(len(account))), account.Bytes()...)
+}
+
+// GetAuditLogKey returns the following key format
+// prefix     entry-id
+// [0x34][0 0 0 0 0 0 0 1]
+func GetAuditLogKey(id uint64) []byte {
+	return append(append([]byte{}, AuditLogKey...), UInt64Bytes(id)...)
+}
+
+// GetCallbackTransferKey returns the following key format
+// prefix     invalidation-id
+// [0x36][0x8a 0x1f ... 0x3c]
+func GetCallbackTransferKey(invalidationID []byte) []byte {
+	return append(append([]byte{}, CallbackTransferKey...), invalidationID...)
+}
+
+// GetDepositReceiptKey returns the following key format
+// prefix     receiver-length  receiver                                      event-nonce
+// [0x37][20][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetDepositReceiptKey(receiver sdk.AccAddress, eventNonce uint64) []byte {
+	return append(GetDepositReceiptPrefix(receiver), UInt64Bytes(eventNonce)...)
+}
+
+// GetDepositReceiptPrefix returns the following key format
+// prefix     receiver-length  receiver
+// [0x37][20][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetDepositReceiptPrefix(receiver sdk.AccAddress) []byte {
+	return append(append(append([]byte{}, DepositReceiptKey...), byte(len(receiver))), receiver.Bytes()...)
+}
+
+// GetDepositReceiptHeightKey returns the following key format
+// prefix     deposit-height      event-nonce
+// [0x38][0 0 0 0 0 0 0 1][0 0 0 0 0 0 0 1]
+func GetDepositReceiptHeightKey(height uint64, eventNonce uint64) []byte {
+	return append(append(append([]byte{}, DepositReceiptHeightKey...), UInt64Bytes(height)...), UInt64Bytes(eventNonce)...)
+}
+
+// GetMergedTransferKey returns the following key format
+// prefix     batch-nonce          transfer-id
+// [0x39][0 0 0 0 0 0 0 1][0 0 0 0 0 0 0 1]
+func GetMergedTransferKey(batchNonce uint64, id uint64) []byte {
+	return append(append(append([]byte{}, MergedTransferKey...), UInt64Bytes(batchNonce)...), UInt64Bytes(id)...)
+}
+
+// GetTimedOutBatchKey returns the following key format
+// prefix     batch-nonce
+// [0x28][0 0 0 0 0 0 0 1]
+func GetTimedOutBatchKey(nonce uint64) []byte {
+	return append(append([]byte{}, TimedOutBatchKey...), UInt64Bytes(nonce)...)
+}
+
+// GetOutflowKey returns the following key format
+// prefix     block-height
+// [0x26][0 0 0 0 0 0 0 1]
+func GetOutflowKey(height uint64) []byte {
+	return append(append([]byte{}, OutflowKey...), UInt64Bytes(height)...)
+}
+
+// GetConflictingClaimSlashedKey returns the following key format
+// prefix     validator-length  validator                                     event-nonce
+// [0x3b][20][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetConflictingClaimSlashedKey(validator sdk.ValAddress, eventNonce uint64) []byte {
+	key := append(append(append([]byte{}, ConflictingClaimSlashedKey...), byte(len(validator))), validator.Bytes()...)
+	return append(key, UInt64Bytes(eventNonce)...)
+}
+
+// GetQuarantinedDepositKey returns the following key format
+// prefix     event-nonce
+// [0x3c][0 0 0 0 0 0 0 1]
+func GetQuarantinedDepositKey(eventNonce uint64) []byte {
+	return append(append([]byte{}, QuarantinedDepositKey...), UInt64Bytes(eventNonce)...)
+}
+
+// GetEthereumHeightDriftKey returns the following key format
+// prefix    cosmos-validator
+// [0x3d][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetEthereumHeightDriftKey(validator sdk.ValAddress) []byte {
+	return append(append([]byte{}, EthereumHeightDriftKey...), validator.Bytes()...)
+}
+
+// GetValsetRewardReserveKey returns the following key format
+// prefix     denom
+// [0x3e][gravity0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetValsetRewardReserveKey(denom string) []byte {
+	return append(append([]byte{}, ValsetRewardReserveKey...), []byte(denom)...)
+}
+
+// GetBatchSlashedKey returns the following key format
+// prefix     blockheight         eth-contract-address                       nonce
+// [0x3f][0 0 0 0 2 1 4 3][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetBatchSlashedKey(block uint64, tokenContract EthAddress, nonce uint64) []byte {
+	key := append(append([]byte{}, BatchSlashedKey...), UInt64Bytes(block)...)
+	return append(append(key, []byte(tokenContract.GetAddress())...), UInt64Bytes(nonce)...)
+}
+
+// GetBadSignatureSlashedKey returns the following key format
+// prefix     validator-length  validator                                     checkpoint
+// [0x40][20][0xc783df8a850f42e7F7e57013759C285caa701eB6][0xd4f8f...]
+func GetBadSignatureSlashedKey(validator sdk.ValAddress, checkpoint []byte) []byte {
+	key := append(append(append([]byte{}, BadSignatureSlashedKey...), byte(len(validator))), validator.Bytes()...)
+	return append(key, checkpoint...)
+}
+
+// GetDepositEthTxHashKey returns the following key format
+// prefix     eth-tx-hash
+// [0x41][0x5f2c1e1e6b1b3c0cbd4b6f4aa3d2d8a5c3a0e5c0d58c2b3c1a5f4e2d0c9b8a71]
+func GetDepositEthTxHashKey(txHash string) []byte {
+	return append(append([]byte{}, DepositEthTxHashKey...), []byte(strings.ToLower(txHash))...)
+}
+
+// GetFinishedBatchKey returns the following key format
+// prefix     batch-nonce
+// [0x42][0 0 0 0 0 0 0 1]
+func GetFinishedBatchKey(nonce uint64) []byte {
+	return append(append([]byte{}, FinishedBatchKey...), UInt64Bytes(nonce)...)
+}
+
+// GetOutflowTxKey returns the following key format
+// prefix     tx-id              block-height
+// [0x44][0 0 0 0 0 0 0 1][0 0 0 0 0 0 0 1]
+func GetOutflowTxKey(txID uint64, height uint64) []byte {
+	return append(GetOutflowTxPrefix(txID), UInt64Bytes(height)...)
+}
+
+// GetOutflowTxPrefix returns the following key format
+// prefix     tx-id
+// [0x44][0 0 0 0 0 0 0 1]
+func GetOutflowTxPrefix(txID uint64) []byte {
+	return append(append([]byte{}, OutflowTxKey...), UInt64Bytes(txID)...)
+}
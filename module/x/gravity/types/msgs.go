@@ -3,9 +3,14 @@ package types
 import (
 	"encoding/hex"
 	"fmt"
+	"regexp"
+	"sort"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gogo/protobuf/proto"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
@@ -15,6 +20,9 @@ var (
 	_ sdk.Msg = &MsgValsetConfirm{}
 	_ sdk.Msg = &MsgSendToEth{}
 	_ sdk.Msg = &MsgCancelSendToEth{}
+	_ sdk.Msg = &MsgCancelAllSendToEth{}
+	_ sdk.Msg = &MsgReleaseSendToEth{}
+	_ sdk.Msg = &MsgBumpSendToEthFee{}
 	_ sdk.Msg = &MsgRequestBatch{}
 	_ sdk.Msg = &MsgConfirmBatch{}
 	_ sdk.Msg = &MsgERC20DeployedClaim{}
@@ -24,17 +32,33 @@ var (
 	_ sdk.Msg = &MsgBatchSendToEthClaim{}
 	_ sdk.Msg = &MsgValsetUpdatedClaim{}
 	_ sdk.Msg = &MsgSubmitBadSignatureEvidence{}
+	_ sdk.Msg = &MsgOrchestratorHeartbeat{}
+	_ sdk.Msg = &MsgMigrationCompletedClaim{}
+	_ sdk.Msg = &MsgSetEthDestinationLabel{}
+	_ sdk.Msg = &MsgSetFirstSendDelay{}
+	_ sdk.Msg = &MsgSubmitConfirms{}
+	_ sdk.Msg = &MsgSubmitConflictingClaimEvidence{}
+	_ sdk.Msg = &MsgSubmitEthereumEvents{}
+	_ sdk.Msg = &MsgFundValsetReward{}
 )
 
-// NewMsgSetOrchestratorAddress returns a new msgSetOrchestratorAddress
-func NewMsgSetOrchestratorAddress(val sdk.ValAddress, oper sdk.AccAddress, eth EthAddress) *MsgSetOrchestratorAddress {
+// NewMsgSetOrchestratorAddress returns a new msgSetOrchestratorAddress, ethSignature is the signature by the key of
+// eth over DelegateKeysSignHash of val
+func NewMsgSetOrchestratorAddress(val sdk.ValAddress, oper sdk.AccAddress, eth EthAddress, ethSignature []byte) *MsgSetOrchestratorAddress {
 	return &MsgSetOrchestratorAddress{
 		Validator:    val.String(),
 		Orchestrator: oper.String(),
 		EthAddress:   eth.GetAddress(),
+		EthSignature: hex.EncodeToString(ethSignature),
 	}
 }
 
+// DelegateKeysSignHash returns the hash the Ethereum key of a MsgSetOrchestratorAddress signs to prove that the
+// operator of validator controls it
+func DelegateKeysSignHash(validator sdk.ValAddress) []byte {
+	return crypto.Keccak256(validator.Bytes())
+}
+
 // Route should return the name of the module
 func (msg *MsgSetOrchestratorAddress) Route() string { return RouterKey }
 
@@ -52,6 +76,9 @@ func (msg *MsgSetOrchestratorAddress) ValidateBasic() (err error) {
 	if err := ValidateEthAddress(msg.EthAddress); err != nil {
 		return sdkerrors.Wrap(err, "ethereum address")
 	}
+	if sig, err := hex.DecodeString(msg.EthSignature); err != nil || len(sig) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "ethereum signature")
+	}
 	return nil
 }
 
@@ -151,9 +178,31 @@ func (msg MsgSendToEth) ValidateBasic() error {
 	if !msg.BridgeFee.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "fee")
 	}
-	if err := ValidateEthAddress(msg.EthDest); err != nil {
+	// the destination is either given directly or looked up in the senders address book
+	if msg.EthDestLabel != "" {
+		if msg.EthDest != "" {
+			return sdkerrors.Wrap(ErrInvalid, "both an ethereum address and a destination label")
+		}
+		if err := ValidateEthDestinationLabel(msg.EthDestLabel); err != nil {
+			return err
+		}
+	} else if err := ValidateEthAddress(msg.EthDest); err != nil {
 		return sdkerrors.Wrap(err, "ethereum address")
 	}
+	if msg.CallbackTarget != "" {
+		if err := ValidateEthAddress(msg.CallbackTarget); err != nil {
+			return sdkerrors.Wrap(err, "callback target")
+		}
+	} else if len(msg.CallbackData) > 0 {
+		return sdkerrors.Wrap(ErrInvalid, "callback data without a callback target")
+	}
+	// a transfer with a receiver hook is relayed on its own, it never waits for a batch
+	if msg.CallbackTarget != "" && msg.Priority != 0 {
+		return sdkerrors.Wrap(ErrInvalid, "priority of a transfer with a callback target")
+	}
+	if msg.CallbackTarget != "" && len(msg.Payload) > 0 {
+		return sdkerrors.Wrap(ErrInvalid, "payload of a transfer with a callback target, use the callback data")
+	}
 	// TODO validate fee is sufficient, fixed fee to start
 	return nil
 }
@@ -289,6 +338,8 @@ func (msg MsgConfirmLogicCall) GetSigners() []sdk.AccAddress {
 
 // EthereumClaim represents a claim on ethereum state
 type EthereumClaim interface {
+	// Claims are stored in attestations packed into an Any
+	proto.Message
 	// All Ethereum claims that we relay from the Gravity contract and into the module
 	// have a nonce that is monotonically increasing and unique, since this nonce is
 	// issued by the Ethereum contract it is immutable and must be agreed on by all validators
@@ -305,11 +356,48 @@ type EthereumClaim interface {
 	GetClaimer() sdk.AccAddress
 	// Which type of claim this is
 	GetType() ClaimType
+	// The chain id of the Ethereum chain the event was observed on. It is checked against the BridgeChainId
+	// param before the claim is stored, so it is left out of the claim hash
+	GetBridgeChainId() uint64
 	ValidateBasic() error
 	// The claim hash of this claim. This is used to store these claims and also used to check if two different
 	// validators claims agree. Therefore it's extremely important that this include all elements of the claim
 	// with the exception of the orchestrator who sent it in, which will be used as a different part of the index
 	ClaimHash() ([]byte, error)
+	// The claim hash under an older or newer ClaimHashVersion, attestations record the version they are stored under
+	VersionedClaimHash(version uint32) ([]byte, error)
+}
+
+const (
+	// ClaimHashVersionLegacy hashes the claim path with tmhash, attestations stored before claim hashes were
+	// versioned all use it
+	ClaimHashVersionLegacy uint32 = 0
+	// ClaimHashVersionKeccak hashes the version byte followed by the claim path with keccak256
+	ClaimHashVersionKeccak uint32 = 1
+	// ClaimHashVersion is the version new attestations are stored under. When it is bumped claims are still
+	// accepted for attestations stored under the previous version, so the attestations in flight at the upgrade
+	// can still be observed
+	ClaimHashVersion = ClaimHashVersionKeccak
+)
+
+// hashClaimPath hashes the path describing a claim under the given claim hash version
+func hashClaimPath(version uint32, path string) ([]byte, error) {
+	switch version {
+	case ClaimHashVersionLegacy:
+		return tmhash.Sum([]byte(path)), nil
+	case ClaimHashVersionKeccak:
+		return crypto.Keccak256(append([]byte{byte(version)}, path...)), nil
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalid, "unknown claim hash version %d", version)
+	}
+}
+
+// TimestampedEthereumClaim is an EthereumClaim that also carries the unix timestamp of the Ethereum
+// block the event occurred in. Once observed these (height, timestamp) pairs are used to recalibrate
+// the average Ethereum block time, a zero timestamp means the orchestrator did not provide one
+type TimestampedEthereumClaim interface {
+	EthereumClaim
+	GetEthBlockTimestamp() uint64
 }
 
 //nolint: exhaustivestruct
@@ -318,6 +406,10 @@ var (
 	_ EthereumClaim = &MsgBatchSendToEthClaim{}
 	_ EthereumClaim = &MsgERC20DeployedClaim{}
 	_ EthereumClaim = &MsgLogicCallExecutedClaim{}
+	_ EthereumClaim = &MsgMigrationCompletedClaim{}
+
+	_ TimestampedEthereumClaim = &MsgSendToCosmosClaim{}
+	_ TimestampedEthereumClaim = &MsgBatchSendToEthClaim{}
 )
 
 // GetType returns the type of the claim
@@ -333,9 +425,23 @@ func (msg *MsgSendToCosmosClaim) ValidateBasic() error {
 	if err := ValidateEthAddress(msg.EthereumSender); err != nil {
 		return sdkerrors.Wrap(err, "eth sender")
 	}
+	if msg.TokenSender != "" {
+		if err := ValidateEthAddress(msg.TokenSender); err != nil {
+			return sdkerrors.Wrap(err, "token sender")
+		}
+		// an externally owned account can only deposit its own tokens, only a contract deposits for someone else
+		if !msg.EthereumSenderIsContract && !strings.EqualFold(msg.TokenSender, msg.EthereumSender) {
+			return sdkerrors.Wrap(ErrInvalid, "token sender differs from an eth sender that is not a contract")
+		}
+	}
 	if err := ValidateEthAddress(msg.TokenContract); err != nil {
 		return sdkerrors.Wrap(err, "erc20 token")
 	}
+	if msg.EthTxHash != "" {
+		if err := ValidateEthTxHash(msg.EthTxHash); err != nil {
+			return sdkerrors.Wrap(err, "eth tx hash")
+		}
+	}
 	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
 	}
@@ -389,9 +495,39 @@ const (
 // could engineer a hash collision and execute a version of the claim with any unhashed data changed to benefit them.
 // note that the Orchestrator is the only field excluded from this hash, this is because that value is used higher up in the store
 // structure for who has made what claim and is verified by the msg ante-handler for signatures
-func (msg *MsgSendToCosmosClaim) ClaimHash() ([]byte, error) {
+func (msg *MsgSendToCosmosClaim) VersionedClaimHash(version uint32) ([]byte, error) {
 	path := fmt.Sprintf("%d/%d/%s/%s/%s/%s", msg.EventNonce, msg.BlockHeight, msg.TokenContract, msg.Amount.String(), msg.EthereumSender, msg.CosmosReceiver)
-	return tmhash.Sum([]byte(path)), nil
+	// the timestamp is only appended when present so claims from orchestrators that do not report it keep their hash
+	if msg.EthBlockTimestamp != 0 {
+		path = fmt.Sprintf("%s/%d", path, msg.EthBlockTimestamp)
+	}
+	// the token sender likewise, it is prefixed so it can not be confused with a timestamp
+	if msg.TokenSender != "" {
+		path = fmt.Sprintf("%s/from:%s", path, msg.TokenSender)
+	}
+	// and the contract flag, so orchestrators have to agree on what kind of account sent the deposit
+	if msg.EthereumSenderIsContract {
+		path = fmt.Sprintf("%s/contract", path)
+	}
+	// and the transaction hash, lower cased since orchestrators may report it in any case
+	if msg.EthTxHash != "" {
+		path = fmt.Sprintf("%s/tx:%s", path, strings.ToLower(msg.EthTxHash))
+	}
+	return hashClaimPath(version, path)
+}
+
+// ClaimHash returns the claim hash under the current ClaimHashVersion
+func (msg *MsgSendToCosmosClaim) ClaimHash() ([]byte, error) {
+	return msg.VersionedClaimHash(ClaimHashVersion)
+}
+
+// GetDepositor returns the Ethereum address the deposited tokens came from, the token sender if the claim has one
+// and the msg.sender of the deposit otherwise
+func (msg *MsgSendToCosmosClaim) GetDepositor() string {
+	if msg.TokenSender != "" {
+		return msg.TokenSender
+	}
+	return msg.EthereumSender
 }
 
 // GetType returns the claim type
@@ -413,13 +549,29 @@ func (e *MsgBatchSendToEthClaim) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(e.Orchestrator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, e.Orchestrator)
 	}
+	if e.Relayer != "" {
+		if err := ValidateEthAddress(e.Relayer); err != nil {
+			return sdkerrors.Wrap(err, "relayer")
+		}
+	}
 	return nil
 }
 
 // Hash implements WithdrawBatch.Hash
-func (msg *MsgBatchSendToEthClaim) ClaimHash() ([]byte, error) {
+func (msg *MsgBatchSendToEthClaim) VersionedClaimHash(version uint32) ([]byte, error) {
 	path := fmt.Sprintf("%s/%d/%d/%s", msg.TokenContract, msg.BatchNonce, msg.EventNonce, msg.TokenContract)
-	return tmhash.Sum([]byte(path)), nil
+	if msg.EthBlockTimestamp != 0 {
+		path = fmt.Sprintf("%s/%d", path, msg.EthBlockTimestamp)
+	}
+	if msg.Relayer != "" {
+		path = fmt.Sprintf("%s/%s", path, msg.Relayer)
+	}
+	return hashClaimPath(version, path)
+}
+
+// ClaimHash returns the claim hash under the current ClaimHashVersion
+func (msg *MsgBatchSendToEthClaim) ClaimHash() ([]byte, error) {
+	return msg.VersionedClaimHash(ClaimHashVersion)
 }
 
 // GetSignBytes encodes the message for signing
@@ -514,9 +666,14 @@ func (msg MsgERC20DeployedClaim) Route() string { return RouterKey }
 // could engineer a hash collision and execute a version of the claim with any unhashed data changed to benefit them.
 // note that the Orchestrator is the only field excluded from this hash, this is because that value is used higher up in the store
 // structure for who has made what claim and is verified by the msg ante-handler for signatures
-func (b *MsgERC20DeployedClaim) ClaimHash() ([]byte, error) {
+func (b *MsgERC20DeployedClaim) VersionedClaimHash(version uint32) ([]byte, error) {
 	path := fmt.Sprintf("%d/%d/%s/%s/%s/%s/%d", b.EventNonce, b.BlockHeight, b.CosmosDenom, b.TokenContract, b.Name, b.Symbol, b.Decimals)
-	return tmhash.Sum([]byte(path)), nil
+	return hashClaimPath(version, path)
+}
+
+// ClaimHash returns the claim hash under the current ClaimHashVersion
+func (b *MsgERC20DeployedClaim) ClaimHash() ([]byte, error) {
+	return b.VersionedClaimHash(ClaimHashVersion)
 }
 
 // EthereumClaim implementation for MsgLogicCallExecutedClaim
@@ -574,9 +731,14 @@ func (msg MsgLogicCallExecutedClaim) Route() string { return RouterKey }
 // could engineer a hash collision and execute a version of the claim with any unhashed data changed to benefit them.
 // note that the Orchestrator is the only field excluded from this hash, this is because that value is used higher up in the store
 // structure for who has made what claim and is verified by the msg ante-handler for signatures
-func (b *MsgLogicCallExecutedClaim) ClaimHash() ([]byte, error) {
+func (b *MsgLogicCallExecutedClaim) VersionedClaimHash(version uint32) ([]byte, error) {
 	path := fmt.Sprintf("%d,%d,%s/%d/", b.EventNonce, b.BlockHeight, b.InvalidationId, b.InvalidationNonce)
-	return tmhash.Sum([]byte(path)), nil
+	return hashClaimPath(version, path)
+}
+
+// ClaimHash returns the claim hash under the current ClaimHashVersion
+func (b *MsgLogicCallExecutedClaim) ClaimHash() ([]byte, error) {
+	return b.VersionedClaimHash(ClaimHashVersion)
 }
 
 // EthereumClaim implementation for MsgValsetUpdatedClaim
@@ -647,7 +809,7 @@ func (msg MsgValsetUpdatedClaim) Route() string { return RouterKey }
 // could engineer a hash collision and execute a version of the claim with any unhashed data changed to benefit them.
 // note that the Orchestrator is the only field excluded from this hash, this is because that value is used higher up in the store
 // structure for who has made what claim and is verified by the msg ante-handler for signatures
-func (b *MsgValsetUpdatedClaim) ClaimHash() ([]byte, error) {
+func (b *MsgValsetUpdatedClaim) VersionedClaimHash(version uint32) ([]byte, error) {
 	var members BridgeValidators = b.Members
 	internalMembers, err := members.ToInternal()
 	if err != nil {
@@ -655,7 +817,80 @@ func (b *MsgValsetUpdatedClaim) ClaimHash() ([]byte, error) {
 	}
 	internalMembers.Sort()
 	path := fmt.Sprintf("%d/%d/%d/%s/%s/%s", b.EventNonce, b.ValsetNonce, b.BlockHeight, internalMembers.ToExternal(), b.RewardAmount.String(), b.RewardToken)
-	return tmhash.Sum([]byte(path)), nil
+	return hashClaimPath(version, path)
+}
+
+// ClaimHash returns the claim hash under the current ClaimHashVersion
+func (b *MsgValsetUpdatedClaim) ClaimHash() ([]byte, error) {
+	return b.VersionedClaimHash(ClaimHashVersion)
+}
+
+// EthereumClaim implementation for MsgMigrationCompletedClaim
+// ===========================================================
+
+// GetType returns the type of the claim
+func (e *MsgMigrationCompletedClaim) GetType() ClaimType {
+	return CLAIM_TYPE_MIGRATION_COMPLETED
+}
+
+// ValidateBasic performs stateless checks
+func (e *MsgMigrationCompletedClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(e.Orchestrator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, e.Orchestrator)
+	}
+	if e.EventNonce == 0 {
+		return fmt.Errorf("nonce == 0")
+	}
+	if err := ValidateEthAddress(e.NewBridgeContract); err != nil {
+		return sdkerrors.Wrap(err, "new bridge contract")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgMigrationCompletedClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgMigrationCompletedClaim) GetClaimer() sdk.AccAddress {
+	err := msg.ValidateBasic()
+	if err != nil {
+		panic("MsgMigrationCompletedClaim failed ValidateBasic! Should have been handled earlier")
+	}
+
+	val, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+	return val
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgMigrationCompletedClaim) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// Type should return the action
+func (msg MsgMigrationCompletedClaim) Type() string { return "migration_completed_claim" }
+
+// Route should return the name of the module
+func (msg MsgMigrationCompletedClaim) Route() string { return RouterKey }
+
+// Hash implements BridgeDeposit.Hash
+// modify this with care as it is security sensitive. If an element of the claim is not in this hash a single hostile validator
+// could engineer a hash collision and execute a version of the claim with any unhashed data changed to benefit them.
+// note that the Orchestrator is the only field excluded from this hash, this is because that value is used higher up in the store
+// structure for who has made what claim and is verified by the msg ante-handler for signatures
+func (b *MsgMigrationCompletedClaim) VersionedClaimHash(version uint32) ([]byte, error) {
+	path := fmt.Sprintf("%d/%d/%s", b.EventNonce, b.BlockHeight, b.NewBridgeContract)
+	return hashClaimPath(version, path)
+}
+
+// ClaimHash returns the claim hash under the current ClaimHashVersion
+func (b *MsgMigrationCompletedClaim) ClaimHash() ([]byte, error) {
+	return b.VersionedClaimHash(ClaimHashVersion)
 }
 
 // NewMsgCancelSendToEth returns a new msgSetOrchestratorAddress
@@ -666,6 +901,16 @@ func NewMsgCancelSendToEth(user sdk.AccAddress, id uint64) *MsgCancelSendToEth {
 	}
 }
 
+// NewMsgCancelSendToEthWithRefundAddress returns a new MsgCancelSendToEth that has the refund paid to
+// refundAddress instead of the sender
+func NewMsgCancelSendToEthWithRefundAddress(user sdk.AccAddress, id uint64, refundAddress sdk.AccAddress) *MsgCancelSendToEth {
+	return &MsgCancelSendToEth{
+		Sender:        user.String(),
+		TransactionId: id,
+		RefundAddress: refundAddress.String(),
+	}
+}
+
 // Route should return the name of the module
 func (msg *MsgCancelSendToEth) Route() string { return RouterKey }
 
@@ -678,6 +923,11 @@ func (msg *MsgCancelSendToEth) ValidateBasic() (err error) {
 	if err != nil {
 		return err
 	}
+	if msg.RefundAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.RefundAddress); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.RefundAddress)
+		}
+	}
 	return nil
 }
 
@@ -695,6 +945,125 @@ func (msg *MsgCancelSendToEth) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{acc}
 }
 
+// NewMsgCancelAllSendToEth returns a new MsgCancelAllSendToEth
+func NewMsgCancelAllSendToEth(user sdk.AccAddress) *MsgCancelAllSendToEth {
+	return &MsgCancelAllSendToEth{
+		Sender: user.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgCancelAllSendToEth) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgCancelAllSendToEth) Type() string { return "cancel_all_send_to_eth" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgCancelAllSendToEth) ValidateBasic() (err error) {
+	_, err = sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCancelAllSendToEth) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgCancelAllSendToEth) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgReleaseSendToEth returns a new MsgReleaseSendToEth
+func NewMsgReleaseSendToEth(user sdk.AccAddress, id uint64) *MsgReleaseSendToEth {
+	return &MsgReleaseSendToEth{
+		Sender:        user.String(),
+		TransactionId: id,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgReleaseSendToEth) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgReleaseSendToEth) Type() string { return "release_send_to_eth" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgReleaseSendToEth) ValidateBasic() (err error) {
+	_, err = sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return err
+	}
+	if msg.TransactionId == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "transaction id")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgReleaseSendToEth) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgReleaseSendToEth) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgBumpSendToEthFee returns a new MsgBumpSendToEthFee
+func NewMsgBumpSendToEthFee(user sdk.AccAddress, id uint64, extraFee sdk.Coin) *MsgBumpSendToEthFee {
+	return &MsgBumpSendToEthFee{
+		Sender:        user.String(),
+		TransactionId: id,
+		ExtraFee:      extraFee,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgBumpSendToEthFee) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgBumpSendToEthFee) Type() string { return "bump_send_to_eth_fee" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgBumpSendToEthFee) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if msg.TransactionId == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "transaction id")
+	}
+	if !msg.ExtraFee.IsValid() || !msg.ExtraFee.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "extra fee")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgBumpSendToEthFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgBumpSendToEthFee) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
 // MsgSubmitBadSignatureEvidence
 // ======================================================
 
@@ -704,6 +1073,16 @@ func (e *MsgSubmitBadSignatureEvidence) ValidateBasic() (err error) {
 	if err != nil {
 		return err
 	}
+	if e.Subject == nil {
+		return sdkerrors.Wrap(ErrInvalid, "subject is required")
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(e.Signature, "0x"))
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "signature %s is not hex", e.Signature)
+	}
+	if len(sig) != 65 {
+		return sdkerrors.Wrapf(ErrInvalid, "signature is %d bytes instead of 65", len(sig))
+	}
 	return nil
 }
 
@@ -726,3 +1105,423 @@ func (msg MsgSubmitBadSignatureEvidence) Type() string { return "Submit_Bad_Sign
 
 // Route should return the name of the module
 func (msg MsgSubmitBadSignatureEvidence) Route() string { return RouterKey }
+
+// MsgSubmitConflictingClaimEvidence
+// ======================================================
+
+// NewMsgSubmitConflictingClaimEvidence returns a new MsgSubmitConflictingClaimEvidence
+func NewMsgSubmitConflictingClaimEvidence(sender sdk.AccAddress, tx []byte, accountNumber uint64) *MsgSubmitConflictingClaimEvidence {
+	return &MsgSubmitConflictingClaimEvidence{
+		Tx:            tx,
+		AccountNumber: accountNumber,
+		Sender:        sender.String(),
+	}
+}
+
+// ValidateBasic performs stateless checks
+func (msg *MsgSubmitConflictingClaimEvidence) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if len(msg.Tx) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "tx")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSubmitConflictingClaimEvidence) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgSubmitConflictingClaimEvidence) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// Type should return the action
+func (msg *MsgSubmitConflictingClaimEvidence) Type() string {
+	return "submit_conflicting_claim_evidence"
+}
+
+// Route should return the name of the module
+func (msg *MsgSubmitConflictingClaimEvidence) Route() string { return RouterKey }
+
+// MaxHeartbeatVersionLength bounds the size of the version string an orchestrator
+// may report in a heartbeat, it is stored on chain so it must be small
+const MaxHeartbeatVersionLength = 64
+
+// NewMsgOrchestratorHeartbeat returns a new MsgOrchestratorHeartbeat
+func NewMsgOrchestratorHeartbeat(
+	orchestrator sdk.AccAddress,
+	ethBlockHeight uint64,
+	version string,
+	ethGasPrice uint64,
+	ethBaseFee uint64,
+	ethPriorityFee uint64,
+) *MsgOrchestratorHeartbeat {
+	return &MsgOrchestratorHeartbeat{
+		Orchestrator:   orchestrator.String(),
+		EthBlockHeight: ethBlockHeight,
+		Version:        version,
+		EthGasPrice:    ethGasPrice,
+		EthBaseFee:     ethBaseFee,
+		EthPriorityFee: ethPriorityFee,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgOrchestratorHeartbeat) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgOrchestratorHeartbeat) Type() string { return "orchestrator_heartbeat" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgOrchestratorHeartbeat) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
+	}
+	if len(msg.Version) > MaxHeartbeatVersionLength {
+		return sdkerrors.Wrapf(ErrInvalid, "version longer than %d characters", MaxHeartbeatVersionLength)
+	}
+	if msg.EthPriorityFee != 0 && msg.EthBaseFee == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "priority fee reported without a base fee")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgOrchestratorHeartbeat) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgOrchestratorHeartbeat) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// MaxEthDestinationLabelLength bounds the size of the labels in an Ethereum destination address book
+const MaxEthDestinationLabelLength = 64
+
+var ethDestinationLabelRegex = regexp.MustCompile(fmt.Sprintf("^[a-zA-Z0-9][a-zA-Z0-9._-]{0,%d}$", MaxEthDestinationLabelLength-1))
+
+// ValidateEthDestinationLabel checks that label is a valid Ethereum destination label: letters, digits, dots,
+// dashes and underscores, starting with a letter or digit and at most MaxEthDestinationLabelLength long
+func ValidateEthDestinationLabel(label string) error {
+	if !ethDestinationLabelRegex.MatchString(label) {
+		return sdkerrors.Wrapf(ErrInvalid, "destination label %q", label)
+	}
+	return nil
+}
+
+// NewMsgSetEthDestinationLabel returns a new MsgSetEthDestinationLabel, a nil address removes the label
+func NewMsgSetEthDestinationLabel(owner sdk.AccAddress, label string, ethAddress *EthAddress) *MsgSetEthDestinationLabel {
+	msg := &MsgSetEthDestinationLabel{
+		Owner:      owner.String(),
+		Label:      label,
+		EthAddress: "",
+	}
+	if ethAddress != nil {
+		msg.EthAddress = ethAddress.GetAddress()
+	}
+	return msg
+}
+
+// Route should return the name of the module
+func (msg *MsgSetEthDestinationLabel) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgSetEthDestinationLabel) Type() string { return "set_eth_destination_label" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgSetEthDestinationLabel) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner)
+	}
+	if err := ValidateEthDestinationLabel(msg.Label); err != nil {
+		return err
+	}
+	if msg.EthAddress != "" {
+		if err := ValidateEthAddress(msg.EthAddress); err != nil {
+			return sdkerrors.Wrap(err, "ethereum address")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSetEthDestinationLabel) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgSetEthDestinationLabel) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// MaxFirstSendDelay bounds the number of blocks a send to a new Ethereum destination can be held
+const MaxFirstSendDelay = 1000000
+
+// NewMsgSetFirstSendDelay returns a new MsgSetFirstSendDelay, a delay of zero opts out
+func NewMsgSetFirstSendDelay(sender sdk.AccAddress, delayBlocks uint64) *MsgSetFirstSendDelay {
+	return &MsgSetFirstSendDelay{
+		Sender:      sender.String(),
+		DelayBlocks: delayBlocks,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgSetFirstSendDelay) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgSetFirstSendDelay) Type() string { return "set_first_send_delay" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgSetFirstSendDelay) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if msg.DelayBlocks > MaxFirstSendDelay {
+		return sdkerrors.Wrapf(ErrInvalid, "first send delay of %d blocks is above the maximum of %d", msg.DelayBlocks, MaxFirstSendDelay)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSetFirstSendDelay) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgSetFirstSendDelay) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// MaxConfirmsPerSubmission bounds the number of confirms a single MsgSubmitConfirms may carry
+const MaxConfirmsPerSubmission = 100
+
+// NewMsgSubmitConfirms returns a new MsgSubmitConfirms bundling the confirms of orchestrator
+func NewMsgSubmitConfirms(orchestrator sdk.AccAddress, valsetConfirms []MsgValsetConfirm,
+	batchConfirms []MsgConfirmBatch, logicCallConfirms []MsgConfirmLogicCall) *MsgSubmitConfirms {
+	return &MsgSubmitConfirms{
+		Orchestrator:      orchestrator.String(),
+		ValsetConfirms:    valsetConfirms,
+		BatchConfirms:     batchConfirms,
+		LogicCallConfirms: logicCallConfirms,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgSubmitConfirms) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgSubmitConfirms) Type() string { return "submit_confirms" }
+
+// ValidateBasic performs stateless checks, every bundled confirm must be valid and from the orchestrator
+// signing the message
+func (msg *MsgSubmitConfirms) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
+	}
+	total := len(msg.ValsetConfirms) + len(msg.BatchConfirms) + len(msg.LogicCallConfirms)
+	if total == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "confirms")
+	}
+	if total > MaxConfirmsPerSubmission {
+		return sdkerrors.Wrapf(ErrInvalid, "%d confirms is above the maximum of %d", total, MaxConfirmsPerSubmission)
+	}
+	for i := range msg.ValsetConfirms {
+		if err := msg.checkConfirm(&msg.ValsetConfirms[i], msg.ValsetConfirms[i].Orchestrator); err != nil {
+			return sdkerrors.Wrapf(err, "valset confirm %d", i)
+		}
+	}
+	for i := range msg.BatchConfirms {
+		if err := msg.checkConfirm(&msg.BatchConfirms[i], msg.BatchConfirms[i].Orchestrator); err != nil {
+			return sdkerrors.Wrapf(err, "batch confirm %d", i)
+		}
+	}
+	for i := range msg.LogicCallConfirms {
+		if err := msg.checkConfirm(&msg.LogicCallConfirms[i], msg.LogicCallConfirms[i].Orchestrator); err != nil {
+			return sdkerrors.Wrapf(err, "logic call confirm %d", i)
+		}
+	}
+	return nil
+}
+
+// checkConfirm validates a bundled confirm and checks it is from the orchestrator signing the message
+func (msg *MsgSubmitConfirms) checkConfirm(confirm sdk.Msg, orchestrator string) error {
+	if orchestrator != msg.Orchestrator {
+		return sdkerrors.Wrapf(ErrMismatched, "confirm from %s bundled by %s", orchestrator, msg.Orchestrator)
+	}
+	return confirm.ValidateBasic()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSubmitConfirms) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgSubmitConfirms) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// MaxEthereumEventsPerSubmission bounds the number of claims a single MsgSubmitEthereumEvents may carry
+const MaxEthereumEventsPerSubmission = 100
+
+// NewMsgSubmitEthereumEvents returns a new MsgSubmitEthereumEvents bundling the given claims of orchestrator,
+// claims of a type the message can not carry are refused
+func NewMsgSubmitEthereumEvents(orchestrator sdk.AccAddress, claims ...EthereumClaim) (*MsgSubmitEthereumEvents, error) {
+	msg := &MsgSubmitEthereumEvents{Orchestrator: orchestrator.String()}
+	for _, claim := range claims {
+		switch claim := claim.(type) {
+		case *MsgSendToCosmosClaim:
+			msg.Deposits = append(msg.Deposits, *claim)
+		case *MsgBatchSendToEthClaim:
+			msg.Withdrawals = append(msg.Withdrawals, *claim)
+		case *MsgERC20DeployedClaim:
+			msg.Erc20Deployments = append(msg.Erc20Deployments, *claim)
+		case *MsgValsetUpdatedClaim:
+			msg.ValsetUpdates = append(msg.ValsetUpdates, *claim)
+		case *MsgLogicCallExecutedClaim:
+			msg.LogicCallExecutions = append(msg.LogicCallExecutions, *claim)
+		default:
+			return nil, sdkerrors.Wrapf(ErrInvalid, "claim type %s can not be bundled", claim.GetType())
+		}
+	}
+	return msg, nil
+}
+
+// Route should return the name of the module
+func (msg *MsgSubmitEthereumEvents) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgSubmitEthereumEvents) Type() string { return "submit_ethereum_events" }
+
+// GetClaims returns every bundled claim, in event nonce order
+func (msg *MsgSubmitEthereumEvents) GetClaims() []EthereumClaim {
+	claims := make([]EthereumClaim, 0, len(msg.Deposits)+len(msg.Withdrawals)+len(msg.Erc20Deployments)+
+		len(msg.ValsetUpdates)+len(msg.LogicCallExecutions))
+	for i := range msg.Deposits {
+		claims = append(claims, &msg.Deposits[i])
+	}
+	for i := range msg.Withdrawals {
+		claims = append(claims, &msg.Withdrawals[i])
+	}
+	for i := range msg.Erc20Deployments {
+		claims = append(claims, &msg.Erc20Deployments[i])
+	}
+	for i := range msg.ValsetUpdates {
+		claims = append(claims, &msg.ValsetUpdates[i])
+	}
+	for i := range msg.LogicCallExecutions {
+		claims = append(claims, &msg.LogicCallExecutions[i])
+	}
+	sort.SliceStable(claims, func(i, j int) bool {
+		return claims[i].GetEventNonce() < claims[j].GetEventNonce()
+	})
+	return claims
+}
+
+// ValidateBasic performs stateless checks, every bundled claim must be valid, from the orchestrator signing the
+// message and for its own event nonce
+func (msg *MsgSubmitEthereumEvents) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
+	}
+	claims := msg.GetClaims()
+	if len(claims) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "claims")
+	}
+	if len(claims) > MaxEthereumEventsPerSubmission {
+		return sdkerrors.Wrapf(ErrInvalid, "%d claims is above the maximum of %d", len(claims), MaxEthereumEventsPerSubmission)
+	}
+	for i, claim := range claims {
+		if err := claim.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "claim at event nonce %d", claim.GetEventNonce())
+		}
+		if claimer := claim.GetClaimer().String(); claimer != msg.Orchestrator {
+			return sdkerrors.Wrapf(ErrMismatched, "claim from %s bundled by %s", claimer, msg.Orchestrator)
+		}
+		if i > 0 && claims[i-1].GetEventNonce() == claim.GetEventNonce() {
+			return sdkerrors.Wrapf(ErrDuplicate, "two claims at event nonce %d", claim.GetEventNonce())
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSubmitEthereumEvents) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgSubmitEthereumEvents) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgFundValsetReward returns a new MsgFundValsetReward adding amount to the valset reward reserve
+func NewMsgFundValsetReward(sender sdk.AccAddress, amount sdk.Coin) *MsgFundValsetReward {
+	return &MsgFundValsetReward{
+		Sender: sender.String(),
+		Amount: amount,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgFundValsetReward) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgFundValsetReward) Type() string { return "fund_valset_reward" }
+
+// ValidateBasic performs stateless checks, only the vouchers of Ethereum originated tokens are held in the reserve
+func (msg *MsgFundValsetReward) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if _, err := GravityDenomToERC20(msg.Amount.Denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "%s is not the voucher of an Ethereum originated token", msg.Amount.Denom)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgFundValsetReward) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgFundValsetReward) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
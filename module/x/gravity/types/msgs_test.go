@@ -2,10 +2,15 @@ package types
 
 import (
 	"bytes"
+	"fmt"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
 func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
@@ -18,12 +23,20 @@ func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
 		srcCosmosAddr sdk.AccAddress
 		srcValAddr    sdk.ValAddress
 		srcETHAddr    string
+		srcSignature  []byte
 		expErr        bool
 	}{
 		"all good": {
 			srcCosmosAddr: cosmosAddress,
 			srcValAddr:    valAddress,
 			srcETHAddr:    ethAddress,
+			srcSignature:  []byte("signature"),
+		},
+		"empty ethereum signature": {
+			srcCosmosAddr: cosmosAddress,
+			srcValAddr:    valAddress,
+			srcETHAddr:    ethAddress,
+			expErr:        true,
 		},
 		"empty validator address": {
 			srcETHAddr:    ethAddress,
@@ -51,7 +64,7 @@ func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
 			ethAddr, err := NewEthAddress(spec.srcETHAddr)
-			msg := NewMsgSetOrchestratorAddress(spec.srcValAddr, spec.srcCosmosAddr, *ethAddr)
+			msg := NewMsgSetOrchestratorAddress(spec.srcValAddr, spec.srcCosmosAddr, *ethAddr, spec.srcSignature)
 			// when
 			err = msg.ValidateBasic()
 			if spec.expErr {
@@ -63,3 +76,116 @@ func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
 	}
 
 }
+
+func TestClaimHashEthBlockTimestamp(t *testing.T) {
+	claim := MsgBatchSendToEthClaim{
+		EventNonce:    1,
+		BlockHeight:   100,
+		BatchNonce:    2,
+		TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		Orchestrator:  "",
+	}
+	legacy, err := claim.VersionedClaimHash(ClaimHashVersionLegacy)
+	assert.NoError(t, err)
+	// the hash without a timestamp is the one computed before timestamps were added
+	path := fmt.Sprintf("%s/%d/%d/%s", claim.TokenContract, claim.BatchNonce, claim.EventNonce, claim.TokenContract)
+	assert.Equal(t, tmhash.Sum([]byte(path)), legacy)
+
+	claim.EthBlockTimestamp = 1600000000
+	timestamped, err := claim.VersionedClaimHash(ClaimHashVersionLegacy)
+	assert.NoError(t, err)
+	assert.NotEqual(t, legacy, timestamped)
+
+	var c TimestampedEthereumClaim = &claim
+	assert.Equal(t, uint64(1600000000), c.GetEthBlockTimestamp())
+}
+
+func TestClaimHashTokenSender(t *testing.T) {
+	claim := MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    100,
+		TokenContract:  "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		Amount:         sdk.NewInt(1000),
+		EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+		CosmosReceiver: "cosmos16ahjkfqxpp6lvfy9fpfnfjg39xr96qett0alj5",
+		Orchestrator:   "cosmos16ahjkfqxpp6lvfy9fpfnfjg39xr96qett0alj5",
+	}
+	legacy, err := claim.VersionedClaimHash(ClaimHashVersionLegacy)
+	assert.NoError(t, err)
+	// the hash without a token sender is the one computed before token senders were added
+	path := fmt.Sprintf("%d/%d/%s/%s/%s/%s", claim.EventNonce, claim.BlockHeight, claim.TokenContract,
+		claim.Amount.String(), claim.EthereumSender, claim.CosmosReceiver)
+	assert.Equal(t, tmhash.Sum([]byte(path)), legacy)
+	assert.Equal(t, claim.EthereumSender, claim.GetDepositor())
+
+	claim.TokenSender = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+	withSender, err := claim.VersionedClaimHash(ClaimHashVersionLegacy)
+	assert.NoError(t, err)
+	assert.NotEqual(t, legacy, withSender)
+	assert.Equal(t, claim.TokenSender, claim.GetDepositor())
+	// only a contract can deposit tokens transferred from another address
+	assert.Error(t, claim.ValidateBasic())
+
+	claim.EthereumSenderIsContract = true
+	fromContract, err := claim.VersionedClaimHash(ClaimHashVersionLegacy)
+	assert.NoError(t, err)
+	assert.NotEqual(t, withSender, fromContract)
+	assert.NoError(t, claim.ValidateBasic())
+
+	claim.TokenSender = "0xinvalid"
+	assert.Error(t, claim.ValidateBasic())
+}
+
+func TestClaimHashEthTxHash(t *testing.T) {
+	claim := MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    100,
+		TokenContract:  "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		Amount:         sdk.NewInt(1000),
+		EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+		CosmosReceiver: "cosmos16ahjkfqxpp6lvfy9fpfnfjg39xr96qett0alj5",
+		Orchestrator:   "cosmos16ahjkfqxpp6lvfy9fpfnfjg39xr96qett0alj5",
+	}
+	without, err := claim.ClaimHash()
+	assert.NoError(t, err)
+
+	claim.EthTxHash = "0x" + strings.Repeat("aB", 32)
+	assert.NoError(t, claim.ValidateBasic())
+	lower, err := claim.ClaimHash()
+	assert.NoError(t, err)
+	assert.NotEqual(t, without, lower)
+	// orchestrators reporting the hash in another case still agree on the claim
+	claim.EthTxHash = "0x" + strings.ToUpper(claim.EthTxHash[2:])
+	upper, err := claim.ClaimHash()
+	assert.NoError(t, err)
+	assert.Equal(t, lower, upper)
+
+	claim.EthTxHash = "0x1234"
+	assert.Error(t, claim.ValidateBasic())
+}
+
+func TestVersionedClaimHash(t *testing.T) {
+	claim := MsgMigrationCompletedClaim{
+		EventNonce:        3,
+		BlockHeight:       200,
+		NewBridgeContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		Orchestrator:      "",
+	}
+	path := fmt.Sprintf("%d/%d/%s", claim.EventNonce, claim.BlockHeight, claim.NewBridgeContract)
+
+	legacy, err := claim.VersionedClaimHash(ClaimHashVersionLegacy)
+	require.NoError(t, err)
+	assert.Equal(t, tmhash.Sum([]byte(path)), legacy)
+
+	keccak, err := claim.VersionedClaimHash(ClaimHashVersionKeccak)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.Keccak256(append([]byte{byte(ClaimHashVersionKeccak)}, path...)), keccak)
+	assert.NotEqual(t, legacy, keccak)
+
+	current, err := claim.ClaimHash()
+	require.NoError(t, err)
+	assert.Equal(t, keccak, current)
+
+	_, err = claim.VersionedClaimHash(ClaimHashVersion + 1)
+	assert.Error(t, err)
+}
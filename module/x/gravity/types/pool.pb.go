@@ -69,9 +69,16 @@ func (m *IDSet) GetIds() []uint64 {
 	return nil
 }
 
+// BatchFees describes the fees the next batch of a token would collect,
+// min_fee, max_fee and median_fee are taken over the tx_count transactions
+// it would pick and are zero if it would pick none
 type BatchFees struct {
 	Token     string                                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	TotalFees github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_fees,json=totalFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_fees"`
+	TxCount   uint64                                 `protobuf:"varint,3,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	MinFee    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_fee,json=minFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fee"`
+	MaxFee    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=max_fee,json=maxFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_fee"`
+	MedianFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=median_fee,json=medianFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"median_fee"`
 }
 
 func (m *BatchFees) Reset()         { *m = BatchFees{} }
@@ -114,6 +121,13 @@ func (m *BatchFees) GetToken() string {
 	return ""
 }
 
+func (m *BatchFees) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IDSet)(nil), "gravity.v1.IDSet")
 	proto.RegisterType((*BatchFees)(nil), "gravity.v1.BatchFees")
@@ -122,24 +136,28 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/pool.proto", fileDescriptor_18d107f7cfc31f22) }
 
 var fileDescriptor_18d107f7cfc31f22 = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4d, 0x2f, 0x4a, 0x2c,
-	0xcb, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0xc8, 0xcf, 0xcf, 0xd1, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0xe2, 0x82, 0x0a, 0xeb, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x85,
-	0xf5, 0x41, 0x2c, 0x88, 0x0a, 0x25, 0x49, 0x2e, 0x56, 0x4f, 0x97, 0xe0, 0xd4, 0x12, 0x21, 0x01,
-	0x2e, 0xe6, 0xcc, 0x94, 0x62, 0x09, 0x46, 0x05, 0x66, 0x0d, 0x96, 0x20, 0x10, 0x53, 0xa9, 0x80,
-	0x8b, 0xd3, 0x29, 0xb1, 0x24, 0x39, 0xc3, 0x2d, 0x35, 0xb5, 0x58, 0x48, 0x84, 0x8b, 0xb5, 0x24,
-	0x3f, 0x3b, 0x35, 0x4f, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0xc2, 0x11, 0xf2, 0xe5, 0xe2,
-	0x2a, 0xc9, 0x2f, 0x49, 0xcc, 0x89, 0x4f, 0x4b, 0x4d, 0x2d, 0x96, 0x60, 0x02, 0x49, 0x39, 0xe9,
-	0x9d, 0xb8, 0x27, 0xcf, 0x70, 0xeb, 0x9e, 0xbc, 0x5a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x7e, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x31, 0x94, 0xd2, 0x2d, 0x4e, 0xc9, 0xd6,
-	0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0xf3, 0xcc, 0x2b, 0x09, 0xe2, 0x04, 0x9b, 0x00, 0xb2, 0xc4,
-	0x29, 0xe6, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x9c, 0x90, 0x0c, 0x4b,
-	0xcc, 0x29, 0xc9, 0x48, 0x4d, 0xd4, 0xcd, 0x4b, 0x2d, 0x81, 0x19, 0x08, 0xf5, 0xa5, 0x6e, 0x52,
-	0x51, 0x66, 0x4a, 0x7a, 0xaa, 0x7e, 0x6e, 0x7e, 0x4a, 0x69, 0x4e, 0xaa, 0x7e, 0x85, 0x3e, 0x2c,
-	0x50, 0xc0, 0x96, 0x25, 0xb1, 0x81, 0x7d, 0x6c, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x44, 0x58,
-	0x7f, 0x3a, 0x2c, 0x01, 0x00, 0x00,
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xcf, 0x6a, 0xfa, 0x40,
+	0x10, 0xc7, 0xb3, 0xfe, 0xfd, 0xb9, 0xa7, 0x1f, 0xc1, 0x42, 0xec, 0x21, 0x8a, 0x87, 0xe2, 0xc5,
+	0x2c, 0xd2, 0x37, 0x48, 0x8b, 0xc5, 0x83, 0x97, 0xf4, 0x56, 0x0a, 0xb2, 0x26, 0xd3, 0xb8, 0x98,
+	0x64, 0xc4, 0x1d, 0x25, 0xbe, 0x45, 0x1f, 0xcb, 0xa3, 0xc7, 0xd2, 0x83, 0x14, 0xbd, 0xf6, 0x21,
+	0x4a, 0xfe, 0x14, 0x7a, 0xce, 0x69, 0x67, 0xbe, 0xbb, 0x7c, 0x3e, 0x3b, 0x0c, 0xbf, 0x09, 0xb7,
+	0x72, 0xaf, 0xe8, 0x20, 0xf6, 0x13, 0xb1, 0x41, 0x8c, 0x9c, 0xcd, 0x16, 0x09, 0x4d, 0x5e, 0xc6,
+	0xce, 0x7e, 0x72, 0xdb, 0x0d, 0x31, 0xc4, 0x3c, 0x16, 0x59, 0x55, 0xbc, 0x18, 0xf6, 0x78, 0x73,
+	0xf6, 0xf8, 0x0c, 0x64, 0xfe, 0xe7, 0x75, 0x15, 0x68, 0x8b, 0x0d, 0xea, 0xa3, 0x86, 0x97, 0x95,
+	0xc3, 0xef, 0x1a, 0xef, 0xb8, 0x92, 0xfc, 0xd5, 0x14, 0x40, 0x9b, 0x5d, 0xde, 0x24, 0x5c, 0x43,
+	0x62, 0xb1, 0x01, 0x1b, 0x75, 0xbc, 0xa2, 0x31, 0xe7, 0x9c, 0x13, 0x92, 0x8c, 0x16, 0x6f, 0x00,
+	0xda, 0xaa, 0x65, 0x57, 0xae, 0x73, 0x3c, 0xf7, 0x8d, 0xcf, 0x73, 0xff, 0x2e, 0x54, 0xb4, 0xda,
+	0x2d, 0x1d, 0x1f, 0x63, 0xe1, 0xa3, 0x8e, 0x51, 0x97, 0xc7, 0x58, 0x07, 0x6b, 0x41, 0x87, 0x0d,
+	0x68, 0x67, 0x96, 0x90, 0xd7, 0xc9, 0x09, 0xb9, 0xa4, 0xc7, 0xff, 0x51, 0xba, 0xf0, 0x71, 0x97,
+	0x90, 0x55, 0x1f, 0xb0, 0x51, 0xc3, 0x6b, 0x53, 0xfa, 0x90, 0xb5, 0xe6, 0x13, 0x6f, 0xc7, 0x2a,
+	0xc9, 0x3c, 0x56, 0xa3, 0x92, 0xa6, 0x15, 0xab, 0x64, 0x0a, 0x90, 0x83, 0x64, 0x9a, 0x83, 0x9a,
+	0x15, 0x41, 0x32, 0xcd, 0x40, 0x73, 0xce, 0x63, 0x08, 0x94, 0x2c, 0x3e, 0xd5, 0xaa, 0x36, 0x7b,
+	0x41, 0x98, 0x02, 0xb8, 0xaf, 0xc7, 0x8b, 0xcd, 0x4e, 0x17, 0x9b, 0x7d, 0x5d, 0x6c, 0xf6, 0x7e,
+	0xb5, 0x8d, 0xd3, 0xd5, 0x36, 0x3e, 0xae, 0xb6, 0xf1, 0xe2, 0xfe, 0x81, 0xc9, 0x88, 0x56, 0x20,
+	0xc7, 0x09, 0xd0, 0x2f, 0xb0, 0x5c, 0xf1, 0x78, 0xb9, 0x55, 0x41, 0x08, 0x22, 0xc6, 0x60, 0x17,
+	0x81, 0x48, 0x45, 0x99, 0x17, 0xb2, 0x65, 0x2b, 0x5f, 0xf7, 0xfd, 0xcf, 0x00, 0xaa, 0xe3, 0xa6,
+	0xea, 0x29, 0x02, 0x00, 0x00,
 }
 
 func (m *IDSet) Marshal() (dAtA []byte, err error) {
@@ -203,6 +221,41 @@ func (m *BatchFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MedianFee.Size()
+		i -= size
+		if _, err := m.MedianFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TxCount != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.TotalFees.Size()
 		i -= size
@@ -262,6 +315,15 @@ func (m *BatchFees) Size() (n int) {
 	}
 	l = m.TotalFees.Size()
 	n += 1 + l + sovPool(uint64(l))
+	if m.TxCount != 0 {
+		n += 1 + sovPool(uint64(m.TxCount))
+	}
+	l = m.MinFee.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.MaxFee.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.MedianFee.Size()
+	n += 1 + l + sovPool(uint64(l))
 	return n
 }
 
@@ -495,6 +557,127 @@ func (m *BatchFees) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MedianFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
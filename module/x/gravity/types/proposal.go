@@ -0,0 +1,320 @@
+package types
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+const (
+	// ProposalTypeBridgeMigration defines the type for a BridgeMigrationProposal
+	ProposalTypeBridgeMigration = "BridgeMigration"
+	// ProposalTypeAttestationVeto defines the type for an AttestationVetoProposal
+	ProposalTypeAttestationVeto = "AttestationVeto"
+	// ProposalTypeEvacuatePool defines the type for an EvacuatePoolProposal
+	ProposalTypeEvacuatePool = "EvacuatePool"
+	// ProposalTypeCancelOutgoingBatch defines the type for a CancelOutgoingBatchProposal
+	ProposalTypeCancelOutgoingBatch = "CancelOutgoingBatch"
+	// ProposalTypeModuleSendGrant defines the type for a ModuleSendGrantProposal
+	ProposalTypeModuleSendGrant = "ModuleSendGrant"
+	// ProposalTypeBridgeInstanceReset defines the type for a BridgeInstanceResetProposal
+	ProposalTypeBridgeInstanceReset = "BridgeInstanceReset"
+	// ProposalTypeResolveEventNonce defines the type for a ResolveEventNonceProposal
+	ProposalTypeResolveEventNonce = "ResolveEventNonce"
+	// ProposalTypeReleaseQuarantinedDeposit defines the type for a ReleaseQuarantinedDepositProposal
+	ProposalTypeReleaseQuarantinedDeposit = "ReleaseQuarantinedDeposit"
+)
+
+// nolint: exhaustivestruct
+var (
+	_ govtypes.Content = &BridgeMigrationProposal{}
+	_ govtypes.Content = &AttestationVetoProposal{}
+	_ govtypes.Content = &EvacuatePoolProposal{}
+	_ govtypes.Content = &CancelOutgoingBatchProposal{}
+	_ govtypes.Content = &ModuleSendGrantProposal{}
+	_ govtypes.Content = &BridgeInstanceResetProposal{}
+	_ govtypes.Content = &ResolveEventNonceProposal{}
+	_ govtypes.Content = &ReleaseQuarantinedDepositProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeBridgeMigration)
+	govtypes.RegisterProposalTypeCodec(&BridgeMigrationProposal{}, "gravity/BridgeMigrationProposal")
+	govtypes.RegisterProposalType(ProposalTypeAttestationVeto)
+	govtypes.RegisterProposalTypeCodec(&AttestationVetoProposal{}, "gravity/AttestationVetoProposal")
+	govtypes.RegisterProposalType(ProposalTypeEvacuatePool)
+	govtypes.RegisterProposalTypeCodec(&EvacuatePoolProposal{}, "gravity/EvacuatePoolProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelOutgoingBatch)
+	govtypes.RegisterProposalTypeCodec(&CancelOutgoingBatchProposal{}, "gravity/CancelOutgoingBatchProposal")
+	govtypes.RegisterProposalType(ProposalTypeModuleSendGrant)
+	govtypes.RegisterProposalTypeCodec(&ModuleSendGrantProposal{}, "gravity/ModuleSendGrantProposal")
+	govtypes.RegisterProposalType(ProposalTypeBridgeInstanceReset)
+	govtypes.RegisterProposalTypeCodec(&BridgeInstanceResetProposal{}, "gravity/BridgeInstanceResetProposal")
+	govtypes.RegisterProposalType(ProposalTypeResolveEventNonce)
+	govtypes.RegisterProposalTypeCodec(&ResolveEventNonceProposal{}, "gravity/ResolveEventNonceProposal")
+	govtypes.RegisterProposalType(ProposalTypeReleaseQuarantinedDeposit)
+	govtypes.RegisterProposalTypeCodec(&ReleaseQuarantinedDepositProposal{}, "gravity/ReleaseQuarantinedDepositProposal")
+}
+
+// NewBridgeMigrationProposal creates a new bridge migration proposal
+func NewBridgeMigrationProposal(title, description string, newContract EthAddress) *BridgeMigrationProposal {
+	return &BridgeMigrationProposal{
+		Title:             title,
+		Description:       description,
+		NewBridgeContract: newContract.GetAddress(),
+	}
+}
+
+// ProposalRoute returns the routing key of a bridge migration proposal
+func (p *BridgeMigrationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a bridge migration proposal
+func (p *BridgeMigrationProposal) ProposalType() string { return ProposalTypeBridgeMigration }
+
+// ValidateBasic runs stateless checks on a bridge migration proposal
+func (p *BridgeMigrationProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := ValidateEthAddress(p.NewBridgeContract); err != nil {
+		return sdkerrors.Wrap(err, "new bridge contract")
+	}
+	return nil
+}
+
+// NewAttestationVetoProposal creates a new attestation veto proposal
+func NewAttestationVetoProposal(title, description string, eventNonce uint64, claimHash []byte) *AttestationVetoProposal {
+	return &AttestationVetoProposal{
+		Title:       title,
+		Description: description,
+		EventNonce:  eventNonce,
+		ClaimHash:   hex.EncodeToString(claimHash),
+	}
+}
+
+// ProposalRoute returns the routing key of an attestation veto proposal
+func (p *AttestationVetoProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an attestation veto proposal
+func (p *AttestationVetoProposal) ProposalType() string { return ProposalTypeAttestationVeto }
+
+// ValidateBasic runs stateless checks on an attestation veto proposal
+func (p *AttestationVetoProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.EventNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "event nonce")
+	}
+	if _, err := p.GetClaimHashBytes(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetClaimHashBytes decodes the hex encoded claim hash of the vetoed attestation
+func (p *AttestationVetoProposal) GetClaimHashBytes() ([]byte, error) {
+	return decodeClaimHash(p.ClaimHash)
+}
+
+// decodeClaimHash decodes the hex encoded claim hash a proposal names an attestation by
+func decodeClaimHash(claimHash string) ([]byte, error) {
+	hash, err := hex.DecodeString(claimHash)
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalid, "claim hash is not hex encoded")
+	}
+	if len(hash) != tmhash.Size {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "claim hash must be %d bytes", tmhash.Size)
+	}
+	return hash, nil
+}
+
+// NewResolveEventNonceProposal creates a new event nonce resolution proposal, a nil claimHash skips the event nonce
+func NewResolveEventNonceProposal(title, description string, eventNonce uint64, claimHash []byte) *ResolveEventNonceProposal {
+	return &ResolveEventNonceProposal{
+		Title:       title,
+		Description: description,
+		EventNonce:  eventNonce,
+		ClaimHash:   hex.EncodeToString(claimHash),
+	}
+}
+
+// ProposalRoute returns the routing key of an event nonce resolution proposal
+func (p *ResolveEventNonceProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an event nonce resolution proposal
+func (p *ResolveEventNonceProposal) ProposalType() string { return ProposalTypeResolveEventNonce }
+
+// ValidateBasic runs stateless checks on an event nonce resolution proposal
+func (p *ResolveEventNonceProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.EventNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "event nonce")
+	}
+	if p.ClaimHash == "" {
+		return nil
+	}
+	if _, err := p.GetClaimHashBytes(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetClaimHashBytes decodes the hex encoded claim hash of the attestation to observe
+func (p *ResolveEventNonceProposal) GetClaimHashBytes() ([]byte, error) {
+	return decodeClaimHash(p.ClaimHash)
+}
+
+// NewReleaseQuarantinedDepositProposal creates a new quarantined deposit release proposal, a nil recipient pays the
+// receiver of the deposit
+func NewReleaseQuarantinedDepositProposal(title, description string, eventNonce uint64, recipient sdk.AccAddress) *ReleaseQuarantinedDepositProposal {
+	p := &ReleaseQuarantinedDepositProposal{
+		Title:       title,
+		Description: description,
+		EventNonce:  eventNonce,
+		Recipient:   "",
+	}
+	if !recipient.Empty() {
+		p.Recipient = recipient.String()
+	}
+	return p
+}
+
+// ProposalRoute returns the routing key of a quarantined deposit release proposal
+func (p *ReleaseQuarantinedDepositProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a quarantined deposit release proposal
+func (p *ReleaseQuarantinedDepositProposal) ProposalType() string {
+	return ProposalTypeReleaseQuarantinedDeposit
+}
+
+// ValidateBasic runs stateless checks on a quarantined deposit release proposal
+func (p *ReleaseQuarantinedDepositProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.EventNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "event nonce")
+	}
+	if p.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, p.Recipient)
+		}
+	}
+	return nil
+}
+
+// NewEvacuatePoolProposal creates a new pool evacuation proposal
+func NewEvacuatePoolProposal(title, description string) *EvacuatePoolProposal {
+	return &EvacuatePoolProposal{
+		Title:       title,
+		Description: description,
+	}
+}
+
+// ProposalRoute returns the routing key of a pool evacuation proposal
+func (p *EvacuatePoolProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a pool evacuation proposal
+func (p *EvacuatePoolProposal) ProposalType() string { return ProposalTypeEvacuatePool }
+
+// ValidateBasic runs stateless checks on a pool evacuation proposal
+func (p *EvacuatePoolProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
+
+// NewCancelOutgoingBatchProposal creates a new batch cancellation proposal, refund pays the transactions of the
+// batch back to their senders instead of returning them to the pool
+func NewCancelOutgoingBatchProposal(title, description string, tokenContract EthAddress, batchNonce uint64, refund bool) *CancelOutgoingBatchProposal {
+	return &CancelOutgoingBatchProposal{
+		Title:         title,
+		Description:   description,
+		TokenContract: tokenContract.GetAddress(),
+		BatchNonce:    batchNonce,
+		Refund:        refund,
+	}
+}
+
+// ProposalRoute returns the routing key of a batch cancellation proposal
+func (p *CancelOutgoingBatchProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a batch cancellation proposal
+func (p *CancelOutgoingBatchProposal) ProposalType() string { return ProposalTypeCancelOutgoingBatch }
+
+// ValidateBasic runs stateless checks on a batch cancellation proposal
+func (p *CancelOutgoingBatchProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := ValidateEthAddress(p.TokenContract); err != nil {
+		return sdkerrors.Wrap(err, "token contract")
+	}
+	if p.BatchNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "batch nonce")
+	}
+	return nil
+}
+
+// NewModuleSendGrantProposal creates a new module send grant proposal, an empty cap revokes the grant
+func NewModuleSendGrantProposal(title, description, module string, cap sdk.Coins, epochBlocks uint64) *ModuleSendGrantProposal {
+	return &ModuleSendGrantProposal{
+		Title:       title,
+		Description: description,
+		Module:      module,
+		Cap:         cap,
+		EpochBlocks: epochBlocks,
+	}
+}
+
+// ProposalRoute returns the routing key of a module send grant proposal
+func (p *ModuleSendGrantProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a module send grant proposal
+func (p *ModuleSendGrantProposal) ProposalType() string { return ProposalTypeModuleSendGrant }
+
+// ValidateBasic runs stateless checks on a module send grant proposal
+func (p *ModuleSendGrantProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.Module == "" {
+		return sdkerrors.Wrap(ErrEmpty, "module name")
+	}
+	if p.Module == ModuleName {
+		return sdkerrors.Wrap(ErrInvalid, "the gravity module can not be granted a budget on itself")
+	}
+	if p.Cap.Empty() {
+		return nil
+	}
+	if !p.Cap.IsValid() {
+		return sdkerrors.Wrap(ErrInvalid, "cap")
+	}
+	if p.EpochBlocks == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "epoch blocks")
+	}
+	return nil
+}
+
+// NewBridgeInstanceResetProposal creates a new bridge instance reset proposal
+func NewBridgeInstanceResetProposal(title, description string) *BridgeInstanceResetProposal {
+	return &BridgeInstanceResetProposal{
+		Title:       title,
+		Description: description,
+	}
+}
+
+// ProposalRoute returns the routing key of a bridge instance reset proposal
+func (p *BridgeInstanceResetProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a bridge instance reset proposal
+func (p *BridgeInstanceResetProposal) ProposalType() string { return ProposalTypeBridgeInstanceReset }
+
+// ValidateBasic runs stateless checks on a bridge instance reset proposal
+func (p *BridgeInstanceResetProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
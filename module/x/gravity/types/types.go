@@ -209,11 +209,16 @@ func NewValset(nonce, height uint64, members InternalBridgeValidators, rewardAmo
 		nil
 }
 
-// GetCheckpoint returns the checkpoint
+// GetCheckpoint returns the checkpoint, if the valset carries a power threshold it is
+// encoded between the powers and the reward, otherwise the legacy encoding is used
 func (v Valset) GetCheckpoint(gravityIDstring string) []byte {
 
+	abiJSON := ValsetCheckpointABIJSON
+	if v.PowerThreshold != 0 {
+		abiJSON = ValsetCheckpointWithThresholdABIJSON
+	}
 	// error case here should not occur outside of testing since the above is a constant
-	contractAbi, abiErr := abi.JSON(strings.NewReader(ValsetCheckpointABIJSON))
+	contractAbi, abiErr := abi.JSON(strings.NewReader(abiJSON))
 	if abiErr != nil {
 		panic("Bad ABI constant!")
 	}
@@ -253,7 +258,14 @@ func (v Valset) GetCheckpoint(gravityIDstring string) []byte {
 	// the word 'checkpoint' needs to be the same as the 'name' above in the checkpointAbiJson
 	// but other than that it's a constant that has no impact on the output. This is because
 	// it gets encoded as a function name which we must then discard.
-	bytes, packErr := contractAbi.Pack("checkpoint", gravityID, checkpoint, big.NewInt(int64(v.Nonce)), memberAddresses, convertedPowers, rewardAmount, rewardToken)
+	var bytes []byte
+	var packErr error
+	if v.PowerThreshold != 0 {
+		powerThreshold := new(big.Int).SetUint64(v.PowerThreshold)
+		bytes, packErr = contractAbi.Pack("checkpoint", gravityID, checkpoint, big.NewInt(int64(v.Nonce)), memberAddresses, convertedPowers, powerThreshold, rewardAmount, rewardToken)
+	} else {
+		bytes, packErr = contractAbi.Pack("checkpoint", gravityID, checkpoint, big.NewInt(int64(v.Nonce)), memberAddresses, convertedPowers, rewardAmount, rewardToken)
+	}
 
 	// this should never happen outside of test since any case that could crash on encoding
 	// should be filtered above.
@@ -268,17 +280,74 @@ func (v Valset) GetCheckpoint(gravityIDstring string) []byte {
 	return hash.Bytes()
 }
 
+// GetDeploymentArgs returns the arguments of the Gravity contract constructor that deploys a contract with v as
+// its validator set, along with their ABI encoding. powerThreshold is used if v does not carry a threshold, the
+// legacy threshold if neither does. Returns an error if the members do not hold more than the threshold, the
+// constructor would revert
+func (v Valset) GetDeploymentArgs(gravityIDstring string, powerThreshold uint64) (*QueryValsetDeploymentArgsResponse, error) {
+	if v.PowerThreshold != 0 {
+		powerThreshold = v.PowerThreshold
+	}
+	if powerThreshold == 0 {
+		powerThreshold = LegacyEthereumPowerThreshold
+	}
+	gravityID, err := strToFixByteArray(gravityIDstring)
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalid, "gravity id too long")
+	}
+
+	res := &QueryValsetDeploymentArgsResponse{
+		ValsetNonce:     v.Nonce,
+		GravityId:       "0x" + gethcommon.Bytes2Hex(gravityID[:]),
+		PowerThreshold:  powerThreshold,
+		Validators:      make([]string, len(v.Members)),
+		Powers:          make([]uint64, len(v.Members)),
+		ConstructorArgs: "",
+	}
+	memberAddresses := make([]gethcommon.Address, len(v.Members))
+	convertedPowers := make([]*big.Int, len(v.Members))
+	var totalPower uint64
+	for i, m := range v.Members {
+		if err := ValidateEthAddress(m.EthereumAddress); err != nil {
+			return nil, sdkerrors.Wrapf(err, "member %d", i)
+		}
+		res.Validators[i] = m.EthereumAddress
+		res.Powers[i] = m.Power
+		memberAddresses[i] = gethcommon.HexToAddress(m.EthereumAddress)
+		convertedPowers[i] = new(big.Int).SetUint64(m.Power)
+		totalPower += m.Power
+	}
+	if totalPower <= powerThreshold {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "members hold %d power, the contract requires more than %d",
+			totalPower, powerThreshold)
+	}
+
+	// error case here should not occur outside of testing since the above is a constant
+	contractAbi, abiErr := abi.JSON(strings.NewReader(GravityConstructorABIJSON))
+	if abiErr != nil {
+		panic("Bad ABI constant!")
+	}
+	// packing the constructor encodes only its arguments, there is no selector to discard
+	bytes, err := contractAbi.Pack("", gravityID, new(big.Int).SetUint64(powerThreshold), memberAddresses, convertedPowers)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "pack constructor arguments")
+	}
+	res.ConstructorArgs = "0x" + gethcommon.Bytes2Hex(bytes)
+	return res, nil
+}
+
 // WithoutEmptyMembers returns a new Valset without member that have 0 power or an empty Ethereum address.
 func (v *Valset) WithoutEmptyMembers() *Valset {
 	if v == nil {
 		return nil
 	}
 	r := Valset{
-		Nonce:        v.Nonce,
-		Members:      make([]*BridgeValidator, 0, len(v.Members)),
-		Height:       0,
-		RewardAmount: sdk.Int{},
-		RewardToken:  "",
+		Nonce:          v.Nonce,
+		Members:        make([]*BridgeValidator, 0, len(v.Members)),
+		Height:         0,
+		RewardAmount:   sdk.Int{},
+		RewardToken:    "",
+		PowerThreshold: 0,
 	}
 	for i := range v.Members {
 		if _, err := v.Members[i].ToInternal(); err == nil {
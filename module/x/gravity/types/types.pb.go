@@ -5,8 +5,8 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,6 +25,131 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BridgeMigrationStatus tracks the progress of a governance approved move to
+// a new Gravity contract
+type BridgeMigrationStatus int32
+
+const (
+	BRIDGE_MIGRATION_STATUS_UNSPECIFIED BridgeMigrationStatus = 0
+	// new sends and batches are refused while the in flight batches and logic
+	// calls are executed or time out on the old contract
+	BRIDGE_MIGRATION_STATUS_DRAINING BridgeMigrationStatus = 1
+	// the migration valset has been emitted and the module is waiting for a
+	// MsgMigrationCompletedClaim naming the new contract
+	BRIDGE_MIGRATION_STATUS_AWAITING_COMPLETION BridgeMigrationStatus = 2
+)
+
+var BridgeMigrationStatus_name = map[int32]string{
+	0: "BRIDGE_MIGRATION_STATUS_UNSPECIFIED",
+	1: "BRIDGE_MIGRATION_STATUS_DRAINING",
+	2: "BRIDGE_MIGRATION_STATUS_AWAITING_COMPLETION",
+}
+
+var BridgeMigrationStatus_value = map[string]int32{
+	"BRIDGE_MIGRATION_STATUS_UNSPECIFIED":         0,
+	"BRIDGE_MIGRATION_STATUS_DRAINING":            1,
+	"BRIDGE_MIGRATION_STATUS_AWAITING_COMPLETION": 2,
+}
+
+func (x BridgeMigrationStatus) String() string {
+	return proto.EnumName(BridgeMigrationStatus_name, int32(x))
+}
+
+func (BridgeMigrationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{0}
+}
+
+// BatchLifecycleStatus is where a batch is in its life, see BatchLifecycle
+type BatchLifecycleStatus int32
+
+const (
+	BATCH_LIFECYCLE_STATUS_UNSPECIFIED BatchLifecycleStatus = 0
+	// the batch is collecting signatures or waiting to be relayed
+	BATCH_LIFECYCLE_STATUS_PENDING BatchLifecycleStatus = 1
+	// the batch was observed executed on Ethereum
+	BATCH_LIFECYCLE_STATUS_EXECUTED BatchLifecycleStatus = 2
+	// the batch was canceled, because a later batch of the token was executed
+	// first or by governance
+	BATCH_LIFECYCLE_STATUS_CANCELED BatchLifecycleStatus = 3
+	// the batch passed its timeout on Ethereum before being executed
+	BATCH_LIFECYCLE_STATUS_TIMED_OUT BatchLifecycleStatus = 4
+)
+
+var BatchLifecycleStatus_name = map[int32]string{
+	0: "BATCH_LIFECYCLE_STATUS_UNSPECIFIED",
+	1: "BATCH_LIFECYCLE_STATUS_PENDING",
+	2: "BATCH_LIFECYCLE_STATUS_EXECUTED",
+	3: "BATCH_LIFECYCLE_STATUS_CANCELED",
+	4: "BATCH_LIFECYCLE_STATUS_TIMED_OUT",
+}
+
+var BatchLifecycleStatus_value = map[string]int32{
+	"BATCH_LIFECYCLE_STATUS_UNSPECIFIED": 0,
+	"BATCH_LIFECYCLE_STATUS_PENDING":     1,
+	"BATCH_LIFECYCLE_STATUS_EXECUTED":    2,
+	"BATCH_LIFECYCLE_STATUS_CANCELED":    3,
+	"BATCH_LIFECYCLE_STATUS_TIMED_OUT":   4,
+}
+
+func (x BatchLifecycleStatus) String() string {
+	return proto.EnumName(BatchLifecycleStatus_name, int32(x))
+}
+
+func (BatchLifecycleStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{1}
+}
+
+// RefundReason is why a transfer to Ethereum was taken out of the pool and
+// paid back to its sender
+type RefundReason int32
+
+const (
+	REFUND_REASON_UNSPECIFIED RefundReason = 0
+	// the sender canceled the transfer with MsgCancelSendToEth
+	REFUND_REASON_CANCELED RefundReason = 1
+	// the observed Ethereum height stood still for longer than
+	// stall_refund_threshold blocks
+	REFUND_REASON_STALLED RefundReason = 2
+	// governance evacuated the pool with an EvacuatePoolProposal
+	REFUND_REASON_EVACUATED RefundReason = 3
+	// the transfer waited in the pool for longer than pool_tx_timeout blocks
+	REFUND_REASON_EXPIRED RefundReason = 4
+	// the logic call delivering a transfer with a receiver hook timed out on
+	// Ethereum, usually because the hook reverted
+	REFUND_REASON_CALLBACK_TIMED_OUT RefundReason = 5
+	// governance canceled the batch holding the transfer with a
+	// CancelOutgoingBatchProposal
+	REFUND_REASON_BATCH_CANCELED RefundReason = 6
+)
+
+var RefundReason_name = map[int32]string{
+	0: "REFUND_REASON_UNSPECIFIED",
+	1: "REFUND_REASON_CANCELED",
+	2: "REFUND_REASON_STALLED",
+	3: "REFUND_REASON_EVACUATED",
+	4: "REFUND_REASON_EXPIRED",
+	5: "REFUND_REASON_CALLBACK_TIMED_OUT",
+	6: "REFUND_REASON_BATCH_CANCELED",
+}
+
+var RefundReason_value = map[string]int32{
+	"REFUND_REASON_UNSPECIFIED":        0,
+	"REFUND_REASON_CANCELED":           1,
+	"REFUND_REASON_STALLED":            2,
+	"REFUND_REASON_EVACUATED":          3,
+	"REFUND_REASON_EXPIRED":            4,
+	"REFUND_REASON_CALLBACK_TIMED_OUT": 5,
+	"REFUND_REASON_BATCH_CANCELED":     6,
+}
+
+func (x RefundReason) String() string {
+	return proto.EnumName(RefundReason_name, int32(x))
+}
+
+func (RefundReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{2}
+}
+
 // BridgeValidator represents a validator's ETH address and its power
 type BridgeValidator struct {
 	Power           uint64 `protobuf:"varint,1,opt,name=power,proto3" json:"power,omitempty"`
@@ -88,6 +213,11 @@ type Valset struct {
 	RewardAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=reward_amount,json=rewardAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reward_amount"`
 	// the reward token in it's Ethereum hex address representation
 	RewardToken string `protobuf:"bytes,5,opt,name=reward_token,json=rewardToken,proto3" json:"reward_token,omitempty"`
+	// the normalized power that must sign for this valset to be accepted by the
+	// Ethereum contract, zero for valsets created while the threshold param is
+	// zero, in which case it is not encoded into the checkpoint and the contract
+	// checks the threshold it hardcodes
+	PowerThreshold uint64 `protobuf:"varint,6,opt,name=power_threshold,json=powerThreshold,proto3" json:"power_threshold,omitempty"`
 }
 
 func (m *Valset) Reset()         { *m = Valset{} }
@@ -151,14 +281,25 @@ func (m *Valset) GetRewardToken() string {
 	return ""
 }
 
+func (m *Valset) GetPowerThreshold() uint64 {
+	if m != nil {
+		return m.PowerThreshold
+	}
+	return 0
+}
+
 // LastObservedEthereumBlockHeight stores the last observed
 // Ethereum block height along with the Cosmos block height that
 // it was observed at. These two numbers can be used to project
 // outward and always produce batches with timeouts in the future
 // even if no Ethereum block height has been relayed for a long time
+//
+// cosmos_block_time is the unix time in milliseconds of the Cosmos block the
+// height was observed at, it is zero for heights stored by older versions
 type LastObservedEthereumBlockHeight struct {
 	CosmosBlockHeight   uint64 `protobuf:"varint,1,opt,name=cosmos_block_height,json=cosmosBlockHeight,proto3" json:"cosmos_block_height,omitempty"`
 	EthereumBlockHeight uint64 `protobuf:"varint,2,opt,name=ethereum_block_height,json=ethereumBlockHeight,proto3" json:"ethereum_block_height,omitempty"`
+	CosmosBlockTime     uint64 `protobuf:"varint,3,opt,name=cosmos_block_time,json=cosmosBlockTime,proto3" json:"cosmos_block_time,omitempty"`
 }
 
 func (m *LastObservedEthereumBlockHeight) Reset()         { *m = LastObservedEthereumBlockHeight{} }
@@ -208,6 +349,166 @@ func (m *LastObservedEthereumBlockHeight) GetEthereumBlockHeight() uint64 {
 	return 0
 }
 
+func (m *LastObservedEthereumBlockHeight) GetCosmosBlockTime() uint64 {
+	if m != nil {
+		return m.CosmosBlockTime
+	}
+	return 0
+}
+
+// EthereumHeightVote is the latest Ethereum block height reported by a
+// validators orchestrator, either through a claim or a heartbeat, along
+// with the Cosmos block height at which it was reported
+type EthereumHeightVote struct {
+	Validator           string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	EthereumBlockHeight uint64 `protobuf:"varint,2,opt,name=ethereum_block_height,json=ethereumBlockHeight,proto3" json:"ethereum_block_height,omitempty"`
+	CosmosBlockHeight   uint64 `protobuf:"varint,3,opt,name=cosmos_block_height,json=cosmosBlockHeight,proto3" json:"cosmos_block_height,omitempty"`
+}
+
+func (m *EthereumHeightVote) Reset()         { *m = EthereumHeightVote{} }
+func (m *EthereumHeightVote) String() string { return proto.CompactTextString(m) }
+func (*EthereumHeightVote) ProtoMessage()    {}
+func (*EthereumHeightVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{3}
+}
+func (m *EthereumHeightVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumHeightVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumHeightVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthereumHeightVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumHeightVote.Merge(m, src)
+}
+func (m *EthereumHeightVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumHeightVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumHeightVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumHeightVote proto.InternalMessageInfo
+
+func (m *EthereumHeightVote) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *EthereumHeightVote) GetEthereumBlockHeight() uint64 {
+	if m != nil {
+		return m.EthereumBlockHeight
+	}
+	return 0
+}
+
+func (m *EthereumHeightVote) GetCosmosBlockHeight() uint64 {
+	if m != nil {
+		return m.CosmosBlockHeight
+	}
+	return 0
+}
+
+// EthereumBlockTimeCalibration holds the Ethereum (height, unix timestamp)
+// samples of the current calibration window, taken from observed claims. Every
+// calibration period the average block time across the window, in
+// milliseconds, becomes the calibrated_block_time used in place of the
+// average_ethereum_block_time param and the window restarts from the latest
+// sample
+type EthereumBlockTimeCalibration struct {
+	AnchorEthereumHeight  uint64 `protobuf:"varint,1,opt,name=anchor_ethereum_height,json=anchorEthereumHeight,proto3" json:"anchor_ethereum_height,omitempty"`
+	AnchorEthereumTime    uint64 `protobuf:"varint,2,opt,name=anchor_ethereum_time,json=anchorEthereumTime,proto3" json:"anchor_ethereum_time,omitempty"`
+	LatestEthereumHeight  uint64 `protobuf:"varint,3,opt,name=latest_ethereum_height,json=latestEthereumHeight,proto3" json:"latest_ethereum_height,omitempty"`
+	LatestEthereumTime    uint64 `protobuf:"varint,4,opt,name=latest_ethereum_time,json=latestEthereumTime,proto3" json:"latest_ethereum_time,omitempty"`
+	LastCalibrationHeight uint64 `protobuf:"varint,5,opt,name=last_calibration_height,json=lastCalibrationHeight,proto3" json:"last_calibration_height,omitempty"`
+	CalibratedBlockTime   uint64 `protobuf:"varint,6,opt,name=calibrated_block_time,json=calibratedBlockTime,proto3" json:"calibrated_block_time,omitempty"`
+}
+
+func (m *EthereumBlockTimeCalibration) Reset()         { *m = EthereumBlockTimeCalibration{} }
+func (m *EthereumBlockTimeCalibration) String() string { return proto.CompactTextString(m) }
+func (*EthereumBlockTimeCalibration) ProtoMessage()    {}
+func (*EthereumBlockTimeCalibration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{4}
+}
+func (m *EthereumBlockTimeCalibration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumBlockTimeCalibration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumBlockTimeCalibration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthereumBlockTimeCalibration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumBlockTimeCalibration.Merge(m, src)
+}
+func (m *EthereumBlockTimeCalibration) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumBlockTimeCalibration) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumBlockTimeCalibration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumBlockTimeCalibration proto.InternalMessageInfo
+
+func (m *EthereumBlockTimeCalibration) GetAnchorEthereumHeight() uint64 {
+	if m != nil {
+		return m.AnchorEthereumHeight
+	}
+	return 0
+}
+
+func (m *EthereumBlockTimeCalibration) GetAnchorEthereumTime() uint64 {
+	if m != nil {
+		return m.AnchorEthereumTime
+	}
+	return 0
+}
+
+func (m *EthereumBlockTimeCalibration) GetLatestEthereumHeight() uint64 {
+	if m != nil {
+		return m.LatestEthereumHeight
+	}
+	return 0
+}
+
+func (m *EthereumBlockTimeCalibration) GetLatestEthereumTime() uint64 {
+	if m != nil {
+		return m.LatestEthereumTime
+	}
+	return 0
+}
+
+func (m *EthereumBlockTimeCalibration) GetLastCalibrationHeight() uint64 {
+	if m != nil {
+		return m.LastCalibrationHeight
+	}
+	return 0
+}
+
+func (m *EthereumBlockTimeCalibration) GetCalibratedBlockTime() uint64 {
+	if m != nil {
+		return m.CalibratedBlockTime
+	}
+	return 0
+}
+
 // This records the relationship between an ERC20 token and the denom
 // of the corresponding Cosmos originated asset
 type ERC20ToDenom struct {
@@ -219,7 +520,7 @@ func (m *ERC20ToDenom) Reset()         { *m = ERC20ToDenom{} }
 func (m *ERC20ToDenom) String() string { return proto.CompactTextString(m) }
 func (*ERC20ToDenom) ProtoMessage()    {}
 func (*ERC20ToDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_163831c23fcc179f, []int{3}
+	return fileDescriptor_163831c23fcc179f, []int{5}
 }
 func (m *ERC20ToDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -262,310 +563,8902 @@ func (m *ERC20ToDenom) GetDenom() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*BridgeValidator)(nil), "gravity.v1.BridgeValidator")
-	proto.RegisterType((*Valset)(nil), "gravity.v1.Valset")
-	proto.RegisterType((*LastObservedEthereumBlockHeight)(nil), "gravity.v1.LastObservedEthereumBlockHeight")
-	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+// OrchestratorHeartbeat is the most recent heartbeat received from a
+// validators orchestrator, along with the Cosmos block height and block time
+// (in unix seconds) at which it was received. eth_gas_price is the Ethereum
+// gas price in wei the orchestrator reported, zero if it reported none.
+// eth_base_fee and eth_priority_fee are the type-2 transaction fees in wei it
+// reported, zero if it reported none
+type OrchestratorHeartbeat struct {
+	Validator         string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Orchestrator      string `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	EthBlockHeight    uint64 `protobuf:"varint,3,opt,name=eth_block_height,json=ethBlockHeight,proto3" json:"eth_block_height,omitempty"`
+	Version           string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	CosmosBlockHeight uint64 `protobuf:"varint,5,opt,name=cosmos_block_height,json=cosmosBlockHeight,proto3" json:"cosmos_block_height,omitempty"`
+	CosmosBlockTime   uint64 `protobuf:"varint,6,opt,name=cosmos_block_time,json=cosmosBlockTime,proto3" json:"cosmos_block_time,omitempty"`
+	EthGasPrice       uint64 `protobuf:"varint,7,opt,name=eth_gas_price,json=ethGasPrice,proto3" json:"eth_gas_price,omitempty"`
+	EthBaseFee        uint64 `protobuf:"varint,8,opt,name=eth_base_fee,json=ethBaseFee,proto3" json:"eth_base_fee,omitempty"`
+	EthPriorityFee    uint64 `protobuf:"varint,9,opt,name=eth_priority_fee,json=ethPriorityFee,proto3" json:"eth_priority_fee,omitempty"`
 }
 
-func init() { proto.RegisterFile("gravity/v1/types.proto", fileDescriptor_163831c23fcc179f) }
-
-var fileDescriptor_163831c23fcc179f = []byte{
-	// 460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xfb, 0x11, 0xd4, 0x6d, 0x51, 0xc1, 0x2d, 0x95, 0x55, 0x24, 0xa7, 0xe4, 0x80, 0xc2,
-	0x21, 0xde, 0x26, 0x88, 0x0b, 0xb7, 0x1a, 0x2a, 0x81, 0x84, 0x84, 0x64, 0xaa, 0x1e, 0x10, 0x92,
-	0xb5, 0xb6, 0x47, 0xb6, 0x15, 0xdb, 0x13, 0xed, 0x6e, 0x5c, 0xfa, 0x03, 0xb8, 0xf3, 0xb3, 0x7a,
-	0xec, 0x11, 0x71, 0xa8, 0x50, 0x22, 0xfe, 0x07, 0xda, 0x0f, 0x43, 0x80, 0xd3, 0xee, 0x7b, 0x3b,
-	0xfb, 0x66, 0xe6, 0xcd, 0x90, 0xa3, 0x9c, 0xb3, 0xb6, 0x94, 0xd7, 0xb4, 0x9d, 0x50, 0x79, 0x3d,
-	0x07, 0x11, 0xcc, 0x39, 0x4a, 0x74, 0x89, 0xe5, 0x83, 0x76, 0x72, 0xec, 0xa7, 0x28, 0x6a, 0x14,
-	0x34, 0x61, 0x02, 0x68, 0x3b, 0x49, 0x40, 0xb2, 0x09, 0x4d, 0xb1, 0x6c, 0x4c, 0xec, 0xf1, 0x61,
-	0x8e, 0x39, 0xea, 0x2b, 0x55, 0x37, 0xc3, 0x0e, 0x23, 0xb2, 0x1f, 0xf2, 0x32, 0xcb, 0xe1, 0x92,
-	0x55, 0x65, 0xc6, 0x24, 0x72, 0xf7, 0x90, 0x6c, 0xcf, 0xf1, 0x0a, 0xb8, 0xe7, 0x9c, 0x38, 0xa3,
-	0xad, 0xc8, 0x00, 0xf7, 0x19, 0x79, 0x00, 0xb2, 0x00, 0x0e, 0x8b, 0x3a, 0x66, 0x59, 0xc6, 0x41,
-	0x08, 0x6f, 0xe3, 0xc4, 0x19, 0xed, 0x44, 0xfb, 0x1d, 0x7f, 0x66, 0xe8, 0xe1, 0x4f, 0x87, 0xf4,
-	0x2f, 0x59, 0x25, 0x40, 0x2a, 0xad, 0x06, 0x9b, 0x14, 0x3a, 0x2d, 0x0d, 0xdc, 0x17, 0xe4, 0x5e,
-	0x0d, 0x75, 0x02, 0x5c, 0x49, 0x6c, 0x8e, 0x76, 0xa7, 0x8f, 0x83, 0x3f, 0x8d, 0x04, 0xff, 0xd4,
-	0x13, 0x75, 0xb1, 0xee, 0x11, 0xe9, 0x17, 0x50, 0xe6, 0x85, 0xf4, 0x36, 0xb5, 0x9a, 0x45, 0xee,
-	0x07, 0x72, 0x9f, 0xc3, 0x15, 0xe3, 0x59, 0xcc, 0x6a, 0x5c, 0x34, 0xd2, 0xdb, 0x52, 0x75, 0x85,
-	0xc1, 0xcd, 0xdd, 0xa0, 0xf7, 0xfd, 0x6e, 0xf0, 0x34, 0x2f, 0x65, 0xb1, 0x48, 0x82, 0x14, 0x6b,
-	0x6a, 0x3d, 0x32, 0xc7, 0x58, 0x64, 0x33, 0x6b, 0xe7, 0xdb, 0x46, 0x46, 0x7b, 0x46, 0xe4, 0x4c,
-	0x6b, 0xb8, 0x4f, 0x88, 0xc5, 0xb1, 0xc4, 0x19, 0x34, 0xde, 0xb6, 0xee, 0x75, 0xd7, 0x70, 0x17,
-	0x8a, 0x1a, 0x7e, 0x71, 0xc8, 0xe0, 0x1d, 0x13, 0xf2, 0x7d, 0x22, 0x80, 0xb7, 0x90, 0x9d, 0x5b,
-	0x1f, 0xc2, 0x0a, 0xd3, 0xd9, 0x1b, 0x53, 0x5b, 0x40, 0x0e, 0x4c, 0xb2, 0x38, 0x51, 0x6c, 0x6c,
-	0x1b, 0x30, 0x76, 0x3c, 0x34, 0x4f, 0xeb, 0xf1, 0x53, 0xf2, 0xe8, 0xb7, 0xcd, 0x7f, 0xfd, 0xd8,
-	0xd0, 0x3f, 0x0e, 0xe0, 0xff, 0x1c, 0xc3, 0x97, 0x64, 0xef, 0x3c, 0x7a, 0x35, 0x3d, 0xbd, 0xc0,
-	0xd7, 0xd0, 0x60, 0xad, 0x4c, 0x07, 0x9e, 0x4e, 0x4f, 0x75, 0x96, 0x9d, 0xc8, 0x00, 0xc5, 0x66,
-	0xea, 0xd9, 0x4e, 0xcd, 0x80, 0xf0, 0xd3, 0xcd, 0xd2, 0x77, 0x6e, 0x97, 0xbe, 0xf3, 0x63, 0xe9,
-	0x3b, 0x5f, 0x57, 0x7e, 0xef, 0x76, 0xe5, 0xf7, 0xbe, 0xad, 0xfc, 0xde, 0xc7, 0x70, 0xcd, 0x36,
-	0x56, 0xc9, 0x02, 0xd8, 0xb8, 0x01, 0xd9, 0x59, 0x67, 0xe7, 0x35, 0x4e, 0xf4, 0xb0, 0x68, 0x8d,
-	0xd9, 0xa2, 0x02, 0xfa, 0x99, 0x76, 0x8b, 0xaa, 0x6d, 0x4d, 0xfa, 0x7a, 0xc9, 0x9e, 0xff, 0x0a,
-	0x00, 0x00, 0xff, 0xff, 0xef, 0xd3, 0xc6, 0xb8, 0xc0, 0x02, 0x00, 0x00,
+func (m *OrchestratorHeartbeat) Reset()         { *m = OrchestratorHeartbeat{} }
+func (m *OrchestratorHeartbeat) String() string { return proto.CompactTextString(m) }
+func (*OrchestratorHeartbeat) ProtoMessage()    {}
+func (*OrchestratorHeartbeat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{6}
+}
+func (m *OrchestratorHeartbeat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrchestratorHeartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrchestratorHeartbeat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrchestratorHeartbeat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrchestratorHeartbeat.Merge(m, src)
+}
+func (m *OrchestratorHeartbeat) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrchestratorHeartbeat) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrchestratorHeartbeat.DiscardUnknown(m)
 }
 
-func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OrchestratorHeartbeat proto.InternalMessageInfo
+
+func (m *OrchestratorHeartbeat) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BridgeValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OrchestratorHeartbeat) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return ""
 }
 
-func (m *BridgeValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthereumAddress) > 0 {
-		i -= len(m.EthereumAddress)
-		copy(dAtA[i:], m.EthereumAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumAddress)))
-		i--
-		dAtA[i] = 0x12
+func (m *OrchestratorHeartbeat) GetEthBlockHeight() uint64 {
+	if m != nil {
+		return m.EthBlockHeight
 	}
-	if m.Power != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *OrchestratorHeartbeat) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Valset) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OrchestratorHeartbeat) GetCosmosBlockHeight() uint64 {
+	if m != nil {
+		return m.CosmosBlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Valset) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OrchestratorHeartbeat) GetCosmosBlockTime() uint64 {
+	if m != nil {
+		return m.CosmosBlockTime
+	}
+	return 0
 }
 
-func (m *Valset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RewardToken) > 0 {
-		i -= len(m.RewardToken)
-		copy(dAtA[i:], m.RewardToken)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.RewardToken)))
-		i--
-		dAtA[i] = 0x2a
+func (m *OrchestratorHeartbeat) GetEthGasPrice() uint64 {
+	if m != nil {
+		return m.EthGasPrice
 	}
-	{
-		size := m.RewardAmount.Size()
-		i -= size
-		if _, err := m.RewardAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *OrchestratorHeartbeat) GetEthBaseFee() uint64 {
+	if m != nil {
+		return m.EthBaseFee
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.Height != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *OrchestratorHeartbeat) GetEthPriorityFee() uint64 {
+	if m != nil {
+		return m.EthPriorityFee
 	}
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
+	return 0
+}
+
+// OrchestratorLiveness summarizes the liveness of a single validators
+// orchestrator, last_heartbeat is unset if no heartbeat was ever received
+type OrchestratorLiveness struct {
+	Validator      string                 `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Orchestrator   string                 `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	LastHeartbeat  *OrchestratorHeartbeat `protobuf:"bytes,3,opt,name=last_heartbeat,json=lastHeartbeat,proto3" json:"last_heartbeat,omitempty"`
+	LastEventNonce uint64                 `protobuf:"varint,4,opt,name=last_event_nonce,json=lastEventNonce,proto3" json:"last_event_nonce,omitempty"`
+	Live           bool                   `protobuf:"varint,5,opt,name=live,proto3" json:"live,omitempty"`
+}
+
+func (m *OrchestratorLiveness) Reset()         { *m = OrchestratorLiveness{} }
+func (m *OrchestratorLiveness) String() string { return proto.CompactTextString(m) }
+func (*OrchestratorLiveness) ProtoMessage()    {}
+func (*OrchestratorLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{7}
+}
+func (m *OrchestratorLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrchestratorLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrchestratorLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrchestratorLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrchestratorLiveness.Merge(m, src)
+}
+func (m *OrchestratorLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrchestratorLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrchestratorLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrchestratorLiveness proto.InternalMessageInfo
+
+func (m *OrchestratorLiveness) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *OrchestratorLiveness) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return ""
+}
+
+func (m *OrchestratorLiveness) GetLastHeartbeat() *OrchestratorHeartbeat {
+	if m != nil {
+		return m.LastHeartbeat
+	}
+	return nil
+}
+
+func (m *OrchestratorLiveness) GetLastEventNonce() uint64 {
+	if m != nil {
+		return m.LastEventNonce
+	}
+	return 0
+}
+
+func (m *OrchestratorLiveness) GetLive() bool {
+	if m != nil {
+		return m.Live
+	}
+	return false
+}
+
+// BridgeMigration is the state of an in progress contract migration,
+// migration_valset_nonce is only set once the migration valset was emitted
+type BridgeMigration struct {
+	NewBridgeContract    string                `protobuf:"bytes,1,opt,name=new_bridge_contract,json=newBridgeContract,proto3" json:"new_bridge_contract,omitempty"`
+	Status               BridgeMigrationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=gravity.v1.BridgeMigrationStatus" json:"status,omitempty"`
+	StartedHeight        uint64                `protobuf:"varint,3,opt,name=started_height,json=startedHeight,proto3" json:"started_height,omitempty"`
+	MigrationValsetNonce uint64                `protobuf:"varint,4,opt,name=migration_valset_nonce,json=migrationValsetNonce,proto3" json:"migration_valset_nonce,omitempty"`
+}
+
+func (m *BridgeMigration) Reset()         { *m = BridgeMigration{} }
+func (m *BridgeMigration) String() string { return proto.CompactTextString(m) }
+func (*BridgeMigration) ProtoMessage()    {}
+func (*BridgeMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{8}
+}
+func (m *BridgeMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeMigration.Merge(m, src)
+}
+func (m *BridgeMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeMigration proto.InternalMessageInfo
+
+func (m *BridgeMigration) GetNewBridgeContract() string {
+	if m != nil {
+		return m.NewBridgeContract
+	}
+	return ""
+}
+
+func (m *BridgeMigration) GetStatus() BridgeMigrationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BRIDGE_MIGRATION_STATUS_UNSPECIFIED
+}
+
+func (m *BridgeMigration) GetStartedHeight() uint64 {
+	if m != nil {
+		return m.StartedHeight
+	}
+	return 0
+}
+
+func (m *BridgeMigration) GetMigrationValsetNonce() uint64 {
+	if m != nil {
+		return m.MigrationValsetNonce
+	}
+	return 0
+}
+
+// BridgeTokenStats counts the deposits to Cosmos and the withdrawals to
+// Ethereum of a single token, either in one hourly bucket of the bridge
+// statistics store or summed over a window. Withdrawals are counted when
+// their batch is executed on Ethereum. unique_senders is only set on window
+// sums, it counts the distinct senders that moved this token within the window
+type BridgeTokenStats struct {
+	TokenContract    string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Deposits         uint64                                 `protobuf:"varint,2,opt,name=deposits,proto3" json:"deposits,omitempty"`
+	DepositVolume    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=deposit_volume,json=depositVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"deposit_volume"`
+	Withdrawals      uint64                                 `protobuf:"varint,4,opt,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	WithdrawalVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=withdrawal_volume,json=withdrawalVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"withdrawal_volume"`
+	UniqueSenders    uint64                                 `protobuf:"varint,6,opt,name=unique_senders,json=uniqueSenders,proto3" json:"unique_senders,omitempty"`
+}
+
+func (m *BridgeTokenStats) Reset()         { *m = BridgeTokenStats{} }
+func (m *BridgeTokenStats) String() string { return proto.CompactTextString(m) }
+func (*BridgeTokenStats) ProtoMessage()    {}
+func (*BridgeTokenStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{9}
+}
+func (m *BridgeTokenStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeTokenStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeTokenStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeTokenStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeTokenStats.Merge(m, src)
+}
+func (m *BridgeTokenStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeTokenStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeTokenStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeTokenStats proto.InternalMessageInfo
+
+func (m *BridgeTokenStats) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *BridgeTokenStats) GetDeposits() uint64 {
+	if m != nil {
+		return m.Deposits
+	}
+	return 0
+}
+
+func (m *BridgeTokenStats) GetWithdrawals() uint64 {
+	if m != nil {
+		return m.Withdrawals
+	}
+	return 0
+}
+
+func (m *BridgeTokenStats) GetUniqueSenders() uint64 {
+	if m != nil {
+		return m.UniqueSenders
+	}
+	return 0
+}
+
+// BridgeStatsWindow sums the bridge statistics of the last window_seconds,
+// unique_senders counts the distinct Ethereum senders of deposits and
+// Cosmos senders of withdrawals
+type BridgeStatsWindow struct {
+	WindowSeconds uint64             `protobuf:"varint,1,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	Deposits      uint64             `protobuf:"varint,2,opt,name=deposits,proto3" json:"deposits,omitempty"`
+	Withdrawals   uint64             `protobuf:"varint,3,opt,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	UniqueSenders uint64             `protobuf:"varint,4,opt,name=unique_senders,json=uniqueSenders,proto3" json:"unique_senders,omitempty"`
+	Tokens        []BridgeTokenStats `protobuf:"bytes,5,rep,name=tokens,proto3" json:"tokens"`
+}
+
+func (m *BridgeStatsWindow) Reset()         { *m = BridgeStatsWindow{} }
+func (m *BridgeStatsWindow) String() string { return proto.CompactTextString(m) }
+func (*BridgeStatsWindow) ProtoMessage()    {}
+func (*BridgeStatsWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{10}
+}
+func (m *BridgeStatsWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeStatsWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeStatsWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeStatsWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeStatsWindow.Merge(m, src)
+}
+func (m *BridgeStatsWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeStatsWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeStatsWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeStatsWindow proto.InternalMessageInfo
+
+func (m *BridgeStatsWindow) GetWindowSeconds() uint64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
+}
+
+func (m *BridgeStatsWindow) GetDeposits() uint64 {
+	if m != nil {
+		return m.Deposits
+	}
+	return 0
+}
+
+func (m *BridgeStatsWindow) GetWithdrawals() uint64 {
+	if m != nil {
+		return m.Withdrawals
+	}
+	return 0
+}
+
+func (m *BridgeStatsWindow) GetUniqueSenders() uint64 {
+	if m != nil {
+		return m.UniqueSenders
+	}
+	return 0
+}
+
+func (m *BridgeStatsWindow) GetTokens() []BridgeTokenStats {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+// TokenSolvency compares the bank balances of a bridged token with what the
+// module still owes on it. pool_amount and batched_amount sum the amounts
+// and fees of the unbatched transfers and of the batches not yet executed on
+// Ethereum. escrow_balance is what the module account holds of the denom and
+// supply its total supply on Cosmos. quarantined_amount is what the module
+// account holds for deposits from quarantined Ethereum senders and
+// reserved_amount what it holds of vouchers to pay valset rewards with.
+// discrepancy is empty while the token is consistent and describes the
+// problem otherwise
+type TokenSolvency struct {
+	TokenContract     string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Denom             string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	CosmosOriginated  bool                                   `protobuf:"varint,3,opt,name=cosmos_originated,json=cosmosOriginated,proto3" json:"cosmos_originated,omitempty"`
+	Supply            github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=supply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply"`
+	EscrowBalance     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=escrow_balance,json=escrowBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"escrow_balance"`
+	PoolAmount        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=pool_amount,json=poolAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"pool_amount"`
+	BatchedAmount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=batched_amount,json=batchedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"batched_amount"`
+	Discrepancy       string                                 `protobuf:"bytes,8,opt,name=discrepancy,proto3" json:"discrepancy,omitempty"`
+	QuarantinedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=quarantined_amount,json=quarantinedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quarantined_amount"`
+	ReservedAmount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=reserved_amount,json=reservedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reserved_amount"`
+}
+
+func (m *TokenSolvency) Reset()         { *m = TokenSolvency{} }
+func (m *TokenSolvency) String() string { return proto.CompactTextString(m) }
+func (*TokenSolvency) ProtoMessage()    {}
+func (*TokenSolvency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{11}
+}
+func (m *TokenSolvency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenSolvency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenSolvency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenSolvency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenSolvency.Merge(m, src)
+}
+func (m *TokenSolvency) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenSolvency) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenSolvency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenSolvency proto.InternalMessageInfo
+
+func (m *TokenSolvency) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *TokenSolvency) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TokenSolvency) GetCosmosOriginated() bool {
+	if m != nil {
+		return m.CosmosOriginated
+	}
+	return false
+}
+
+func (m *TokenSolvency) GetDiscrepancy() string {
+	if m != nil {
+		return m.Discrepancy
+	}
+	return ""
+}
+
+// ReplayedToken compares what replaying the observed claims issued of a
+// bridged token with what is outstanding of it now. issued is what the
+// replayed deposits minted, or for Cosmos originated tokens released from
+// escrow. For Ethereum originated tokens outstanding is the voucher supply
+// plus the vouchers burned for transfers that have not been executed yet,
+// withdrawals executed on Ethereum can only make it lower than issued.
+// discrepancy is empty while the token is consistent and describes the
+// problem otherwise
+type ReplayedToken struct {
+	TokenContract    string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Denom            string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	CosmosOriginated bool                                   `protobuf:"varint,3,opt,name=cosmos_originated,json=cosmosOriginated,proto3" json:"cosmos_originated,omitempty"`
+	Issued           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=issued,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"issued"`
+	Outstanding      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=outstanding,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outstanding"`
+	Discrepancy      string                                 `protobuf:"bytes,6,opt,name=discrepancy,proto3" json:"discrepancy,omitempty"`
+}
+
+func (m *ReplayedToken) Reset()         { *m = ReplayedToken{} }
+func (m *ReplayedToken) String() string { return proto.CompactTextString(m) }
+func (*ReplayedToken) ProtoMessage()    {}
+func (*ReplayedToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{12}
+}
+func (m *ReplayedToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayedToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayedToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplayedToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayedToken.Merge(m, src)
+}
+func (m *ReplayedToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayedToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayedToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayedToken proto.InternalMessageInfo
+
+func (m *ReplayedToken) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *ReplayedToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ReplayedToken) GetCosmosOriginated() bool {
+	if m != nil {
+		return m.CosmosOriginated
+	}
+	return false
+}
+
+func (m *ReplayedToken) GetDiscrepancy() string {
+	if m != nil {
+		return m.Discrepancy
+	}
+	return ""
+}
+
+// ReplayFailure is an observed claim the attestation handler no longer
+// applies when replayed
+type ReplayFailure struct {
+	EventNonce uint64 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	ClaimType  string `protobuf:"bytes,2,opt,name=claim_type,json=claimType,proto3" json:"claim_type,omitempty"`
+	Error      string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ReplayFailure) Reset()         { *m = ReplayFailure{} }
+func (m *ReplayFailure) String() string { return proto.CompactTextString(m) }
+func (*ReplayFailure) ProtoMessage()    {}
+func (*ReplayFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{13}
+}
+func (m *ReplayFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplayFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayFailure.Merge(m, src)
+}
+func (m *ReplayFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayFailure proto.InternalMessageInfo
+
+func (m *ReplayFailure) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *ReplayFailure) GetClaimType() string {
+	if m != nil {
+		return m.ClaimType
+	}
+	return ""
+}
+
+func (m *ReplayFailure) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// ReplayReport is the result of replaying the stored observed claims against
+// a fresh bank. first_event_nonce and last_event_nonce bound the claims
+// replayed, complete is false if older claims have been pruned, the issued
+// amounts then only cover part of the history and are not checked
+type ReplayReport struct {
+	FirstEventNonce uint64          `protobuf:"varint,1,opt,name=first_event_nonce,json=firstEventNonce,proto3" json:"first_event_nonce,omitempty"`
+	LastEventNonce  uint64          `protobuf:"varint,2,opt,name=last_event_nonce,json=lastEventNonce,proto3" json:"last_event_nonce,omitempty"`
+	Replayed        uint64          `protobuf:"varint,3,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	Complete        bool            `protobuf:"varint,4,opt,name=complete,proto3" json:"complete,omitempty"`
+	Tokens          []ReplayedToken `protobuf:"bytes,5,rep,name=tokens,proto3" json:"tokens"`
+	Failures        []ReplayFailure `protobuf:"bytes,6,rep,name=failures,proto3" json:"failures"`
+}
+
+func (m *ReplayReport) Reset()         { *m = ReplayReport{} }
+func (m *ReplayReport) String() string { return proto.CompactTextString(m) }
+func (*ReplayReport) ProtoMessage()    {}
+func (*ReplayReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{14}
+}
+func (m *ReplayReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplayReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayReport.Merge(m, src)
+}
+func (m *ReplayReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayReport proto.InternalMessageInfo
+
+func (m *ReplayReport) GetFirstEventNonce() uint64 {
+	if m != nil {
+		return m.FirstEventNonce
+	}
+	return 0
+}
+
+func (m *ReplayReport) GetLastEventNonce() uint64 {
+	if m != nil {
+		return m.LastEventNonce
+	}
+	return 0
+}
+
+func (m *ReplayReport) GetReplayed() uint64 {
+	if m != nil {
+		return m.Replayed
+	}
+	return 0
+}
+
+func (m *ReplayReport) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+func (m *ReplayReport) GetTokens() []ReplayedToken {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *ReplayReport) GetFailures() []ReplayFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+// StateChange is a gravity store entry a proposal would change, key is hex
+// encoded and starts with the prefix of the state it belongs to. operation is
+// one of created, updated or deleted
+type StateChange struct {
+	Key       string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+}
+
+func (m *StateChange) Reset()         { *m = StateChange{} }
+func (m *StateChange) String() string { return proto.CompactTextString(m) }
+func (*StateChange) ProtoMessage()    {}
+func (*StateChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{15}
+}
+func (m *StateChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateChange.Merge(m, src)
+}
+func (m *StateChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateChange proto.InternalMessageInfo
+
+func (m *StateChange) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *StateChange) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+// FundsMovement is an amount a proposal would move between accounts, from and
+// to are bech32 account addresses, module accounts included. Minted coins
+// have no from and burned coins no to
+type FundsMovement struct {
+	From   string                                   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To     string                                   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *FundsMovement) Reset()         { *m = FundsMovement{} }
+func (m *FundsMovement) String() string { return proto.CompactTextString(m) }
+func (*FundsMovement) ProtoMessage()    {}
+func (*FundsMovement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{16}
+}
+func (m *FundsMovement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundsMovement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundsMovement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundsMovement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundsMovement.Merge(m, src)
+}
+func (m *FundsMovement) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundsMovement) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundsMovement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundsMovement proto.InternalMessageInfo
+
+func (m *FundsMovement) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *FundsMovement) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *FundsMovement) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// ProposalSimulation is the result of executing a gravity proposal against
+// the current state without persisting anything. error is set if the
+// proposal would fail, nothing would change then
+type ProposalSimulation struct {
+	ProposalType string          `protobuf:"bytes,1,opt,name=proposal_type,json=proposalType,proto3" json:"proposal_type,omitempty"`
+	Error        string          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	StateChanges []StateChange   `protobuf:"bytes,3,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes"`
+	FundsMoved   []FundsMovement `protobuf:"bytes,4,rep,name=funds_moved,json=fundsMoved,proto3" json:"funds_moved"`
+}
+
+func (m *ProposalSimulation) Reset()         { *m = ProposalSimulation{} }
+func (m *ProposalSimulation) String() string { return proto.CompactTextString(m) }
+func (*ProposalSimulation) ProtoMessage()    {}
+func (*ProposalSimulation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{17}
+}
+func (m *ProposalSimulation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalSimulation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalSimulation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalSimulation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalSimulation.Merge(m, src)
+}
+func (m *ProposalSimulation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalSimulation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalSimulation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalSimulation proto.InternalMessageInfo
+
+func (m *ProposalSimulation) GetProposalType() string {
+	if m != nil {
+		return m.ProposalType
+	}
+	return ""
+}
+
+func (m *ProposalSimulation) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ProposalSimulation) GetStateChanges() []StateChange {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+func (m *ProposalSimulation) GetFundsMoved() []FundsMovement {
+	if m != nil {
+		return m.FundsMoved
+	}
+	return nil
+}
+
+// BatchLifecycle describes a batch from its creation until it leaves the
+// store. confirms is the number of batch confirms submitted, signed_power the
+// power they hold in the last valset observed on Ethereum, out of the
+// power_threshold the Gravity contract requires. finished_height is the block
+// the batch left the store at, and execution_ethereum_height the Ethereum
+// block an executed batch was executed in. The state of a batch that left the
+// store is kept as it was at that point, for the last batches created only
+type BatchLifecycle struct {
+	TokenContract           string               `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	BatchNonce              uint64               `protobuf:"varint,2,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	Status                  BatchLifecycleStatus `protobuf:"varint,3,opt,name=status,proto3,enum=gravity.v1.BatchLifecycleStatus" json:"status,omitempty"`
+	CreatedHeight           uint64               `protobuf:"varint,4,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	BatchTimeout            uint64               `protobuf:"varint,5,opt,name=batch_timeout,json=batchTimeout,proto3" json:"batch_timeout,omitempty"`
+	Confirms                uint64               `protobuf:"varint,6,opt,name=confirms,proto3" json:"confirms,omitempty"`
+	SignedPower             uint64               `protobuf:"varint,7,opt,name=signed_power,json=signedPower,proto3" json:"signed_power,omitempty"`
+	PowerThreshold          uint64               `protobuf:"varint,8,opt,name=power_threshold,json=powerThreshold,proto3" json:"power_threshold,omitempty"`
+	FinishedHeight          uint64               `protobuf:"varint,9,opt,name=finished_height,json=finishedHeight,proto3" json:"finished_height,omitempty"`
+	ExecutionEthereumHeight uint64               `protobuf:"varint,10,opt,name=execution_ethereum_height,json=executionEthereumHeight,proto3" json:"execution_ethereum_height,omitempty"`
+}
+
+func (m *BatchLifecycle) Reset()         { *m = BatchLifecycle{} }
+func (m *BatchLifecycle) String() string { return proto.CompactTextString(m) }
+func (*BatchLifecycle) ProtoMessage()    {}
+func (*BatchLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{18}
+}
+func (m *BatchLifecycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchLifecycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchLifecycle.Merge(m, src)
+}
+func (m *BatchLifecycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchLifecycle proto.InternalMessageInfo
+
+func (m *BatchLifecycle) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *BatchLifecycle) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *BatchLifecycle) GetStatus() BatchLifecycleStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BATCH_LIFECYCLE_STATUS_UNSPECIFIED
+}
+
+func (m *BatchLifecycle) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *BatchLifecycle) GetBatchTimeout() uint64 {
+	if m != nil {
+		return m.BatchTimeout
+	}
+	return 0
+}
+
+func (m *BatchLifecycle) GetConfirms() uint64 {
+	if m != nil {
+		return m.Confirms
+	}
+	return 0
+}
+
+func (m *BatchLifecycle) GetSignedPower() uint64 {
+	if m != nil {
+		return m.SignedPower
+	}
+	return 0
+}
+
+func (m *BatchLifecycle) GetPowerThreshold() uint64 {
+	if m != nil {
+		return m.PowerThreshold
+	}
+	return 0
+}
+
+func (m *BatchLifecycle) GetFinishedHeight() uint64 {
+	if m != nil {
+		return m.FinishedHeight
+	}
+	return 0
+}
+
+func (m *BatchLifecycle) GetExecutionEthereumHeight() uint64 {
+	if m != nil {
+		return m.ExecutionEthereumHeight
+	}
+	return 0
+}
+
+// TimedOutBatch records a batch that was canceled because it passed its
+// timeout on Ethereum before being executed, released_tx_ids are the
+// transactions that went back into the unbatched pool. timed_out_height and
+// timed_out_time are the Cosmos block and its unix time the batch was
+// canceled at
+type TimedOutBatch struct {
+	TokenContract          string   `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	BatchNonce             uint64   `protobuf:"varint,2,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	BatchTimeout           uint64   `protobuf:"varint,3,opt,name=batch_timeout,json=batchTimeout,proto3" json:"batch_timeout,omitempty"`
+	ObservedEthereumHeight uint64   `protobuf:"varint,4,opt,name=observed_ethereum_height,json=observedEthereumHeight,proto3" json:"observed_ethereum_height,omitempty"`
+	TimedOutHeight         uint64   `protobuf:"varint,5,opt,name=timed_out_height,json=timedOutHeight,proto3" json:"timed_out_height,omitempty"`
+	TimedOutTime           uint64   `protobuf:"varint,6,opt,name=timed_out_time,json=timedOutTime,proto3" json:"timed_out_time,omitempty"`
+	ReleasedTxIds          []uint64 `protobuf:"varint,7,rep,packed,name=released_tx_ids,json=releasedTxIds,proto3" json:"released_tx_ids,omitempty"`
+}
+
+func (m *TimedOutBatch) Reset()         { *m = TimedOutBatch{} }
+func (m *TimedOutBatch) String() string { return proto.CompactTextString(m) }
+func (*TimedOutBatch) ProtoMessage()    {}
+func (*TimedOutBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{19}
+}
+func (m *TimedOutBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimedOutBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimedOutBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimedOutBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimedOutBatch.Merge(m, src)
+}
+func (m *TimedOutBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimedOutBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimedOutBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimedOutBatch proto.InternalMessageInfo
+
+func (m *TimedOutBatch) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *TimedOutBatch) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *TimedOutBatch) GetBatchTimeout() uint64 {
+	if m != nil {
+		return m.BatchTimeout
+	}
+	return 0
+}
+
+func (m *TimedOutBatch) GetObservedEthereumHeight() uint64 {
+	if m != nil {
+		return m.ObservedEthereumHeight
+	}
+	return 0
+}
+
+func (m *TimedOutBatch) GetTimedOutHeight() uint64 {
+	if m != nil {
+		return m.TimedOutHeight
+	}
+	return 0
+}
+
+func (m *TimedOutBatch) GetTimedOutTime() uint64 {
+	if m != nil {
+		return m.TimedOutTime
+	}
+	return 0
+}
+
+func (m *TimedOutBatch) GetReleasedTxIds() []uint64 {
+	if m != nil {
+		return m.ReleasedTxIds
+	}
+	return nil
+}
+
+// RefundReceipt records a transfer to Ethereum that was refunded instead of
+// sent, amount and fee are what was paid back to the sender. refund_height
+// and refund_time are the Cosmos block and its unix time the refund was paid
+// in. refund_address is only set when the sender had the refund paid to
+// another account
+type RefundReceipt struct {
+	TxId          uint64       `protobuf:"varint,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Sender        string       `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	DestAddress   string       `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
+	TokenContract string       `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Amount        types.Coin   `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	Fee           types.Coin   `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee"`
+	Reason        RefundReason `protobuf:"varint,7,opt,name=reason,proto3,enum=gravity.v1.RefundReason" json:"reason,omitempty"`
+	RefundHeight  uint64       `protobuf:"varint,8,opt,name=refund_height,json=refundHeight,proto3" json:"refund_height,omitempty"`
+	RefundTime    uint64       `protobuf:"varint,9,opt,name=refund_time,json=refundTime,proto3" json:"refund_time,omitempty"`
+	RefundAddress string       `protobuf:"bytes,10,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+}
+
+func (m *RefundReceipt) Reset()         { *m = RefundReceipt{} }
+func (m *RefundReceipt) String() string { return proto.CompactTextString(m) }
+func (*RefundReceipt) ProtoMessage()    {}
+func (*RefundReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{20}
+}
+func (m *RefundReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefundReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundReceipt.Merge(m, src)
+}
+func (m *RefundReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundReceipt proto.InternalMessageInfo
+
+func (m *RefundReceipt) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *RefundReceipt) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *RefundReceipt) GetDestAddress() string {
+	if m != nil {
+		return m.DestAddress
+	}
+	return ""
+}
+
+func (m *RefundReceipt) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *RefundReceipt) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *RefundReceipt) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *RefundReceipt) GetReason() RefundReason {
+	if m != nil {
+		return m.Reason
+	}
+	return REFUND_REASON_UNSPECIFIED
+}
+
+func (m *RefundReceipt) GetRefundHeight() uint64 {
+	if m != nil {
+		return m.RefundHeight
+	}
+	return 0
+}
+
+func (m *RefundReceipt) GetRefundTime() uint64 {
+	if m != nil {
+		return m.RefundTime
+	}
+	return 0
+}
+
+func (m *RefundReceipt) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+// DepositReceipt records a deposit from Ethereum that was paid out to
+// cosmos_receiver. ethereum_sender is the msg.sender of the deposit and
+// token_sender the address the tokens came from if that was someone else, for
+// example the user a contract deposited for. ethereum_sender_is_contract is
+// set when the orchestrators reported msg.sender to be a contract rather than
+// an externally owned account. deposit_height and deposit_time are the Cosmos
+// block and its unix time the deposit was paid out in. A deposit from a
+// quarantined sender is kept in the same form until governance releases it,
+// deposit_height and deposit_time are then when it was quarantined
+type DepositReceipt struct {
+	EventNonce               uint64     `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	EthereumSender           string     `protobuf:"bytes,2,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
+	TokenSender              string     `protobuf:"bytes,3,opt,name=token_sender,json=tokenSender,proto3" json:"token_sender,omitempty"`
+	CosmosReceiver           string     `protobuf:"bytes,4,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	TokenContract            string     `protobuf:"bytes,5,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Amount                   types.Coin `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount"`
+	EthBlockHeight           uint64     `protobuf:"varint,7,opt,name=eth_block_height,json=ethBlockHeight,proto3" json:"eth_block_height,omitempty"`
+	DepositHeight            uint64     `protobuf:"varint,8,opt,name=deposit_height,json=depositHeight,proto3" json:"deposit_height,omitempty"`
+	DepositTime              uint64     `protobuf:"varint,9,opt,name=deposit_time,json=depositTime,proto3" json:"deposit_time,omitempty"`
+	EthereumSenderIsContract bool       `protobuf:"varint,10,opt,name=ethereum_sender_is_contract,json=ethereumSenderIsContract,proto3" json:"ethereum_sender_is_contract,omitempty"`
+}
+
+func (m *DepositReceipt) Reset()         { *m = DepositReceipt{} }
+func (m *DepositReceipt) String() string { return proto.CompactTextString(m) }
+func (*DepositReceipt) ProtoMessage()    {}
+func (*DepositReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{21}
+}
+func (m *DepositReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositReceipt.Merge(m, src)
+}
+func (m *DepositReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositReceipt proto.InternalMessageInfo
+
+func (m *DepositReceipt) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *DepositReceipt) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
+	}
+	return ""
+}
+
+func (m *DepositReceipt) GetTokenSender() string {
+	if m != nil {
+		return m.TokenSender
+	}
+	return ""
+}
+
+func (m *DepositReceipt) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
+	}
+	return ""
+}
+
+func (m *DepositReceipt) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *DepositReceipt) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *DepositReceipt) GetEthBlockHeight() uint64 {
+	if m != nil {
+		return m.EthBlockHeight
+	}
+	return 0
+}
+
+func (m *DepositReceipt) GetDepositHeight() uint64 {
+	if m != nil {
+		return m.DepositHeight
+	}
+	return 0
+}
+
+func (m *DepositReceipt) GetDepositTime() uint64 {
+	if m != nil {
+		return m.DepositTime
+	}
+	return 0
+}
+
+func (m *DepositReceipt) GetEthereumSenderIsContract() bool {
+	if m != nil {
+		return m.EthereumSenderIsContract
+	}
+	return false
+}
+
+// ModuleSendGrant is the budget governance granted a module for sending to
+// Ethereum, see ModuleSendGrantProposal. spent is what the module sent in the
+// current epoch, which started at the Cosmos block epoch_start
+type ModuleSendGrant struct {
+	Module      string                                   `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Cap         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=cap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"cap"`
+	EpochBlocks uint64                                   `protobuf:"varint,3,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
+	EpochStart  uint64                                   `protobuf:"varint,4,opt,name=epoch_start,json=epochStart,proto3" json:"epoch_start,omitempty"`
+	Spent       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *ModuleSendGrant) Reset()         { *m = ModuleSendGrant{} }
+func (m *ModuleSendGrant) String() string { return proto.CompactTextString(m) }
+func (*ModuleSendGrant) ProtoMessage()    {}
+func (*ModuleSendGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{22}
+}
+func (m *ModuleSendGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleSendGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleSendGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleSendGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleSendGrant.Merge(m, src)
+}
+func (m *ModuleSendGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleSendGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleSendGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleSendGrant proto.InternalMessageInfo
+
+func (m *ModuleSendGrant) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ModuleSendGrant) GetCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Cap
+	}
+	return nil
+}
+
+func (m *ModuleSendGrant) GetEpochBlocks() uint64 {
+	if m != nil {
+		return m.EpochBlocks
+	}
+	return 0
+}
+
+func (m *ModuleSendGrant) GetEpochStart() uint64 {
+	if m != nil {
+		return m.EpochStart
+	}
+	return 0
+}
+
+func (m *ModuleSendGrant) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+// BridgeInstance identifies one run of the chain. A new instance is started
+// whenever the chain is initialized from genesis, including when it is
+// relaunched from an export. Claims at event nonces up to start_event_nonce and
+// confirms for valsets, batches and logic calls created before start_height
+// were produced for the previous instance and are refused, until governance
+// passes a BridgeInstanceResetProposal
+type BridgeInstance struct {
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PreviousId      string `protobuf:"bytes,2,opt,name=previous_id,json=previousId,proto3" json:"previous_id,omitempty"`
+	StartEventNonce uint64 `protobuf:"varint,3,opt,name=start_event_nonce,json=startEventNonce,proto3" json:"start_event_nonce,omitempty"`
+	StartHeight     uint64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (m *BridgeInstance) Reset()         { *m = BridgeInstance{} }
+func (m *BridgeInstance) String() string { return proto.CompactTextString(m) }
+func (*BridgeInstance) ProtoMessage()    {}
+func (*BridgeInstance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{23}
+}
+func (m *BridgeInstance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeInstance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeInstance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeInstance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeInstance.Merge(m, src)
+}
+func (m *BridgeInstance) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeInstance) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeInstance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeInstance proto.InternalMessageInfo
+
+func (m *BridgeInstance) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BridgeInstance) GetPreviousId() string {
+	if m != nil {
+		return m.PreviousId
+	}
+	return ""
+}
+
+func (m *BridgeInstance) GetStartEventNonce() uint64 {
+	if m != nil {
+		return m.StartEventNonce
+	}
+	return 0
+}
+
+func (m *BridgeInstance) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// EthDestinationLabel is a labeled Ethereum destination in the address book of
+// owner, see MsgSetEthDestinationLabel
+type EthDestinationLabel struct {
+	Owner      string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Label      string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	EthAddress string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+}
+
+func (m *EthDestinationLabel) Reset()         { *m = EthDestinationLabel{} }
+func (m *EthDestinationLabel) String() string { return proto.CompactTextString(m) }
+func (*EthDestinationLabel) ProtoMessage()    {}
+func (*EthDestinationLabel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{24}
+}
+func (m *EthDestinationLabel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthDestinationLabel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthDestinationLabel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthDestinationLabel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthDestinationLabel.Merge(m, src)
+}
+func (m *EthDestinationLabel) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthDestinationLabel) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthDestinationLabel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthDestinationLabel proto.InternalMessageInfo
+
+func (m *EthDestinationLabel) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EthDestinationLabel) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *EthDestinationLabel) GetEthAddress() string {
+	if m != nil {
+		return m.EthAddress
+	}
+	return ""
+}
+
+// FirstSendDelay is the number of blocks the sends of account to an Ethereum
+// destination it has not sent to before are held before they can be batched,
+// see MsgSetFirstSendDelay. known_eth_destinations are the destinations it has
+// sent to since setting the delay
+type FirstSendDelay struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	DelayBlocks          uint64   `protobuf:"varint,2,opt,name=delay_blocks,json=delayBlocks,proto3" json:"delay_blocks,omitempty"`
+	KnownEthDestinations []string `protobuf:"bytes,3,rep,name=known_eth_destinations,json=knownEthDestinations,proto3" json:"known_eth_destinations,omitempty"`
+}
+
+func (m *FirstSendDelay) Reset()         { *m = FirstSendDelay{} }
+func (m *FirstSendDelay) String() string { return proto.CompactTextString(m) }
+func (*FirstSendDelay) ProtoMessage()    {}
+func (*FirstSendDelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{25}
+}
+func (m *FirstSendDelay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FirstSendDelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FirstSendDelay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FirstSendDelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FirstSendDelay.Merge(m, src)
+}
+func (m *FirstSendDelay) XXX_Size() int {
+	return m.Size()
+}
+func (m *FirstSendDelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_FirstSendDelay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FirstSendDelay proto.InternalMessageInfo
+
+func (m *FirstSendDelay) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *FirstSendDelay) GetDelayBlocks() uint64 {
+	if m != nil {
+		return m.DelayBlocks
+	}
+	return 0
+}
+
+func (m *FirstSendDelay) GetKnownEthDestinations() []string {
+	if m != nil {
+		return m.KnownEthDestinations
+	}
+	return nil
+}
+
+// AuditLogEntry records a change governance made to the bridge by passing a
+// gravity proposal, at height and time (unix seconds). before and after
+// describe the state the proposal changed. Gov proposal handlers are not given
+// the proposal id or proposer, the type and title of the proposal together
+// with the height identify it in the gov module
+type AuditLogEntry struct {
+	Id            uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Height        uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time          uint64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	ProposalType  string `protobuf:"bytes,4,opt,name=proposal_type,json=proposalType,proto3" json:"proposal_type,omitempty"`
+	ProposalTitle string `protobuf:"bytes,5,opt,name=proposal_title,json=proposalTitle,proto3" json:"proposal_title,omitempty"`
+	Before        string `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After         string `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (m *AuditLogEntry) Reset()         { *m = AuditLogEntry{} }
+func (m *AuditLogEntry) String() string { return proto.CompactTextString(m) }
+func (*AuditLogEntry) ProtoMessage()    {}
+func (*AuditLogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{26}
+}
+func (m *AuditLogEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditLogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditLogEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuditLogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLogEntry.Merge(m, src)
+}
+func (m *AuditLogEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditLogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLogEntry proto.InternalMessageInfo
+
+func (m *AuditLogEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AuditLogEntry) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AuditLogEntry) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *AuditLogEntry) GetProposalType() string {
+	if m != nil {
+		return m.ProposalType
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetProposalTitle() string {
+	if m != nil {
+		return m.ProposalTitle
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetBefore() string {
+	if m != nil {
+		return m.Before
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetAfter() string {
+	if m != nil {
+		return m.After
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("gravity.v1.BridgeMigrationStatus", BridgeMigrationStatus_name, BridgeMigrationStatus_value)
+	proto.RegisterEnum("gravity.v1.BatchLifecycleStatus", BatchLifecycleStatus_name, BatchLifecycleStatus_value)
+	proto.RegisterEnum("gravity.v1.RefundReason", RefundReason_name, RefundReason_value)
+	proto.RegisterType((*BridgeValidator)(nil), "gravity.v1.BridgeValidator")
+	proto.RegisterType((*Valset)(nil), "gravity.v1.Valset")
+	proto.RegisterType((*LastObservedEthereumBlockHeight)(nil), "gravity.v1.LastObservedEthereumBlockHeight")
+	proto.RegisterType((*EthereumHeightVote)(nil), "gravity.v1.EthereumHeightVote")
+	proto.RegisterType((*EthereumBlockTimeCalibration)(nil), "gravity.v1.EthereumBlockTimeCalibration")
+	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+	proto.RegisterType((*OrchestratorHeartbeat)(nil), "gravity.v1.OrchestratorHeartbeat")
+	proto.RegisterType((*OrchestratorLiveness)(nil), "gravity.v1.OrchestratorLiveness")
+	proto.RegisterType((*BridgeMigration)(nil), "gravity.v1.BridgeMigration")
+	proto.RegisterType((*BridgeTokenStats)(nil), "gravity.v1.BridgeTokenStats")
+	proto.RegisterType((*BridgeStatsWindow)(nil), "gravity.v1.BridgeStatsWindow")
+	proto.RegisterType((*TokenSolvency)(nil), "gravity.v1.TokenSolvency")
+	proto.RegisterType((*ReplayedToken)(nil), "gravity.v1.ReplayedToken")
+	proto.RegisterType((*ReplayFailure)(nil), "gravity.v1.ReplayFailure")
+	proto.RegisterType((*ReplayReport)(nil), "gravity.v1.ReplayReport")
+	proto.RegisterType((*StateChange)(nil), "gravity.v1.StateChange")
+	proto.RegisterType((*FundsMovement)(nil), "gravity.v1.FundsMovement")
+	proto.RegisterType((*ProposalSimulation)(nil), "gravity.v1.ProposalSimulation")
+	proto.RegisterType((*BatchLifecycle)(nil), "gravity.v1.BatchLifecycle")
+	proto.RegisterType((*TimedOutBatch)(nil), "gravity.v1.TimedOutBatch")
+	proto.RegisterType((*RefundReceipt)(nil), "gravity.v1.RefundReceipt")
+	proto.RegisterType((*DepositReceipt)(nil), "gravity.v1.DepositReceipt")
+	proto.RegisterType((*ModuleSendGrant)(nil), "gravity.v1.ModuleSendGrant")
+	proto.RegisterType((*BridgeInstance)(nil), "gravity.v1.BridgeInstance")
+	proto.RegisterType((*EthDestinationLabel)(nil), "gravity.v1.EthDestinationLabel")
+	proto.RegisterType((*FirstSendDelay)(nil), "gravity.v1.FirstSendDelay")
+	proto.RegisterType((*AuditLogEntry)(nil), "gravity.v1.AuditLogEntry")
+}
+
+func init() { proto.RegisterFile("gravity/v1/types.proto", fileDescriptor_163831c23fcc179f) }
+
+var fileDescriptor_163831c23fcc179f = []byte{
+	// 2694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4b, 0x6f, 0x1b, 0xd7,
+	0xf5, 0x37, 0x1f, 0xa2, 0xa5, 0x43, 0x91, 0xa2, 0xaf, 0x65, 0x99, 0x76, 0x1c, 0x49, 0x19, 0xc7,
+	0xb1, 0xfe, 0x09, 0x22, 0xd9, 0xfe, 0xa7, 0x4d, 0x9a, 0x22, 0x40, 0xf9, 0x92, 0x4d, 0x94, 0x96,
+	0x84, 0x11, 0xe5, 0xa4, 0x8f, 0x60, 0x30, 0x9c, 0x39, 0x22, 0x07, 0x1e, 0xce, 0x65, 0xe6, 0x5e,
+	0x52, 0xe6, 0xba, 0x9b, 0xae, 0x8a, 0xb4, 0x8b, 0xa2, 0x28, 0xd0, 0x55, 0x77, 0x2d, 0xd0, 0xa2,
+	0x8b, 0x2e, 0xfa, 0x01, 0x0a, 0x64, 0x19, 0x14, 0x28, 0x90, 0xa6, 0x40, 0x1a, 0xc4, 0xab, 0xf6,
+	0x53, 0x14, 0xf7, 0x31, 0xe4, 0x0c, 0x45, 0x39, 0x8e, 0x60, 0x74, 0x25, 0xde, 0xdf, 0x3d, 0xf7,
+	0xbc, 0xe7, 0x9c, 0x73, 0xaf, 0x60, 0xad, 0x1b, 0xda, 0x23, 0x8f, 0x8f, 0x77, 0x46, 0x77, 0x77,
+	0xf8, 0x78, 0x80, 0x6c, 0x7b, 0x10, 0x52, 0x4e, 0x09, 0x68, 0x7c, 0x7b, 0x74, 0xf7, 0xfa, 0xba,
+	0x43, 0x59, 0x9f, 0xb2, 0x9d, 0x8e, 0xcd, 0x70, 0x67, 0x74, 0xb7, 0x83, 0xdc, 0xbe, 0xbb, 0xe3,
+	0x50, 0x2f, 0x50, 0xb4, 0xd7, 0x57, 0xbb, 0xb4, 0x4b, 0xe5, 0xcf, 0x1d, 0xf1, 0x4b, 0xa1, 0x86,
+	0x09, 0x2b, 0xd5, 0xd0, 0x73, 0xbb, 0xf8, 0xc8, 0xf6, 0x3d, 0xd7, 0xe6, 0x34, 0x24, 0xab, 0xb0,
+	0x30, 0xa0, 0x27, 0x18, 0x96, 0x53, 0x9b, 0xa9, 0xad, 0xac, 0xa9, 0x16, 0xe4, 0xff, 0xa0, 0x84,
+	0xbc, 0x87, 0x21, 0x0e, 0xfb, 0x96, 0xed, 0xba, 0x21, 0x32, 0x56, 0x4e, 0x6f, 0xa6, 0xb6, 0x96,
+	0xcc, 0x95, 0x08, 0xaf, 0x28, 0xd8, 0xf8, 0x59, 0x1a, 0x72, 0x8f, 0x6c, 0x9f, 0x21, 0x17, 0xbc,
+	0x02, 0x1a, 0x38, 0x18, 0xf1, 0x92, 0x0b, 0xf2, 0x2d, 0xb8, 0xd8, 0xc7, 0x7e, 0x07, 0x43, 0xc1,
+	0x22, 0xb3, 0x95, 0xbf, 0xf7, 0xd2, 0xf6, 0xd4, 0x90, 0xed, 0x19, 0x7d, 0xcc, 0x88, 0x96, 0xac,
+	0x41, 0xae, 0x87, 0x5e, 0xb7, 0xc7, 0xcb, 0x19, 0xc9, 0x4d, 0xaf, 0xc8, 0x21, 0x14, 0x42, 0x3c,
+	0xb1, 0x43, 0xd7, 0xb2, 0xfb, 0x74, 0x18, 0xf0, 0x72, 0x56, 0xe8, 0x55, 0xdd, 0xfe, 0xe4, 0x8b,
+	0x8d, 0x0b, 0x9f, 0x7f, 0xb1, 0xf1, 0x5a, 0xd7, 0xe3, 0xbd, 0x61, 0x67, 0xdb, 0xa1, 0xfd, 0x1d,
+	0xed, 0x23, 0xf5, 0xe7, 0x4d, 0xe6, 0x3e, 0xd6, 0xee, 0x6c, 0x06, 0xdc, 0x5c, 0x56, 0x4c, 0x2a,
+	0x92, 0x07, 0x79, 0x05, 0xf4, 0xda, 0xe2, 0xf4, 0x31, 0x06, 0xe5, 0x05, 0x69, 0x6b, 0x5e, 0x61,
+	0x6d, 0x01, 0x91, 0xdb, 0xb0, 0x22, 0x7d, 0x63, 0xf1, 0x5e, 0x88, 0xac, 0x47, 0x7d, 0xb7, 0x9c,
+	0x93, 0x8a, 0x15, 0x25, 0xdc, 0x8e, 0x50, 0xe3, 0x4f, 0x29, 0xd8, 0x68, 0xd9, 0x8c, 0xef, 0x77,
+	0x18, 0x86, 0x23, 0x74, 0x1b, 0xda, 0x61, 0x55, 0x9f, 0x3a, 0x8f, 0x1f, 0x28, 0x23, 0xb6, 0xe1,
+	0xb2, 0xd2, 0xca, 0xea, 0x08, 0xd4, 0xd2, 0x96, 0x2a, 0xbf, 0x5d, 0x52, 0x5b, 0x71, 0xfa, 0x7b,
+	0x70, 0x65, 0x12, 0x8f, 0xc4, 0x89, 0xb4, 0x3c, 0x71, 0x19, 0xe7, 0xc8, 0x78, 0x1d, 0x2e, 0x25,
+	0x64, 0x70, 0xaf, 0x8f, 0xda, 0x97, 0x2b, 0x31, 0x09, 0x6d, 0xaf, 0x8f, 0xc6, 0x2f, 0x53, 0x40,
+	0x22, 0x3d, 0xd5, 0xf1, 0x47, 0x94, 0x23, 0xb9, 0x01, 0x4b, 0xa3, 0x28, 0x32, 0x52, 0xb9, 0x25,
+	0x73, 0x0a, 0x9c, 0x4b, 0xa9, 0x33, 0x0c, 0xcf, 0x9c, 0x61, 0xb8, 0xf1, 0x79, 0x1a, 0x6e, 0x24,
+	0x1c, 0x28, 0xd4, 0xad, 0xd9, 0xbe, 0xd7, 0x09, 0x6d, 0xee, 0xd1, 0x80, 0xbc, 0x05, 0x6b, 0x76,
+	0xe0, 0xf4, 0x68, 0x68, 0x4d, 0x74, 0x49, 0x38, 0x73, 0x55, 0xed, 0x26, 0x8d, 0x23, 0x77, 0x60,
+	0x75, 0xf6, 0x94, 0x74, 0x8f, 0xd2, 0x9c, 0x24, 0xcf, 0x08, 0x91, 0x42, 0x8e, 0x6f, 0x73, 0x64,
+	0xfc, 0x94, 0x1c, 0xa5, 0xfb, 0xaa, 0xda, 0x3d, 0x2d, 0x67, 0xf6, 0x94, 0x94, 0x93, 0x55, 0x72,
+	0x92, 0x67, 0xa4, 0x9c, 0x6f, 0xc3, 0x55, 0xdf, 0x66, 0xdc, 0x72, 0xa6, 0x36, 0x46, 0x82, 0x16,
+	0xe4, 0xa1, 0x2b, 0x62, 0x3b, 0xe6, 0x81, 0x69, 0x86, 0x44, 0x47, 0xd0, 0x8d, 0x47, 0x5c, 0x25,
+	0xe9, 0xe5, 0xe9, 0xe6, 0x34, 0xea, 0xef, 0xc2, 0x72, 0xc3, 0xac, 0xdd, 0xbb, 0xd3, 0xa6, 0x75,
+	0x0c, 0x68, 0x5f, 0x7c, 0xbf, 0x18, 0x3a, 0xf7, 0xee, 0xe8, 0x50, 0xab, 0x85, 0x40, 0x5d, 0xb1,
+	0xad, 0x0b, 0x80, 0x5a, 0x18, 0xff, 0x4e, 0xc3, 0x95, 0xfd, 0xd0, 0xe9, 0x21, 0xe3, 0xa1, 0xc8,
+	0x86, 0x07, 0x68, 0x87, 0xbc, 0x83, 0x36, 0xff, 0x9a, 0xa4, 0x31, 0x60, 0x99, 0xc6, 0x8e, 0x69,
+	0xa6, 0x09, 0x8c, 0x6c, 0xc9, 0xea, 0x33, 0x2f, 0x43, 0x8a, 0xc8, 0x7b, 0xf1, 0x74, 0x2a, 0xc3,
+	0xc5, 0x11, 0x86, 0xcc, 0xa3, 0x81, 0x2a, 0x03, 0x66, 0xb4, 0x3c, 0x2b, 0xd1, 0x16, 0xce, 0xfa,
+	0xc2, 0xe6, 0x7e, 0x2d, 0xb9, 0xb9, 0x5f, 0x0b, 0x31, 0xa0, 0x20, 0xf4, 0xeb, 0xda, 0xcc, 0x1a,
+	0x84, 0x9e, 0x83, 0xe5, 0x8b, 0x92, 0x2e, 0x8f, 0xbc, 0x77, 0xdf, 0x66, 0x07, 0x02, 0x22, 0x9b,
+	0xb0, 0x2c, 0x6d, 0xb0, 0x19, 0x5a, 0xc7, 0x88, 0xe5, 0x45, 0x49, 0x02, 0x42, 0x7f, 0x9b, 0xe1,
+	0x2e, 0x62, 0x64, 0xe5, 0x20, 0xf4, 0x68, 0xe8, 0xf1, 0xb1, 0xa4, 0x5a, 0x9a, 0x58, 0x79, 0xa0,
+	0xe1, 0x5d, 0x44, 0xe3, 0xcb, 0x14, 0xac, 0xc6, 0x7d, 0xdd, 0xf2, 0x46, 0x18, 0x20, 0x63, 0x2f,
+	0xc0, 0xd5, 0x0f, 0xa0, 0x28, 0xd3, 0xad, 0x17, 0x85, 0x4f, 0x3a, 0x3a, 0x7f, 0xef, 0x95, 0x78,
+	0x8d, 0x9e, 0x1b, 0x67, 0xb3, 0x20, 0x0e, 0x4e, 0xc3, 0xbe, 0x05, 0x25, 0xc9, 0x09, 0x47, 0x18,
+	0x70, 0x4b, 0xf5, 0x01, 0x95, 0xe6, 0x52, 0x42, 0x43, 0xc0, 0x7b, 0x02, 0x25, 0x04, 0xb2, 0xbe,
+	0x37, 0x42, 0x19, 0x8b, 0x45, 0x53, 0xfe, 0x36, 0xfe, 0x91, 0x8a, 0x5a, 0xd3, 0x43, 0xaf, 0xab,
+	0x3f, 0xed, 0x6d, 0xb8, 0x1c, 0xe0, 0x89, 0xd5, 0x91, 0xb0, 0xe5, 0xd0, 0x80, 0x87, 0xb6, 0xc3,
+	0xb5, 0x9d, 0x97, 0x02, 0x3c, 0x51, 0x07, 0x6a, 0x7a, 0x83, 0x7c, 0x07, 0x72, 0x8c, 0xdb, 0x7c,
+	0xa8, 0x5a, 0x55, 0x31, 0x69, 0xc3, 0x0c, 0xf3, 0x43, 0x49, 0x68, 0xea, 0x03, 0xe4, 0x16, 0x14,
+	0x19, 0xb7, 0x43, 0xf1, 0xe9, 0x24, 0xf2, 0xad, 0xa0, 0x51, 0x9d, 0x24, 0x6f, 0xc1, 0x5a, 0x3f,
+	0xe2, 0x60, 0x8d, 0x64, 0xd3, 0x4b, 0x58, 0xba, 0x3a, 0xd9, 0x55, 0x1d, 0x51, 0xda, 0x6b, 0xfc,
+	0x2d, 0x0d, 0x25, 0x25, 0x5e, 0x76, 0x12, 0x21, 0x5a, 0x4a, 0x94, 0xad, 0x66, 0xd6, 0xae, 0x82,
+	0x44, 0x27, 0x36, 0x5d, 0x87, 0x45, 0x17, 0x07, 0x94, 0x79, 0x9c, 0xe9, 0xe2, 0x34, 0x59, 0x93,
+	0x23, 0x28, 0xea, 0xdf, 0xd6, 0x88, 0xfa, 0x43, 0x5d, 0xdd, 0xbf, 0x79, 0x2b, 0x2c, 0x68, 0x2e,
+	0x8f, 0x24, 0x13, 0xb2, 0x09, 0xf9, 0x13, 0x8f, 0xf7, 0xdc, 0xd0, 0x3e, 0xb1, 0x7d, 0xa6, 0x2d,
+	0x8b, 0x43, 0xe4, 0x47, 0x70, 0x69, 0xba, 0x8c, 0x64, 0x2f, 0x9c, 0x4b, 0x76, 0x69, 0xca, 0x48,
+	0x8b, 0xbf, 0x05, 0xc5, 0x61, 0xe0, 0x7d, 0x34, 0x44, 0x8b, 0x61, 0xe0, 0x8a, 0xa9, 0x41, 0x7d,
+	0x85, 0x05, 0x85, 0x1e, 0x2a, 0xd0, 0xf8, 0x67, 0x0a, 0x2e, 0x29, 0xa7, 0x4a, 0x7f, 0xbe, 0xef,
+	0x05, 0x2e, 0x3d, 0x11, 0x87, 0x4f, 0xe4, 0x2f, 0x8b, 0xa1, 0x43, 0x03, 0x97, 0xe9, 0x2e, 0x50,
+	0x50, 0xe8, 0xa1, 0x02, 0x9f, 0xe9, 0xd5, 0x19, 0xf3, 0x33, 0xa7, 0xcd, 0x3f, 0xad, 0x61, 0x76,
+	0x8e, 0x86, 0xe4, 0x5d, 0xc8, 0xc9, 0x58, 0xb2, 0xf2, 0x82, 0x1c, 0x7b, 0x6e, 0x9c, 0x4e, 0xc7,
+	0x69, 0x3e, 0x54, 0xb3, 0xc2, 0x71, 0xa6, 0x3e, 0x61, 0xfc, 0x7a, 0x01, 0x0a, 0x6a, 0x93, 0xfa,
+	0x23, 0x0c, 0x9c, 0xf1, 0xf3, 0xe6, 0xcb, 0xdc, 0x62, 0x4d, 0xde, 0x98, 0x14, 0x37, 0x1a, 0x7a,
+	0x5d, 0x2f, 0x10, 0x6d, 0x40, 0x5a, 0xb6, 0x68, 0x96, 0xd4, 0xc6, 0xfe, 0x04, 0x27, 0xbb, 0x90,
+	0x63, 0xc3, 0xc1, 0xc0, 0x1f, 0x9f, 0x73, 0xb2, 0xd2, 0xa7, 0x45, 0x7a, 0x22, 0x73, 0x42, 0x7a,
+	0x62, 0x75, 0x6c, 0xdf, 0x0e, 0x9c, 0xf3, 0xa6, 0x48, 0x41, 0x71, 0xa9, 0x2a, 0x26, 0x64, 0x1f,
+	0xf2, 0x03, 0x4a, 0xfd, 0x68, 0xfa, 0xcb, 0x9d, 0x8b, 0x27, 0x08, 0x16, 0x7a, 0xf6, 0x3b, 0x82,
+	0x62, 0xc7, 0xe6, 0x4e, 0x0f, 0x27, 0x13, 0xe5, 0xc5, 0xf3, 0xe9, 0xa9, 0xb9, 0x68, 0xb6, 0x9b,
+	0x90, 0x77, 0x3d, 0xe6, 0x84, 0x38, 0xb0, 0x03, 0x67, 0x2c, 0xeb, 0xff, 0x92, 0x19, 0x87, 0xc8,
+	0x87, 0x40, 0x3e, 0x1a, 0xda, 0xa1, 0x1d, 0x70, 0x2f, 0x98, 0x0a, 0x5f, 0x3a, 0x97, 0xf0, 0x4b,
+	0x31, 0x4e, 0x5a, 0x81, 0xf7, 0x61, 0x25, 0x44, 0x35, 0x82, 0x46, 0xbc, 0xe1, 0x5c, 0xbc, 0x8b,
+	0x11, 0x1b, 0xc5, 0xd8, 0xf8, 0x73, 0x1a, 0x0a, 0x26, 0x0e, 0x7c, 0x7b, 0x8c, 0x7a, 0x36, 0xfe,
+	0x9f, 0x26, 0xa7, 0xc7, 0xd8, 0x10, 0xdd, 0xf3, 0x26, 0xa7, 0x3a, 0x4d, 0x0e, 0x20, 0x4f, 0x87,
+	0x9c, 0x71, 0x3b, 0x70, 0xbd, 0xa0, 0x7b, 0xce, 0xcc, 0x8c, 0xb3, 0x98, 0x8d, 0x77, 0xee, 0x54,
+	0xbc, 0x0d, 0x8c, 0xdc, 0xb6, 0x6b, 0x7b, 0xfe, 0x30, 0x44, 0xb2, 0x01, 0xf9, 0x78, 0xb7, 0x4c,
+	0xe9, 0x11, 0x61, 0xda, 0x29, 0x5f, 0x06, 0x70, 0x7c, 0xdb, 0xeb, 0x5b, 0x42, 0xa6, 0xf6, 0xda,
+	0x92, 0x44, 0xda, 0xe3, 0x01, 0xaa, 0x79, 0x2d, 0xa4, 0x61, 0x39, 0x13, 0xcd, 0x6b, 0x21, 0x0d,
+	0x8d, 0x5f, 0xa4, 0x61, 0x59, 0xc9, 0x31, 0x71, 0x40, 0x43, 0x39, 0xda, 0x1c, 0x7b, 0xe1, 0x4c,
+	0x6b, 0x56, 0xc2, 0x56, 0xe4, 0x46, 0xac, 0x37, 0xcf, 0xeb, 0xe2, 0xe9, 0xb9, 0x5d, 0xfc, 0x3a,
+	0x2c, 0x86, 0x3a, 0x09, 0x74, 0x91, 0x9c, 0xac, 0xc5, 0x9e, 0x43, 0xfb, 0x03, 0x1f, 0xb9, 0xea,
+	0x8c, 0x8b, 0xe6, 0x64, 0x4d, 0xde, 0x9e, 0x29, 0x8b, 0xd7, 0xe2, 0x65, 0x31, 0x91, 0x56, 0xc9,
+	0x9a, 0x48, 0xbe, 0x0b, 0x8b, 0xc7, 0xca, 0x71, 0xa2, 0x25, 0x9c, 0x71, 0x54, 0xbb, 0x56, 0x1f,
+	0x9d, 0x1c, 0x30, 0xde, 0x83, 0xbc, 0xa8, 0xb3, 0x58, 0xeb, 0xd9, 0x41, 0x17, 0x49, 0x09, 0x32,
+	0x8f, 0x71, 0xac, 0xb3, 0x54, 0xfc, 0x14, 0xa3, 0x14, 0x1d, 0xa0, 0x6a, 0xde, 0x91, 0xa7, 0x27,
+	0x80, 0xf1, 0xab, 0x14, 0x14, 0x76, 0x87, 0x81, 0xcb, 0x1e, 0xd2, 0x11, 0xf6, 0x31, 0xe0, 0x62,
+	0x88, 0x39, 0x0e, 0x69, 0x5f, 0xb3, 0x90, 0xbf, 0x49, 0x11, 0xd2, 0x9c, 0xea, 0xc3, 0x69, 0x4e,
+	0x89, 0x03, 0x39, 0xfd, 0xe1, 0x65, 0xb4, 0xbe, 0x2a, 0x8d, 0xb6, 0xc5, 0x54, 0xb8, 0xad, 0x6f,
+	0xed, 0xdb, 0x35, 0xea, 0x05, 0xd5, 0x3b, 0x42, 0xdf, 0xdf, 0xfd, 0x6b, 0x63, 0xeb, 0x39, 0x52,
+	0x4f, 0x1c, 0x60, 0xa6, 0x66, 0x6d, 0xfc, 0x3d, 0x05, 0xe4, 0x20, 0xa4, 0x03, 0xca, 0x6c, 0xff,
+	0xd0, 0xeb, 0x0f, 0x7d, 0x35, 0x3c, 0xdd, 0x84, 0xc2, 0x40, 0xa3, 0x2a, 0x7b, 0x94, 0xa2, 0xcb,
+	0x11, 0x98, 0x4c, 0xa0, 0x74, 0x2c, 0x81, 0x48, 0x15, 0xc4, 0xd8, 0xc3, 0xd1, 0x72, 0xa4, 0xb3,
+	0x98, 0xd6, 0xfe, 0x6a, 0xdc, 0xdb, 0x31, 0x67, 0x6a, 0x5f, 0x2f, 0xb3, 0x29, 0xc4, 0xc8, 0xf7,
+	0x20, 0x7f, 0x2c, 0xfc, 0x65, 0xf5, 0xe9, 0x48, 0x7e, 0xac, 0xa7, 0xe2, 0x95, 0x70, 0xa7, 0xe6,
+	0x01, 0xc7, 0x11, 0xe8, 0x1a, 0xbf, 0xcf, 0x40, 0xb1, 0x2a, 0x2a, 0x6a, 0xcb, 0x3b, 0x46, 0x67,
+	0xec, 0xf8, 0xf8, 0xbc, 0x65, 0x66, 0x03, 0xf2, 0xb2, 0x14, 0x27, 0xd2, 0x17, 0x24, 0xa4, 0x52,
+	0xf7, 0x9d, 0xc9, 0xa0, 0x98, 0x91, 0x83, 0xe2, 0x66, 0xa2, 0x33, 0x27, 0x64, 0x9e, 0x9e, 0x13,
+	0x9d, 0x10, 0xed, 0xd8, 0x9c, 0xa8, 0x5b, 0xbf, 0x46, 0xf5, 0x9c, 0x78, 0x13, 0x54, 0x33, 0x90,
+	0xb7, 0x08, 0x3a, 0x8c, 0xae, 0x1d, 0xcb, 0x12, 0x6c, 0x2b, 0x4c, 0x7d, 0x24, 0xc1, 0xb1, 0x17,
+	0xf6, 0xa3, 0x11, 0x67, 0xb2, 0x16, 0xef, 0x11, 0xcc, 0xeb, 0x8a, 0xae, 0xa0, 0x1e, 0x67, 0xf4,
+	0x05, 0x43, 0x61, 0x07, 0x02, 0x9a, 0xf7, 0x1e, 0xb1, 0x38, 0xef, 0x3d, 0x42, 0x10, 0x1e, 0x7b,
+	0x81, 0xc7, 0x7a, 0x53, 0xa5, 0xf5, 0x35, 0x23, 0x82, 0xb5, 0xd6, 0xef, 0xc2, 0x35, 0x7c, 0x82,
+	0xce, 0x50, 0x4e, 0xb7, 0xb3, 0xb7, 0x5c, 0x90, 0x47, 0xae, 0x4e, 0x08, 0x92, 0x17, 0x5d, 0xe3,
+	0x0f, 0x69, 0x28, 0x08, 0xc3, 0xdc, 0xfd, 0x21, 0x97, 0x1e, 0x7c, 0x61, 0xc1, 0x3a, 0xe5, 0xcb,
+	0xcc, 0x1c, 0x5f, 0xbe, 0x03, 0x65, 0xaa, 0x9f, 0x5b, 0x4e, 0x69, 0xae, 0x22, 0xb4, 0x46, 0x67,
+	0x9e, 0x63, 0xb4, 0xd1, 0x5b, 0x50, 0x12, 0x8c, 0x5d, 0x8b, 0x0e, 0x79, 0xf2, 0x92, 0x58, 0xe4,
+	0xda, 0x1e, 0x4d, 0xf9, 0x2a, 0x14, 0xa7, 0x94, 0xb1, 0xeb, 0xe1, 0x72, 0x44, 0x27, 0xef, 0x86,
+	0xaf, 0x89, 0xae, 0xeb, 0xa3, 0xcd, 0xd0, 0xb5, 0xf8, 0x13, 0xcb, 0x73, 0x59, 0xf9, 0xe2, 0x66,
+	0x46, 0xa4, 0x48, 0x04, 0xb7, 0x9f, 0x34, 0x5d, 0x66, 0xfc, 0x3c, 0x23, 0xba, 0x81, 0xc8, 0x77,
+	0x13, 0x1d, 0xf4, 0x06, 0x9c, 0x5c, 0x86, 0x05, 0x79, 0x40, 0x97, 0xe6, 0x2c, 0x7f, 0xd2, 0x74,
+	0xc5, 0x2b, 0x98, 0x1a, 0x32, 0xf5, 0x27, 0xaa, 0x57, 0x22, 0x41, 0x5c, 0xf1, 0xac, 0x10, 0x3d,
+	0xce, 0x65, 0x74, 0xbb, 0x41, 0xc6, 0xf5, 0xc3, 0xdc, 0x9c, 0x00, 0x64, 0xe7, 0x05, 0xe0, 0xed,
+	0x49, 0x91, 0x5a, 0xd8, 0x4c, 0x3d, 0xbb, 0x48, 0xe9, 0x7a, 0xac, 0xc8, 0xc9, 0x5d, 0xc8, 0x1c,
+	0xa3, 0x72, 0xc2, 0x73, 0x9c, 0x12, 0xb4, 0xe4, 0x0e, 0xe4, 0x42, 0xb4, 0x19, 0x0d, 0x64, 0x42,
+	0x17, 0xef, 0x95, 0x93, 0x05, 0x5c, 0x79, 0x43, 0xec, 0x9b, 0x9a, 0x4e, 0x44, 0x3f, 0x94, 0x78,
+	0x14, 0x1b, 0x95, 0xe3, 0xcb, 0x0a, 0xd4, 0x91, 0xd9, 0x80, 0xbc, 0x26, 0x92, 0x61, 0x51, 0xd9,
+	0x0d, 0x0a, 0x92, 0x41, 0xb9, 0x05, 0x45, 0x4d, 0x10, 0xf9, 0x0b, 0x94, 0x2b, 0x14, 0x1a, 0x3d,
+	0x65, 0xfe, 0x25, 0x03, 0xc5, 0xba, 0xba, 0x07, 0x44, 0x41, 0xf9, 0xda, 0x16, 0x7d, 0x1b, 0x26,
+	0x2f, 0xa2, 0x56, 0x22, 0x52, 0xc5, 0x08, 0x3e, 0x9c, 0x44, 0x4c, 0x85, 0x43, 0x53, 0xe9, 0x88,
+	0x49, 0x4c, 0x93, 0xdc, 0x06, 0xfd, 0xd4, 0x60, 0x85, 0x42, 0xfc, 0x08, 0x43, 0x1d, 0xb2, 0xa2,
+	0x82, 0x4d, 0x8d, 0xce, 0x09, 0xed, 0xc2, 0xb3, 0x43, 0x9b, 0xfb, 0x66, 0xa1, 0x9d, 0xf7, 0x00,
+	0x73, 0x71, 0xee, 0x03, 0xcc, 0xad, 0xe9, 0x1d, 0x34, 0x11, 0xa0, 0xe8, 0x4e, 0xa9, 0xc9, 0x64,
+	0xba, 0x2a, 0xb2, 0x58, 0x88, 0xf2, 0x1a, 0x93, 0x31, 0x7a, 0x0f, 0x5e, 0x9a, 0x71, 0xa4, 0xe5,
+	0xb1, 0xa9, 0x81, 0x20, 0xc7, 0x88, 0x72, 0xd2, 0xa9, 0x4d, 0x16, 0xd9, 0x6a, 0xfc, 0x31, 0x0d,
+	0x2b, 0x0f, 0xa9, 0x3b, 0xf4, 0xe5, 0xfd, 0xeb, 0xbe, 0x98, 0x85, 0xc5, 0xc7, 0xd3, 0x97, 0x90,
+	0x2e, 0x3d, 0x7a, 0x45, 0x3e, 0x84, 0x8c, 0x63, 0x0f, 0xf4, 0x6b, 0xf4, 0x0b, 0x6d, 0xca, 0x82,
+	0xaf, 0x30, 0x16, 0x07, 0xd4, 0xd1, 0xfe, 0x9b, 0x5c, 0x21, 0x25, 0x26, 0x7d, 0xc7, 0x64, 0x5a,
+	0x49, 0x12, 0xf9, 0xbe, 0xa0, 0x4b, 0x14, 0x48, 0xe8, 0x50, 0x20, 0xc4, 0x86, 0x05, 0x36, 0x40,
+	0xf9, 0x51, 0xbe, 0x70, 0x25, 0x15, 0x67, 0xe3, 0xe3, 0x14, 0x14, 0xd5, 0x35, 0xb4, 0x19, 0x88,
+	0x29, 0xd6, 0x41, 0x31, 0xc0, 0xe8, 0xfa, 0xb3, 0x64, 0xa6, 0x3d, 0x57, 0xa8, 0x39, 0x08, 0x71,
+	0xe4, 0xd1, 0x21, 0x13, 0x85, 0x49, 0x25, 0x36, 0x44, 0x50, 0xd3, 0x15, 0xa3, 0xa5, 0xb4, 0x20,
+	0x31, 0x2f, 0xea, 0x37, 0x66, 0xb9, 0x11, 0x1b, 0x18, 0x45, 0x4f, 0x93, 0xb4, 0x89, 0xba, 0x9c,
+	0x97, 0x98, 0xee, 0x22, 0x1d, 0xb8, 0xdc, 0xe0, 0xbd, 0x3a, 0x32, 0x2e, 0xc6, 0x7d, 0x8f, 0x06,
+	0x2d, 0xbb, 0x83, 0xbe, 0x18, 0x53, 0xe8, 0x49, 0x80, 0xd1, 0x13, 0x97, 0x5a, 0x08, 0xd4, 0x17,
+	0xdb, 0xd1, 0xf0, 0x22, 0x17, 0xd2, 0xb3, 0xbc, 0x37, 0x53, 0x17, 0x01, 0x79, 0x2f, 0xfa, 0xc8,
+	0x7f, 0x92, 0x82, 0xe2, 0xae, 0x98, 0x7a, 0x45, 0x9e, 0xd4, 0xd1, 0xb7, 0xc7, 0xe2, 0x15, 0xd1,
+	0x76, 0x1c, 0xf9, 0xa1, 0x28, 0x09, 0xd1, 0x52, 0xe5, 0xad, 0x6f, 0x8f, 0xa3, 0x50, 0xa6, 0xa3,
+	0xbc, 0xf5, 0xed, 0xb1, 0x0e, 0xe5, 0x5b, 0xb0, 0xf6, 0x38, 0xa0, 0x27, 0xb2, 0x63, 0x5a, 0xee,
+	0x54, 0x75, 0x35, 0x36, 0x2d, 0x99, 0xab, 0x72, 0x37, 0x69, 0x16, 0x33, 0xfe, 0x9a, 0x82, 0x42,
+	0x65, 0xe8, 0x7a, 0xbc, 0x45, 0xbb, 0x8d, 0x80, 0x87, 0xe3, 0x98, 0xef, 0xb3, 0xd2, 0xf7, 0xd3,
+	0xff, 0x7f, 0xa4, 0x13, 0xff, 0xff, 0x20, 0x90, 0x8d, 0xbd, 0xe4, 0xcb, 0xdf, 0xa7, 0x87, 0xbd,
+	0xec, 0x9c, 0x61, 0xef, 0x16, 0x14, 0xa7, 0x44, 0x1e, 0xf7, 0x31, 0x2a, 0x1a, 0x13, 0x2a, 0x01,
+	0x0a, 0xb9, 0x1d, 0x3c, 0xa6, 0x21, 0xea, 0x2b, 0x8c, 0x5e, 0x09, 0x77, 0xdb, 0xc7, 0x5c, 0xcf,
+	0x22, 0x4b, 0xa6, 0x5a, 0xbc, 0xfe, 0x9b, 0x14, 0x5c, 0x99, 0xfb, 0xb4, 0x46, 0x6e, 0xc3, 0xcd,
+	0xaa, 0xd9, 0xac, 0xdf, 0x6f, 0x58, 0x0f, 0x9b, 0xf7, 0xcd, 0x4a, 0xbb, 0xb9, 0xbf, 0x67, 0x1d,
+	0xb6, 0x2b, 0xed, 0xa3, 0x43, 0xeb, 0x68, 0xef, 0xf0, 0xa0, 0x51, 0x6b, 0xee, 0x36, 0x1b, 0xf5,
+	0xd2, 0x05, 0xf2, 0x2a, 0x6c, 0x9e, 0x45, 0x58, 0x37, 0x2b, 0xcd, 0xbd, 0xe6, 0xde, 0xfd, 0x52,
+	0x8a, 0xec, 0xc0, 0x1b, 0x67, 0x51, 0x55, 0xde, 0xaf, 0x34, 0xdb, 0xcd, 0xbd, 0xfb, 0x56, 0x6d,
+	0xff, 0xe1, 0x41, 0xab, 0x21, 0xb6, 0x4a, 0xe9, 0xeb, 0xd9, 0x9f, 0xfe, 0x76, 0xfd, 0xc2, 0xeb,
+	0x9f, 0xa5, 0x60, 0x75, 0xde, 0x44, 0x47, 0x5e, 0x03, 0xa3, 0x5a, 0x69, 0xd7, 0x1e, 0x58, 0xad,
+	0xe6, 0x6e, 0xa3, 0xf6, 0x83, 0x5a, 0xab, 0x31, 0x5f, 0x3b, 0x03, 0xd6, 0xcf, 0xa0, 0x3b, 0x68,
+	0xec, 0xd5, 0x95, 0x6e, 0x37, 0x61, 0xe3, 0x0c, 0x9a, 0xc6, 0x07, 0x8d, 0xda, 0x51, 0xbb, 0x51,
+	0x2f, 0xa5, 0x9f, 0x41, 0x54, 0xab, 0xec, 0xd5, 0x1a, 0xad, 0x46, 0xbd, 0x94, 0x91, 0xbe, 0x98,
+	0x4f, 0xd4, 0x6e, 0x3e, 0x6c, 0xd4, 0xad, 0xfd, 0xa3, 0x76, 0x29, 0xab, 0x4d, 0xfb, 0x4f, 0x4a,
+	0xdc, 0xf3, 0xa6, 0x3d, 0x93, 0xbc, 0x0c, 0xd7, 0xcc, 0xc6, 0xee, 0xd1, 0x5e, 0xdd, 0x32, 0x1b,
+	0x95, 0xc3, 0xfd, 0xbd, 0x19, 0x4b, 0xae, 0xc3, 0x5a, 0x72, 0x7b, 0x22, 0x37, 0x45, 0xae, 0xc1,
+	0x95, 0xe4, 0xde, 0x61, 0xbb, 0xd2, 0x6a, 0x49, 0xbd, 0x5f, 0x82, 0xab, 0xc9, 0xad, 0xc6, 0xa3,
+	0x4a, 0xed, 0xa8, 0xd2, 0x96, 0xfa, 0x9e, 0x3a, 0xd7, 0xf8, 0xe0, 0xa0, 0x69, 0x36, 0xea, 0xa5,
+	0xac, 0x30, 0x65, 0x56, 0x5c, 0xab, 0x55, 0xad, 0xd4, 0xbe, 0x1f, 0x33, 0x65, 0x81, 0x6c, 0xc2,
+	0x8d, 0x24, 0x95, 0x32, 0x7f, 0xa2, 0x5a, 0x4e, 0x19, 0x5b, 0xfd, 0xf1, 0x27, 0x5f, 0xad, 0xa7,
+	0x3e, 0xfd, 0x6a, 0x3d, 0xf5, 0xe5, 0x57, 0xeb, 0xa9, 0x8f, 0x9f, 0xae, 0x5f, 0xf8, 0xf4, 0xe9,
+	0xfa, 0x85, 0xcf, 0x9e, 0xae, 0x5f, 0xf8, 0x61, 0x35, 0x56, 0xf7, 0x6c, 0x9f, 0xf7, 0xd0, 0x7e,
+	0x33, 0x40, 0x1e, 0xd5, 0x3e, 0x3d, 0x5f, 0xbc, 0xa9, 0x1e, 0x91, 0x77, 0x54, 0x03, 0xd8, 0x79,
+	0xb2, 0xa3, 0x71, 0x55, 0x17, 0x3b, 0x39, 0xf9, 0xef, 0xd1, 0xff, 0xff, 0xef, 0x00, 0x47, 0x23,
+	0x3d, 0x76, 0x7a, 0x1d, 0x00, 0x00,
+}
+
+func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Power != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Valset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Valset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Valset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PowerThreshold != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PowerThreshold))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RewardToken) > 0 {
+		i -= len(m.RewardToken)
+		copy(dAtA[i:], m.RewardToken)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RewardToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.RewardAmount.Size()
+		i -= size
+		if _, err := m.RewardAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastObservedEthereumBlockHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastObservedEthereumBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastObservedEthereumBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CosmosBlockTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EthereumBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CosmosBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumHeightVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumHeightVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumHeightVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CosmosBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EthereumBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumBlockTimeCalibration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumBlockTimeCalibration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumBlockTimeCalibration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CalibratedBlockTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CalibratedBlockTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastCalibrationHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastCalibrationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LatestEthereumTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LatestEthereumTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LatestEthereumHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LatestEthereumHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AnchorEthereumTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AnchorEthereumTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AnchorEthereumHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AnchorEthereumHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrchestratorHeartbeat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrchestratorHeartbeat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrchestratorHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthPriorityFee != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthPriorityFee))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EthBaseFee != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthBaseFee))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EthGasPrice != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthGasPrice))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CosmosBlockTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CosmosBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EthBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrchestratorLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrchestratorLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrchestratorLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Live {
+		i--
+		if m.Live {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastEventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastEventNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastHeartbeat != nil {
+		{
+			size, err := m.LastHeartbeat.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MigrationValsetNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MigrationValsetNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NewBridgeContract) > 0 {
+		i -= len(m.NewBridgeContract)
+		copy(dAtA[i:], m.NewBridgeContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NewBridgeContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeTokenStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeTokenStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeTokenStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UniqueSenders != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UniqueSenders))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.WithdrawalVolume.Size()
+		i -= size
+		if _, err := m.WithdrawalVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Withdrawals != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Withdrawals))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.DepositVolume.Size()
+		i -= size
+		if _, err := m.DepositVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Deposits != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Deposits))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeStatsWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeStatsWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeStatsWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.UniqueSenders != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UniqueSenders))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Withdrawals != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Withdrawals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Deposits != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Deposits))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WindowSeconds != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenSolvency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenSolvency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenSolvency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReservedAmount.Size()
+		i -= size
+		if _, err := m.ReservedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.QuarantinedAmount.Size()
+		i -= size
+		if _, err := m.QuarantinedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Discrepancy) > 0 {
+		i -= len(m.Discrepancy)
+		copy(dAtA[i:], m.Discrepancy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Discrepancy)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.BatchedAmount.Size()
+		i -= size
+		if _, err := m.BatchedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.PoolAmount.Size()
+		i -= size
+		if _, err := m.PoolAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.EscrowBalance.Size()
+		i -= size
+		if _, err := m.EscrowBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.CosmosOriginated {
+		i--
+		if m.CosmosOriginated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayedToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayedToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayedToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Discrepancy) > 0 {
+		i -= len(m.Discrepancy)
+		copy(dAtA[i:], m.Discrepancy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Discrepancy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Outstanding.Size()
+		i -= size
+		if _, err := m.Outstanding.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Issued.Size()
+		i -= size
+		if _, err := m.Issued.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.CosmosOriginated {
+		i--
+		if m.CosmosOriginated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClaimType) > 0 {
+		i -= len(m.ClaimType)
+		copy(dAtA[i:], m.ClaimType)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ClaimType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Complete {
+		i--
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Replayed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Replayed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastEventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastEventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FirstEventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FirstEventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operation) > 0 {
+		i -= len(m.Operation)
+		copy(dAtA[i:], m.Operation)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Operation)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundsMovement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundsMovement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundsMovement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalSimulation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalSimulation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalSimulation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FundsMoved) > 0 {
+		for iNdEx := len(m.FundsMoved) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundsMoved[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProposalType) > 0 {
+		i -= len(m.ProposalType)
+		copy(dAtA[i:], m.ProposalType)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProposalType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchLifecycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchLifecycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchLifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutionEthereumHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutionEthereumHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.FinishedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FinishedHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PowerThreshold != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PowerThreshold))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SignedPower != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SignedPower))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Confirms != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Confirms))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BatchTimeout != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BatchTimeout))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimedOutBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimedOutBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimedOutBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReleasedTxIds) > 0 {
+		dAtA3 := make([]byte, len(m.ReleasedTxIds)*10)
+		var j2 int
+		for _, num := range m.ReleasedTxIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTypes(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TimedOutTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TimedOutTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TimedOutHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TimedOutHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ObservedEthereumHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ObservedEthereumHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BatchTimeout != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BatchTimeout))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefundReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefundReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.RefundTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RefundTime))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RefundHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RefundHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Reason != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TxId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumSenderIsContract {
+		i--
+		if m.EthereumSenderIsContract {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DepositTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DepositTime))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DepositHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DepositHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EthBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthBlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenSender) > 0 {
+		i -= len(m.TokenSender)
+		copy(dAtA[i:], m.TokenSender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenSender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleSendGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleSendGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleSendGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.EpochStart != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EpochStart))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EpochBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EpochBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Cap) > 0 {
+		for iNdEx := len(m.Cap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
 				i -= size
 				i = encodeVarintTypes(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *LastObservedEthereumBlockHeight) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *LastObservedEthereumBlockHeight) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *LastObservedEthereumBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EthereumBlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumBlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.CosmosBlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Erc20) > 0 {
-		i -= len(m.Erc20)
-		copy(dAtA[i:], m.Erc20)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Erc20)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *BridgeValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Power != 0 {
-		n += 1 + sovTypes(uint64(m.Power))
-	}
-	l = len(m.EthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
-}
-
-func (m *Valset) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovTypes(uint64(m.Nonce))
-	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeInstance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeInstance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeInstance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartEventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartEventNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PreviousId) > 0 {
+		i -= len(m.PreviousId)
+		copy(dAtA[i:], m.PreviousId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PreviousId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthDestinationLabel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthDestinationLabel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthDestinationLabel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FirstSendDelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FirstSendDelay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FirstSendDelay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KnownEthDestinations) > 0 {
+		for iNdEx := len(m.KnownEthDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KnownEthDestinations[iNdEx])
+			copy(dAtA[i:], m.KnownEthDestinations[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.KnownEthDestinations[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.DelayBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DelayBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditLogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditLogEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditLogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.After) > 0 {
+		i -= len(m.After)
+		copy(dAtA[i:], m.After)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.After)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Before) > 0 {
+		i -= len(m.Before)
+		copy(dAtA[i:], m.Before)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Before)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ProposalTitle) > 0 {
+		i -= len(m.ProposalTitle)
+		copy(dAtA[i:], m.ProposalTitle)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProposalTitle)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProposalType) > 0 {
+		i -= len(m.ProposalType)
+		copy(dAtA[i:], m.ProposalType)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProposalType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Time != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BridgeValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Power != 0 {
+		n += 1 + sovTypes(uint64(m.Power))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Valset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = m.RewardAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.RewardToken)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.PowerThreshold != 0 {
+		n += 1 + sovTypes(uint64(m.PowerThreshold))
+	}
+	return n
+}
+
+func (m *LastObservedEthereumBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CosmosBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
+	}
+	if m.EthereumBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
+	}
+	if m.CosmosBlockTime != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosBlockTime))
+	}
+	return n
+}
+
+func (m *EthereumHeightVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EthereumBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
+	}
+	if m.CosmosBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
+	}
+	return n
+}
+
+func (m *EthereumBlockTimeCalibration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AnchorEthereumHeight != 0 {
+		n += 1 + sovTypes(uint64(m.AnchorEthereumHeight))
+	}
+	if m.AnchorEthereumTime != 0 {
+		n += 1 + sovTypes(uint64(m.AnchorEthereumTime))
+	}
+	if m.LatestEthereumHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LatestEthereumHeight))
+	}
+	if m.LatestEthereumTime != 0 {
+		n += 1 + sovTypes(uint64(m.LatestEthereumTime))
+	}
+	if m.LastCalibrationHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LastCalibrationHeight))
+	}
+	if m.CalibratedBlockTime != 0 {
+		n += 1 + sovTypes(uint64(m.CalibratedBlockTime))
+	}
+	return n
+}
+
+func (m *ERC20ToDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *OrchestratorHeartbeat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EthBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EthBlockHeight))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CosmosBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
+	}
+	if m.CosmosBlockTime != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosBlockTime))
+	}
+	if m.EthGasPrice != 0 {
+		n += 1 + sovTypes(uint64(m.EthGasPrice))
+	}
+	if m.EthBaseFee != 0 {
+		n += 1 + sovTypes(uint64(m.EthBaseFee))
+	}
+	if m.EthPriorityFee != 0 {
+		n += 1 + sovTypes(uint64(m.EthPriorityFee))
+	}
+	return n
+}
+
+func (m *OrchestratorLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LastHeartbeat != nil {
+		l = m.LastHeartbeat.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LastEventNonce != 0 {
+		n += 1 + sovTypes(uint64(m.LastEventNonce))
+	}
+	if m.Live {
+		n += 2
+	}
+	return n
+}
+
+func (m *BridgeMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewBridgeContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	if m.StartedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.StartedHeight))
+	}
+	if m.MigrationValsetNonce != 0 {
+		n += 1 + sovTypes(uint64(m.MigrationValsetNonce))
+	}
+	return n
+}
+
+func (m *BridgeTokenStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Deposits != 0 {
+		n += 1 + sovTypes(uint64(m.Deposits))
+	}
+	l = m.DepositVolume.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Withdrawals != 0 {
+		n += 1 + sovTypes(uint64(m.Withdrawals))
+	}
+	l = m.WithdrawalVolume.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.UniqueSenders != 0 {
+		n += 1 + sovTypes(uint64(m.UniqueSenders))
+	}
+	return n
+}
+
+func (m *BridgeStatsWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowSeconds != 0 {
+		n += 1 + sovTypes(uint64(m.WindowSeconds))
+	}
+	if m.Deposits != 0 {
+		n += 1 + sovTypes(uint64(m.Deposits))
+	}
+	if m.Withdrawals != 0 {
+		n += 1 + sovTypes(uint64(m.Withdrawals))
+	}
+	if m.UniqueSenders != 0 {
+		n += 1 + sovTypes(uint64(m.UniqueSenders))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenSolvency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CosmosOriginated {
+		n += 2
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.EscrowBalance.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PoolAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.BatchedAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Discrepancy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.QuarantinedAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ReservedAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ReplayedToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CosmosOriginated {
+		n += 2
+	}
+	l = m.Issued.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Outstanding.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Discrepancy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ReplayFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovTypes(uint64(m.EventNonce))
+	}
+	l = len(m.ClaimType)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ReplayReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FirstEventNonce != 0 {
+		n += 1 + sovTypes(uint64(m.FirstEventNonce))
+	}
+	if m.LastEventNonce != 0 {
+		n += 1 + sovTypes(uint64(m.LastEventNonce))
+	}
+	if m.Replayed != 0 {
+		n += 1 + sovTypes(uint64(m.Replayed))
+	}
+	if m.Complete {
+		n += 2
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Failures) > 0 {
+		for _, e := range m.Failures {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StateChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *FundsMovement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposalSimulation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProposalType)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.FundsMoved) > 0 {
+		for _, e := range m.FundsMoved {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchLifecycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovTypes(uint64(m.BatchNonce))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CreatedHeight))
+	}
+	if m.BatchTimeout != 0 {
+		n += 1 + sovTypes(uint64(m.BatchTimeout))
+	}
+	if m.Confirms != 0 {
+		n += 1 + sovTypes(uint64(m.Confirms))
+	}
+	if m.SignedPower != 0 {
+		n += 1 + sovTypes(uint64(m.SignedPower))
+	}
+	if m.PowerThreshold != 0 {
+		n += 1 + sovTypes(uint64(m.PowerThreshold))
+	}
+	if m.FinishedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.FinishedHeight))
+	}
+	if m.ExecutionEthereumHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExecutionEthereumHeight))
+	}
+	return n
+}
+
+func (m *TimedOutBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovTypes(uint64(m.BatchNonce))
+	}
+	if m.BatchTimeout != 0 {
+		n += 1 + sovTypes(uint64(m.BatchTimeout))
+	}
+	if m.ObservedEthereumHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ObservedEthereumHeight))
+	}
+	if m.TimedOutHeight != 0 {
+		n += 1 + sovTypes(uint64(m.TimedOutHeight))
+	}
+	if m.TimedOutTime != 0 {
+		n += 1 + sovTypes(uint64(m.TimedOutTime))
+	}
+	if len(m.ReleasedTxIds) > 0 {
+		l = 0
+		for _, e := range m.ReleasedTxIds {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *RefundReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovTypes(uint64(m.TxId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Reason != 0 {
+		n += 1 + sovTypes(uint64(m.Reason))
+	}
+	if m.RefundHeight != 0 {
+		n += 1 + sovTypes(uint64(m.RefundHeight))
+	}
+	if m.RefundTime != 0 {
+		n += 1 + sovTypes(uint64(m.RefundTime))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DepositReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovTypes(uint64(m.EventNonce))
+	}
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenSender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.EthBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EthBlockHeight))
+	}
+	if m.DepositHeight != 0 {
+		n += 1 + sovTypes(uint64(m.DepositHeight))
+	}
+	if m.DepositTime != 0 {
+		n += 1 + sovTypes(uint64(m.DepositTime))
+	}
+	if m.EthereumSenderIsContract {
+		n += 2
+	}
+	return n
+}
+
+func (m *ModuleSendGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Cap) > 0 {
+		for _, e := range m.Cap {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.EpochBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.EpochBlocks))
+	}
+	if m.EpochStart != 0 {
+		n += 1 + sovTypes(uint64(m.EpochStart))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgeInstance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PreviousId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StartEventNonce != 0 {
+		n += 1 + sovTypes(uint64(m.StartEventNonce))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *EthDestinationLabel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *FirstSendDelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.DelayBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.DelayBlocks))
+	}
+	if len(m.KnownEthDestinations) > 0 {
+		for _, s := range m.KnownEthDestinations {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AuditLogEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Time != 0 {
+		n += 1 + sovTypes(uint64(m.Time))
+	}
+	l = len(m.ProposalType)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ProposalTitle)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Before)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.After)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Valset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Valset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Valset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &BridgeValidator{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerThreshold", wireType)
+			}
+			m.PowerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PowerThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			}
+			m.CosmosBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+			}
+			m.EthereumBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockTime", wireType)
+			}
+			m.CosmosBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumHeightVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumHeightVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumHeightVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+			}
+			m.EthereumBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			}
+			m.CosmosBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumBlockTimeCalibration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumBlockTimeCalibration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumBlockTimeCalibration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnchorEthereumHeight", wireType)
+			}
+			m.AnchorEthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnchorEthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnchorEthereumTime", wireType)
+			}
+			m.AnchorEthereumTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnchorEthereumTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestEthereumHeight", wireType)
+			}
+			m.LatestEthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestEthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestEthereumTime", wireType)
+			}
+			m.LatestEthereumTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestEthereumTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCalibrationHeight", wireType)
+			}
+			m.LastCalibrationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCalibrationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CalibratedBlockTime", wireType)
+			}
+			m.CalibratedBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CalibratedBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrchestratorHeartbeat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrchestratorHeartbeat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrchestratorHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
+			}
+			m.EthBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			}
+			m.CosmosBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockTime", wireType)
+			}
+			m.CosmosBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthGasPrice", wireType)
+			}
+			m.EthGasPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthGasPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBaseFee", wireType)
+			}
+			m.EthBaseFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthBaseFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthPriorityFee", wireType)
+			}
+			m.EthPriorityFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthPriorityFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrchestratorLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrchestratorLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrchestratorLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastHeartbeat == nil {
+				m.LastHeartbeat = &OrchestratorHeartbeat{}
+			}
+			if err := m.LastHeartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventNonce", wireType)
+			}
+			m.LastEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Live", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Live = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBridgeContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewBridgeContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BridgeMigrationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedHeight", wireType)
+			}
+			m.StartedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationValsetNonce", wireType)
+			}
+			m.MigrationValsetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigrationValsetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeTokenStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeTokenStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeTokenStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			m.Deposits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deposits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+			}
+			m.Withdrawals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Withdrawals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawalVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueSenders", wireType)
+			}
+			m.UniqueSenders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UniqueSenders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeStatsWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeStatsWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeStatsWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			m.Deposits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deposits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+			}
+			m.Withdrawals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Withdrawals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueSenders", wireType)
+			}
+			m.UniqueSenders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UniqueSenders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, BridgeTokenStats{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenSolvency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenSolvency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenSolvency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosOriginated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CosmosOriginated = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BatchedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discrepancy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Discrepancy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuarantinedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplayedToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayedToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayedToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosOriginated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CosmosOriginated = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issued", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Issued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outstanding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outstanding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discrepancy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Discrepancy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplayFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplayReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstEventNonce", wireType)
+			}
+			m.FirstEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstEventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventNonce", wireType)
+			}
+			m.LastEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replayed", wireType)
+			}
+			m.Replayed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replayed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Complete = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, ReplayedToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failures = append(m.Failures, ReplayFailure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundsMovement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundsMovement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundsMovement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalSimulation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalSimulation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalSimulation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, StateChange{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundsMoved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundsMoved = append(m.FundsMoved, FundsMovement{})
+			if err := m.FundsMoved[len(m.FundsMoved)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchLifecycle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchLifecycle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BatchLifecycleStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTimeout", wireType)
+			}
+			m.BatchTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirms", wireType)
+			}
+			m.Confirms = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confirms |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPower", wireType)
+			}
+			m.SignedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerThreshold", wireType)
+			}
+			m.PowerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PowerThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedHeight", wireType)
+			}
+			m.FinishedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionEthereumHeight", wireType)
+			}
+			m.ExecutionEthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionEthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimedOutBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimedOutBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimedOutBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTimeout", wireType)
+			}
+			m.BatchTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedEthereumHeight", wireType)
+			}
+			m.ObservedEthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedEthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOutHeight", wireType)
+			}
+			m.TimedOutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimedOutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOutTime", wireType)
+			}
+			m.TimedOutTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimedOutTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReleasedTxIds = append(m.ReleasedTxIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ReleasedTxIds) == 0 {
+					m.ReleasedTxIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReleasedTxIds = append(m.ReleasedTxIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedTxIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefundReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefundReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefundReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= RefundReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundHeight", wireType)
+			}
+			m.RefundHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundTime", wireType)
+			}
+			m.RefundTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
+			}
+			m.EthBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositHeight", wireType)
+			}
+			m.DepositHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositTime", wireType)
+			}
+			m.DepositTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSenderIsContract", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EthereumSenderIsContract = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleSendGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleSendGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleSendGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cap = append(m.Cap, types.Coin{})
+			if err := m.Cap[len(m.Cap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBlocks", wireType)
+			}
+			m.EpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStart", wireType)
+			}
+			m.EpochStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Height != 0 {
-		n += 1 + sovTypes(uint64(m.Height))
-	}
-	l = m.RewardAmount.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.RewardToken)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
-}
-
-func (m *LastObservedEthereumBlockHeight) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CosmosBlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
-	}
-	if m.EthereumBlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
-	}
-	return n
-}
 
-func (m *ERC20ToDenom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Erc20)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
+func (m *BridgeInstance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -588,17 +9481,17 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BridgeValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeInstance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BridgeValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeInstance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Power = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -608,16 +9501,80 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEventNonce", wireType)
+			}
+			m.StartEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -627,24 +9584,11 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -669,7 +9613,7 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Valset) Unmarshal(dAtA []byte) error {
+func (m *EthDestinationLabel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -692,36 +9636,17 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Valset: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthDestinationLabel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Valset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthDestinationLabel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -731,48 +9656,27 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &BridgeValidator{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -800,13 +9704,11 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Label = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -834,7 +9736,7 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardToken = string(dAtA[iNdEx:postIndex])
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -860,7 +9762,7 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+func (m *FirstSendDelay) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -883,17 +9785,17 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: FirstSendDelay: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FirstSendDelay: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			m.CosmosBlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -903,16 +9805,29 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayBlocks", wireType)
 			}
-			m.EthereumBlockHeight = 0
+			m.DelayBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -922,11 +9837,43 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				m.DelayBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KnownEthDestinations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KnownEthDestinations = append(m.KnownEthDestinations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -951,7 +9898,7 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+func (m *AuditLogEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -974,15 +9921,72 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuditLogEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuditLogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1010,11 +10014,11 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			m.ProposalType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTitle", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1042,7 +10046,71 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ProposalTitle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Before = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.After = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
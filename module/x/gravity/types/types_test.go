@@ -3,10 +3,14 @@ package types
 import (
 	"bytes"
 	"encoding/hex"
+	"fmt"
+	"math/big"
 	mrand "math/rand"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -59,6 +63,77 @@ func TestValsetCheckpointGold1(t *testing.T) {
 	assert.Equal(t, goldHash, hex.EncodeToString(ourHash))
 }
 
+// Checks that the power threshold is part of the checkpoint once set and that
+// valsets without a threshold keep the legacy encoding
+func TestValsetCheckpointPowerThreshold(t *testing.T) {
+	bridgeValidators, err := BridgeValidators{{
+		Power:           6667,
+		EthereumAddress: "0xc783df8a850f42e7F7e57013759C285caa701eB6",
+	}}.ToInternal()
+	require.NoError(t, err)
+	src, err := NewValset(0, 0, *bridgeValidators, sdk.NewInt(0), *ZeroAddress())
+	require.NoError(t, err)
+	legacyHash := src.GetCheckpoint("foo")
+
+	src.PowerThreshold = LegacyEthereumPowerThreshold
+	legacyThresholdHash := src.GetCheckpoint("foo")
+	assert.NotEqual(t, legacyHash, legacyThresholdHash)
+
+	src.PowerThreshold = LegacyEthereumPowerThreshold + 1
+	assert.NotEqual(t, legacyThresholdHash, src.GetCheckpoint("foo"))
+}
+
+func TestValsetDeploymentArgs(t *testing.T) {
+	bridgeValidators, err := BridgeValidators{
+		{Power: 3333, EthereumAddress: "0xc783df8a850f42e7F7e57013759C285caa701eB6"},
+		{Power: 3334, EthereumAddress: "0xeAD9C93b79Ae7C1591b1FB5323BD777E86e150d4"},
+	}.ToInternal()
+	require.NoError(t, err)
+	src, err := NewValset(3, 0, *bridgeValidators, sdk.NewInt(0), *ZeroAddress())
+	require.NoError(t, err)
+
+	args, err := src.GetDeploymentArgs("foo", 6666)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), args.ValsetNonce)
+	assert.Equal(t, "0x666f6f"+strings.Repeat("0", 58), args.GravityId)
+	assert.Equal(t, uint64(6666), args.PowerThreshold)
+	// members are in the sorted order of the valset, highest power first
+	assert.Equal(t, []string{"0xeAD9C93b79Ae7C1591b1FB5323BD777E86e150d4", "0xc783df8a850f42e7F7e57013759C285caa701eB6"}, args.Validators)
+	assert.Equal(t, []uint64{3334, 3333}, args.Powers)
+
+	// the encoding decodes back into the same arguments
+	contractAbi, err := abi.JSON(strings.NewReader(GravityConstructorABIJSON))
+	require.NoError(t, err)
+	decoded, err := contractAbi.Constructor.Inputs.Unpack(gethcommon.FromHex(args.ConstructorArgs))
+	require.NoError(t, err)
+	require.Len(t, decoded, 4)
+	gravityID := decoded[0].([32]byte)
+	assert.Equal(t, gethcommon.FromHex(args.GravityId), gravityID[:])
+	assert.Equal(t, uint64(6666), decoded[1].(*big.Int).Uint64())
+	assert.Equal(t, []gethcommon.Address{
+		gethcommon.HexToAddress(args.Validators[0]), gethcommon.HexToAddress(args.Validators[1]),
+	}, decoded[2].([]gethcommon.Address))
+
+	// the threshold of the valset wins over the one passed in
+	src.PowerThreshold = 5000
+	args, err = src.GetDeploymentArgs("foo", 6666)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5000), args.PowerThreshold)
+
+	// without any threshold the legacy one applies, which these members do not reach
+	src.PowerThreshold = 0
+	_, err = src.GetDeploymentArgs("foo", 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprint(LegacyEthereumPowerThreshold))
+
+	// the constructor reverts unless the members hold more than the threshold
+	src.PowerThreshold = 6667
+	_, err = src.GetDeploymentArgs("foo", 0)
+	require.Error(t, err)
+	_, err = src.GetDeploymentArgs(strings.Repeat("a", 33), 0)
+	require.Error(t, err)
+}
+
 func TestValsetPowerDiff(t *testing.T) {
 	specs := map[string]struct {
 		start BridgeValidators
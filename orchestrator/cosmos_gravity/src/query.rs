@@ -136,7 +136,11 @@ pub async fn get_latest_transaction_batches(
     client: &mut GravityQueryClient<Channel>,
 ) -> Result<Vec<TransactionBatch>, GravityError> {
     let request = client
-        .outgoing_tx_batches(QueryOutgoingTxBatchesRequest {})
+        .outgoing_tx_batches(QueryOutgoingTxBatchesRequest {
+            token_contract: String::new(),
+            unsigned_by: String::new(),
+            pagination: None,
+        })
         .await?;
     let batches = request.into_inner().batches;
     let mut out = Vec::new();
@@ -253,6 +257,7 @@ pub async fn get_pending_send_to_eth(
     let request = client
         .get_pending_send_to_eth(QueryPendingSendToEth {
             sender_address: sender_address.to_string(),
+            pagination: None,
         })
         .await?;
     Ok(request.into_inner())
@@ -35,30 +35,35 @@ pub const TIMEOUT: Duration = Duration::from_secs(60);
 /// Send a transaction updating the eth address for the sending
 /// Cosmos address. The sending Cosmos address should be a validator
 /// this can only be called once! Key rotation code is possible but
-/// not currently implemented
+/// not currently implemented. The delegate Ethereum key signs the
+/// validator operator address to prove the validator controls it
 pub async fn set_gravity_delegate_addresses(
     contact: &Contact,
-    delegate_eth_address: EthAddress,
+    delegate_eth_private_key: EthPrivateKey,
     delegate_cosmos_address: Address,
     private_key: PrivateKey,
     fee: Coin,
 ) -> Result<TxResponse, CosmosGrpcError> {
     trace!("Updating Gravity Delegate addresses");
-    let our_valoper_address = private_key
-        .to_address(&contact.get_prefix())
-        .unwrap()
+    let our_address = private_key.to_address(&contact.get_prefix()).unwrap();
+    let our_valoper_address = our_address
         // This works so long as the format set by the cosmos hub is maintained
         // having a main prefix followed by a series of titles for specific keys
         // this will not work if that convention is broken. This will be resolved when
         // GRPC exposes prefix endpoints (coming to upstream cosmos sdk soon)
         .to_bech32(format!("{}valoper", contact.get_prefix()))
         .unwrap();
-    let our_address = private_key.to_address(&contact.get_prefix()).unwrap();
+    let delegate_eth_address = delegate_eth_private_key.to_public_key().unwrap();
+
+    // the module checks this against the keccak256 hash of the operator address bytes,
+    // sign_ethereum_msg hashes the message before signing it
+    let eth_signature = delegate_eth_private_key.sign_ethereum_msg(&our_address.as_bytes());
 
     let msg_set_orch_address = MsgSetOrchestratorAddress {
         validator: our_valoper_address.to_string(),
         orchestrator: delegate_cosmos_address.to_string(),
         eth_address: delegate_eth_address.to_string(),
+        eth_signature: bytes_to_hex_str(&eth_signature.to_bytes()),
     };
 
     let fee = Fee {
@@ -273,6 +278,11 @@ pub async fn send_ethereum_claims(
             cosmos_receiver: deposit.destination.to_bech32(contact.get_prefix()).unwrap(),
             ethereum_sender: deposit.sender.to_string(),
             orchestrator: our_address.to_string(),
+            eth_block_timestamp: 0,
+            bridge_chain_id: 0,
+            token_sender: String::new(),
+            ethereum_sender_is_contract: false,
+            eth_tx_hash: String::new(),
         };
         let msg = Msg::new("/gravity.v1.MsgSendToCosmosClaim", claim);
         unordered_msgs.insert(deposit.event_nonce, msg);
@@ -284,6 +294,9 @@ pub async fn send_ethereum_claims(
             token_contract: withdraw.erc20.to_string(),
             batch_nonce: withdraw.batch_nonce,
             orchestrator: our_address.to_string(),
+            eth_block_timestamp: 0,
+            relayer: String::new(),
+            bridge_chain_id: 0,
         };
         let msg = Msg::new("/gravity.v1.MsgBatchSendToEthClaim", claim);
         unordered_msgs.insert(withdraw.event_nonce, msg);
@@ -298,6 +311,7 @@ pub async fn send_ethereum_claims(
             symbol: deploy.symbol,
             decimals: deploy.decimals as u64,
             orchestrator: our_address.to_string(),
+            bridge_chain_id: 0,
         };
         let msg = Msg::new("/gravity.v1.MsgERC20DeployedClaim", claim);
         unordered_msgs.insert(deploy.event_nonce, msg);
@@ -309,6 +323,7 @@ pub async fn send_ethereum_claims(
             invalidation_id: call.invalidation_id,
             invalidation_nonce: call.invalidation_nonce,
             orchestrator: our_address.to_string(),
+            bridge_chain_id: 0,
         };
         let msg = Msg::new("/gravity.v1.MsgLogicCallExecutedClaim", claim);
         unordered_msgs.insert(call.event_nonce, msg);
@@ -325,6 +340,7 @@ pub async fn send_ethereum_claims(
                 .unwrap_or_else(|| *ZERO_ADDRESS)
                 .to_string(),
             orchestrator: our_address.to_string(),
+            bridge_chain_id: 0,
         };
         let msg = Msg::new("/gravity.v1.MsgValsetUpdatedClaim", claim);
         unordered_msgs.insert(valset.event_nonce, msg);
@@ -404,6 +420,11 @@ pub async fn send_to_eth(
         eth_dest: destination.to_string(),
         amount: Some(amount.into()),
         bridge_fee: Some(bridge_fee.clone().into()),
+        eth_dest_label: String::new(),
+        callback_target: String::new(),
+        callback_data: Vec::new(),
+        priority: 0,
+        payload: Vec::new(),
     };
 
     let fee = Fee {
@@ -521,6 +542,7 @@ pub async fn cancel_send_to_eth(
     let msg_cancel_send_to_eth = MsgCancelSendToEth {
         transaction_id,
         sender: our_address.to_string(),
+        refund_address: String::new(),
     };
 
     let fee = Fee {
@@ -78,11 +78,10 @@ pub async fn register_orchestrator_address(
         key.unwrap()
     };
 
-    let ethereum_address = ethereum_key.to_public_key().unwrap();
     let cosmos_address = cosmos_key.to_address(&contact.get_prefix()).unwrap();
     let res = set_gravity_delegate_addresses(
         &contact,
-        ethereum_address,
+        ethereum_key,
         cosmos_address,
         validator_key,
         fee.clone(),
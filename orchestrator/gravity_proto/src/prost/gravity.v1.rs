@@ -13,6 +13,17 @@
 /// the key in which the attestation is stored is keyed on the exact details of the claim
 /// but there is no reason to store those exact details becuause the next message sender
 /// will kindly provide you with them.
+///
+/// eth_block_timestamp is the unix timestamp of the Ethereum block the claimed
+/// event occurred in, zero for claims that do not carry one. observed_time is
+/// the unix time of the Cosmos block the attestation was observed in, zero
+/// while it is not observed. vetoed attestations were blocked by governance,
+/// they are never observed and accept no further votes. pending_execution is
+/// set on observed attestations that are queued to be applied to the state.
+/// claim_hash_version is the claim hash version the attestation is keyed
+/// under, attestations stored before claim hashes were versioned are version 0.
+/// observed_height is the Cosmos block height the attestation was observed at,
+/// zero while it is not observed
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct Attestation {
     #[prost(bool, tag="1")]
@@ -23,6 +34,53 @@ pub struct Attestation {
     pub height: u64,
     #[prost(message, optional, tag="4")]
     pub claim: ::core::option::Option<::prost_types::Any>,
+    #[prost(uint64, tag="5")]
+    pub eth_block_timestamp: u64,
+    #[prost(uint64, tag="6")]
+    pub observed_time: u64,
+    #[prost(bool, tag="7")]
+    pub vetoed: bool,
+    #[prost(bool, tag="8")]
+    pub pending_execution: bool,
+    #[prost(uint32, tag="9")]
+    pub claim_hash_version: u32,
+    #[prost(uint64, tag="10")]
+    pub observed_height: u64,
+}
+/// AttestationVote is the vote of a single validator on an attestation along
+/// with its current power
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct AttestationVote {
+    #[prost(string, tag="1")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub power: u64,
+}
+/// AttestationVoteBreakdown explains how close an attestation is to being
+/// observed, all powers are current consensus powers. remaining_power is the
+/// power that still has to vote before the attestation is observed.
+/// missing_votes are the validators of the current set that have not voted on
+/// the attestation, the most powerful first
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct AttestationVoteBreakdown {
+    #[prost(uint64, tag="1")]
+    pub event_nonce: u64,
+    #[prost(string, tag="2")]
+    pub claim_hash: ::prost::alloc::string::String,
+    #[prost(bool, tag="3")]
+    pub observed: bool,
+    #[prost(message, repeated, tag="4")]
+    pub votes: ::prost::alloc::vec::Vec<AttestationVote>,
+    #[prost(uint64, tag="5")]
+    pub voted_power: u64,
+    #[prost(uint64, tag="6")]
+    pub required_power: u64,
+    #[prost(uint64, tag="7")]
+    pub remaining_power: u64,
+    #[prost(uint64, tag="8")]
+    pub total_power: u64,
+    #[prost(message, repeated, tag="9")]
+    pub missing_votes: ::prost::alloc::vec::Vec<AttestationVote>,
 }
 /// ERC20Token unique identifier for an Ethereum ERC20 token.
 /// CONTRACT:
@@ -48,6 +106,7 @@ pub enum ClaimType {
     Erc20Deployed = 3,
     LogicCallExecuted = 4,
     ValsetUpdated = 5,
+    MigrationCompleted = 6,
 }
 /// OutgoingTxBatch represents a batch of transactions going from gravity to ETH
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -76,6 +135,37 @@ pub struct OutgoingTransferTx {
     pub erc20_token: ::core::option::Option<Erc20Token>,
     #[prost(message, optional, tag="5")]
     pub erc20_fee: ::core::option::Option<Erc20Token>,
+    /// set when the fee dominates the transferred amount, the transfer is not
+    /// batched until the sender releases it
+    #[prost(bool, tag="6")]
+    pub needs_confirmation: bool,
+    /// set when the sender requires a delay before sending to a new destination,
+    /// the transfer is not batched before this block height
+    #[prost(uint64, tag="7")]
+    pub held_until: u64,
+    /// the block height the transfer entered the pool at, it is refunded once it
+    /// waited for longer than the pool_tx_timeout param
+    #[prost(uint64, tag="8")]
+    pub created_height: u64,
+    /// the priority class the sender paid for, batches take the transfers of a
+    /// higher priority before those of a lower one regardless of their fees
+    #[prost(uint32, tag="9")]
+    pub priority: u32,
+    /// the data the sender handed to the destination, see MsgSendToEth
+    #[prost(bytes="vec", tag="10")]
+    pub payload: ::prost::alloc::vec::Vec<u8>,
+}
+/// MergedTransfer records the pool transactions to the same destination a
+/// batch carries as the single transfer id, the id of the first of them. They
+/// go back into the pool one by one if the batch does not execute
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MergedTransfer {
+    #[prost(uint64, tag="1")]
+    pub batch_nonce: u64,
+    #[prost(uint64, tag="2")]
+    pub id: u64,
+    #[prost(message, repeated, tag="3")]
+    pub children: ::prost::alloc::vec::Vec<OutgoingTransferTx>,
 }
 /// OutgoingLogicCall represents an individual logic call from gravity to ETH
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -129,18 +219,62 @@ pub struct Valset {
     /// the reward token in it's Ethereum hex address representation
     #[prost(string, tag="5")]
     pub reward_token: ::prost::alloc::string::String,
+    /// the normalized power that must sign for this valset to be accepted by the
+    /// Ethereum contract, zero for valsets created while the threshold param is
+    /// zero, in which case it is not encoded into the checkpoint and the contract
+    /// checks the threshold it hardcodes
+    #[prost(uint64, tag="6")]
+    pub power_threshold: u64,
 }
 /// LastObservedEthereumBlockHeight stores the last observed
 /// Ethereum block height along with the Cosmos block height that
 /// it was observed at. These two numbers can be used to project
 /// outward and always produce batches with timeouts in the future
 /// even if no Ethereum block height has been relayed for a long time
+///
+/// cosmos_block_time is the unix time in milliseconds of the Cosmos block the
+/// height was observed at, it is zero for heights stored by older versions
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct LastObservedEthereumBlockHeight {
     #[prost(uint64, tag="1")]
     pub cosmos_block_height: u64,
     #[prost(uint64, tag="2")]
     pub ethereum_block_height: u64,
+    #[prost(uint64, tag="3")]
+    pub cosmos_block_time: u64,
+}
+/// EthereumHeightVote is the latest Ethereum block height reported by a
+/// validators orchestrator, either through a claim or a heartbeat, along
+/// with the Cosmos block height at which it was reported
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EthereumHeightVote {
+    #[prost(string, tag="1")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub ethereum_block_height: u64,
+    #[prost(uint64, tag="3")]
+    pub cosmos_block_height: u64,
+}
+/// EthereumBlockTimeCalibration holds the Ethereum (height, unix timestamp)
+/// samples of the current calibration window, taken from observed claims. Every
+/// calibration period the average block time across the window, in
+/// milliseconds, becomes the calibrated_block_time used in place of the
+/// average_ethereum_block_time param and the window restarts from the latest
+/// sample
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EthereumBlockTimeCalibration {
+    #[prost(uint64, tag="1")]
+    pub anchor_ethereum_height: u64,
+    #[prost(uint64, tag="2")]
+    pub anchor_ethereum_time: u64,
+    #[prost(uint64, tag="3")]
+    pub latest_ethereum_height: u64,
+    #[prost(uint64, tag="4")]
+    pub latest_ethereum_time: u64,
+    #[prost(uint64, tag="5")]
+    pub last_calibration_height: u64,
+    #[prost(uint64, tag="6")]
+    pub calibrated_block_time: u64,
 }
 /// This records the relationship between an ERC20 token and the denom
 /// of the corresponding Cosmos originated asset
@@ -151,6 +285,459 @@ pub struct Erc20ToDenom {
     #[prost(string, tag="2")]
     pub denom: ::prost::alloc::string::String,
 }
+/// OrchestratorHeartbeat is the most recent heartbeat received from a
+/// validators orchestrator, along with the Cosmos block height and block time
+/// (in unix seconds) at which it was received. eth_gas_price is the Ethereum
+/// gas price in wei the orchestrator reported, zero if it reported none.
+/// eth_base_fee and eth_priority_fee are the type-2 transaction fees in wei it
+/// reported, zero if it reported none
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct OrchestratorHeartbeat {
+    #[prost(string, tag="1")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub orchestrator: ::prost::alloc::string::String,
+    #[prost(uint64, tag="3")]
+    pub eth_block_height: u64,
+    #[prost(string, tag="4")]
+    pub version: ::prost::alloc::string::String,
+    #[prost(uint64, tag="5")]
+    pub cosmos_block_height: u64,
+    #[prost(uint64, tag="6")]
+    pub cosmos_block_time: u64,
+    #[prost(uint64, tag="7")]
+    pub eth_gas_price: u64,
+    #[prost(uint64, tag="8")]
+    pub eth_base_fee: u64,
+    #[prost(uint64, tag="9")]
+    pub eth_priority_fee: u64,
+}
+/// OrchestratorLiveness summarizes the liveness of a single validators
+/// orchestrator, last_heartbeat is unset if no heartbeat was ever received
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct OrchestratorLiveness {
+    #[prost(string, tag="1")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub orchestrator: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="3")]
+    pub last_heartbeat: ::core::option::Option<OrchestratorHeartbeat>,
+    #[prost(uint64, tag="4")]
+    pub last_event_nonce: u64,
+    #[prost(bool, tag="5")]
+    pub live: bool,
+}
+/// BridgeMigration is the state of an in progress contract migration,
+/// migration_valset_nonce is only set once the migration valset was emitted
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BridgeMigration {
+    #[prost(string, tag="1")]
+    pub new_bridge_contract: ::prost::alloc::string::String,
+    #[prost(enumeration="BridgeMigrationStatus", tag="2")]
+    pub status: i32,
+    #[prost(uint64, tag="3")]
+    pub started_height: u64,
+    #[prost(uint64, tag="4")]
+    pub migration_valset_nonce: u64,
+}
+/// BridgeTokenStats counts the deposits to Cosmos and the withdrawals to
+/// Ethereum of a single token, either in one hourly bucket of the bridge
+/// statistics store or summed over a window. Withdrawals are counted when
+/// their batch is executed on Ethereum. unique_senders is only set on window
+/// sums, it counts the distinct senders that moved this token within the window
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BridgeTokenStats {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub deposits: u64,
+    #[prost(string, tag="3")]
+    pub deposit_volume: ::prost::alloc::string::String,
+    #[prost(uint64, tag="4")]
+    pub withdrawals: u64,
+    #[prost(string, tag="5")]
+    pub withdrawal_volume: ::prost::alloc::string::String,
+    #[prost(uint64, tag="6")]
+    pub unique_senders: u64,
+}
+/// BridgeStatsWindow sums the bridge statistics of the last window_seconds,
+/// unique_senders counts the distinct Ethereum senders of deposits and
+/// Cosmos senders of withdrawals
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BridgeStatsWindow {
+    #[prost(uint64, tag="1")]
+    pub window_seconds: u64,
+    #[prost(uint64, tag="2")]
+    pub deposits: u64,
+    #[prost(uint64, tag="3")]
+    pub withdrawals: u64,
+    #[prost(uint64, tag="4")]
+    pub unique_senders: u64,
+    #[prost(message, repeated, tag="5")]
+    pub tokens: ::prost::alloc::vec::Vec<BridgeTokenStats>,
+}
+/// TokenSolvency compares the bank balances of a bridged token with what the
+/// module still owes on it. pool_amount and batched_amount sum the amounts
+/// and fees of the unbatched transfers and of the batches not yet executed on
+/// Ethereum. escrow_balance is what the module account holds of the denom and
+/// supply its total supply on Cosmos. quarantined_amount is what the module
+/// account holds for deposits from quarantined Ethereum senders and
+/// reserved_amount what it holds of vouchers to pay valset rewards with.
+/// discrepancy is empty while the token is consistent and describes the
+/// problem otherwise
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TokenSolvency {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub denom: ::prost::alloc::string::String,
+    #[prost(bool, tag="3")]
+    pub cosmos_originated: bool,
+    #[prost(string, tag="4")]
+    pub supply: ::prost::alloc::string::String,
+    #[prost(string, tag="5")]
+    pub escrow_balance: ::prost::alloc::string::String,
+    #[prost(string, tag="6")]
+    pub pool_amount: ::prost::alloc::string::String,
+    #[prost(string, tag="7")]
+    pub batched_amount: ::prost::alloc::string::String,
+    #[prost(string, tag="8")]
+    pub discrepancy: ::prost::alloc::string::String,
+    #[prost(string, tag="9")]
+    pub quarantined_amount: ::prost::alloc::string::String,
+    #[prost(string, tag="10")]
+    pub reserved_amount: ::prost::alloc::string::String,
+}
+/// ReplayedToken compares what replaying the observed claims issued of a
+/// bridged token with what is outstanding of it now. issued is what the
+/// replayed deposits minted, or for Cosmos originated tokens released from
+/// escrow. For Ethereum originated tokens outstanding is the voucher supply
+/// plus the vouchers burned for transfers that have not been executed yet,
+/// withdrawals executed on Ethereum can only make it lower than issued.
+/// discrepancy is empty while the token is consistent and describes the
+/// problem otherwise
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ReplayedToken {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub denom: ::prost::alloc::string::String,
+    #[prost(bool, tag="3")]
+    pub cosmos_originated: bool,
+    #[prost(string, tag="4")]
+    pub issued: ::prost::alloc::string::String,
+    #[prost(string, tag="5")]
+    pub outstanding: ::prost::alloc::string::String,
+    #[prost(string, tag="6")]
+    pub discrepancy: ::prost::alloc::string::String,
+}
+/// ReplayFailure is an observed claim the attestation handler no longer
+/// applies when replayed
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ReplayFailure {
+    #[prost(uint64, tag="1")]
+    pub event_nonce: u64,
+    #[prost(string, tag="2")]
+    pub claim_type: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub error: ::prost::alloc::string::String,
+}
+/// ReplayReport is the result of replaying the stored observed claims against
+/// a fresh bank. first_event_nonce and last_event_nonce bound the claims
+/// replayed, complete is false if older claims have been pruned, the issued
+/// amounts then only cover part of the history and are not checked
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ReplayReport {
+    #[prost(uint64, tag="1")]
+    pub first_event_nonce: u64,
+    #[prost(uint64, tag="2")]
+    pub last_event_nonce: u64,
+    #[prost(uint64, tag="3")]
+    pub replayed: u64,
+    #[prost(bool, tag="4")]
+    pub complete: bool,
+    #[prost(message, repeated, tag="5")]
+    pub tokens: ::prost::alloc::vec::Vec<ReplayedToken>,
+    #[prost(message, repeated, tag="6")]
+    pub failures: ::prost::alloc::vec::Vec<ReplayFailure>,
+}
+/// StateChange is a gravity store entry a proposal would change, key is hex
+/// encoded and starts with the prefix of the state it belongs to. operation is
+/// one of created, updated or deleted
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct StateChange {
+    #[prost(string, tag="1")]
+    pub key: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub operation: ::prost::alloc::string::String,
+}
+/// FundsMovement is an amount a proposal would move between accounts, from and
+/// to are bech32 account addresses, module accounts included. Minted coins
+/// have no from and burned coins no to
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct FundsMovement {
+    #[prost(string, tag="1")]
+    pub from: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub to: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag="3")]
+    pub amount: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+}
+/// ProposalSimulation is the result of executing a gravity proposal against
+/// the current state without persisting anything. error is set if the
+/// proposal would fail, nothing would change then
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ProposalSimulation {
+    #[prost(string, tag="1")]
+    pub proposal_type: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub error: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag="3")]
+    pub state_changes: ::prost::alloc::vec::Vec<StateChange>,
+    #[prost(message, repeated, tag="4")]
+    pub funds_moved: ::prost::alloc::vec::Vec<FundsMovement>,
+}
+/// BatchLifecycle describes a batch from its creation until it leaves the
+/// store. confirms is the number of batch confirms submitted, signed_power the
+/// power they hold in the last valset observed on Ethereum, out of the
+/// power_threshold the Gravity contract requires. finished_height is the block
+/// the batch left the store at, and execution_ethereum_height the Ethereum
+/// block an executed batch was executed in. The state of a batch that left the
+/// store is kept as it was at that point, for the last batches created only
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BatchLifecycle {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub batch_nonce: u64,
+    #[prost(enumeration="BatchLifecycleStatus", tag="3")]
+    pub status: i32,
+    #[prost(uint64, tag="4")]
+    pub created_height: u64,
+    #[prost(uint64, tag="5")]
+    pub batch_timeout: u64,
+    #[prost(uint64, tag="6")]
+    pub confirms: u64,
+    #[prost(uint64, tag="7")]
+    pub signed_power: u64,
+    #[prost(uint64, tag="8")]
+    pub power_threshold: u64,
+    #[prost(uint64, tag="9")]
+    pub finished_height: u64,
+    #[prost(uint64, tag="10")]
+    pub execution_ethereum_height: u64,
+}
+/// TimedOutBatch records a batch that was canceled because it passed its
+/// timeout on Ethereum before being executed, released_tx_ids are the
+/// transactions that went back into the unbatched pool. timed_out_height and
+/// timed_out_time are the Cosmos block and its unix time the batch was
+/// canceled at
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TimedOutBatch {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub batch_nonce: u64,
+    #[prost(uint64, tag="3")]
+    pub batch_timeout: u64,
+    #[prost(uint64, tag="4")]
+    pub observed_ethereum_height: u64,
+    #[prost(uint64, tag="5")]
+    pub timed_out_height: u64,
+    #[prost(uint64, tag="6")]
+    pub timed_out_time: u64,
+    #[prost(uint64, repeated, tag="7")]
+    pub released_tx_ids: ::prost::alloc::vec::Vec<u64>,
+}
+/// RefundReceipt records a transfer to Ethereum that was refunded instead of
+/// sent, amount and fee are what was paid back to the sender. refund_height
+/// and refund_time are the Cosmos block and its unix time the refund was paid
+/// in. refund_address is only set when the sender had the refund paid to
+/// another account
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RefundReceipt {
+    #[prost(uint64, tag="1")]
+    pub tx_id: u64,
+    #[prost(string, tag="2")]
+    pub sender: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub dest_address: ::prost::alloc::string::String,
+    #[prost(string, tag="4")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="5")]
+    pub amount: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    #[prost(message, optional, tag="6")]
+    pub fee: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    #[prost(enumeration="RefundReason", tag="7")]
+    pub reason: i32,
+    #[prost(uint64, tag="8")]
+    pub refund_height: u64,
+    #[prost(uint64, tag="9")]
+    pub refund_time: u64,
+    #[prost(string, tag="10")]
+    pub refund_address: ::prost::alloc::string::String,
+}
+/// DepositReceipt records a deposit from Ethereum that was paid out to
+/// cosmos_receiver. ethereum_sender is the msg.sender of the deposit and
+/// token_sender the address the tokens came from if that was someone else, for
+/// example the user a contract deposited for. ethereum_sender_is_contract is
+/// set when the orchestrators reported msg.sender to be a contract rather than
+/// an externally owned account. deposit_height and deposit_time are the Cosmos
+/// block and its unix time the deposit was paid out in. A deposit from a
+/// quarantined sender is kept in the same form until governance releases it,
+/// deposit_height and deposit_time are then when it was quarantined
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DepositReceipt {
+    #[prost(uint64, tag="1")]
+    pub event_nonce: u64,
+    #[prost(string, tag="2")]
+    pub ethereum_sender: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub token_sender: ::prost::alloc::string::String,
+    #[prost(string, tag="4")]
+    pub cosmos_receiver: ::prost::alloc::string::String,
+    #[prost(string, tag="5")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="6")]
+    pub amount: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    #[prost(uint64, tag="7")]
+    pub eth_block_height: u64,
+    #[prost(uint64, tag="8")]
+    pub deposit_height: u64,
+    #[prost(uint64, tag="9")]
+    pub deposit_time: u64,
+    #[prost(bool, tag="10")]
+    pub ethereum_sender_is_contract: bool,
+}
+/// ModuleSendGrant is the budget governance granted a module for sending to
+/// Ethereum, see ModuleSendGrantProposal. spent is what the module sent in the
+/// current epoch, which started at the Cosmos block epoch_start
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ModuleSendGrant {
+    #[prost(string, tag="1")]
+    pub module: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag="2")]
+    pub cap: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    #[prost(uint64, tag="3")]
+    pub epoch_blocks: u64,
+    #[prost(uint64, tag="4")]
+    pub epoch_start: u64,
+    #[prost(message, repeated, tag="5")]
+    pub spent: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+}
+/// BridgeInstance identifies one run of the chain. A new instance is started
+/// whenever the chain is initialized from genesis, including when it is
+/// relaunched from an export. Claims at event nonces up to start_event_nonce and
+/// confirms for valsets, batches and logic calls created before start_height
+/// were produced for the previous instance and are refused, until governance
+/// passes a BridgeInstanceResetProposal
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BridgeInstance {
+    #[prost(string, tag="1")]
+    pub id: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub previous_id: ::prost::alloc::string::String,
+    #[prost(uint64, tag="3")]
+    pub start_event_nonce: u64,
+    #[prost(uint64, tag="4")]
+    pub start_height: u64,
+}
+/// EthDestinationLabel is a labeled Ethereum destination in the address book of
+/// owner, see MsgSetEthDestinationLabel
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EthDestinationLabel {
+    #[prost(string, tag="1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub label: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub eth_address: ::prost::alloc::string::String,
+}
+/// FirstSendDelay is the number of blocks the sends of account to an Ethereum
+/// destination it has not sent to before are held before they can be batched,
+/// see MsgSetFirstSendDelay. known_eth_destinations are the destinations it has
+/// sent to since setting the delay
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct FirstSendDelay {
+    #[prost(string, tag="1")]
+    pub account: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub delay_blocks: u64,
+    #[prost(string, repeated, tag="3")]
+    pub known_eth_destinations: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+/// AuditLogEntry records a change governance made to the bridge by passing a
+/// gravity proposal, at height and time (unix seconds). before and after
+/// describe the state the proposal changed. Gov proposal handlers are not given
+/// the proposal id or proposer, the type and title of the proposal together
+/// with the height identify it in the gov module
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct AuditLogEntry {
+    #[prost(uint64, tag="1")]
+    pub id: u64,
+    #[prost(uint64, tag="2")]
+    pub height: u64,
+    #[prost(uint64, tag="3")]
+    pub time: u64,
+    #[prost(string, tag="4")]
+    pub proposal_type: ::prost::alloc::string::String,
+    #[prost(string, tag="5")]
+    pub proposal_title: ::prost::alloc::string::String,
+    #[prost(string, tag="6")]
+    pub before: ::prost::alloc::string::String,
+    #[prost(string, tag="7")]
+    pub after: ::prost::alloc::string::String,
+}
+/// BridgeMigrationStatus tracks the progress of a governance approved move to
+/// a new Gravity contract
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum BridgeMigrationStatus {
+    Unspecified = 0,
+    /// new sends and batches are refused while the in flight batches and logic
+    /// calls are executed or time out on the old contract
+    Draining = 1,
+    /// the migration valset has been emitted and the module is waiting for a
+    /// MsgMigrationCompletedClaim naming the new contract
+    AwaitingCompletion = 2,
+}
+/// BatchLifecycleStatus is where a batch is in its life, see BatchLifecycle
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum BatchLifecycleStatus {
+    Unspecified = 0,
+    /// the batch is collecting signatures or waiting to be relayed
+    Pending = 1,
+    /// the batch was observed executed on Ethereum
+    Executed = 2,
+    /// the batch was canceled, because a later batch of the token was executed
+    /// first or by governance
+    Canceled = 3,
+    /// the batch passed its timeout on Ethereum before being executed
+    TimedOut = 4,
+}
+/// RefundReason is why a transfer to Ethereum was taken out of the pool and
+/// paid back to its sender
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum RefundReason {
+    Unspecified = 0,
+    /// the sender canceled the transfer with MsgCancelSendToEth
+    Canceled = 1,
+    /// the observed Ethereum height stood still for longer than
+    /// stall_refund_threshold blocks
+    Stalled = 2,
+    /// governance evacuated the pool with an EvacuatePoolProposal
+    Evacuated = 3,
+    /// the transfer waited in the pool for longer than pool_tx_timeout blocks
+    Expired = 4,
+    /// the logic call delivering a transfer with a receiver hook timed out on
+    /// Ethereum, usually because the hook reverted
+    CallbackTimedOut = 5,
+    /// governance canceled the batch holding the transfer with a
+    /// CancelOutgoingBatchProposal
+    BatchCanceled = 6,
+}
 /// MsgSetOrchestratorAddress
 /// this message allows validators to delegate their voting responsibilities
 /// to a given key. This key is then used as an optional authentication method
@@ -164,6 +751,11 @@ pub struct Erc20ToDenom {
 /// ETH_ADDRESS
 /// This is a hex encoded 0x Ethereum public key that will be used by this validator
 /// on Ethereum
+/// ETH_SIGNATURE
+/// The hex encoded signature by the key of eth_address over the keccak256 hash of
+/// the validator operator address bytes, in the Ethereum signed message format.
+/// It proves the validator controls the key, a key it can not sign with would
+/// leave it unable to confirm valsets and batches
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgSetOrchestratorAddress {
     #[prost(string, tag="1")]
@@ -172,6 +764,8 @@ pub struct MsgSetOrchestratorAddress {
     pub orchestrator: ::prost::alloc::string::String,
     #[prost(string, tag="3")]
     pub eth_address: ::prost::alloc::string::String,
+    #[prost(string, tag="4")]
+    pub eth_signature: ::prost::alloc::string::String,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgSetOrchestratorAddressResponse {
@@ -217,6 +811,19 @@ pub struct MsgValsetConfirmResponse {
 /// the fee paid for the bridge, distinct from the fee paid to the chain to
 /// actually send this message in the first place. So a successful send has
 /// two layers of fees for the user
+/// ETH_DEST_LABEL:
+/// the label of a destination in the senders address book, set with
+/// MsgSetEthDestinationLabel, to send to instead of eth_dest. Exactly one of
+/// the two must be set
+/// CALLBACK_TARGET:
+/// an optional Ethereum contract to deliver the amount to instead, it is then
+/// called with onTokenTransfer(eth_dest, amount, callback_data) in the same
+/// transaction, in the style of an ERC677 receiver hook. Such a send is not
+/// batched but relayed on its own as a logic call, and refunded if it times
+/// out on Ethereum
+/// CALLBACK_DATA:
+/// the data passed to the receiver hook, at most max_callback_data_size bytes
+/// and charged callback_data_byte_fee per byte
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgSendToEth {
     #[prost(string, tag="1")]
@@ -227,9 +834,35 @@ pub struct MsgSendToEth {
     pub amount: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
     #[prost(message, optional, tag="4")]
     pub bridge_fee: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    #[prost(string, tag="5")]
+    pub eth_dest_label: ::prost::alloc::string::String,
+    #[prost(string, tag="6")]
+    pub callback_target: ::prost::alloc::string::String,
+    #[prost(bytes="vec", tag="7")]
+    pub callback_data: ::prost::alloc::vec::Vec<u8>,
+    /// optional priority class of the transfer, see the send_to_eth_priority_fees
+    /// param. 0 is the default class that costs nothing extra
+    #[prost(uint32, tag="8")]
+    pub priority: u32,
+    /// optional data handed to the destination with the transfer, at most
+    /// max_send_to_eth_payload_size bytes. It is part of the batch checkpoint,
+    /// a batch carrying payloads is only accepted by a Gravity contract that
+    /// verifies them
+    #[prost(bytes="vec", tag="9")]
+    pub payload: ::prost::alloc::vec::Vec<u8>,
 }
+/// MsgSendToEthResponse is only filled in when the message is simulated, it
+/// previews whether a batch of the token built right away would pick the
+/// transfer. batch_position is the number of transfers ahead of it in fee
+/// order and next_batch_min_fee the lowest fee such a batch would include
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgSendToEthResponse {
+    #[prost(bool, tag="1")]
+    pub in_next_batch: bool,
+    #[prost(uint64, tag="2")]
+    pub batch_position: u64,
+    #[prost(string, tag="3")]
+    pub next_batch_min_fee: ::prost::alloc::string::String,
 }
 /// MsgRequestBatch
 /// this is a message anyone can send that requests a batch of transactions to
@@ -319,6 +952,29 @@ pub struct MsgSendToCosmosClaim {
     pub cosmos_receiver: ::prost::alloc::string::String,
     #[prost(string, tag="7")]
     pub orchestrator: ::prost::alloc::string::String,
+    /// unix timestamp of the Ethereum block the deposit occurred in
+    #[prost(uint64, tag="8")]
+    pub eth_block_timestamp: u64,
+    /// the chain id of the Ethereum chain the event was observed on, claims
+    /// that do not match the bridge_chain_id param are rejected. 0 means the
+    /// orchestrator did not report it and is not checked
+    #[prost(uint64, tag="9")]
+    pub bridge_chain_id: u64,
+    /// the address the deposited tokens were transferred from when it is not
+    /// ethereum_sender, the msg.sender of the deposit. Set when a contract such
+    /// as an aggregator deposits on behalf of the user whose tokens it moves,
+    /// left empty otherwise and by orchestrators that do not report it
+    #[prost(string, tag="10")]
+    pub token_sender: ::prost::alloc::string::String,
+    /// set when ethereum_sender is a contract rather than an externally owned
+    /// account, it is only part of the claim hash when set
+    #[prost(bool, tag="11")]
+    pub ethereum_sender_is_contract: bool,
+    /// the 0x prefixed hash of the Ethereum transaction that made the deposit,
+    /// left empty by orchestrators that do not report it. Deposits can be looked
+    /// up by it with the DepositByEthTxHash query
+    #[prost(string, tag="12")]
+    pub eth_tx_hash: ::prost::alloc::string::String,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgSendToCosmosClaimResponse {
@@ -337,6 +993,15 @@ pub struct MsgBatchSendToEthClaim {
     pub token_contract: ::prost::alloc::string::String,
     #[prost(string, tag="5")]
     pub orchestrator: ::prost::alloc::string::String,
+    /// unix timestamp of the Ethereum block the batch was executed in
+    #[prost(uint64, tag="6")]
+    pub eth_block_timestamp: u64,
+    /// the Ethereum address that submitted the batch, left empty by orchestrators
+    /// that do not report it
+    #[prost(string, tag="7")]
+    pub relayer: ::prost::alloc::string::String,
+    #[prost(uint64, tag="8")]
+    pub bridge_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgBatchSendToEthClaimResponse {
@@ -362,6 +1027,8 @@ pub struct MsgErc20DeployedClaim {
     pub decimals: u64,
     #[prost(string, tag="8")]
     pub orchestrator: ::prost::alloc::string::String,
+    #[prost(uint64, tag="9")]
+    pub bridge_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgErc20DeployedClaimResponse {
@@ -380,6 +1047,8 @@ pub struct MsgLogicCallExecutedClaim {
     pub invalidation_nonce: u64,
     #[prost(string, tag="5")]
     pub orchestrator: ::prost::alloc::string::String,
+    #[prost(uint64, tag="6")]
+    pub bridge_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgLogicCallExecutedClaimResponse {
@@ -402,23 +1071,94 @@ pub struct MsgValsetUpdatedClaim {
     pub reward_token: ::prost::alloc::string::String,
     #[prost(string, tag="7")]
     pub orchestrator: ::prost::alloc::string::String,
+    #[prost(uint64, tag="8")]
+    pub bridge_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgValsetUpdatedClaimResponse {
 }
-/// This call allows the sender (and only the sender)
+/// MigrationCompletedClaim is submitted once the replacement Gravity contract
+/// selected by a BridgeMigrationProposal has been deployed with the migration
+/// valset and has taken over the event nonce sequence of the old contract.
+/// Once observed the module starts targeting new_bridge_contract.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgMigrationCompletedClaim {
+    #[prost(uint64, tag="1")]
+    pub event_nonce: u64,
+    #[prost(uint64, tag="2")]
+    pub block_height: u64,
+    #[prost(string, tag="3")]
+    pub new_bridge_contract: ::prost::alloc::string::String,
+    #[prost(string, tag="4")]
+    pub orchestrator: ::prost::alloc::string::String,
+    #[prost(uint64, tag="5")]
+    pub bridge_chain_id: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgMigrationCompletedClaimResponse {
+}
+/// This call allows the sender (and only the sender)
 /// to cancel a given MsgSendToEth and recieve a refund
-/// of the tokens
+/// of the tokens. The refund is paid to refund_address
+/// if it is set, otherwise to the sender
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgCancelSendToEth {
     #[prost(uint64, tag="1")]
     pub transaction_id: u64,
     #[prost(string, tag="2")]
     pub sender: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub refund_address: ::prost::alloc::string::String,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgCancelSendToEthResponse {
 }
+/// MsgCancelAllSendToEth
+/// This call cancels every MsgSendToEth of the sender that is still waiting in
+/// the pool and refunds their tokens, transfers that are already batched are
+/// not touched
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgCancelAllSendToEth {
+    #[prost(string, tag="1")]
+    pub sender: ::prost::alloc::string::String,
+}
+/// MsgCancelAllSendToEthResponse holds the ids of the canceled transfers
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgCancelAllSendToEthResponse {
+    #[prost(uint64, repeated, tag="1")]
+    pub transaction_ids: ::prost::alloc::vec::Vec<u64>,
+}
+/// MsgReleaseSendToEth
+/// This call allows the sender of a MsgSendToEth that is held
+/// out of batches because its fee dominates the amount to confirm
+/// the transfer so that it can be batched
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgReleaseSendToEth {
+    #[prost(uint64, tag="1")]
+    pub transaction_id: u64,
+    #[prost(string, tag="2")]
+    pub sender: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgReleaseSendToEthResponse {
+}
+/// MsgBumpSendToEthFee
+/// This call allows the sender of a MsgSendToEth that is still
+/// in the pool to add extra_fee to its bridge fee, so that it is
+/// batched sooner without canceling and sending it again. The
+/// extra fee must be in the denom of the transfer
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgBumpSendToEthFee {
+    #[prost(uint64, tag="1")]
+    pub transaction_id: u64,
+    #[prost(string, tag="2")]
+    pub sender: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="3")]
+    pub extra_fee: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgBumpSendToEthFeeResponse {
+}
 /// This call allows anyone to submit evidence that a
 /// validator has signed a valset, batch, or logic call that never
 /// existed on the Cosmos chain. 
@@ -435,18 +1175,295 @@ pub struct MsgSubmitBadSignatureEvidence {
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgSubmitBadSignatureEvidenceResponse {
 }
-# [doc = r" Generated client implementations."] pub mod msg_client { # ! [allow (unused_variables , dead_code , missing_docs)] use tonic :: codegen :: * ; # [doc = " Msg defines the state transitions possible within gravity"] pub struct MsgClient < T > { inner : tonic :: client :: Grpc < T > , } impl MsgClient < tonic :: transport :: Channel > { # [doc = r" Attempt to create a new client by connecting to a given endpoint."] pub async fn connect < D > (dst : D) -> Result < Self , tonic :: transport :: Error > where D : std :: convert :: TryInto < tonic :: transport :: Endpoint > , D :: Error : Into < StdError > , { let conn = tonic :: transport :: Endpoint :: new (dst) ? . connect () . await ? ; Ok (Self :: new (conn)) } } impl < T > MsgClient < T > where T : tonic :: client :: GrpcService < tonic :: body :: BoxBody > , T :: ResponseBody : Body + HttpBody + Send + 'static , T :: Error : Into < StdError > , < T :: ResponseBody as HttpBody > :: Error : Into < StdError > + Send , { pub fn new (inner : T) -> Self { let inner = tonic :: client :: Grpc :: new (inner) ; Self { inner } } pub fn with_interceptor (inner : T , interceptor : impl Into < tonic :: Interceptor >) -> Self { let inner = tonic :: client :: Grpc :: with_interceptor (inner , interceptor) ; Self { inner } } pub async fn valset_confirm (& mut self , request : impl tonic :: IntoRequest < super :: MsgValsetConfirm > ,) -> Result < tonic :: Response < super :: MsgValsetConfirmResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ValsetConfirm") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: MsgSendToEth > ,) -> Result < tonic :: Response < super :: MsgSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn request_batch (& mut self , request : impl tonic :: IntoRequest < super :: MsgRequestBatch > ,) -> Result < tonic :: Response < super :: MsgRequestBatchResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/RequestBatch") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn confirm_batch (& mut self , request : impl tonic :: IntoRequest < super :: MsgConfirmBatch > ,) -> Result < tonic :: Response < super :: MsgConfirmBatchResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ConfirmBatch") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn confirm_logic_call (& mut self , request : impl tonic :: IntoRequest < super :: MsgConfirmLogicCall > ,) -> Result < tonic :: Response < super :: MsgConfirmLogicCallResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ConfirmLogicCall") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn send_to_cosmos_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgSendToCosmosClaim > ,) -> Result < tonic :: Response < super :: MsgSendToCosmosClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SendToCosmosClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_send_to_eth_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgBatchSendToEthClaim > ,) -> Result < tonic :: Response < super :: MsgBatchSendToEthClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/BatchSendToEthClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_update_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgValsetUpdatedClaim > ,) -> Result < tonic :: Response < super :: MsgValsetUpdatedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ValsetUpdateClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn erc20_deployed_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgErc20DeployedClaim > ,) -> Result < tonic :: Response < super :: MsgErc20DeployedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ERC20DeployedClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn logic_call_executed_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgLogicCallExecutedClaim > ,) -> Result < tonic :: Response < super :: MsgLogicCallExecutedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/LogicCallExecutedClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn set_orchestrator_address (& mut self , request : impl tonic :: IntoRequest < super :: MsgSetOrchestratorAddress > ,) -> Result < tonic :: Response < super :: MsgSetOrchestratorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SetOrchestratorAddress") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn cancel_send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: MsgCancelSendToEth > ,) -> Result < tonic :: Response < super :: MsgCancelSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/CancelSendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn submit_bad_signature_evidence (& mut self , request : impl tonic :: IntoRequest < super :: MsgSubmitBadSignatureEvidence > ,) -> Result < tonic :: Response < super :: MsgSubmitBadSignatureEvidenceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SubmitBadSignatureEvidence") ; self . inner . unary (request . into_request () , path , codec) . await } } impl < T : Clone > Clone for MsgClient < T > { fn clone (& self) -> Self { Self { inner : self . inner . clone () , } } } impl < T > std :: fmt :: Debug for MsgClient < T > { fn fmt (& self , f : & mut std :: fmt :: Formatter < '_ >) -> std :: fmt :: Result { write ! (f , "MsgClient {{ ... }}") } } }/// IDSet represents a set of IDs
+/// MsgSubmitConflictingClaimEvidence
+/// This call allows anyone to submit evidence that an orchestrator signed a
+/// claim that conflicts with the claim it submitted for the same event nonce.
+/// tx is a transaction in its raw encoding (cosmos.tx.v1beta1.TxRaw) holding
+/// the conflicting claim, signed by the orchestrator in SIGN_MODE_DIRECT for
+/// this chain. account_number is the account number of the orchestrator the
+/// transaction was signed with
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSubmitConflictingClaimEvidence {
+    #[prost(bytes="vec", tag="1")]
+    pub tx: ::prost::alloc::vec::Vec<u8>,
+    #[prost(uint64, tag="2")]
+    pub account_number: u64,
+    #[prost(string, tag="3")]
+    pub sender: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSubmitConflictingClaimEvidenceResponse {
+}
+/// MsgOrchestratorHeartbeat
+/// this is a lightweight message an orchestrator sends periodically to signal
+/// that it is online, it records the latest Ethereum block height the
+/// orchestrator has seen and the version of the orchestrator software it is
+/// running. It has no effect on the bridge state beyond being recorded so that
+/// the liveness of every validators orchestrator can be queried directly
+/// rather than inferred from claim nonces. The orchestrator may also report
+/// the current Ethereum gas price in wei, the power weighted median of the
+/// recent reports prices the relay cost of batches. Since London a relayer
+/// sends type-2 transactions and pays the base fee of the block plus the
+/// priority fee it tips, an orchestrator may report both in wei instead,
+/// a priority fee is only valid along with a base fee
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgOrchestratorHeartbeat {
+    #[prost(string, tag="1")]
+    pub orchestrator: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub eth_block_height: u64,
+    #[prost(string, tag="3")]
+    pub version: ::prost::alloc::string::String,
+    #[prost(uint64, tag="4")]
+    pub eth_gas_price: u64,
+    #[prost(uint64, tag="5")]
+    pub eth_base_fee: u64,
+    #[prost(uint64, tag="6")]
+    pub eth_priority_fee: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgOrchestratorHeartbeatResponse {
+}
+/// MsgSetEthDestinationLabel
+/// this message adds a labeled Ethereum destination to the address book of its
+/// owner, replacing the address of an existing label. An empty eth_address
+/// removes the label. MsgSendToEth can then send to the label instead of an
+/// address, so a mistyped label fails rather than sending to a wrong address.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSetEthDestinationLabel {
+    #[prost(string, tag="1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub label: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub eth_address: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSetEthDestinationLabelResponse {
+}
+/// MsgSetFirstSendDelay
+/// this message opts its sender into holding every MsgSendToEth to an Ethereum
+/// address it has not sent to before for delay_blocks blocks before it can be
+/// batched. The held transfer can be canceled with MsgCancelSendToEth, which
+/// protects accounts such as treasuries from a substituted destination address.
+/// A delay_blocks of zero opts out and forgets the known destinations.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSetFirstSendDelay {
+    #[prost(string, tag="1")]
+    pub sender: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub delay_blocks: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSetFirstSendDelayResponse {
+}
+/// MsgSubmitConfirms
+/// this message bundles valset, batch and logic call confirms signed by one
+/// orchestrator, so that an orchestrator catching up after downtime does not
+/// need a transaction per confirm. Every confirm must be from the orchestrator
+/// that signs the message. Each confirm is processed on its own exactly as if
+/// it had been sent alone, a refused confirm does not undo the others, the
+/// message only fails if every confirm is refused for another reason than
+/// having been submitted already
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSubmitConfirms {
+    #[prost(string, tag="1")]
+    pub orchestrator: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag="2")]
+    pub valset_confirms: ::prost::alloc::vec::Vec<MsgValsetConfirm>,
+    #[prost(message, repeated, tag="3")]
+    pub batch_confirms: ::prost::alloc::vec::Vec<MsgConfirmBatch>,
+    #[prost(message, repeated, tag="4")]
+    pub logic_call_confirms: ::prost::alloc::vec::Vec<MsgConfirmLogicCall>,
+}
+/// MsgSubmitConfirmsResponse holds, for every confirm in the order of the
+/// message, why it was refused or an empty string if it was accepted.
+/// already_submitted counts the confirms the module already had
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSubmitConfirmsResponse {
+    #[prost(string, repeated, tag="1")]
+    pub valset_confirm_errors: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    #[prost(string, repeated, tag="2")]
+    pub batch_confirm_errors: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    #[prost(string, repeated, tag="3")]
+    pub logic_call_confirm_errors: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    #[prost(uint64, tag="4")]
+    pub already_submitted: u64,
+}
+/// MsgSubmitEthereumEvents
+/// this message bundles the claims of one orchestrator for several Ethereum
+/// events, so that an orchestrator does not need a transaction per event.
+/// Every claim must be from the orchestrator that signs the message and no two
+/// claims may share an event nonce. The claims are processed in event nonce
+/// order and atomically, if one claim is refused the whole message fails
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSubmitEthereumEvents {
+    #[prost(string, tag="1")]
+    pub orchestrator: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag="2")]
+    pub deposits: ::prost::alloc::vec::Vec<MsgSendToCosmosClaim>,
+    #[prost(message, repeated, tag="3")]
+    pub withdrawals: ::prost::alloc::vec::Vec<MsgBatchSendToEthClaim>,
+    #[prost(message, repeated, tag="4")]
+    pub erc20_deployments: ::prost::alloc::vec::Vec<MsgErc20DeployedClaim>,
+    #[prost(message, repeated, tag="5")]
+    pub valset_updates: ::prost::alloc::vec::Vec<MsgValsetUpdatedClaim>,
+    #[prost(message, repeated, tag="6")]
+    pub logic_call_executions: ::prost::alloc::vec::Vec<MsgLogicCallExecutedClaim>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSubmitEthereumEventsResponse {
+}
+/// MsgFundValsetReward
+/// this message adds vouchers of an Ethereum originated token to the valset
+/// reward reserve. The contract pays a valset reward in an Ethereum originated
+/// token out of the tokens backing its vouchers, so the module burns the same
+/// amount from the reserve when it observes the valset update. A valset only
+/// offers such a reward while the reserve covers it
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgFundValsetReward {
+    #[prost(string, tag="1")]
+    pub sender: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub amount: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgFundValsetRewardResponse {
+}
+# [doc = r" Generated client implementations."] pub mod msg_client { # ! [allow (unused_variables , dead_code , missing_docs)] use tonic :: codegen :: * ; # [doc = " Msg defines the state transitions possible within gravity"] pub struct MsgClient < T > { inner : tonic :: client :: Grpc < T > , } impl MsgClient < tonic :: transport :: Channel > { # [doc = r" Attempt to create a new client by connecting to a given endpoint."] pub async fn connect < D > (dst : D) -> Result < Self , tonic :: transport :: Error > where D : std :: convert :: TryInto < tonic :: transport :: Endpoint > , D :: Error : Into < StdError > , { let conn = tonic :: transport :: Endpoint :: new (dst) ? . connect () . await ? ; Ok (Self :: new (conn)) } } impl < T > MsgClient < T > where T : tonic :: client :: GrpcService < tonic :: body :: BoxBody > , T :: ResponseBody : Body + HttpBody + Send + 'static , T :: Error : Into < StdError > , < T :: ResponseBody as HttpBody > :: Error : Into < StdError > + Send , { pub fn new (inner : T) -> Self { let inner = tonic :: client :: Grpc :: new (inner) ; Self { inner } } pub fn with_interceptor (inner : T , interceptor : impl Into < tonic :: Interceptor >) -> Self { let inner = tonic :: client :: Grpc :: with_interceptor (inner , interceptor) ; Self { inner } } pub async fn valset_confirm (& mut self , request : impl tonic :: IntoRequest < super :: MsgValsetConfirm > ,) -> Result < tonic :: Response < super :: MsgValsetConfirmResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ValsetConfirm") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: MsgSendToEth > ,) -> Result < tonic :: Response < super :: MsgSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn request_batch (& mut self , request : impl tonic :: IntoRequest < super :: MsgRequestBatch > ,) -> Result < tonic :: Response < super :: MsgRequestBatchResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/RequestBatch") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn confirm_batch (& mut self , request : impl tonic :: IntoRequest < super :: MsgConfirmBatch > ,) -> Result < tonic :: Response < super :: MsgConfirmBatchResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ConfirmBatch") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn confirm_logic_call (& mut self , request : impl tonic :: IntoRequest < super :: MsgConfirmLogicCall > ,) -> Result < tonic :: Response < super :: MsgConfirmLogicCallResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ConfirmLogicCall") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn send_to_cosmos_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgSendToCosmosClaim > ,) -> Result < tonic :: Response < super :: MsgSendToCosmosClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SendToCosmosClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_send_to_eth_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgBatchSendToEthClaim > ,) -> Result < tonic :: Response < super :: MsgBatchSendToEthClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/BatchSendToEthClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_update_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgValsetUpdatedClaim > ,) -> Result < tonic :: Response < super :: MsgValsetUpdatedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ValsetUpdateClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn erc20_deployed_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgErc20DeployedClaim > ,) -> Result < tonic :: Response < super :: MsgErc20DeployedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ERC20DeployedClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn logic_call_executed_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgLogicCallExecutedClaim > ,) -> Result < tonic :: Response < super :: MsgLogicCallExecutedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/LogicCallExecutedClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn migration_completed_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgMigrationCompletedClaim > ,) -> Result < tonic :: Response < super :: MsgMigrationCompletedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/MigrationCompletedClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn set_orchestrator_address (& mut self , request : impl tonic :: IntoRequest < super :: MsgSetOrchestratorAddress > ,) -> Result < tonic :: Response < super :: MsgSetOrchestratorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SetOrchestratorAddress") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn cancel_send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: MsgCancelSendToEth > ,) -> Result < tonic :: Response < super :: MsgCancelSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/CancelSendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn cancel_all_send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: MsgCancelAllSendToEth > ,) -> Result < tonic :: Response < super :: MsgCancelAllSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/CancelAllSendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn release_send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: MsgReleaseSendToEth > ,) -> Result < tonic :: Response < super :: MsgReleaseSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ReleaseSendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn bump_send_to_eth_fee (& mut self , request : impl tonic :: IntoRequest < super :: MsgBumpSendToEthFee > ,) -> Result < tonic :: Response < super :: MsgBumpSendToEthFeeResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/BumpSendToEthFee") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn submit_bad_signature_evidence (& mut self , request : impl tonic :: IntoRequest < super :: MsgSubmitBadSignatureEvidence > ,) -> Result < tonic :: Response < super :: MsgSubmitBadSignatureEvidenceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SubmitBadSignatureEvidence") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn orchestrator_heartbeat (& mut self , request : impl tonic :: IntoRequest < super :: MsgOrchestratorHeartbeat > ,) -> Result < tonic :: Response < super :: MsgOrchestratorHeartbeatResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/OrchestratorHeartbeat") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn set_eth_destination_label (& mut self , request : impl tonic :: IntoRequest < super :: MsgSetEthDestinationLabel > ,) -> Result < tonic :: Response < super :: MsgSetEthDestinationLabelResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SetEthDestinationLabel") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn set_first_send_delay (& mut self , request : impl tonic :: IntoRequest < super :: MsgSetFirstSendDelay > ,) -> Result < tonic :: Response < super :: MsgSetFirstSendDelayResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SetFirstSendDelay") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn submit_confirms (& mut self , request : impl tonic :: IntoRequest < super :: MsgSubmitConfirms > ,) -> Result < tonic :: Response < super :: MsgSubmitConfirmsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SubmitConfirms") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn submit_conflicting_claim_evidence (& mut self , request : impl tonic :: IntoRequest < super :: MsgSubmitConflictingClaimEvidence > ,) -> Result < tonic :: Response < super :: MsgSubmitConflictingClaimEvidenceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SubmitConflictingClaimEvidence") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn submit_ethereum_events (& mut self , request : impl tonic :: IntoRequest < super :: MsgSubmitEthereumEvents > ,) -> Result < tonic :: Response < super :: MsgSubmitEthereumEventsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SubmitEthereumEvents") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn fund_valset_reward (& mut self , request : impl tonic :: IntoRequest < super :: MsgFundValsetReward > ,) -> Result < tonic :: Response < super :: MsgFundValsetRewardResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/FundValsetReward") ; self . inner . unary (request . into_request () , path , codec) . await } } impl < T : Clone > Clone for MsgClient < T > { fn clone (& self) -> Self { Self { inner : self . inner . clone () , } } } impl < T > std :: fmt :: Debug for MsgClient < T > { fn fmt (& self , f : & mut std :: fmt :: Formatter < '_ >) -> std :: fmt :: Result { write ! (f , "MsgClient {{ ... }}") } } }/// IDSet represents a set of IDs
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct IdSet {
     #[prost(uint64, repeated, tag="1")]
     pub ids: ::prost::alloc::vec::Vec<u64>,
 }
+/// BatchFees describes the fees the next batch of a token would collect,
+/// min_fee, max_fee and median_fee are taken over the tx_count transactions
+/// it would pick and are zero if it would pick none
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct BatchFees {
     #[prost(string, tag="1")]
     pub token: ::prost::alloc::string::String,
     #[prost(string, tag="2")]
     pub total_fees: ::prost::alloc::string::String,
+    #[prost(uint64, tag="3")]
+    pub tx_count: u64,
+    #[prost(string, tag="4")]
+    pub min_fee: ::prost::alloc::string::String,
+    #[prost(string, tag="5")]
+    pub max_fee: ::prost::alloc::string::String,
+    #[prost(string, tag="6")]
+    pub median_fee: ::prost::alloc::string::String,
+}
+/// BridgeMigrationProposal moves the bridge to a new Gravity contract. Once
+/// passed outgoing traffic is frozen, the pending batches and logic calls are
+/// drained, a final migration valset is emitted for the new contract and the
+/// module switches to new_bridge_contract when its deployment is observed
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BridgeMigrationProposal {
+    #[prost(string, tag="1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub new_bridge_contract: ::prost::alloc::string::String,
+}
+/// AttestationVetoProposal blocks an unobserved attestation from ever being
+/// executed and clears its votes. It is a circuit breaker for events produced by
+/// a compromised Ethereum contract, claim_hash is hex encoded
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct AttestationVetoProposal {
+    #[prost(string, tag="1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(uint64, tag="3")]
+    pub event_nonce: u64,
+    #[prost(string, tag="4")]
+    pub claim_hash: ::prost::alloc::string::String,
+}
+/// ResolveEventNonceProposal unblocks the bridge when the attestations at the
+/// next event nonce can not reach consensus. If claim_hash names one of them it
+/// is observed and applied as if its votes had passed the threshold, if
+/// claim_hash is empty the event nonce is skipped without applying any of them.
+/// event_nonce must be the nonce after the last observed one
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ResolveEventNonceProposal {
+    #[prost(string, tag="1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(uint64, tag="3")]
+    pub event_nonce: u64,
+    #[prost(string, tag="4")]
+    pub claim_hash: ::prost::alloc::string::String,
+}
+/// ReleaseQuarantinedDepositProposal pays out the deposit held in quarantine
+/// from the event at event_nonce, because its Ethereum sender was listed in
+/// the quarantined_eth_senders param. It is paid to recipient, or to the
+/// cosmos_receiver of the deposit if recipient is empty
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ReleaseQuarantinedDepositProposal {
+    #[prost(string, tag="1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(uint64, tag="3")]
+    pub event_nonce: u64,
+    #[prost(string, tag="4")]
+    pub recipient: ::prost::alloc::string::String,
+}
+/// EvacuatePoolProposal cancels every unexecuted batch and refunds every
+/// transaction waiting in the pool to its sender. It is a last resort for a
+/// compromised Ethereum contract, when withdrawals must no longer be relayed
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EvacuatePoolProposal {
+    #[prost(string, tag="1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub description: ::prost::alloc::string::String,
+}
+/// CancelOutgoingBatchProposal cancels a single unexecuted batch, for when the
+/// ERC20 it withdraws is exploited on Ethereum. Its transactions go back into
+/// the pool, or are refunded to their senders if refund is set
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CancelOutgoingBatchProposal {
+    #[prost(string, tag="1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag="4")]
+    pub batch_nonce: u64,
+    #[prost(bool, tag="5")]
+    pub refund: bool,
+}
+/// ModuleSendGrantProposal lets another module send funds from its module
+/// account to Ethereum through Keeper.SendToEthFromModule. The amounts and
+/// fees it sends within each period of epoch_blocks blocks may not add up to
+/// more than cap. Passing a proposal with an empty cap revokes the grant
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ModuleSendGrantProposal {
+    #[prost(string, tag="1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub module: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag="4")]
+    pub cap: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    #[prost(uint64, tag="5")]
+    pub epoch_blocks: u64,
+}
+/// BridgeInstanceResetProposal lets a chain relaunched from an export accept
+/// the claims and confirms produced for the previous bridge instance again, for
+/// when the relaunch continues the same bridge and they are known to be valid
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BridgeInstanceResetProposal {
+    #[prost(string, tag="1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub description: ::prost::alloc::string::String,
 }
 // Params represent the Gravity genesis and store parameters
 // gravity_id:
@@ -491,7 +1508,8 @@ pub struct BatchFees {
 
 // This is the 'target' value for when batches time out, this is a target becuase
 // Ethereum is a probabalistic chain and you can't say for sure what the block
-// frequency is ahead of time.
+// frequency is ahead of time. Outgoing timeouts are now computed from the observed
+// Ethereum height and ethereum_timeout_margin instead.
 
 // average_block_time
 // average_ethereum_block_time
@@ -516,13 +1534,17 @@ pub struct BatchFees {
 /// a validator needs to continue signing blocks. The goal of this paramater is that when a validator leaves
 /// the set, if their leaving creates enough change in the validator set to justify an update they will sign
 /// a validator set update for the Ethereum bridge that does not include themselves. Allowing us to remove them
-/// from the Ethereum bridge and replace them with the new set gracefully.
+/// from the Ethereum bridge and replace them with the new set gracefully. The same window applies to the
+/// batches created after they started to unbond, past it they are exempt from signing both.
 ///
 /// valset_reward
 ///
 /// Valset rewards are the amount of tokens this chain issues to relayers of validator sets.
-/// These can be any ERC20 token in the bridge, but it's strongly advised that chains use only
-/// Cosmos originated tokens, which the bridge effectively mints on Ethereum. If you run out of
+/// These can be any ERC20 token in the bridge, either a Cosmos originated denom with a deployed
+/// ERC20, which the bridge effectively mints on Ethereum, or the voucher of an Ethereum originated
+/// token. The contract pays Ethereum originated rewards out of the tokens backing the vouchers, so
+/// the module burns vouchers from the valset reward reserve, funded with MsgFundValsetReward, for
+/// every reward paid and only offers the reward while the reserve covers it. If you run out of
 /// the token you are using for validator set rewards valset updates will fail and the bridge
 /// will be vulnerable to highjacking. For these paramaters the zero values are special and indicate
 /// not to attempt any reward. This is the default for bootstrapping.
@@ -560,6 +1582,250 @@ pub struct Params {
     pub slash_fraction_bad_eth_signature: ::prost::alloc::vec::Vec<u8>,
     #[prost(message, optional, tag="17")]
     pub valset_reward: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    /// the normalized power (out of u32 max) that must sign a valset update, batch
+    /// or logic call before the Ethereum contract will execute it. This value is
+    /// encoded into every valset checkpoint so that the contract and the module can
+    /// be moved to a new threshold in lockstep. It may never be set below 2/3. Zero,
+    /// the default, keeps the legacy checkpoint encoding the deployed contract and
+    /// orchestrators expect, with the threshold hardcoded in the contract
+    #[prost(uint64, tag="18")]
+    pub ethereum_power_threshold: u64,
+    /// the number of Ethereum blocks past the observed Ethereum height at which
+    /// newly created batches and logic calls time out
+    #[prost(uint64, tag="19")]
+    pub ethereum_timeout_margin: u64,
+    /// the number of Cosmos blocks between recalibrations of the Ethereum block
+    /// time from the Ethereum block heights and timestamps of observed claims. The
+    /// calibrated block time is used in place of average_ethereum_block_time,
+    /// which is left as governance set it. Zero disables the recalibration and
+    /// uses average_ethereum_block_time again
+    #[prost(uint64, tag="20")]
+    pub ethereum_block_time_calibration_period: u64,
+    /// the percentage of the total validator power whose votes make an
+    /// attestation observed as soon as the final claim is submitted, instead of in
+    /// the EndBlocker. It must be above the regular attestation threshold, zero
+    /// disables the fast path
+    #[prost(uint64, tag="21")]
+    pub attestation_fast_path_threshold: u64,
+    /// the maximum number of observed attestations applied to the state in a
+    /// single EndBlocker, attestations past the budget are applied in the
+    /// following blocks in event nonce order
+    #[prost(uint64, tag="22")]
+    pub attestation_execution_budget: u64,
+    /// the gas available to the automatic valset creation in each EndBlocker. A
+    /// step that runs out of its gas budget keeps the work it completed and
+    /// resumes from there in the next block, a zero budget leaves the step
+    /// unmetered
+    #[prost(uint64, tag="23")]
+    pub valset_creation_gas_budget: u64,
+    /// the gas available to the valset, batch and logic call slashing scans in each
+    /// EndBlocker
+    #[prost(uint64, tag="24")]
+    pub slashing_gas_budget: u64,
+    /// the gas available to valset and attestation pruning in each EndBlocker
+    #[prost(uint64, tag="25")]
+    pub pruning_gas_budget: u64,
+    /// the gas available to tallying attestation votes and, separately, to applying
+    /// queued attestations in each EndBlocker
+    #[prost(uint64, tag="26")]
+    pub attestation_gas_budget: u64,
+    /// the coin minted for the winner of the relayer lottery held every time a
+    /// batch is executed, the winner is drawn from the relayers of recent batches
+    /// weighted by how many they relayed. A zero amount disables the lottery.
+    /// Tokens minted by the bridge itself may not be used
+    #[prost(message, optional, tag="27")]
+    pub relayer_lottery_reward: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    /// the number of Cosmos blocks of batch relays the relayer lottery weighs
+    /// relayers by
+    #[prost(uint64, tag="28")]
+    pub relayer_lottery_window: u64,
+    /// transfers to Ethereum whose fee is more than this multiple of the
+    /// transferred amount are held out of batches until the sender confirms
+    /// them, 0 disables the check
+    #[prost(uint64, tag="29")]
+    pub fee_confirmation_multiple: u64,
+    /// the maximum USD value, as reported by the price feed, of all transfers
+    /// to Ethereum requested across every token within outflow_limit_window
+    /// blocks. Zero disables the limit, while it is set transfers of tokens
+    /// without a price are rejected. It can only be set if the keeper has a
+    /// price feed, refunded transfers do not count against it
+    #[prost(bytes="vec", tag="30")]
+    pub outflow_usd_limit: ::prost::alloc::vec::Vec<u8>,
+    /// the number of blocks the outflow_usd_limit applies to
+    #[prost(uint64, tag="31")]
+    pub outflow_limit_window: u64,
+    /// the number of blocks after which an orchestrator that sent no heartbeat
+    /// no longer counts as live. Once the live validators hold too little power
+    /// to confirm anything the transfers waiting in the pool are refunded to
+    /// their senders, 0 disables the refunds
+    #[prost(uint64, tag="32")]
+    pub stall_refund_threshold: u64,
+    /// the maximum number of pool transfers refunded per block while the bridge
+    /// is stalled
+    #[prost(uint64, tag="33")]
+    pub stall_refund_budget: u64,
+    /// the number of blocks the receipts of refunded transfers to Ethereum are
+    /// kept for, 0 keeps them forever
+    #[prost(uint64, tag="34")]
+    pub refund_receipt_retention: u64,
+    /// the number of blocks a transfer may wait in the pool before it is refunded
+    /// to its sender, 0 lets transfers wait forever
+    #[prost(uint64, tag="35")]
+    pub pool_tx_timeout: u64,
+    /// the maximum size of the callback data of a transfer to Ethereum with a
+    /// receiver hook, 0 disables such transfers
+    #[prost(uint64, tag="36")]
+    pub max_callback_data_size: u64,
+    /// the fee taken from the sender for every byte of callback data, it is paid
+    /// to the fee collector. An empty denom makes callback data free
+    #[prost(message, optional, tag="37")]
+    pub callback_data_byte_fee: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    /// the Ethereum gas estimated for every transfer in a batch, a batch is only
+    /// built if its fees are worth more than the estimated gas at the Ethereum
+    /// gas price reported by the orchestrators. 0 for both this and
+    /// batch_gas_overhead falls back to requiring more fees than the last batch
+    #[prost(uint64, tag="38")]
+    pub batch_gas_per_tx: u64,
+    /// the Ethereum gas estimated for relaying a batch on top of its transfers,
+    /// mostly spent checking the validator signatures
+    #[prost(uint64, tag="39")]
+    pub batch_gas_overhead: u64,
+    /// the number of blocks the receipts of deposits from Ethereum are kept for,
+    /// 0 keeps them forever
+    #[prost(uint64, tag="40")]
+    pub deposit_receipt_retention: u64,
+    /// the minimum fee a transfer to Ethereum of a token has to pay, keyed by the
+    /// token contract, tokens without an entry can be sent without a fee
+    #[prost(message, repeated, tag="41")]
+    pub min_send_to_eth_fees: ::prost::alloc::vec::Vec<Erc20Token>,
+    /// the most transactions a batch may carry
+    #[prost(uint64, tag="42")]
+    pub max_batch_size: u64,
+    /// per token overrides of max_batch_size, so that tokens that are expensive
+    /// to transfer can use smaller batches
+    #[prost(message, repeated, tag="43")]
+    pub token_max_batch_sizes: ::prost::alloc::vec::Vec<TokenBatchSize>,
+    /// the most event nonces a claim may be behind the last observed event nonce,
+    /// 0 accepts claims of any age
+    #[prost(uint64, tag="44")]
+    pub max_claim_age: u64,
+    /// rebuild a batch for a token in the same block one of its batches times
+    /// out, so that the returned transfers do not wait for a batch request
+    #[prost(bool, tag="45")]
+    pub rebuild_timed_out_batches: bool,
+    /// the fee a transfer to Ethereum pays to the fee collector for each priority
+    /// class above 0, the n-th entry is the price of priority n. Priorities past
+    /// the end of the list are refused, an empty list disables priorities
+    #[prost(message, repeated, tag="46")]
+    pub send_to_eth_priority_fees: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    /// merge the transactions of a batch to the same destination into a single
+    /// transfer, saving calldata and gas on Ethereum
+    #[prost(bool, tag="47")]
+    pub merge_batch_transfers: bool,
+    /// every pool_aging_blocks blocks a transfer waits in the pool it is batched
+    /// as if its priority class were one higher, so that low fee transfers are
+    /// not starved by a steady stream of higher fees. Zero disables aging
+    #[prost(uint64, tag="48")]
+    pub pool_aging_blocks: u64,
+    /// the maximum size of the payload a transfer to Ethereum may carry through
+    /// its batch, 0 disables payloads. Only enable it once the Gravity contract
+    /// verifies the batch checkpoint that includes them
+    #[prost(uint64, tag="49")]
+    pub max_send_to_eth_payload_size: u64,
+    /// the number of transfers a sender may have waiting in the pool at once,
+    /// further transfers are refused until some are batched or canceled. Zero
+    /// disables the limit
+    #[prost(uint64, tag="50")]
+    pub max_pending_txs_per_sender: u64,
+    /// the number of blocks after an event is observed within which every bonded
+    /// validator must have submitted a claim for it, a validator that did not is
+    /// slashed by slash_fraction_claim and jailed. Zero disables claim slashing
+    #[prost(uint64, tag="51")]
+    pub signed_claims_window: u64,
+    #[prost(bytes="vec", tag="52")]
+    pub slash_fraction_claim: ::prost::alloc::vec::Vec<u8>,
+    /// the fraction a validator is slashed by when its orchestrator is proven to
+    /// have signed two different claims for the same event nonce
+    #[prost(bytes="vec", tag="53")]
+    pub slash_fraction_conflicting_claim: ::prost::alloc::vec::Vec<u8>,
+    /// the number of blocks the attestations of an event are kept for after it
+    /// is observed, 0 keeps them forever
+    #[prost(uint64, tag="54")]
+    pub attestation_retention: u64,
+    /// the number of Ethereum blocks an event has to be buried under before
+    /// orchestrators claim it, so that a reorg can not undo an observed event.
+    /// The module can not see Ethereum blocks itself, orchestrators read the
+    /// depth from here instead of hardcoding it
+    #[prost(uint64, tag="55")]
+    pub eth_blocks_to_observe: u64,
+    /// Ethereum addresses whose deposits are not paid out. A deposit whose
+    /// ethereum_sender or token_sender is listed is minted, or for Cosmos
+    /// originated tokens left, in the module account and held there until a
+    /// ReleaseQuarantinedDepositProposal releases it
+    #[prost(string, repeated, tag="56")]
+    pub quarantined_eth_senders: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// the number of Ethereum blocks the height reported by a validators
+    /// orchestrator may fall behind the median of the bonded validators before an
+    /// EventEthereumHeightDrift is emitted for it, 0 disables the alert
+    #[prost(uint64, tag="57")]
+    pub ethereum_height_drift_threshold: u64,
+    /// a new valset is requested once the normalized power of the current
+    /// validators differs from the latest valset by more than this fraction
+    #[prost(bytes="vec", tag="58")]
+    pub valset_power_change_threshold: ::prost::alloc::vec::Vec<u8>,
+    /// the number of blocks after which a new valset is requested even if the
+    /// power did not change enough, so that the valset on Ethereum does not go
+    /// stale on a quiet chain. Zero disables it
+    #[prost(uint64, tag="59")]
+    pub max_valset_interval: u64,
+    /// the number of valsets below the last observed one that are kept, together
+    /// with their confirms, for the HistoricalValsets query. Older ones are pruned
+    /// once the signed valsets window has passed for them
+    #[prost(uint64, tag="60")]
+    pub valset_retention: u64,
+    /// per token overrides of signed_batches_window, so that validators can be
+    /// given more time to sign the batches of low value tokens than those of the
+    /// main assets of the chain
+    #[prost(message, repeated, tag="61")]
+    pub token_signed_batches_windows: ::prost::alloc::vec::Vec<TokenSignedBatchesWindow>,
+    /// the number of blocks the attestations for the event nonce after the last
+    /// observed one may go without reaching consensus before an
+    /// EventNonceStalled is emitted, an early warning that the bridge is about to
+    /// halt. Zero disables it
+    #[prost(uint64, tag="62")]
+    pub event_nonce_stall_threshold: u64,
+    /// whether only the typed events are emitted for the state changes that have
+    /// one, leaving out the deprecated untyped events they duplicate. The
+    /// message events of the service messages and the event version are still
+    /// emitted. Shrinks the block results of busy chains, but indexers that still
+    /// read the untyped events must move to the typed ones first
+    #[prost(bool, tag="63")]
+    pub minimal_events: bool,
+    /// Cosmos addresses exempt from the fees the chain charges on transfers to
+    /// Ethereum, such as a community pool executor or the market makers of an
+    /// incentive program. Their transfers skip the min_send_to_eth_fees floor and
+    /// are not charged the send_to_eth_priority_fees or the
+    /// callback_data_byte_fee, the bridge fee they pay relayers is still theirs
+    /// to choose
+    #[prost(string, repeated, tag="64")]
+    pub fee_exempt_senders: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+/// TokenBatchSize overrides the max_batch_size param for the batches of a token
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TokenBatchSize {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub max_batch_size: u64,
+}
+/// TokenSignedBatchesWindow overrides the signed_batches_window param for the
+/// batches of a token
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TokenSignedBatchesWindow {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub signed_batches_window: u64,
 }
 /// GenesisState struct
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -588,6 +1854,24 @@ pub struct GenesisState {
     pub erc20_to_denoms: ::prost::alloc::vec::Vec<Erc20ToDenom>,
     #[prost(message, repeated, tag="12")]
     pub unbatched_transfers: ::prost::alloc::vec::Vec<OutgoingTransferTx>,
+    #[prost(message, repeated, tag="13")]
+    pub module_send_grants: ::prost::alloc::vec::Vec<ModuleSendGrant>,
+    #[prost(message, optional, tag="14")]
+    pub bridge_instance: ::core::option::Option<BridgeInstance>,
+    #[prost(message, repeated, tag="15")]
+    pub eth_destination_labels: ::prost::alloc::vec::Vec<EthDestinationLabel>,
+    #[prost(message, repeated, tag="16")]
+    pub first_send_delays: ::prost::alloc::vec::Vec<FirstSendDelay>,
+    #[prost(message, repeated, tag="17")]
+    pub audit_log: ::prost::alloc::vec::Vec<AuditLogEntry>,
+    #[prost(message, repeated, tag="18")]
+    pub callback_transfers: ::prost::alloc::vec::Vec<OutgoingTransferTx>,
+    #[prost(message, repeated, tag="19")]
+    pub merged_transfers: ::prost::alloc::vec::Vec<MergedTransfer>,
+    #[prost(message, repeated, tag="20")]
+    pub quarantined_deposits: ::prost::alloc::vec::Vec<DepositReceipt>,
+    #[prost(message, repeated, tag="21")]
+    pub valset_reward_reserve: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct QueryParamsRequest {
@@ -683,13 +1967,25 @@ pub struct QueryLastPendingLogicCallByAddrResponse {
     #[prost(message, optional, tag="1")]
     pub call: ::core::option::Option<OutgoingLogicCall>,
 }
+/// batches are returned grouped by token contract, each token in batch nonce
+/// order. token_contract, when set, only returns the batches of that token and
+/// unsigned_by, when set to an orchestrator address, only the batches that
+/// orchestrator has not confirmed yet
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct QueryOutgoingTxBatchesRequest {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub unsigned_by: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="3")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct QueryOutgoingTxBatchesResponse {
     #[prost(message, repeated, tag="1")]
     pub batches: ::prost::alloc::vec::Vec<OutgoingTxBatch>,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct QueryOutgoingLogicCallsRequest {
@@ -740,10 +2036,14 @@ pub struct QueryLastEventNonceByAddrRequest {
     #[prost(string, tag="1")]
     pub address: ::prost::alloc::string::String,
 }
+/// eth_blocks_to_observe is the confirmation depth the next claims have to
+/// wait for, the EthBlocksToObserve param
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct QueryLastEventNonceByAddrResponse {
     #[prost(uint64, tag="1")]
     pub event_nonce: u64,
+    #[prost(uint64, tag="2")]
+    pub eth_blocks_to_observe: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct QueryErc20ToDenomRequest {
@@ -769,6 +2069,33 @@ pub struct QueryDenomToErc20Response {
     #[prost(bool, tag="2")]
     pub cosmos_originated: bool,
 }
+/// CosmosOriginatedToken is a Cosmos originated denom and the ERC20 deployed for
+/// it. symbol and decimals are those of the display unit of the denom metadata,
+/// which the ERC20 was deployed with, they are empty if the denom has no metadata
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CosmosOriginatedToken {
+    #[prost(string, tag="1")]
+    pub denom: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub erc20: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub symbol: ::prost::alloc::string::String,
+    #[prost(uint32, tag="4")]
+    pub decimals: u32,
+}
+/// tokens are returned in denom order
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryCosmosOriginatedTokensRequest {
+    #[prost(message, optional, tag="1")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryCosmosOriginatedTokensResponse {
+    #[prost(message, repeated, tag="1")]
+    pub tokens: ::prost::alloc::vec::Vec<CosmosOriginatedToken>,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct QueryAttestationsRequest {
     #[prost(uint64, tag="1")]
@@ -815,10 +2142,14 @@ pub struct QueryDelegateKeysByOrchestratorAddressResponse {
     #[prost(string, tag="2")]
     pub eth_address: ::prost::alloc::string::String,
 }
+/// the pagination applies to unbatched_transfers, which are returned in the
+/// order they were sent, by tx id. transfers_in_batches are always complete
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct QueryPendingSendToEth {
     #[prost(string, tag="1")]
     pub sender_address: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct QueryPendingSendToEthResponse {
@@ -826,5 +2157,1026 @@ pub struct QueryPendingSendToEthResponse {
     pub transfers_in_batches: ::prost::alloc::vec::Vec<OutgoingTransferTx>,
     #[prost(message, repeated, tag="2")]
     pub unbatched_transfers: ::prost::alloc::vec::Vec<OutgoingTransferTx>,
+    #[prost(message, optional, tag="3")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+/// the pagination applies to unbatched_transfers, which are returned in the
+/// order they were sent, by tx id. transfers_in_batches are always complete
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryPendingSendToEthByReceiverRequest {
+    #[prost(string, tag="1")]
+    pub receiver: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryPendingSendToEthByReceiverResponse {
+    #[prost(message, repeated, tag="1")]
+    pub transfers_in_batches: ::prost::alloc::vec::Vec<OutgoingTransferTx>,
+    #[prost(message, repeated, tag="2")]
+    pub unbatched_transfers: ::prost::alloc::vec::Vec<OutgoingTransferTx>,
+    #[prost(message, optional, tag="3")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+/// max_heartbeat_age is the number of Cosmos blocks after which an orchestrator
+/// without a newer heartbeat is considered offline, if zero a default is used
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryOrchestratorLivenessRequest {
+    #[prost(uint64, tag="1")]
+    pub max_heartbeat_age: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryOrchestratorLivenessResponse {
+    #[prost(message, repeated, tag="1")]
+    pub orchestrators: ::prost::alloc::vec::Vec<OrchestratorLiveness>,
+    #[prost(uint64, tag="2")]
+    pub live_count: u64,
+    #[prost(uint64, tag="3")]
+    pub total_count: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBridgeMigrationRequest {
+}
+/// migration is unset when no contract migration is in progress
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBridgeMigrationResponse {
+    #[prost(message, optional, tag="1")]
+    pub migration: ::core::option::Option<BridgeMigration>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryObservedEthereumHeightRequest {
+}
+/// height is the power weighted median of the votes of the bonded validators,
+/// it never moves backwards. votes lists the latest report of every bonded
+/// validator that has reported. median_height is the current median of those
+/// votes, zero if validators holding more than half of the power have not
+/// reported, and lags holds how far each vote is behind it
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryObservedEthereumHeightResponse {
+    #[prost(message, optional, tag="1")]
+    pub height: ::core::option::Option<LastObservedEthereumBlockHeight>,
+    #[prost(message, repeated, tag="2")]
+    pub votes: ::prost::alloc::vec::Vec<EthereumHeightVote>,
+    #[prost(uint64, tag="3")]
+    pub median_height: u64,
+    #[prost(message, repeated, tag="4")]
+    pub lags: ::prost::alloc::vec::Vec<EthereumHeightLag>,
+}
+/// EthereumHeightLag is the number of Ethereum blocks the latest height
+/// reported by a validator is behind the median height, drifting is set once
+/// the lag is above the ethereum_height_drift_threshold param
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EthereumHeightLag {
+    #[prost(string, tag="1")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub lag: u64,
+    #[prost(bool, tag="3")]
+    pub drifting: bool,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryEthereumGasPriceRequest {
+}
+/// gas_price is the gas price in wei the relay cost of batches is estimated
+/// with, zero if validators holding more than half of the power have not
+/// reported one in their recent heartbeats. base_fee and priority_fee are the
+/// power weighted medians in wei of the type-2 transaction fees reported, zero
+/// if validators holding more than half of the power have not reported a base
+/// fee. While they are set the gas price is their sum, otherwise it is the
+/// power weighted median of the flat gas prices reported. reports lists the
+/// heartbeats reporting any of them
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryEthereumGasPriceResponse {
+    #[prost(uint64, tag="1")]
+    pub gas_price: u64,
+    #[prost(message, repeated, tag="2")]
+    pub reports: ::prost::alloc::vec::Vec<OrchestratorHeartbeat>,
+    #[prost(uint64, tag="3")]
+    pub base_fee: u64,
+    #[prost(uint64, tag="4")]
+    pub priority_fee: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryEthereumBlockTimeCalibrationRequest {
+}
+/// average_ethereum_block_time is the block time in milliseconds currently in
+/// use, the calibrated_block_time of calibration once there was a successful
+/// recalibration and the average_ethereum_block_time param until then
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryEthereumBlockTimeCalibrationResponse {
+    #[prost(message, optional, tag="1")]
+    pub calibration: ::core::option::Option<EthereumBlockTimeCalibration>,
+    #[prost(uint64, tag="2")]
+    pub average_ethereum_block_time: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryProjectedEthereumHeightRequest {
+}
+/// projected_height is the observed height extrapolated to the current block
+/// time, it is the height outgoing batch and logic call timeouts are based on
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryProjectedEthereumHeightResponse {
+    #[prost(uint64, tag="1")]
+    pub projected_height: u64,
+    #[prost(message, optional, tag="2")]
+    pub observed: ::core::option::Option<LastObservedEthereumBlockHeight>,
+}
+/// claim_hash is the hex encoded hash of a single claim, if empty every
+/// attestation for the event nonce is returned
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryAttestationVotesRequest {
+    #[prost(uint64, tag="1")]
+    pub event_nonce: u64,
+    #[prost(string, tag="2")]
+    pub claim_hash: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryAttestationVotesResponse {
+    #[prost(message, repeated, tag="1")]
+    pub attestations: ::prost::alloc::vec::Vec<AttestationVoteBreakdown>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBridgeStatsRequest {
+}
+/// the statistics are kept in hourly buckets of block time, so the windows
+/// cover the last 24 and 168 buckets including the current one
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBridgeStatsResponse {
+    #[prost(message, optional, tag="1")]
+    pub last_day: ::core::option::Option<BridgeStatsWindow>,
+    #[prost(message, optional, tag="2")]
+    pub last_week: ::core::option::Option<BridgeStatsWindow>,
+}
+/// window_hours selects how many hourly buckets, including the current one,
+/// are summed and must be between 1 and 168 since older buckets are pruned.
+/// token_contract is optional, when set only the entry of that token is
+/// returned in tokens, the totals of the window still cover every token
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBridgeTokenStatsRequest {
+    #[prost(uint64, tag="1")]
+    pub window_hours: u64,
+    #[prost(string, tag="2")]
+    pub token_contract: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBridgeTokenStatsResponse {
+    #[prost(message, optional, tag="1")]
+    pub window: ::core::option::Option<BridgeStatsWindow>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QuerySolvencyReportRequest {
+}
+/// solvent is false if any token reports a discrepancy
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QuerySolvencyReportResponse {
+    #[prost(message, repeated, tag="1")]
+    pub tokens: ::prost::alloc::vec::Vec<TokenSolvency>,
+    #[prost(bool, tag="2")]
+    pub solvent: bool,
+}
+/// the replay re-executes every stored observed claim and is expensive, it is
+/// meant to be run by operators against their own node
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryReplayAttestationsRequest {
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryReplayAttestationsResponse {
+    #[prost(message, optional, tag="1")]
+    pub report: ::core::option::Option<ReplayReport>,
+}
+/// token_contract is optional, when set only the batches of that token are
+/// returned
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryTimedOutBatchesRequest {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryTimedOutBatchesResponse {
+    #[prost(message, repeated, tag="1")]
+    pub batches: ::prost::alloc::vec::Vec<TimedOutBatch>,
+}
+/// receipts are returned in the order the transfers were sent, by tx id
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryRefundReceiptsRequest {
+    #[prost(string, tag="1")]
+    pub sender: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryRefundReceiptsResponse {
+    #[prost(message, repeated, tag="1")]
+    pub receipts: ::prost::alloc::vec::Vec<RefundReceipt>,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+/// receipts are returned in the order the deposits were observed, by event nonce
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryDepositReceiptsRequest {
+    #[prost(string, tag="1")]
+    pub receiver: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryDepositReceiptsResponse {
+    #[prost(message, repeated, tag="1")]
+    pub receipts: ::prost::alloc::vec::Vec<DepositReceipt>,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+/// deposits are returned in event nonce order
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryQuarantinedDepositsRequest {
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryQuarantinedDepositsResponse {
+    #[prost(message, repeated, tag="1")]
+    pub deposits: ::prost::alloc::vec::Vec<DepositReceipt>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryModuleSendGrantsRequest {
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryModuleSendGrantsResponse {
+    #[prost(message, repeated, tag="1")]
+    pub grants: ::prost::alloc::vec::Vec<ModuleSendGrant>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBridgeInstanceRequest {
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBridgeInstanceResponse {
+    #[prost(message, optional, tag="1")]
+    pub instance: ::core::option::Option<BridgeInstance>,
+}
+/// labels are returned in lexicographic order
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryEthDestinationLabelsRequest {
+    #[prost(string, tag="1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryEthDestinationLabelsResponse {
+    #[prost(message, repeated, tag="1")]
+    pub labels: ::prost::alloc::vec::Vec<EthDestinationLabel>,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryEthDestinationLabelRequest {
+    #[prost(string, tag="1")]
+    pub owner: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub label: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryEthDestinationLabelResponse {
+    #[prost(message, optional, tag="1")]
+    pub label: ::core::option::Option<EthDestinationLabel>,
+}
+/// transfers are returned in the order they were sent, by tx id
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryUnbatchedTxsBySenderRequest {
+    #[prost(string, tag="1")]
+    pub sender: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryUnbatchedTxsBySenderResponse {
+    #[prost(message, repeated, tag="1")]
+    pub transfers: ::prost::alloc::vec::Vec<OutgoingTransferTx>,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+/// transfers are returned grouped by token contract, each token in DESC fee
+/// order, the order batches pick them in. token_contract, when set, only
+/// returns the transfers of that token
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryUnbatchedTxsRequest {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryUnbatchedTxsResponse {
+    #[prost(message, repeated, tag="1")]
+    pub transfers: ::prost::alloc::vec::Vec<OutgoingTransferTx>,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+/// known_eth_destinations are returned in lexicographic order, a delay_blocks of
+/// zero means the account has no first send delay
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryFirstSendDelayRequest {
+    #[prost(string, tag="1")]
+    pub account: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryFirstSendDelayResponse {
+    #[prost(uint64, tag="1")]
+    pub delay_blocks: u64,
+    #[prost(string, repeated, tag="2")]
+    pub known_eth_destinations: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    #[prost(message, optional, tag="3")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+/// entries are returned oldest first, by id
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryAuditLogRequest {
+    #[prost(message, optional, tag="1")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryAuditLogResponse {
+    #[prost(message, repeated, tag="1")]
+    pub entries: ::prost::alloc::vec::Vec<AuditLogEntry>,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+/// the arguments of the Gravity contract constructor for deploying a contract
+/// with the valset of nonce, or with the current valset if nonce is 0
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryValsetDeploymentArgsRequest {
+    #[prost(uint64, tag="1")]
+    pub nonce: u64,
+}
+/// gravity_id is the bytes32 the constructor takes, hex encoded, the
+/// validators and powers are in the order the contract expects them.
+/// constructor_args is the ABI encoding of all of them, as appended to the
+/// contract bytecode when deploying
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryValsetDeploymentArgsResponse {
+    #[prost(uint64, tag="1")]
+    pub valset_nonce: u64,
+    #[prost(string, tag="2")]
+    pub gravity_id: ::prost::alloc::string::String,
+    #[prost(uint64, tag="3")]
+    pub power_threshold: u64,
+    #[prost(string, repeated, tag="4")]
+    pub validators: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    #[prost(uint64, repeated, tag="5")]
+    pub powers: ::prost::alloc::vec::Vec<u64>,
+    #[prost(string, tag="6")]
+    pub constructor_args: ::prost::alloc::string::String,
+}
+/// QueryOrchestratorSubmissionsRequest asks what the module already has from
+/// the orchestrator address, so that a restarted orchestrator knows what it
+/// does not need to submit again
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryOrchestratorSubmissionsRequest {
+    #[prost(string, tag="1")]
+    pub address: ::prost::alloc::string::String,
+}
+/// last_event_nonce is the event nonce the next claim has to follow and
+/// eth_blocks_to_observe the confirmation depth it has to wait for, as for
+/// LastEventNonceByAddr. The confirms are those held for the valsets, batches
+/// and logic calls still in the store
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryOrchestratorSubmissionsResponse {
+    #[prost(uint64, tag="1")]
+    pub last_event_nonce: u64,
+    #[prost(message, repeated, tag="2")]
+    pub valset_confirms: ::prost::alloc::vec::Vec<MsgValsetConfirm>,
+    #[prost(message, repeated, tag="3")]
+    pub batch_confirms: ::prost::alloc::vec::Vec<MsgConfirmBatch>,
+    #[prost(message, repeated, tag="4")]
+    pub logic_call_confirms: ::prost::alloc::vec::Vec<MsgConfirmLogicCall>,
+    #[prost(uint64, tag="5")]
+    pub eth_blocks_to_observe: u64,
+}
+/// QuerySimulateProposalRequest asks what passing a gravity proposal would do,
+/// proposal is any of the gravity proposal types
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QuerySimulateProposalRequest {
+    #[prost(message, optional, tag="1")]
+    pub proposal: ::core::option::Option<::prost_types::Any>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QuerySimulateProposalResponse {
+    #[prost(message, optional, tag="1")]
+    pub simulation: ::core::option::Option<ProposalSimulation>,
+}
+/// QueryPendingBatchPreviewRequest asks for the batch a MsgRequestBatch for
+/// denom would build right now
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryPendingBatchPreviewRequest {
+    #[prost(string, tag="1")]
+    pub denom: ::prost::alloc::string::String,
+}
+/// batch is unset when the pool holds nothing to batch for the token. The
+/// checkpoint is the one validators would sign, it only holds as long as no
+/// other batch is built and the projected Ethereum height stays the same
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryPendingBatchPreviewResponse {
+    #[prost(message, optional, tag="1")]
+    pub batch: ::core::option::Option<OutgoingTxBatch>,
+    #[prost(string, tag="2")]
+    pub total_fees: ::prost::alloc::string::String,
+    #[prost(bytes="vec", tag="3")]
+    pub checkpoint: ::prost::alloc::vec::Vec<u8>,
+}
+/// suggested_fee is the lowest fee, in the smallest unit of the token, a
+/// transfer of the denom sent now should pay to be picked by the next batch of
+/// the token and for that batch to cover the cost of relaying it. next_batch is
+/// the batch the pool would build without the transfer, pool_depth the number
+/// of transfers waiting for the token that are not held
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QuerySendToEthFeeSuggestionRequest {
+    #[prost(string, tag="1")]
+    pub denom: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QuerySendToEthFeeSuggestionResponse {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub suggested_fee: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="3")]
+    pub next_batch: ::core::option::Option<BatchFees>,
+    #[prost(uint64, tag="4")]
+    pub pool_depth: u64,
+    #[prost(uint64, tag="5")]
+    pub max_batch_size: u64,
+}
+/// QueryHistoricalValsetsRequest pages through the valsets still in the store,
+/// oldest first. Valsets more than valset_retention nonces below the last
+/// observed valset are pruned
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryHistoricalValsetsRequest {
+    #[prost(message, optional, tag="1")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryHistoricalValsetsResponse {
+    #[prost(message, repeated, tag="1")]
+    pub valsets: ::prost::alloc::vec::Vec<Valset>,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+/// QueryRelaySignaturesRequest asks for the signatures to relay the valset of
+/// valset_nonce, or if that is 0 the batch of batch_nonce for token_contract
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryRelaySignaturesRequest {
+    #[prost(uint64, tag="1")]
+    pub valset_nonce: u64,
+    #[prost(uint64, tag="2")]
+    pub batch_nonce: u64,
+    #[prost(string, tag="3")]
+    pub token_contract: ::prost::alloc::string::String,
+}
+/// RelaySignature is the signature of a member of the valset on Ethereum, in
+/// the form the Gravity contract takes it. v is 27 or 28, r and s are 0x hex
+/// encoded. A member that did not sign has a zero v, r and s
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RelaySignature {
+    #[prost(string, tag="1")]
+    pub eth_address: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub power: u64,
+    #[prost(uint32, tag="3")]
+    pub v: u32,
+    #[prost(string, tag="4")]
+    pub r: ::prost::alloc::string::String,
+    #[prost(string, tag="5")]
+    pub s: ::prost::alloc::string::String,
+}
+/// signatures follow the order of the members of the last valset observed on
+/// Ethereum, which the contract checks them against. The call can be submitted
+/// once signed_power is above power_threshold
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryRelaySignaturesResponse {
+    #[prost(uint64, tag="1")]
+    pub signer_valset_nonce: u64,
+    #[prost(message, repeated, tag="2")]
+    pub signatures: ::prost::alloc::vec::Vec<RelaySignature>,
+    #[prost(uint64, tag="3")]
+    pub signed_power: u64,
+    #[prost(uint64, tag="4")]
+    pub power_threshold: u64,
+    #[prost(string, tag="5")]
+    pub checkpoint: ::prost::alloc::string::String,
+}
+/// QuerySigningObligationsRequest asks for what the validator of
+/// validator_address still has to sign, or if that is empty every bonded and
+/// unbonding validator
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QuerySigningObligationsRequest {
+    #[prost(string, tag="1")]
+    pub validator_address: ::prost::alloc::string::String,
+}
+/// BatchObligation names a batch a validator still has to confirm
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BatchObligation {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub batch_nonce: u64,
+}
+/// ValidatorSigningObligations holds the valsets and batches a validator has
+/// not confirmed yet and would be slashed for once their window passed. An
+/// unbonding validator is exempt from the valsets and batches created at or
+/// after exempt_from_height, which is 0 for a bonded validator
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ValidatorSigningObligations {
+    #[prost(string, tag="1")]
+    pub validator_address: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub status: ::prost::alloc::string::String,
+    #[prost(uint64, tag="3")]
+    pub unbonding_height: u64,
+    #[prost(uint64, tag="4")]
+    pub exempt_from_height: u64,
+    #[prost(uint64, repeated, tag="5")]
+    pub valset_nonces: ::prost::alloc::vec::Vec<u64>,
+    #[prost(message, repeated, tag="6")]
+    pub batches: ::prost::alloc::vec::Vec<BatchObligation>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QuerySigningObligationsResponse {
+    #[prost(message, repeated, tag="1")]
+    pub obligations: ::prost::alloc::vec::Vec<ValidatorSigningObligations>,
+}
+/// QueryBridgeStatusRequest asks for an overview of the state of the bridge,
+/// as a monitoring dashboard shows it
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBridgeStatusRequest {
+}
+/// TokenBridgeStatus is the outgoing traffic of a token, the batches waiting to
+/// be executed on Ethereum and the transactions in the pool
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TokenBridgeStatus {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub pending_batches: u64,
+    #[prost(uint64, tag="3")]
+    pub unbatched_txs: u64,
+}
+/// unrelayed_valsets are the valsets after the last one observed on Ethereum,
+/// newest first and at most 5 of them. tokens are sorted by contract and
+/// pool_depth counts the transactions in the pool of every token
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBridgeStatusResponse {
+    #[prost(message, optional, tag="1")]
+    pub last_observed_ethereum_height: ::core::option::Option<LastObservedEthereumBlockHeight>,
+    #[prost(uint64, tag="2")]
+    pub last_observed_event_nonce: u64,
+    #[prost(uint64, tag="3")]
+    pub latest_valset_nonce: u64,
+    #[prost(uint64, tag="4")]
+    pub last_observed_valset_nonce: u64,
+    #[prost(message, repeated, tag="5")]
+    pub unrelayed_valsets: ::prost::alloc::vec::Vec<Valset>,
+    #[prost(message, repeated, tag="6")]
+    pub tokens: ::prost::alloc::vec::Vec<TokenBridgeStatus>,
+    #[prost(uint64, tag="7")]
+    pub pool_depth: u64,
+    #[prost(message, repeated, tag="8")]
+    pub module_balances: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+}
+/// QueryDepositByEthTxHashRequest looks a deposit up by the hash of the
+/// Ethereum transaction that made it, as reported by the orchestrators
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryDepositByEthTxHashRequest {
+    #[prost(string, tag="1")]
+    pub eth_tx_hash: ::prost::alloc::string::String,
+}
+/// receipt is only set once the deposit was minted or quarantined, and for as
+/// long as the receipt is kept
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryDepositByEthTxHashResponse {
+    #[prost(enumeration="DepositStatus", tag="1")]
+    pub status: i32,
+    #[prost(message, optional, tag="2")]
+    pub attestation: ::core::option::Option<Attestation>,
+    #[prost(message, optional, tag="3")]
+    pub receipt: ::core::option::Option<DepositReceipt>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBatchLifecycleRequest {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub batch_nonce: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBatchLifecycleResponse {
+    #[prost(message, optional, tag="1")]
+    pub lifecycle: ::core::option::Option<BatchLifecycle>,
+}
+/// QueryEventNonceGapRequest asks for the lowest event nonce that has not
+/// reached attestation consensus yet
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryEventNonceGapRequest {
+}
+/// pending_event_nonce is the event nonce after the last observed one, or 0 if
+/// no orchestrator has claimed it yet. first_claim_height is the block its
+/// first attestation was created at and pending_blocks how long ago that was.
+/// stalled is set once pending_blocks exceeds the event_nonce_stall_threshold
+/// param
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryEventNonceGapResponse {
+    #[prost(uint64, tag="1")]
+    pub last_observed_event_nonce: u64,
+    #[prost(uint64, tag="2")]
+    pub pending_event_nonce: u64,
+    #[prost(uint64, tag="3")]
+    pub attestations: u64,
+    #[prost(uint64, tag="4")]
+    pub first_claim_height: u64,
+    #[prost(uint64, tag="5")]
+    pub pending_blocks: u64,
+    #[prost(bool, tag="6")]
+    pub stalled: bool,
+}
+/// senders lists the Cosmos addresses of the fee_exempt_senders param
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryFeeExemptSendersRequest {
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryFeeExemptSendersResponse {
+    #[prost(string, repeated, tag="1")]
+    pub senders: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+/// DepositStatus is how far a deposit from Ethereum got on its way to the
+/// receiver
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum DepositStatus {
+    Unspecified = 0,
+    /// orchestrators are still voting on the deposit
+    Pending = 1,
+    /// the deposit was observed but has not been paid out, its attestation is
+    /// waiting to be applied or could not be. A paid out deposit reports this
+    /// status as well once its receipt is pruned
+    Observed = 2,
+    /// the deposit was paid out to the receiver
+    Minted = 3,
+    /// the deposit is held until governance releases it, see
+    /// ReleaseQuarantinedDepositProposal
+    Quarantined = 4,
+}
+# [doc = r" Generated client implementations."] pub mod query_client { # ! [allow (unused_variables , dead_code , missing_docs)] use tonic :: codegen :: * ; # [doc = " Query defines the gRPC querier service, every query is also served over REST by the gRPC gateway. The gateway"] # [doc = " matches routes in the order below and the first match wins, so a route with a path variable where another route"] # [doc = " has a literal segment must come after that route"] pub struct QueryClient < T > { inner : tonic :: client :: Grpc < T > , } impl QueryClient < tonic :: transport :: Channel > { # [doc = r" Attempt to create a new client by connecting to a given endpoint."] pub async fn connect < D > (dst : D) -> Result < Self , tonic :: transport :: Error > where D : std :: convert :: TryInto < tonic :: transport :: Endpoint > , D :: Error : Into < StdError > , { let conn = tonic :: transport :: Endpoint :: new (dst) ? . connect () . await ? ; Ok (Self :: new (conn)) } } impl < T > QueryClient < T > where T : tonic :: client :: GrpcService < tonic :: body :: BoxBody > , T :: ResponseBody : Body + HttpBody + Send + 'static , T :: Error : Into < StdError > , < T :: ResponseBody as HttpBody > :: Error : Into < StdError > + Send , { pub fn new (inner : T) -> Self { let inner = tonic :: client :: Grpc :: new (inner) ; Self { inner } } pub fn with_interceptor (inner : T , interceptor : impl Into < tonic :: Interceptor >) -> Self { let inner = tonic :: client :: Grpc :: with_interceptor (inner , interceptor) ; Self { inner } } # [doc = " Deployments queries deployments"] pub async fn params (& mut self , request : impl tonic :: IntoRequest < super :: QueryParamsRequest > ,) -> Result < tonic :: Response < super :: QueryParamsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/Params") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn current_valset (& mut self , request : impl tonic :: IntoRequest < super :: QueryCurrentValsetRequest > ,) -> Result < tonic :: Response < super :: QueryCurrentValsetResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/CurrentValset") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_request (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetRequestRequest > ,) -> Result < tonic :: Response < super :: QueryValsetRequestResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetRequest") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_confirm (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetConfirmRequest > ,) -> Result < tonic :: Response < super :: QueryValsetConfirmResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetConfirm") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_confirms_by_nonce (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetConfirmsByNonceRequest > ,) -> Result < tonic :: Response < super :: QueryValsetConfirmsByNonceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetConfirmsByNonce") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_valset_requests (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastValsetRequestsRequest > ,) -> Result < tonic :: Response < super :: QueryLastValsetRequestsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastValsetRequests") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_pending_valset_request_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastPendingValsetRequestByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastPendingValsetRequestByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastPendingValsetRequestByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_event_nonce_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastEventNonceByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastEventNonceByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastEventNonceByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_fees (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchFeeRequest > ,) -> Result < tonic :: Response < super :: QueryBatchFeeResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchFees") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn outgoing_tx_batches (& mut self , request : impl tonic :: IntoRequest < super :: QueryOutgoingTxBatchesRequest > ,) -> Result < tonic :: Response < super :: QueryOutgoingTxBatchesResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/OutgoingTxBatches") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn outgoing_logic_calls (& mut self , request : impl tonic :: IntoRequest < super :: QueryOutgoingLogicCallsRequest > ,) -> Result < tonic :: Response < super :: QueryOutgoingLogicCallsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/OutgoingLogicCalls") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_confirms (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchConfirmsRequest > ,) -> Result < tonic :: Response < super :: QueryBatchConfirmsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchConfirms") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn logic_confirms (& mut self , request : impl tonic :: IntoRequest < super :: QueryLogicConfirmsRequest > ,) -> Result < tonic :: Response < super :: QueryLogicConfirmsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LogicConfirms") ; self . inner . unary (request . into_request () , path , codec) . await } # [doc = " the queries below are still served on the paths they had before they moved under their own literal segment,"] # [doc = " those paths put a variable where the routes above have literal segments so they have to come after them"] pub async fn last_pending_batch_request_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastPendingBatchRequestByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastPendingBatchRequestByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastPendingBatchRequestByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_pending_logic_call_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastPendingLogicCallByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastPendingLogicCallByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastPendingLogicCallByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_request_by_nonce (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchRequestByNonceRequest > ,) -> Result < tonic :: Response < super :: QueryBatchRequestByNonceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchRequestByNonce") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn erc20_to_denom (& mut self , request : impl tonic :: IntoRequest < super :: QueryErc20ToDenomRequest > ,) -> Result < tonic :: Response < super :: QueryErc20ToDenomResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ERC20ToDenom") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn denom_to_erc20 (& mut self , request : impl tonic :: IntoRequest < super :: QueryDenomToErc20Request > ,) -> Result < tonic :: Response < super :: QueryDenomToErc20Response > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/DenomToERC20") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn cosmos_originated_tokens (& mut self , request : impl tonic :: IntoRequest < super :: QueryCosmosOriginatedTokensRequest > ,) -> Result < tonic :: Response < super :: QueryCosmosOriginatedTokensResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/CosmosOriginatedTokens") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_attestations (& mut self , request : impl tonic :: IntoRequest < super :: QueryAttestationsRequest > ,) -> Result < tonic :: Response < super :: QueryAttestationsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetAttestations") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_delegate_key_by_validator (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysByValidatorAddress > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysByValidatorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetDelegateKeyByValidator") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_delegate_key_by_eth (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysByEthAddress > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysByEthAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetDelegateKeyByEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_delegate_key_by_orchestrator (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysByOrchestratorAddress > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysByOrchestratorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetDelegateKeyByOrchestrator") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_pending_send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: QueryPendingSendToEth > ,) -> Result < tonic :: Response < super :: QueryPendingSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetPendingSendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn pending_send_to_eth_by_receiver (& mut self , request : impl tonic :: IntoRequest < super :: QueryPendingSendToEthByReceiverRequest > ,) -> Result < tonic :: Response < super :: QueryPendingSendToEthByReceiverResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/PendingSendToEthByReceiver") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn orchestrator_liveness (& mut self , request : impl tonic :: IntoRequest < super :: QueryOrchestratorLivenessRequest > ,) -> Result < tonic :: Response < super :: QueryOrchestratorLivenessResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/OrchestratorLiveness") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn observed_ethereum_height (& mut self , request : impl tonic :: IntoRequest < super :: QueryObservedEthereumHeightRequest > ,) -> Result < tonic :: Response < super :: QueryObservedEthereumHeightResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ObservedEthereumHeight") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn ethereum_block_time_calibration (& mut self , request : impl tonic :: IntoRequest < super :: QueryEthereumBlockTimeCalibrationRequest > ,) -> Result < tonic :: Response < super :: QueryEthereumBlockTimeCalibrationResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/EthereumBlockTimeCalibration") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn ethereum_gas_price (& mut self , request : impl tonic :: IntoRequest < super :: QueryEthereumGasPriceRequest > ,) -> Result < tonic :: Response < super :: QueryEthereumGasPriceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/EthereumGasPrice") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn projected_ethereum_height (& mut self , request : impl tonic :: IntoRequest < super :: QueryProjectedEthereumHeightRequest > ,) -> Result < tonic :: Response < super :: QueryProjectedEthereumHeightResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ProjectedEthereumHeight") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn attestation_votes (& mut self , request : impl tonic :: IntoRequest < super :: QueryAttestationVotesRequest > ,) -> Result < tonic :: Response < super :: QueryAttestationVotesResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/AttestationVotes") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn bridge_migration (& mut self , request : impl tonic :: IntoRequest < super :: QueryBridgeMigrationRequest > ,) -> Result < tonic :: Response < super :: QueryBridgeMigrationResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BridgeMigration") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn bridge_stats (& mut self , request : impl tonic :: IntoRequest < super :: QueryBridgeStatsRequest > ,) -> Result < tonic :: Response < super :: QueryBridgeStatsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BridgeStats") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn bridge_token_stats (& mut self , request : impl tonic :: IntoRequest < super :: QueryBridgeTokenStatsRequest > ,) -> Result < tonic :: Response < super :: QueryBridgeTokenStatsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BridgeTokenStats") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn solvency_report (& mut self , request : impl tonic :: IntoRequest < super :: QuerySolvencyReportRequest > ,) -> Result < tonic :: Response < super :: QuerySolvencyReportResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/SolvencyReport") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn replay_attestations (& mut self , request : impl tonic :: IntoRequest < super :: QueryReplayAttestationsRequest > ,) -> Result < tonic :: Response < super :: QueryReplayAttestationsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ReplayAttestations") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn timed_out_batches (& mut self , request : impl tonic :: IntoRequest < super :: QueryTimedOutBatchesRequest > ,) -> Result < tonic :: Response < super :: QueryTimedOutBatchesResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/TimedOutBatches") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn refund_receipts (& mut self , request : impl tonic :: IntoRequest < super :: QueryRefundReceiptsRequest > ,) -> Result < tonic :: Response < super :: QueryRefundReceiptsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/RefundReceipts") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn deposit_receipts (& mut self , request : impl tonic :: IntoRequest < super :: QueryDepositReceiptsRequest > ,) -> Result < tonic :: Response < super :: QueryDepositReceiptsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/DepositReceipts") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn quarantined_deposits (& mut self , request : impl tonic :: IntoRequest < super :: QueryQuarantinedDepositsRequest > ,) -> Result < tonic :: Response < super :: QueryQuarantinedDepositsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/QuarantinedDeposits") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn module_send_grants (& mut self , request : impl tonic :: IntoRequest < super :: QueryModuleSendGrantsRequest > ,) -> Result < tonic :: Response < super :: QueryModuleSendGrantsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ModuleSendGrants") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn bridge_instance (& mut self , request : impl tonic :: IntoRequest < super :: QueryBridgeInstanceRequest > ,) -> Result < tonic :: Response < super :: QueryBridgeInstanceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BridgeInstance") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn eth_destination_labels (& mut self , request : impl tonic :: IntoRequest < super :: QueryEthDestinationLabelsRequest > ,) -> Result < tonic :: Response < super :: QueryEthDestinationLabelsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/EthDestinationLabels") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn eth_destination_label (& mut self , request : impl tonic :: IntoRequest < super :: QueryEthDestinationLabelRequest > ,) -> Result < tonic :: Response < super :: QueryEthDestinationLabelResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/EthDestinationLabel") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn unbatched_txs_by_sender (& mut self , request : impl tonic :: IntoRequest < super :: QueryUnbatchedTxsBySenderRequest > ,) -> Result < tonic :: Response < super :: QueryUnbatchedTxsBySenderResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/UnbatchedTxsBySender") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn unbatched_txs (& mut self , request : impl tonic :: IntoRequest < super :: QueryUnbatchedTxsRequest > ,) -> Result < tonic :: Response < super :: QueryUnbatchedTxsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/UnbatchedTxs") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn first_send_delay (& mut self , request : impl tonic :: IntoRequest < super :: QueryFirstSendDelayRequest > ,) -> Result < tonic :: Response < super :: QueryFirstSendDelayResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/FirstSendDelay") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_deployment_args (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetDeploymentArgsRequest > ,) -> Result < tonic :: Response < super :: QueryValsetDeploymentArgsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetDeploymentArgs") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn audit_log (& mut self , request : impl tonic :: IntoRequest < super :: QueryAuditLogRequest > ,) -> Result < tonic :: Response < super :: QueryAuditLogResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/AuditLog") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn orchestrator_submissions (& mut self , request : impl tonic :: IntoRequest < super :: QueryOrchestratorSubmissionsRequest > ,) -> Result < tonic :: Response < super :: QueryOrchestratorSubmissionsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/OrchestratorSubmissions") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn simulate_proposal (& mut self , request : impl tonic :: IntoRequest < super :: QuerySimulateProposalRequest > ,) -> Result < tonic :: Response < super :: QuerySimulateProposalResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/SimulateProposal") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn pending_batch_preview (& mut self , request : impl tonic :: IntoRequest < super :: QueryPendingBatchPreviewRequest > ,) -> Result < tonic :: Response < super :: QueryPendingBatchPreviewResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/PendingBatchPreview") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn send_to_eth_fee_suggestion (& mut self , request : impl tonic :: IntoRequest < super :: QuerySendToEthFeeSuggestionRequest > ,) -> Result < tonic :: Response < super :: QuerySendToEthFeeSuggestionResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/SendToEthFeeSuggestion") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn historical_valsets (& mut self , request : impl tonic :: IntoRequest < super :: QueryHistoricalValsetsRequest > ,) -> Result < tonic :: Response < super :: QueryHistoricalValsetsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/HistoricalValsets") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn relay_signatures (& mut self , request : impl tonic :: IntoRequest < super :: QueryRelaySignaturesRequest > ,) -> Result < tonic :: Response < super :: QueryRelaySignaturesResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/RelaySignatures") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn signing_obligations (& mut self , request : impl tonic :: IntoRequest < super :: QuerySigningObligationsRequest > ,) -> Result < tonic :: Response < super :: QuerySigningObligationsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/SigningObligations") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn bridge_status (& mut self , request : impl tonic :: IntoRequest < super :: QueryBridgeStatusRequest > ,) -> Result < tonic :: Response < super :: QueryBridgeStatusResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BridgeStatus") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn deposit_by_eth_tx_hash (& mut self , request : impl tonic :: IntoRequest < super :: QueryDepositByEthTxHashRequest > ,) -> Result < tonic :: Response < super :: QueryDepositByEthTxHashResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/DepositByEthTxHash") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_lifecycle (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchLifecycleRequest > ,) -> Result < tonic :: Response < super :: QueryBatchLifecycleResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchLifecycle") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn event_nonce_gap (& mut self , request : impl tonic :: IntoRequest < super :: QueryEventNonceGapRequest > ,) -> Result < tonic :: Response < super :: QueryEventNonceGapResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/EventNonceGap") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn fee_exempt_senders (& mut self , request : impl tonic :: IntoRequest < super :: QueryFeeExemptSendersRequest > ,) -> Result < tonic :: Response < super :: QueryFeeExemptSendersResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/FeeExemptSenders") ; self . inner . unary (request . into_request () , path , codec) . await } } impl < T : Clone > Clone for QueryClient < T > { fn clone (& self) -> Self { Self { inner : self . inner . clone () , } } } impl < T > std :: fmt :: Debug for QueryClient < T > { fn fmt (& self , f : & mut std :: fmt :: Formatter < '_ >) -> std :: fmt :: Result { write ! (f , "QueryClient {{ ... }}") } } }// The typed events below are emitted next to the untyped events of the same
+// actions, they carry the full transfers so an indexer can follow a transfer
+// to Ethereum from the pool to its execution without reading state.
+//
+// Their attributes are the JSON encoded fields of the messages, so they only
+// change with the messages in this file and fields are only ever added. The
+// untyped events are deprecated, they are still emitted for existing
+// subscribers but their attributes are not kept stable. Every transaction and
+// EndBlock also emits a gravity event with an event_version attribute, which
+// is bumped whenever a typed event changes in a way a subscriber has to handle
+
+/// EventSendToEthAdded is emitted when a transfer to Ethereum enters the pool,
+/// amount and fee are in the token of token_contract
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventSendToEthAdded {
+    #[prost(uint64, tag="1")]
+    pub tx_id: u64,
+    #[prost(string, tag="2")]
+    pub sender: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub receiver: ::prost::alloc::string::String,
+    #[prost(string, tag="4")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(string, tag="5")]
+    pub amount: ::prost::alloc::string::String,
+    #[prost(string, tag="6")]
+    pub fee: ::prost::alloc::string::String,
+}
+/// EventOutgoingBatchCreated is emitted when a batch is built, transactions are
+/// the transfers as they will be sent to Ethereum
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventOutgoingBatchCreated {
+    #[prost(uint64, tag="1")]
+    pub batch_nonce: u64,
+    #[prost(string, tag="2")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag="3")]
+    pub batch_timeout: u64,
+    #[prost(message, repeated, tag="4")]
+    pub transactions: ::prost::alloc::vec::Vec<OutgoingTransferTx>,
+}
+/// EventOutgoingBatchExecuted is emitted when a batch is observed to have been
+/// executed on Ethereum
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventOutgoingBatchExecuted {
+    #[prost(uint64, tag="1")]
+    pub batch_nonce: u64,
+    #[prost(string, tag="2")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag="3")]
+    pub transactions: ::prost::alloc::vec::Vec<OutgoingTransferTx>,
+}
+/// EventOutgoingBatchTimedOut is emitted when a batch passes its timeout on
+/// Ethereum, transactions are the transfers returned to the pool
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventOutgoingBatchTimedOut {
+    #[prost(uint64, tag="1")]
+    pub batch_nonce: u64,
+    #[prost(string, tag="2")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag="3")]
+    pub batch_timeout: u64,
+    #[prost(message, repeated, tag="4")]
+    pub transactions: ::prost::alloc::vec::Vec<OutgoingTransferTx>,
+}
+/// EventEthereumHeightDrift is emitted when the Ethereum height reported by the
+/// orchestrator of a bonded validator falls more than the
+/// ethereum_height_drift_threshold param behind the median of the bonded
+/// validators. It is emitted once when the validator starts lagging and again
+/// only after it caught up in between
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventEthereumHeightDrift {
+    #[prost(string, tag="1")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub ethereum_block_height: u64,
+    #[prost(uint64, tag="3")]
+    pub median_height: u64,
+    #[prost(uint64, tag="4")]
+    pub lag: u64,
+}
+/// EventNonceStalled is emitted when the attestations for the event nonce
+/// after the last observed one went more than the event_nonce_stall_threshold
+/// param blocks without reaching consensus. It is emitted once per event nonce
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventNonceStalled {
+    #[prost(uint64, tag="1")]
+    pub event_nonce: u64,
+    #[prost(uint64, tag="2")]
+    pub first_claim_height: u64,
+    #[prost(uint64, tag="3")]
+    pub pending_blocks: u64,
+}
+/// EventSendToEthNeedsConfirmation is emitted when a transfer to Ethereum is
+/// held in the pool until its sender confirms its fee
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventSendToEthNeedsConfirmation {
+    #[prost(uint64, tag="1")]
+    pub tx_id: u64,
+}
+/// EventSendToEthDelayed is emitted when a transfer to Ethereum to an address
+/// its sender never used before is held in the pool until held_until
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventSendToEthDelayed {
+    #[prost(uint64, tag="1")]
+    pub tx_id: u64,
+    #[prost(uint64, tag="2")]
+    pub held_until: u64,
+}
+/// EventSendToEthReleased is emitted when the sender of a transfer to Ethereum
+/// confirms its fee and the transfer can be batched
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventSendToEthReleased {
+    #[prost(uint64, tag="1")]
+    pub tx_id: u64,
+}
+/// EventSendToEthFeeBumped is emitted when the sender of a transfer to Ethereum
+/// raises its fee, fee is the new total fee in the token of token_contract
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventSendToEthFeeBumped {
+    #[prost(uint64, tag="1")]
+    pub tx_id: u64,
+    #[prost(string, tag="2")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub fee: ::prost::alloc::string::String,
+}
+/// EventSendToEthRefunded is emitted when a transfer to Ethereum is taken out of
+/// the pool and its amount and fee are issued back to recipient
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventSendToEthRefunded {
+    #[prost(uint64, tag="1")]
+    pub tx_id: u64,
+    #[prost(string, tag="2")]
+    pub sender: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub recipient: ::prost::alloc::string::String,
+    #[prost(string, tag="4")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(string, tag="5")]
+    pub amount: ::prost::alloc::string::String,
+    #[prost(string, tag="6")]
+    pub fee: ::prost::alloc::string::String,
+    #[prost(enumeration="RefundReason", tag="7")]
+    pub reason: i32,
+}
+/// EventSendToEthExpired is emitted when a transfer to Ethereum waited in the
+/// pool past the pool timeout and was refunded
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventSendToEthExpired {
+    #[prost(uint64, tag="1")]
+    pub tx_id: u64,
+    #[prost(string, tag="2")]
+    pub sender: ::prost::alloc::string::String,
+    #[prost(uint64, tag="3")]
+    pub created_height: u64,
+}
+/// EventSendToEthCallback is emitted when a transfer to Ethereum with a callback
+/// is sent as a logic call, invalidation_id is hex encoded
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventSendToEthCallback {
+    #[prost(uint64, tag="1")]
+    pub tx_id: u64,
+    #[prost(string, tag="2")]
+    pub invalidation_id: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub target: ::prost::alloc::string::String,
+    #[prost(uint64, tag="4")]
+    pub timeout: u64,
+}
+/// EventPoolEvacuated is emitted when an EvacuatePoolProposal cancels every
+/// unexecuted batch and refunds the pool
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventPoolEvacuated {
+    #[prost(uint64, tag="1")]
+    pub canceled_batches: u64,
+    #[prost(uint64, tag="2")]
+    pub refunded_txs: u64,
+}
+/// EventOutgoingBatchCanceled is emitted when a batch is canceled before it was
+/// executed, transactions are the transfers returned to the pool. A batch that
+/// timed out emits EventOutgoingBatchTimedOut instead
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventOutgoingBatchCanceled {
+    #[prost(uint64, tag="1")]
+    pub batch_nonce: u64,
+    #[prost(string, tag="2")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag="3")]
+    pub transactions: ::prost::alloc::vec::Vec<OutgoingTransferTx>,
+}
+/// EventBatchTransfersMerged is emitted when transfers to the same destination
+/// are merged into the transfer tx_id of a batch
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventBatchTransfersMerged {
+    #[prost(uint64, tag="1")]
+    pub batch_nonce: u64,
+    #[prost(uint64, tag="2")]
+    pub tx_id: u64,
+    #[prost(uint64, repeated, tag="3")]
+    pub merged_tx_ids: ::prost::alloc::vec::Vec<u64>,
+}
+/// EventValsetUpdateRequested is emitted when a new valset is created for the
+/// validators to sign
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventValsetUpdateRequested {
+    #[prost(string, tag="1")]
+    pub bridge_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub bridge_chain_id: u64,
+    #[prost(message, optional, tag="3")]
+    pub valset: ::core::option::Option<Valset>,
+}
+/// EventValsetRewardFunded is emitted when amount is added to the valset reward
+/// reserve
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventValsetRewardFunded {
+    #[prost(string, tag="1")]
+    pub sender: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub amount: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+}
+/// EventValsetRewardShortfall is emitted when the valset reward reserve can not
+/// cover the burn of a reward paid on Ethereum
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventValsetRewardShortfall {
+    #[prost(message, optional, tag="1")]
+    pub reward: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    #[prost(message, optional, tag="2")]
+    pub shortfall: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+}
+/// EventAttestationObserved is emitted when an attestation is applied to the
+/// state, claim_hash is hex encoded
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventAttestationObserved {
+    #[prost(enumeration="ClaimType", tag="1")]
+    pub attestation_type: i32,
+    #[prost(uint64, tag="2")]
+    pub event_nonce: u64,
+    #[prost(string, tag="3")]
+    pub claim_hash: ::prost::alloc::string::String,
+    #[prost(uint64, tag="4")]
+    pub eth_block_timestamp: u64,
+}
+/// EventAttestationVetoed is emitted when governance vetoes an attestation,
+/// claim_hash is hex encoded
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventAttestationVetoed {
+    #[prost(uint64, tag="1")]
+    pub event_nonce: u64,
+    #[prost(string, tag="2")]
+    pub claim_hash: ::prost::alloc::string::String,
+}
+/// EventNonceResolved is emitted when governance moves the bridge past an event
+/// nonce, claim_hash is the hex encoded hash of the attestation that was
+/// observed or empty if the event nonce was skipped
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventNonceResolved {
+    #[prost(uint64, tag="1")]
+    pub event_nonce: u64,
+    #[prost(string, tag="2")]
+    pub claim_hash: ::prost::alloc::string::String,
+}
+/// EventOutgoingLogicCallCanceled is emitted when a logic call is canceled
+/// before it was executed, invalidation_id is hex encoded
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventOutgoingLogicCallCanceled {
+    #[prost(string, tag="1")]
+    pub invalidation_id: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub invalidation_nonce: u64,
+}
+/// EventDepositQuarantined is emitted when a deposit from a quarantined
+/// Ethereum sender is held instead of paid out
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventDepositQuarantined {
+    #[prost(uint64, tag="1")]
+    pub event_nonce: u64,
+    #[prost(string, tag="2")]
+    pub ethereum_sender: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub cosmos_receiver: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="4")]
+    pub amount: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+}
+/// EventDepositReleased is emitted when governance releases a quarantined
+/// deposit to cosmos_receiver
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventDepositReleased {
+    #[prost(uint64, tag="1")]
+    pub event_nonce: u64,
+    #[prost(string, tag="2")]
+    pub cosmos_receiver: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="3")]
+    pub amount: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+}
+/// EventRelayerLotteryWon is emitted when the relayer of an executed batch is
+/// paid the relayer lottery reward
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventRelayerLotteryWon {
+    #[prost(uint64, tag="1")]
+    pub event_nonce: u64,
+    #[prost(string, tag="2")]
+    pub relayer: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub receiver: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="4")]
+    pub reward: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+}
+/// EventBridgeMigrationStarted is emitted when governance starts the migration
+/// to a new bridge contract
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventBridgeMigrationStarted {
+    #[prost(string, tag="1")]
+    pub bridge_contract: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub new_bridge_contract: ::prost::alloc::string::String,
+}
+/// EventBridgeMigrationValset is emitted when the old bridge contract is
+/// drained and the valset to deploy the new contract with was created
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventBridgeMigrationValset {
+    #[prost(string, tag="1")]
+    pub new_bridge_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub valset_nonce: u64,
+}
+/// EventBridgeMigrationCompleted is emitted when the deployment of the new
+/// bridge contract is observed and the module switches over to it
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventBridgeMigrationCompleted {
+    #[prost(string, tag="1")]
+    pub bridge_contract: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub new_bridge_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag="3")]
+    pub valset_nonce: u64,
+}
+/// EventBridgeInstanceReset is emitted when governance makes the chain accept
+/// the claims of the previous bridge instance again
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventBridgeInstanceReset {
+    #[prost(string, tag="1")]
+    pub instance_id: ::prost::alloc::string::String,
+}
+/// EventModuleSendGrantUpdated is emitted when governance changes the send
+/// grant of a module, an empty cap removes the grant
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventModuleSendGrantUpdated {
+    #[prost(string, tag="1")]
+    pub module: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag="2")]
+    pub cap: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    #[prost(uint64, tag="3")]
+    pub epoch_blocks: u64,
 }
-# [doc = r" Generated client implementations."] pub mod query_client { # ! [allow (unused_variables , dead_code , missing_docs)] use tonic :: codegen :: * ; # [doc = " Query defines the gRPC querier service"] pub struct QueryClient < T > { inner : tonic :: client :: Grpc < T > , } impl QueryClient < tonic :: transport :: Channel > { # [doc = r" Attempt to create a new client by connecting to a given endpoint."] pub async fn connect < D > (dst : D) -> Result < Self , tonic :: transport :: Error > where D : std :: convert :: TryInto < tonic :: transport :: Endpoint > , D :: Error : Into < StdError > , { let conn = tonic :: transport :: Endpoint :: new (dst) ? . connect () . await ? ; Ok (Self :: new (conn)) } } impl < T > QueryClient < T > where T : tonic :: client :: GrpcService < tonic :: body :: BoxBody > , T :: ResponseBody : Body + HttpBody + Send + 'static , T :: Error : Into < StdError > , < T :: ResponseBody as HttpBody > :: Error : Into < StdError > + Send , { pub fn new (inner : T) -> Self { let inner = tonic :: client :: Grpc :: new (inner) ; Self { inner } } pub fn with_interceptor (inner : T , interceptor : impl Into < tonic :: Interceptor >) -> Self { let inner = tonic :: client :: Grpc :: with_interceptor (inner , interceptor) ; Self { inner } } # [doc = " Deployments queries deployments"] pub async fn params (& mut self , request : impl tonic :: IntoRequest < super :: QueryParamsRequest > ,) -> Result < tonic :: Response < super :: QueryParamsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/Params") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn current_valset (& mut self , request : impl tonic :: IntoRequest < super :: QueryCurrentValsetRequest > ,) -> Result < tonic :: Response < super :: QueryCurrentValsetResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/CurrentValset") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_request (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetRequestRequest > ,) -> Result < tonic :: Response < super :: QueryValsetRequestResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetRequest") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_confirm (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetConfirmRequest > ,) -> Result < tonic :: Response < super :: QueryValsetConfirmResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetConfirm") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_confirms_by_nonce (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetConfirmsByNonceRequest > ,) -> Result < tonic :: Response < super :: QueryValsetConfirmsByNonceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetConfirmsByNonce") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_valset_requests (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastValsetRequestsRequest > ,) -> Result < tonic :: Response < super :: QueryLastValsetRequestsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastValsetRequests") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_pending_valset_request_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastPendingValsetRequestByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastPendingValsetRequestByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastPendingValsetRequestByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_pending_batch_request_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastPendingBatchRequestByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastPendingBatchRequestByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastPendingBatchRequestByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_pending_logic_call_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastPendingLogicCallByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastPendingLogicCallByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastPendingLogicCallByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_event_nonce_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastEventNonceByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastEventNonceByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastEventNonceByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_fees (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchFeeRequest > ,) -> Result < tonic :: Response < super :: QueryBatchFeeResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchFees") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn outgoing_tx_batches (& mut self , request : impl tonic :: IntoRequest < super :: QueryOutgoingTxBatchesRequest > ,) -> Result < tonic :: Response < super :: QueryOutgoingTxBatchesResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/OutgoingTxBatches") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn outgoing_logic_calls (& mut self , request : impl tonic :: IntoRequest < super :: QueryOutgoingLogicCallsRequest > ,) -> Result < tonic :: Response < super :: QueryOutgoingLogicCallsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/OutgoingLogicCalls") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_request_by_nonce (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchRequestByNonceRequest > ,) -> Result < tonic :: Response < super :: QueryBatchRequestByNonceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchRequestByNonce") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_confirms (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchConfirmsRequest > ,) -> Result < tonic :: Response < super :: QueryBatchConfirmsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchConfirms") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn logic_confirms (& mut self , request : impl tonic :: IntoRequest < super :: QueryLogicConfirmsRequest > ,) -> Result < tonic :: Response < super :: QueryLogicConfirmsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LogicConfirms") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn erc20_to_denom (& mut self , request : impl tonic :: IntoRequest < super :: QueryErc20ToDenomRequest > ,) -> Result < tonic :: Response < super :: QueryErc20ToDenomResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ERC20ToDenom") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn denom_to_erc20 (& mut self , request : impl tonic :: IntoRequest < super :: QueryDenomToErc20Request > ,) -> Result < tonic :: Response < super :: QueryDenomToErc20Response > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/DenomToERC20") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_attestations (& mut self , request : impl tonic :: IntoRequest < super :: QueryAttestationsRequest > ,) -> Result < tonic :: Response < super :: QueryAttestationsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetAttestations") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_delegate_key_by_validator (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysByValidatorAddress > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysByValidatorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetDelegateKeyByValidator") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_delegate_key_by_eth (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysByEthAddress > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysByEthAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetDelegateKeyByEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_delegate_key_by_orchestrator (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysByOrchestratorAddress > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysByOrchestratorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetDelegateKeyByOrchestrator") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_pending_send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: QueryPendingSendToEth > ,) -> Result < tonic :: Response < super :: QueryPendingSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetPendingSendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } } impl < T : Clone > Clone for QueryClient < T > { fn clone (& self) -> Self { Self { inner : self . inner . clone () , } } } impl < T > std :: fmt :: Debug for QueryClient < T > { fn fmt (& self , f : & mut std :: fmt :: Formatter < '_ >) -> std :: fmt :: Result { write ! (f , "QueryClient {{ ... }}") } } }
\ No newline at end of file
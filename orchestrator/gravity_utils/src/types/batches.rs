@@ -161,6 +161,11 @@ impl Into<gravity_proto::gravity::OutgoingTransferTx> for &BatchTransaction {
             dest_address: self.destination.to_string(),
             erc20_token: Some(self.erc20_token.clone().into()),
             erc20_fee: Some(self.erc20_fee.clone().into()),
+            needs_confirmation: false,
+            held_until: 0,
+            created_height: 0,
+            priority: 0,
+            payload: Vec::new(),
         }
     }
 }
@@ -456,6 +456,7 @@ impl Into<gravity_proto::gravity::Valset> for &Valset {
                 .reward_token
                 .unwrap_or(*clarity::constants::ZERO_ADDRESS)
                 .to_string(),
+            power_threshold: 0,
         }
     }
 }
@@ -62,10 +62,13 @@ GAIA_HOME="--home /validator$i"
 ARGS="$GAIA_HOME --keyring-backend test"
 ORCHESTRATOR_KEY=$($BIN keys show orchestrator$i -a $ARGS)
 ETHEREUM_KEY=$(grep address /validator-eth-keys | sed -n "$i"p | sed 's/.*://')
+ETHEREUM_PRIVATE_KEY=$(grep private /validator-eth-keys | sed -n "$i"p | sed 's/.*://')
+VALIDATOR_OPERATOR=$($BIN keys show validator$i --bech val -a $ARGS)
+ETHEREUM_SIGNATURE=$($BIN eth_keys sign-validator-address $VALIDATOR_OPERATOR $ETHEREUM_PRIVATE_KEY)
 # the /8 containing 7.7.7.7 is assigned to the DOD and never routable on the public internet
 # we're using it in private to prevent gaia from blacklisting it as unroutable
 # and allow local pex
-$BIN gentx $ARGS $GAIA_HOME --moniker validator$i --chain-id=$CHAIN_ID --ip 7.7.7.$i validator$i 500000000stake $ETHEREUM_KEY $ORCHESTRATOR_KEY
+$BIN gentx $ARGS $GAIA_HOME --moniker validator$i --chain-id=$CHAIN_ID --ip 7.7.7.$i validator$i 500000000stake $ETHEREUM_KEY $ORCHESTRATOR_KEY $ETHEREUM_SIGNATURE
 # obviously we don't need to copy validator1's gentx to itself
 if [ $i -gt 1 ]; then
 cp /validator$i/config/gentx/* /validator1/config/gentx/